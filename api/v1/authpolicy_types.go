@@ -122,6 +122,10 @@ func (p *AuthPolicy) Rules() map[string]MergeableRule {
 		rules["conditions#"] = NewMergeableRule(&whenPredicates, policyLocator)
 	}
 
+	if serviceSettings := spec.MergeableServiceSettings; serviceSettings.IsSet() {
+		rules["service#"] = NewMergeableRule(&serviceSettings, policyLocator)
+	}
+
 	if spec.AuthScheme == nil {
 		return rules
 	}
@@ -175,6 +179,7 @@ func (p *AuthPolicy) SetRules(rules map[string]MergeableRule) {
 	p.Spec.Proper().NamedPatterns = nil
 	p.Spec.Proper().Predicates = nil
 	p.Spec.Proper().AuthScheme = nil
+	p.Spec.Proper().MergeableServiceSettings = MergeableServiceSettings{}
 
 	ensureNamedPatterns := func() {
 		if p.Spec.Proper().NamedPatterns == nil {
@@ -253,6 +258,8 @@ func (p *AuthPolicy) SetRules(rules map[string]MergeableRule) {
 			p.Spec.Proper().NamedPatterns[ruleID] = *rule.(*MergeablePatternExpressions)
 		case "conditions":
 			p.Spec.Proper().MergeableWhenPredicates = *rule.(*MergeableWhenPredicates)
+		case "service":
+			p.Spec.Proper().MergeableServiceSettings = *rule.(*MergeableServiceSettings)
 		case "authentication":
 			ensureAuthentication()
 			p.Spec.Proper().AuthScheme.Authentication[ruleID] = *rule.(*MergeableAuthenticationSpec)
@@ -281,6 +288,11 @@ func (p *AuthPolicy) SetRules(rules map[string]MergeableRule) {
 	}
 }
 
+// IsAudit returns true if the denials of the policy are only logged and reported, but not enforced
+func (p *AuthPolicy) IsAudit() bool {
+	return p.Spec.Proper().Mode == AuthPolicyModeAudit
+}
+
 func (p *AuthPolicy) GetStatus() kuadrantgatewayapi.PolicyStatus {
 	return &p.Status
 }
@@ -289,8 +301,8 @@ func (p *AuthPolicy) Kind() string {
 	return AuthPolicyGroupKind.Kind
 }
 
-// +kubebuilder:validation:XValidation:rule="!(has(self.defaults) && (has(self.patterns) || has(self.when) || has(self.rules)))",message="Implicit and explicit defaults are mutually exclusive"
-// +kubebuilder:validation:XValidation:rule="!(has(self.overrides) && (has(self.patterns) || has(self.when) || has(self.rules)))",message="Implicit defaults and explicit overrides are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.defaults) && (has(self.patterns) || has(self.when) || has(self.rules) || has(self.mode) || has(self.failureMode) || has(self.timeout)))",message="Implicit and explicit defaults are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.overrides) && (has(self.patterns) || has(self.when) || has(self.rules) || has(self.mode) || has(self.failureMode) || has(self.timeout)))",message="Implicit defaults and explicit overrides are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!(has(self.overrides) && has(self.defaults))",message="Explicit overrides and explicit defaults are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!(has(self.overrides) || has(self.defaults)) ? has(self.rules) && ((has(self.rules.authentication) && size(self.rules.authentication) > 0) || (has(self.rules.metadata) && size(self.rules.metadata) > 0) || (has(self.rules.authorization) && size(self.rules.authorization) > 0) || (has(self.rules.response) && (has(self.rules.response.unauthenticated) || has(self.rules.response.unauthorized) || (has(self.rules.response.success) && (size(self.rules.response.success.headers) > 0 ||  size(self.rules.response.success.filters) > 0)))) || (has(self.rules.callbacks) && size(self.rules.callbacks) > 0)) : true",message="At least one spec.rules must be defined"
 // +kubebuilder:validation:XValidation:rule="has(self.defaults) ? has(self.defaults.rules) && ((has(self.defaults.rules.authentication) && size(self.defaults.rules.authentication) > 0) || (has(self.defaults.rules.metadata) && size(self.defaults.rules.metadata) > 0) || (has(self.defaults.rules.authorization) && size(self.defaults.rules.authorization) > 0) || (has(self.defaults.rules.response) && (has(self.defaults.rules.response.unauthenticated) || has(self.defaults.rules.response.unauthorized) || (has(self.defaults.rules.response.success) && (size(self.defaults.rules.response.success.headers) > 0 ||  size(self.defaults.rules.response.success.filters) > 0)))) || (has(self.defaults.rules.callbacks) && size(self.defaults.rules.callbacks) > 0)) : true",message="At least one spec.defaults.rules must be defined"
@@ -298,7 +310,7 @@ func (p *AuthPolicy) Kind() string {
 type AuthPolicySpec struct {
 	// Reference to the object to which this policy applies.
 	// +kubebuilder:validation:XValidation:rule="self.group == 'gateway.networking.k8s.io'",message="Invalid targetRef.group. The only supported value is 'gateway.networking.k8s.io'"
-	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute' and 'Gateway'"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'GRPCRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute', 'GRPCRoute' and 'Gateway'"
 	TargetRef gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
 
 	// Rules to apply as defaults. Can be overridden by more specific policiy rules lower in the hierarchy and by less specific policy overrides.
@@ -352,8 +364,27 @@ type AuthPolicySpecProper struct {
 	// The auth rules of the policy.
 	// See Authorino's AuthConfig CRD for more details.
 	AuthScheme *AuthSchemeSpec `json:"rules,omitempty"`
+
+	// Mode of the policy. In audit mode, the auth rules are evaluated and denials are logged and reported in
+	// the metrics, but the requests are allowed. Audit mode requires a wasm-shim that supports the action-mode feature.
+	// Defaults to enforce.
+	// +optional
+	Mode AuthPolicyMode `json:"mode,omitempty"`
+
+	// Settings of the calls to Authorino
+	// +optional
+	MergeableServiceSettings `json:""`
 }
 
+// AuthPolicyMode defines whether the denials of an AuthPolicy are enforced
+// +kubebuilder:validation:Enum=enforce;audit
+type AuthPolicyMode string
+
+const (
+	AuthPolicyModeEnforce AuthPolicyMode = "enforce"
+	AuthPolicyModeAudit   AuthPolicyMode = "audit"
+)
+
 type AuthSchemeSpec struct {
 	// Authentication configs.
 	// At least one config MUST evaluate to a valid identity object for the auth request to be successful.
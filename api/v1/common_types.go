@@ -62,3 +62,47 @@ func (p *MergeableWhenPredicates) WithSource(source string) MergeableRule {
 	p.Source = source
 	return p
 }
+
+// FailureMode defines what happens to the request when the service enforcing a policy cannot be reached
+// +kubebuilder:validation:Enum=allow;deny
+type FailureMode string
+
+const (
+	FailureModeAllow FailureMode = "allow"
+	FailureModeDeny  FailureMode = "deny"
+)
+
+// MergeableServiceSettings defines how the gateway calls the service enforcing a policy, i.e. Authorino or Limitador.
+// Unset fields fall back to the defaults of the operator.
+type MergeableServiceSettings struct {
+	// FailureMode sets whether the requests are allowed or denied when the service fails or times out.
+	// +optional
+	FailureMode FailureMode `json:"failureMode,omitempty"`
+
+	// Timeout of the calls to the service.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// Source stores the locator of the policy where the settings are orignaly defined (internal use)
+	Source string `json:"-"`
+}
+
+var _ MergeableRule = &MergeableServiceSettings{}
+
+func (s *MergeableServiceSettings) GetSpec() any {
+	return s
+}
+
+func (s *MergeableServiceSettings) GetSource() string {
+	return s.Source
+}
+
+func (s *MergeableServiceSettings) WithSource(source string) MergeableRule {
+	s.Source = source
+	return s
+}
+
+// IsSet returns true if any of the settings is set
+func (s *MergeableServiceSettings) IsSet() bool {
+	return s != nil && (s.FailureMode != "" || s.Timeout != nil)
+}
@@ -61,8 +61,11 @@ type DNSPolicySpec struct {
 	// +optional
 	LoadBalancing *LoadBalancingSpec `json:"loadBalancing,omitempty"`
 
-	// providerRefs is a list of references to provider secrets. Max is one but intention is to allow this to be more in the future
-	// +kubebuilder:validation:MaxItems=1
+	// providerRefs is a list of references to provider secrets.
+	// A DNSRecord is created for each combination of provider and hostname, e.g. to publish the records to both an
+	// internal and a public DNS provider (split-horizon DNS).
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:XValidation:rule="self.all(p, self.exists_one(q, q.name == p.name))",message="providerRefs must be unique"
 	// +optional
 	ProviderRefs []dnsv1alpha1.ProviderRef `json:"providerRefs"`
 
@@ -70,6 +73,13 @@ type DNSPolicySpec struct {
 	// +optional
 	ExcludeAddresses ExcludeAddresses `json:"excludeAddresses,omitempty"`
 
+	// routeHostnames enables publishing a DNSRecord for each explicit hostname of the routes attached to the targeted
+	// listeners, in addition to the record for the hostname of the listener. Wildcard route hostnames are not published.
+	// This allows listeners with a wildcard hostname, or with no hostname at all, to publish records for the exact
+	// hostnames of their routes.
+	// +optional
+	RouteHostnames bool `json:"routeHostnames,omitempty"`
+
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="delegate is immutable"
 	Delegate bool `json:"delegate,omitempty"`
 }
@@ -143,8 +153,16 @@ type DNSPolicyStatus struct {
 	// +optional
 	HealthCheck *dnsv1alpha1.HealthCheckStatus `json:"healthCheck,omitempty"`
 
+	// recordConditions are the not healthy conditions of the DNSRecords of the policy, by root host
 	// +optional
 	RecordConditions map[string][]metav1.Condition `json:"recordConditions,omitempty"`
+
+	// providerRecordConditions are the not healthy conditions of the DNSRecords of the policy, by provider and root host.
+	// Only the providers with not healthy DNSRecords are listed.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ProviderRecordConditions []ProviderRecordConditions `json:"providerRecordConditions,omitempty"`
 	// TotalRecords records the total number of individual DNSRecords managed by this DNSPolicy
 	// +optional
 	TotalRecords int32 `json:"totalRecords,omitempty"`
@@ -154,6 +172,15 @@ func (s *DNSPolicyStatus) GetConditions() []metav1.Condition {
 	return s.Conditions
 }
 
+// ProviderRecordConditions are the not healthy conditions of the DNSRecords of a provider of the policy
+type ProviderRecordConditions struct {
+	// name is the name of the provider secret
+	Name string `json:"name"`
+
+	// recordConditions are the not healthy conditions of the DNSRecords of the provider, by root host
+	RecordConditions map[string][]metav1.Condition `json:"recordConditions"`
+}
+
 var _ kuadrant.Policy = &DNSPolicy{}
 
 // +kubebuilder:object:root=true
@@ -36,6 +36,7 @@ var (
 	// Top level predicate rules key starting with # to prevent conflict with limit names
 	// TODO(eastizle): this coupling between limit names and rule IDs is a bad smell. Merging implementation should be enhanced.
 	RulesKeyTopLevelPredicates = "###_TOP_LEVEL_PREDICATES_###"
+	RulesKeyServiceSettings    = "###_SERVICE_SETTINGS_###"
 )
 
 // +kubebuilder:object:root=true
@@ -118,8 +119,15 @@ func (p *RateLimitPolicy) Rules() map[string]MergeableRule {
 		rules[RulesKeyTopLevelPredicates] = NewMergeableRule(&whenPredicates, policyLocator)
 	}
 
+	if serviceSettings := spec.MergeableServiceSettings; serviceSettings.IsSet() {
+		rules[RulesKeyServiceSettings] = NewMergeableRule(&serviceSettings, policyLocator)
+	}
+
 	for ruleID := range spec.Limits {
 		limit := spec.Limits[ruleID]
+		if limit.Mode == "" {
+			limit.Mode = spec.Mode
+		}
 		rules[ruleID] = NewMergeableRule(&limit, policyLocator)
 	}
 
@@ -130,15 +138,19 @@ func (p *RateLimitPolicy) SetRules(rules map[string]MergeableRule) {
 	// clear all rules of the policy before setting new ones
 	p.Spec.Proper().Limits = nil
 	p.Spec.Proper().Predicates = nil
+	p.Spec.Proper().MergeableServiceSettings = MergeableServiceSettings{}
 
 	if len(rules) > 0 {
 		p.Spec.Proper().Limits = make(map[string]Limit)
 	}
 
 	for ruleID := range rules {
-		if ruleID == RulesKeyTopLevelPredicates {
+		switch ruleID {
+		case RulesKeyTopLevelPredicates:
 			p.Spec.Proper().MergeableWhenPredicates = *rules[ruleID].(*MergeableWhenPredicates)
-		} else {
+		case RulesKeyServiceSettings:
+			p.Spec.Proper().MergeableServiceSettings = *rules[ruleID].(*MergeableServiceSettings)
+		default:
 			p.Spec.Proper().Limits[ruleID] = *rules[ruleID].(*Limit)
 		}
 	}
@@ -158,10 +170,11 @@ func (p *RateLimitPolicy) Kind() string {
 // +kubebuilder:validation:XValidation:rule="!(has(self.overrides) || has(self.defaults)) ? has(self.limits) && size(self.limits) > 0 : true",message="At least one spec.limits must be defined"
 // +kubebuilder:validation:XValidation:rule="has(self.overrides) ? has(self.overrides.limits) && size(self.overrides.limits) > 0 : true",message="At least one spec.overrides.limits must be defined"
 // +kubebuilder:validation:XValidation:rule="has(self.defaults) ? has(self.defaults.limits) && size(self.defaults.limits) > 0 : true",message="At least one spec.defaults.limits must be defined"
+// +kubebuilder:validation:XValidation:rule="!((has(self.defaults) || has(self.overrides)) && (has(self.mode) || has(self.failureMode) || has(self.timeout)))",message="Implicit settings and explicit defaults or overrides are mutually exclusive"
 type RateLimitPolicySpec struct {
 	// Reference to the object to which this policy applies.
 	// +kubebuilder:validation:XValidation:rule="self.group == 'gateway.networking.k8s.io'",message="Invalid targetRef.group. The only supported value is 'gateway.networking.k8s.io'"
-	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute' and 'Gateway'"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'GRPCRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute', 'GRPCRoute' and 'Gateway'"
 	TargetRef gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
 
 	// Rules to apply as defaults. Can be overridden by more specific policiy rules lower in the hierarchy and by less specific policy overrides.
@@ -209,8 +222,29 @@ type RateLimitPolicySpecProper struct {
 	// Limits holds the struct of limits indexed by a unique name
 	// +optional
 	Limits map[string]Limit `json:"limits,omitempty"`
+
+	// Mode of the limits that do not set their own. Defaults to enforce.
+	// +optional
+	Mode LimitMode `json:"mode,omitempty"`
+
+	// Settings of the calls to Limitador
+	// +optional
+	MergeableServiceSettings `json:""`
 }
 
+// LimitMode defines whether requests over a limit are rejected
+// +kubebuilder:validation:Enum=enforce;shadow
+type LimitMode string
+
+const (
+	// LimitModeEnforce rejects the requests over the limit
+	LimitModeEnforce LimitMode = "enforce"
+
+	// LimitModeShadow counts the requests against the limit without ever rejecting them.
+	// The counters of shadow limits are incremented without being checked, in a separate Limitador namespace.
+	LimitModeShadow LimitMode = "shadow"
+)
+
 type Counter struct {
 	Expression Expression `json:"expression"`
 }
@@ -231,10 +265,19 @@ type Limit struct {
 	// +optional
 	Rates []Rate `json:"rates,omitempty"`
 
+	// Mode of the limit. Defaults to the mode of the policy.
+	// +optional
+	Mode LimitMode `json:"mode,omitempty"`
+
 	// Source stores the locator of the policy where the limit is orignaly defined (internal use)
 	Source string `json:"-"`
 }
 
+// IsShadow returns true if the requests over the limit are counted but not rejected
+func (l Limit) IsShadow() bool {
+	return l.Mode == LimitModeShadow
+}
+
 func (l Limit) CountersAsStringList() []string {
 	if len(l.Counters) == 0 {
 		return nil
@@ -320,6 +363,11 @@ type RateLimitPolicyStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// ShadowLimits lists the names of the limits of the policy in shadow mode, i.e. whose requests are counted
+	// but never rejected. Only reported while the policy is enforced.
+	// +optional
+	ShadowLimits []string `json:"shadowLimits,omitempty"`
 }
 
 func (s *RateLimitPolicyStatus) GetConditions() []metav1.Condition {
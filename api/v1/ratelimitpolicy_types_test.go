@@ -95,3 +95,62 @@ func TestConvertRateIntoSeconds(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitPolicyRulesMode(t *testing.T) {
+	policy := &RateLimitPolicy{
+		Spec: RateLimitPolicySpec{
+			RateLimitPolicySpecProper: RateLimitPolicySpecProper{
+				Mode: LimitModeShadow,
+				Limits: map[string]Limit{
+					"inherited": {},
+					"enforced":  {Mode: LimitModeEnforce},
+				},
+			},
+		},
+	}
+
+	rules := policy.Rules()
+	if !rules["inherited"].GetSpec().(*Limit).IsShadow() {
+		t.Errorf("expected limit without mode to inherit the shadow mode of the policy")
+	}
+	if rules["enforced"].GetSpec().(*Limit).IsShadow() {
+		t.Errorf("expected limit mode to take precedence over the mode of the policy")
+	}
+	if policy.Spec.Limits["inherited"].Mode != "" {
+		t.Errorf("expected the policy spec not to be mutated")
+	}
+}
+
+func TestRateLimitPolicyRulesServiceSettings(t *testing.T) {
+	policy := &RateLimitPolicy{
+		Spec: RateLimitPolicySpec{
+			RateLimitPolicySpecProper: RateLimitPolicySpecProper{
+				MergeableServiceSettings: MergeableServiceSettings{
+					FailureMode: FailureModeDeny,
+				},
+				Limits: map[string]Limit{
+					"limit": {},
+				},
+			},
+		},
+	}
+
+	rules := policy.Rules()
+	if _, ok := rules[RulesKeyServiceSettings]; !ok {
+		t.Fatalf("expected the service settings to be a rule of the policy")
+	}
+
+	effectivePolicy := &RateLimitPolicy{}
+	effectivePolicy.SetRules(rules)
+	if effectivePolicy.Spec.FailureMode != FailureModeDeny {
+		t.Errorf("expected failure mode %s, got %s", FailureModeDeny, effectivePolicy.Spec.FailureMode)
+	}
+	if len(effectivePolicy.Spec.Limits) != 1 {
+		t.Errorf("expected 1 limit, got %d", len(effectivePolicy.Spec.Limits))
+	}
+
+	policy.Spec.FailureMode = ""
+	if _, ok := policy.Rules()[RulesKeyServiceSettings]; ok {
+		t.Errorf("expected no service settings rule when no settings are set")
+	}
+}
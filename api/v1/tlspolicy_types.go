@@ -44,6 +44,14 @@ type TLSPolicySpec struct {
 	// +kubebuilder:validation:XValidation:rule="self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'Gateway'"
 	TargetRef gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
 
+	// RouteHostnames issues the certificate of a listener for the explicit hostnames of the routes attached to the
+	// listener (as subject alternative names), instead of for the hostname of the listener.
+	// Wildcard route hostnames are not included. Use it with wildcard listeners when the issuer does not issue wildcard
+	// certificates. The certificate is updated as routes are attached to and detached from the listener, and it is
+	// still stored in the secret referenced by the listener.
+	// +optional
+	RouteHostnames bool `json:"routeHostnames,omitempty"`
+
 	CertificateSpec `json:",inline"`
 }
 
@@ -120,6 +128,41 @@ type TLSPolicyStatus struct {
 	// recorded in the status condition
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// certificates are the cert-manager Certificates managed by the policy, with their expiry and renewal health
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+}
+
+// CertificateStatus is the observed state of a cert-manager Certificate managed by a TLSPolicy
+type CertificateStatus struct {
+	// name is the name of the Certificate
+	Name string `json:"name"`
+
+	// namespace is the namespace of the Certificate
+	Namespace string `json:"namespace"`
+
+	// gateway is the name of the Gateway the Certificate is issued for
+	Gateway string `json:"gateway"`
+
+	// listener is the name of the Gateway listener the Certificate is issued for
+	Listener string `json:"listener"`
+
+	// notAfter is the expiration time of the current certificate
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// renewalTime is the time at which the certificate will next be renewed
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+
+	// lastFailureTime is the time of the last failed attempt to issue the certificate
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// lastFailureReason is the reason of the last failed attempt to issue the certificate
+	// +optional
+	LastFailureReason string `json:"lastFailureReason,omitempty"`
 }
 
 func (s *TLSPolicyStatus) GetConditions() []metav1.Condition {
@@ -130,6 +130,7 @@ func (in *AuthPolicySpecProper) DeepCopyInto(out *AuthPolicySpecProper) {
 		*out = new(AuthSchemeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	in.MergeableServiceSettings.DeepCopyInto(&out.MergeableServiceSettings)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthPolicySpecProper.
@@ -253,6 +254,33 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Counter) DeepCopyInto(out *Counter) {
 	*out = *in
@@ -396,6 +424,13 @@ func (in *DNSPolicyStatus) DeepCopyInto(out *DNSPolicyStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.ProviderRecordConditions != nil {
+		in, out := &in.ProviderRecordConditions, &out.ProviderRecordConditions
+		*out = make([]ProviderRecordConditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPolicyStatus.
@@ -646,6 +681,26 @@ func (in *MergeableResponseSpec) DeepCopy() *MergeableResponseSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeableServiceSettings) DeepCopyInto(out *MergeableServiceSettings) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeableServiceSettings.
+func (in *MergeableServiceSettings) DeepCopy() *MergeableServiceSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeableServiceSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MergeableSuccessResponseSpec) DeepCopyInto(out *MergeableSuccessResponseSpec) {
 	*out = *in
@@ -726,6 +781,39 @@ func (in *Predicate) DeepCopy() *Predicate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderRecordConditions) DeepCopyInto(out *ProviderRecordConditions) {
+	*out = *in
+	if in.RecordConditions != nil {
+		in, out := &in.RecordConditions, &out.RecordConditions
+		*out = make(map[string][]metav1.Condition, len(*in))
+		for key, val := range *in {
+			var outVal []metav1.Condition
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]metav1.Condition, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderRecordConditions.
+func (in *ProviderRecordConditions) DeepCopy() *ProviderRecordConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderRecordConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rate) DeepCopyInto(out *Rate) {
 	*out = *in
@@ -838,6 +926,7 @@ func (in *RateLimitPolicySpecProper) DeepCopyInto(out *RateLimitPolicySpecProper
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	in.MergeableServiceSettings.DeepCopyInto(&out.MergeableServiceSettings)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicySpecProper.
@@ -860,6 +949,11 @@ func (in *RateLimitPolicyStatus) DeepCopyInto(out *RateLimitPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShadowLimits != nil {
+		in, out := &in.ShadowLimits, &out.ShadowLimits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicyStatus.
@@ -958,6 +1052,13 @@ func (in *TLSPolicyStatus) DeepCopyInto(out *TLSPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicyStatus.
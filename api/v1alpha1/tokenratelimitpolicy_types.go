@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
+
 	"github.com/kuadrant/policy-machinery/machinery"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -113,6 +115,10 @@ func (p *TokenRateLimitPolicy) Rules() map[string]kuadrantv1.MergeableRule {
 		rules[kuadrantv1.RulesKeyTopLevelPredicates] = kuadrantv1.NewMergeableRule(&whenPredicates, policyLocator)
 	}
 
+	if serviceSettings := spec.MergeableServiceSettings; serviceSettings.IsSet() {
+		rules[kuadrantv1.RulesKeyServiceSettings] = kuadrantv1.NewMergeableRule(&serviceSettings, policyLocator)
+	}
+
 	for ruleID := range spec.Limits {
 		limit := spec.Limits[ruleID]
 		rules[ruleID] = kuadrantv1.NewMergeableRule(&limit, policyLocator)
@@ -125,15 +131,19 @@ func (p *TokenRateLimitPolicy) SetRules(rules map[string]kuadrantv1.MergeableRul
 	// clear all rules of the policy before setting new ones
 	p.Spec.Proper().Limits = nil
 	p.Spec.Proper().MergeableWhenPredicates = kuadrantv1.MergeableWhenPredicates{}
+	p.Spec.Proper().MergeableServiceSettings = kuadrantv1.MergeableServiceSettings{}
 
 	if len(rules) > 0 {
 		p.Spec.Proper().Limits = make(map[string]TokenLimit)
 	}
 
 	for ruleID := range rules {
-		if ruleID == kuadrantv1.RulesKeyTopLevelPredicates {
+		switch ruleID {
+		case kuadrantv1.RulesKeyTopLevelPredicates:
 			p.Spec.Proper().MergeableWhenPredicates = *rules[ruleID].(*kuadrantv1.MergeableWhenPredicates)
-		} else {
+		case kuadrantv1.RulesKeyServiceSettings:
+			p.Spec.Proper().MergeableServiceSettings = *rules[ruleID].(*kuadrantv1.MergeableServiceSettings)
+		default:
 			p.Spec.Proper().Limits[ruleID] = *rules[ruleID].(*TokenLimit)
 		}
 	}
@@ -153,10 +163,11 @@ func (p *TokenRateLimitPolicy) Kind() string {
 // +kubebuilder:validation:XValidation:rule="!(has(self.overrides) || has(self.defaults)) ? has(self.limits) && size(self.limits) > 0 : true",message="At least one spec.limits must be defined"
 // +kubebuilder:validation:XValidation:rule="has(self.overrides) ? has(self.overrides.limits) && size(self.overrides.limits) > 0 : true",message="At least one spec.overrides.limits must be defined"
 // +kubebuilder:validation:XValidation:rule="has(self.defaults) ? has(self.defaults.limits) && size(self.defaults.limits) > 0 : true",message="At least one spec.defaults.limits must be defined"
+// +kubebuilder:validation:XValidation:rule="!((has(self.defaults) || has(self.overrides)) && (has(self.failureMode) || has(self.timeout)))",message="Implicit settings and explicit defaults or overrides are mutually exclusive"
 type TokenRateLimitPolicySpec struct {
 	// Reference to the object to which this policy applies.
 	// +kubebuilder:validation:XValidation:rule="self.group == 'gateway.networking.k8s.io'",message="Invalid targetRef.group. The only supported value is 'gateway.networking.k8s.io'"
-	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute' and 'Gateway'"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'HTTPRoute' || self.kind == 'GRPCRoute' || self.kind == 'Gateway'",message="Invalid targetRef.kind. The only supported values are 'HTTPRoute', 'GRPCRoute' and 'Gateway'"
 	TargetRef gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
 
 	// Rules to apply as defaults. Can be overridden by more specific policy rules lower in the hierarchy and by less specific policy overrides.
@@ -204,6 +215,10 @@ type TokenRateLimitPolicySpecProper struct {
 	// Limits holds the struct of token-based limits indexed by a unique name
 	// +optional
 	Limits map[string]TokenLimit `json:"limits,omitempty"`
+
+	// Settings of the calls to Limitador
+	// +optional
+	kuadrantv1.MergeableServiceSettings `json:""`
 }
 
 // TokenLimit represents a complete token-based rate limit configuration
@@ -222,10 +237,59 @@ type TokenLimit struct {
 	// +optional
 	Counters []kuadrantv1.Counter `json:"counters,omitempty"`
 
+	// Usage defines how the number of tokens consumed by a request is extracted from the upstream response.
+	// When not set, the `total_tokens` field of an OpenAI-shaped response body is used (`/usage/total_tokens`).
+	// +optional
+	Usage *TokenUsage `json:"usage,omitempty"`
+
 	// Source stores the locator of the policy where the limit is originally defined (internal use)
 	Source string `json:"-"`
 }
 
+// DefaultTokenUsageJSONPointer is the location of the token count in OpenAI-shaped response bodies
+const DefaultTokenUsageJSONPointer = "/usage/total_tokens"
+
+// TokenUsage defines the source of the number of tokens added to the counters of a token limit.
+// +kubebuilder:validation:XValidation:rule="!(has(self.jsonPointer) && has(self.expression))",message="usage.jsonPointer and usage.expression are mutually exclusive"
+type TokenUsage struct {
+	// JSONPointer (RFC 6901) to the token count in the JSON response body.
+	// Example: "/usageMetadata/totalTokenCount"
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(/([^/~]|~[01])*)+$`
+	JSONPointer string `json:"jsonPointer,omitempty"`
+
+	// Expression is a CEL expression evaluating to the number of tokens to add to the counters.
+	// The response body can be read with the `responseBodyJSON` function.
+	// Example: `responseBodyJSON("/usage/input_tokens") + 4 * responseBodyJSON("/usage/output_tokens")`
+	// +optional
+	Expression kuadrantv1.Expression `json:"expression,omitempty"`
+
+	// Streaming enables token accounting for streamed responses (`text/event-stream`).
+	// When enabled, the usage is read from the last server-sent event of the stream carrying a JSON payload,
+	// e.g. the final chunk of an OpenAI chat completion requested with `"stream_options": {"include_usage": true}`.
+	// Responses that are not streamed are still read as a single JSON document.
+	// Requires a wasm-shim that supports the event-stream-response-body feature.
+	// +optional
+	Streaming bool `json:"streaming,omitempty"`
+}
+
+// HitsAddend returns the CEL expression that computes the number of tokens to add to the counters of the limit
+func (l TokenLimit) HitsAddend() string {
+	if l.Usage != nil && l.Usage.Expression != "" {
+		return string(l.Usage.Expression)
+	}
+	pointer := DefaultTokenUsageJSONPointer
+	if l.Usage != nil && l.Usage.JSONPointer != "" {
+		pointer = l.Usage.JSONPointer
+	}
+	return "responseBodyJSON(" + strconv.Quote(pointer) + ")"
+}
+
+// IsStreaming returns true if the usage of the limit is read from streamed responses
+func (l TokenLimit) IsStreaming() bool {
+	return l.Usage != nil && l.Usage.Streaming
+}
+
 func (l TokenLimit) CountersAsStringList() []string {
 	if len(l.Counters) == 0 {
 		return nil
@@ -266,6 +330,11 @@ type TokenRateLimitPolicyStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+
+	// StreamingLimits lists the names of the limits of the policy whose tokens are accounted from
+	// streamed (`text/event-stream`) responses. Only reported while the policy is enforced.
+	// +optional
+	StreamingLimits []string `json:"streamingLimits,omitempty"`
 }
 
 func (s *TokenRateLimitPolicyStatus) GetConditions() []metav1.Condition {
@@ -318,3 +318,46 @@ func TestTokenLimit_CountersAsStringList(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenLimit_HitsAddend(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    TokenLimit
+		expected string
+	}{
+		{
+			name:     "default usage",
+			limit:    TokenLimit{},
+			expected: `responseBodyJSON("/usage/total_tokens")`,
+		},
+		{
+			name: "json pointer",
+			limit: TokenLimit{
+				Usage: &TokenUsage{JSONPointer: "/usageMetadata/totalTokenCount"},
+			},
+			expected: `responseBodyJSON("/usageMetadata/totalTokenCount")`,
+		},
+		{
+			name: "expression",
+			limit: TokenLimit{
+				Usage: &TokenUsage{Expression: `responseBodyJSON("/usage/input_tokens") + responseBodyJSON("/usage/output_tokens")`},
+			},
+			expected: `responseBodyJSON("/usage/input_tokens") + responseBodyJSON("/usage/output_tokens")`,
+		},
+		{
+			name: "streaming with default json pointer",
+			limit: TokenLimit{
+				Usage: &TokenUsage{Streaming: true},
+			},
+			expected: `responseBodyJSON("/usage/total_tokens")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.limit.HitsAddend(); result != tt.expected {
+				t.Errorf("Expected hits addend '%s', got '%s'", tt.expected, result)
+			}
+		})
+	}
+}
@@ -60,6 +60,11 @@ func (in *TokenLimit) DeepCopyInto(out *TokenLimit) {
 		*out = make([]v1.Counter, len(*in))
 		copy(*out, *in)
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(TokenUsage)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenLimit.
@@ -169,6 +174,7 @@ func (in *TokenRateLimitPolicySpecProper) DeepCopyInto(out *TokenRateLimitPolicy
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	in.MergeableServiceSettings.DeepCopyInto(&out.MergeableServiceSettings)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRateLimitPolicySpecProper.
@@ -191,6 +197,11 @@ func (in *TokenRateLimitPolicyStatus) DeepCopyInto(out *TokenRateLimitPolicyStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StreamingLimits != nil {
+		in, out := &in.StreamingLimits, &out.StreamingLimits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRateLimitPolicyStatus.
@@ -202,3 +213,18 @@ func (in *TokenRateLimitPolicyStatus) DeepCopy() *TokenRateLimitPolicyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenUsage) DeepCopyInto(out *TokenUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenUsage.
+func (in *TokenUsage) DeepCopy() *TokenUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenUsage)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,7 +21,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	authorinooperatorv1beta1 "github.com/kuadrant/authorino-operator/api/v1beta1"
+	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
 	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
@@ -71,6 +75,36 @@ func (k *Kuadrant) IsMTLSAuthorinoEnabled() bool {
 	return k.Spec.MTLS.IsAuthorinoEnabled()
 }
 
+// LimitadorForGateway returns the dedicated Limitador instance of a gateway, if any
+func (k *Kuadrant) LimitadorForGateway(namespace, name string) *ServiceEndpoint {
+	if services := k.servicesForGateway(namespace, name); services != nil {
+		return services.Limitador
+	}
+	return nil
+}
+
+// AuthorinoForGateway returns the dedicated Authorino instance of a gateway, if any
+func (k *Kuadrant) AuthorinoForGateway(namespace, name string) *ServiceEndpoint {
+	if services := k.servicesForGateway(namespace, name); services != nil {
+		return services.Authorino
+	}
+	return nil
+}
+
+func (k *Kuadrant) servicesForGateway(namespace, name string) *GatewayServices {
+	if k == nil {
+		return nil
+	}
+	for i := range k.Spec.GatewayServices {
+		if lo.ContainsBy(k.Spec.GatewayServices[i].Gateways, func(ref GatewayReference) bool {
+			return ref.Namespace == namespace && ref.Name == name
+		}) {
+			return &k.Spec.GatewayServices[i]
+		}
+	}
+	return nil
+}
+
 // KuadrantSpec defines the desired state of Kuadrant
 type KuadrantSpec struct {
 	Observability Observability `json:"observability,omitempty"`
@@ -79,6 +113,168 @@ type KuadrantSpec struct {
 	// will add the configuration required to enable mTLS between an Istio provided
 	// gateway and the Kuadrant components.
 	MTLS *MTLS `json:"mtls,omitempty"`
+
+	// +optional
+	// Limitador is an optional entry to tune the deployment of the Limitador
+	// instance managed by kuadrant-operator. The fields set here are merged into
+	// the Limitador custom resource on every reconciliation.
+	Limitador *LimitadorSpec `json:"limitador,omitempty"`
+
+	// +optional
+	// Authorino is an optional entry to tune the deployment of the Authorino
+	// instance managed by kuadrant-operator. The fields set here are merged into
+	// the Authorino custom resource on every reconciliation.
+	Authorino *AuthorinoSpec `json:"authorino,omitempty"`
+
+	// +optional
+	// GatewayServices maps gateways to dedicated Limitador and Authorino instances,
+	// instead of the ones managed by kuadrant-operator. The first entry that lists
+	// a gateway applies to it.
+	GatewayServices []GatewayServices `json:"gatewayServices,omitempty"`
+
+	// +optional
+	// Extensions is an optional entry to register the extensions that run outside
+	// of the kuadrant-operator pod and connect to it over TCP with mutual TLS.
+	Extensions *ExtensionsSpec `json:"extensions,omitempty"`
+}
+
+// ExtensionsSpec defines the extensions registered with kuadrant-operator
+type ExtensionsSpec struct {
+	// Remote extensions, which are only accepted when listed here
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Remote []RemoteExtension `json:"remote,omitempty"`
+}
+
+// RemoteExtension defines an extension that connects to kuadrant-operator over TCP with mutual TLS
+type RemoteExtension struct {
+	// Name of the extension, which must be the common name of its client certificate
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// GatewayServices defines the Limitador and Authorino instances that enforce the policies of a set of gateways
+// +kubebuilder:validation:XValidation:rule="has(self.limitador) || has(self.authorino)",message="at least one of limitador or authorino must be set"
+type GatewayServices struct {
+	// Gateways whose policies are enforced by the dedicated instances
+	// +kubebuilder:validation:MinItems=1
+	Gateways []GatewayReference `json:"gateways"`
+
+	// Limitador instance of the gateways. The Limitador managed by kuadrant-operator is used when not set.
+	// The endpoint must be the gRPC endpoint of a Limitador custom resource, to which the limits of the gateways are written.
+	// +optional
+	Limitador *ServiceEndpoint `json:"limitador,omitempty"`
+
+	// Authorino instance of the gateways. The Authorino managed by kuadrant-operator is used when not set.
+	// +optional
+	Authorino *ServiceEndpoint `json:"authorino,omitempty"`
+}
+
+type GatewayReference struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// ServiceEndpoint is the address of the gRPC endpoint of a service.
+// Calls to dedicated instances are not protected by the mTLS settings of the kuadrant CR.
+type ServiceEndpoint struct {
+	// Host of the service, e.g. limitador-tenant-a.tenant-a.svc.cluster.local
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port of the gRPC endpoint of the service
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// LimitadorSpec defines the tunable fields of the Limitador instance managed by Kuadrant
+// +kubebuilder:validation:XValidation:rule="(!has(self.storage) || !has(self.storage.disk)) || (!has(self.replicas) || self.replicas < 2)",message="disk storage does not allow multiple replicas"
+type LimitadorSpec struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int `json:"replicas,omitempty"`
+
+	// Storage backend of the Limitador counters. In-memory storage is used when not set.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="[has(self.redis), has(self.redis__dash__cached), has(self.disk)].filter(x, x).size() <= 1",message="only one storage backend can be set"
+	// +kubebuilder:validation:XValidation:rule="!has(self.redis) || has(self.redis.configSecretRef)",message="redis storage requires a configSecretRef"
+	// +kubebuilder:validation:XValidation:rule="!has(self.redis__dash__cached) || has(self.redis__dash__cached.configSecretRef)",message="redis-cached storage requires a configSecretRef"
+	Storage *limitadorv1alpha1.Storage `json:"storage,omitempty"`
+
+	// +optional
+	ResourceRequirements *corev1.ResourceRequirements `json:"resourceRequirements,omitempty"`
+
+	// +optional
+	PodDisruptionBudget *limitadorv1alpha1.PodDisruptionBudgetType `json:"pdb,omitempty"`
+
+	// Sets the level of verbosity of the Limitador logs
+	// +optional
+	Verbosity *limitadorv1alpha1.VerbosityLevel `json:"verbosity,omitempty"`
+
+	// MetricLabelsDefault is the CEL expression used to label the Limitador metrics.
+	// Defaults to "descriptors[1]" when not set.
+	// +optional
+	MetricLabelsDefault *string `json:"metricLabelsDefault,omitempty"`
+}
+
+const (
+	LimitadorStorageMemory      = "memory"
+	LimitadorStorageRedis       = "redis"
+	LimitadorStorageRedisCached = "redis-cached"
+	LimitadorStorageDisk        = "disk"
+)
+
+// LimitadorStorageType returns the name of the counters storage backend of a Limitador storage spec
+func LimitadorStorageType(storage *limitadorv1alpha1.Storage) string {
+	switch {
+	case storage == nil:
+		return LimitadorStorageMemory
+	case storage.Redis != nil:
+		return LimitadorStorageRedis
+	case storage.RedisCached != nil:
+		return LimitadorStorageRedisCached
+	case storage.Disk != nil:
+		return LimitadorStorageDisk
+	default:
+		return LimitadorStorageMemory
+	}
+}
+
+// AuthorinoSpec defines the tunable fields of the Authorino instance managed by Kuadrant.
+// Field names match the ones of the Authorino custom resource.
+type AuthorinoSpec struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=debug;info;error
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=production;development
+	LogMode string `json:"logMode,omitempty"`
+
+	// EvaluatorCacheSize is the cache size (in megabytes) of each Authorino evaluator
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	EvaluatorCacheSize *int `json:"evaluatorCacheSize,omitempty"`
+
+	// +optional
+	OIDCServer *AuthorinoOIDCServer `json:"oidcServer,omitempty"`
+
+	// +optional
+	Tracing *authorinooperatorv1beta1.Tracing `json:"tracing,omitempty"`
+}
+
+type AuthorinoOIDCServer struct {
+	// TLS configuration of the OIDC Festival Wristband server
+	// +kubebuilder:validation:XValidation:rule="!has(self.enabled) || !self.enabled || has(self.certSecretRef)",message="certSecretRef is required when tls is enabled"
+	TLS authorinooperatorv1beta1.Tls `json:"tls"`
 }
 
 type Observability struct {
@@ -132,6 +328,107 @@ type KuadrantStatus struct {
 	// Mtls Limitador reflects the mtls feature state regarding comms with limitador.
 	// +optional
 	MtlsLimitador *bool `json:"mtlsLimitador,omitempty"`
+
+	// Limitador reflects the deployment configuration of the managed Limitador instance.
+	// +optional
+	Limitador *LimitadorStatus `json:"limitador,omitempty"`
+
+	// Authorino reflects the deployment configuration of the managed Authorino instance.
+	// +optional
+	Authorino *AuthorinoStatus `json:"authorino,omitempty"`
+
+	// Extensions reflects the health of the extensions run by kuadrant-operator.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Extensions []ExtensionStatus `json:"extensions,omitempty"`
+}
+
+// LimitadorStatus defines the observed deployment configuration of the managed Limitador
+type LimitadorStatus struct {
+	// Replicas is the number of replicas set on the Limitador resource
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Storage is the counters storage backend: memory, redis, redis-cached or disk
+	// +optional
+	Storage string `json:"storage,omitempty"`
+
+	// Verbosity is the level of verbosity set on the Limitador resource
+	// +optional
+	Verbosity *int `json:"verbosity,omitempty"`
+}
+
+// AuthorinoStatus defines the observed deployment configuration of the managed Authorino
+type AuthorinoStatus struct {
+	// Replicas is the number of replicas set on the Authorino resource
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// LogLevel is the log level set on the Authorino resource
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// EvaluatorCacheSize is the evaluator cache size set on the Authorino resource
+	// +optional
+	EvaluatorCacheSize *int `json:"evaluatorCacheSize,omitempty"`
+
+	// OIDCServerTLS reflects whether TLS is enabled on the OIDC Festival Wristband server
+	// +optional
+	OIDCServerTLS *bool `json:"oidcServerTLS,omitempty"`
+
+	// TracingEndpoint is the endpoint Authorino sends traces to
+	// +optional
+	TracingEndpoint string `json:"tracingEndpoint,omitempty"`
+}
+
+// ExtensionStatus defines the observed health of an extension
+type ExtensionStatus struct {
+	// Name of the extension
+	Name string `json:"name"`
+
+	// Executable is the path of the binary of the extension. Empty for remote extensions.
+	// +optional
+	Executable string `json:"executable,omitempty"`
+
+	// State of the extension: Running, Restarting, Failed, Stopped or Disconnected.
+	// A Failed extension exceeded the maximum number of consecutive restarts and is no longer restarted.
+	// A Disconnected remote extension stopped calling the operator, and is Running again as soon as it calls.
+	State string `json:"state"`
+
+	// Restarts is the number of consecutive times the extension was restarted after exiting, reset once it runs
+	// without exiting for a minute
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// LastError is the last error the extension exited or failed to start with
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastPingLatency is the time the last ping of the extension took to reach kuadrant-operator
+	// +optional
+	LastPingLatency *metav1.Duration `json:"lastPingLatency,omitempty"`
+
+	// Mutators is the number of mutators registered by the extension
+	// +optional
+	Mutators int32 `json:"mutators,omitempty"`
+
+	// Subscriptions is the number of subscriptions registered by the extension
+	// +optional
+	Subscriptions int32 `json:"subscriptions,omitempty"`
+
+	// Address is the network address a remote extension last connected to kuadrant-operator from
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// APIVersion is the version of the extension API the extension negotiated with kuadrant-operator
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Incompatibilities are the requirements of the extension kuadrant-operator does not support, e.g. a feature or a
+	// version of the API. Empty when compatible.
+	// +optional
+	Incompatibilities []string `json:"incompatibilities,omitempty"`
 }
 
 func (r *KuadrantStatus) Equals(other *KuadrantStatus, logger logr.Logger) bool {
@@ -153,6 +450,24 @@ func (r *KuadrantStatus) Equals(other *KuadrantStatus, logger logr.Logger) bool
 		return false
 	}
 
+	if !reflect.DeepEqual(r.Limitador, other.Limitador) {
+		diff := cmp.Diff(r.Limitador, other.Limitador)
+		logger.V(1).Info("Limitador not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(r.Authorino, other.Authorino) {
+		diff := cmp.Diff(r.Authorino, other.Authorino)
+		logger.V(1).Info("Authorino not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(r.Extensions, other.Extensions) {
+		diff := cmp.Diff(r.Extensions, other.Extensions)
+		logger.V(1).Info("Extensions not equal", "difference", diff)
+		return false
+	}
+
 	// Marshalling sorts by condition type
 	currentMarshaledJSON, _ := kuadrant.ConditionMarshal(r.Conditions)
 	otherMarshaledJSON, _ := kuadrant.ConditionMarshal(other.Conditions)
@@ -5,6 +5,7 @@ package v1beta1
 import (
 	"testing"
 
+	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
 	"gotest.tools/assert"
 	"k8s.io/utils/ptr"
 )
@@ -130,3 +131,108 @@ func TestIsMTLSAuthorinoEnabled(t *testing.T) {
 		assert.Assert(subT, !got)
 	})
 }
+
+func TestLimitadorStorageType(t *testing.T) {
+	tests := []struct {
+		name     string
+		storage  *limitadorv1alpha1.Storage
+		expected string
+	}{
+		{
+			name:     "nil storage",
+			storage:  nil,
+			expected: LimitadorStorageMemory,
+		},
+		{
+			name:     "empty storage",
+			storage:  &limitadorv1alpha1.Storage{},
+			expected: LimitadorStorageMemory,
+		},
+		{
+			name:     "redis",
+			storage:  &limitadorv1alpha1.Storage{Redis: &limitadorv1alpha1.Redis{}},
+			expected: LimitadorStorageRedis,
+		},
+		{
+			name:     "redis cached",
+			storage:  &limitadorv1alpha1.Storage{RedisCached: &limitadorv1alpha1.RedisCached{}},
+			expected: LimitadorStorageRedisCached,
+		},
+		{
+			name:     "disk",
+			storage:  &limitadorv1alpha1.Storage{Disk: &limitadorv1alpha1.DiskSpec{}},
+			expected: LimitadorStorageDisk,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(subT *testing.T) {
+			assert.Equal(subT, LimitadorStorageType(tt.storage), tt.expected)
+		})
+	}
+}
+
+func TestServicesForGateway(t *testing.T) {
+	tenantLimitador := &ServiceEndpoint{Host: "limitador.tenant-a.svc.cluster.local", Port: 8081}
+	tenantAuthorino := &ServiceEndpoint{Host: "authorino.tenant-b.svc.cluster.local", Port: 50051}
+	otherLimitador := &ServiceEndpoint{Host: "limitador.other.svc.cluster.local", Port: 8081}
+
+	kuadrantCR := &Kuadrant{
+		Spec: KuadrantSpec{
+			GatewayServices: []GatewayServices{
+				{
+					Gateways:  []GatewayReference{{Name: "gw-a", Namespace: "tenant-a"}},
+					Limitador: tenantLimitador,
+				},
+				{
+					Gateways:  []GatewayReference{{Name: "gw-b", Namespace: "tenant-b"}, {Name: "gw-a", Namespace: "tenant-a"}},
+					Limitador: otherLimitador,
+					Authorino: tenantAuthorino,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		namespace         string
+		gateway           string
+		expectedLimitador *ServiceEndpoint
+		expectedAuthorino *ServiceEndpoint
+	}{
+		{
+			name:              "first entry listing the gateway applies",
+			namespace:         "tenant-a",
+			gateway:           "gw-a",
+			expectedLimitador: tenantLimitador,
+		},
+		{
+			name:              "gateway with both services",
+			namespace:         "tenant-b",
+			gateway:           "gw-b",
+			expectedLimitador: otherLimitador,
+			expectedAuthorino: tenantAuthorino,
+		},
+		{
+			name:      "same name in another namespace",
+			namespace: "tenant-b",
+			gateway:   "gw-a",
+		},
+		{
+			name:      "gateway not listed",
+			namespace: "default",
+			gateway:   "gw",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(subT *testing.T) {
+			assert.Equal(subT, kuadrantCR.LimitadorForGateway(tt.namespace, tt.gateway), tt.expectedLimitador)
+			assert.Equal(subT, kuadrantCR.AuthorinoForGateway(tt.namespace, tt.gateway), tt.expectedAuthorino)
+		})
+	}
+
+	t.Run("kuadrant is nil", func(subT *testing.T) {
+		var kuadrantCR *Kuadrant
+		assert.Assert(subT, kuadrantCR.LimitadorForGateway("tenant-a", "gw-a") == nil)
+		assert.Assert(subT, kuadrantCR.AuthorinoForGateway("tenant-a", "gw-a") == nil)
+	})
+}
@@ -21,10 +21,179 @@ limitations under the License.
 package v1beta1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1beta1 "github.com/kuadrant/authorino-operator/api/v1beta1"
+	"github.com/kuadrant/limitador-operator/api/v1alpha1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorinoOIDCServer) DeepCopyInto(out *AuthorinoOIDCServer) {
+	*out = *in
+	in.TLS.DeepCopyInto(&out.TLS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorinoOIDCServer.
+func (in *AuthorinoOIDCServer) DeepCopy() *AuthorinoOIDCServer {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorinoOIDCServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorinoSpec) DeepCopyInto(out *AuthorinoSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvaluatorCacheSize != nil {
+		in, out := &in.EvaluatorCacheSize, &out.EvaluatorCacheSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.OIDCServer != nil {
+		in, out := &in.OIDCServer, &out.OIDCServer
+		*out = new(AuthorinoOIDCServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(apiv1beta1.Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorinoSpec.
+func (in *AuthorinoSpec) DeepCopy() *AuthorinoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorinoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorinoStatus) DeepCopyInto(out *AuthorinoStatus) {
+	*out = *in
+	if in.EvaluatorCacheSize != nil {
+		in, out := &in.EvaluatorCacheSize, &out.EvaluatorCacheSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.OIDCServerTLS != nil {
+		in, out := &in.OIDCServerTLS, &out.OIDCServerTLS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorinoStatus.
+func (in *AuthorinoStatus) DeepCopy() *AuthorinoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorinoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionStatus) DeepCopyInto(out *ExtensionStatus) {
+	*out = *in
+	if in.LastPingLatency != nil {
+		in, out := &in.LastPingLatency, &out.LastPingLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Incompatibilities != nil {
+		in, out := &in.Incompatibilities, &out.Incompatibilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionStatus.
+func (in *ExtensionStatus) DeepCopy() *ExtensionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionsSpec) DeepCopyInto(out *ExtensionsSpec) {
+	*out = *in
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = make([]RemoteExtension, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionsSpec.
+func (in *ExtensionsSpec) DeepCopy() *ExtensionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayServices) DeepCopyInto(out *GatewayServices) {
+	*out = *in
+	if in.Gateways != nil {
+		in, out := &in.Gateways, &out.Gateways
+		*out = make([]GatewayReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Limitador != nil {
+		in, out := &in.Limitador, &out.Limitador
+		*out = new(ServiceEndpoint)
+		**out = **in
+	}
+	if in.Authorino != nil {
+		in, out := &in.Authorino, &out.Authorino
+		*out = new(ServiceEndpoint)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServices.
+func (in *GatewayServices) DeepCopy() *GatewayServices {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kuadrant) DeepCopyInto(out *Kuadrant) {
 	*out = *in
@@ -93,6 +262,28 @@ func (in *KuadrantSpec) DeepCopyInto(out *KuadrantSpec) {
 		*out = new(MTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Limitador != nil {
+		in, out := &in.Limitador, &out.Limitador
+		*out = new(LimitadorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorino != nil {
+		in, out := &in.Authorino, &out.Authorino
+		*out = new(AuthorinoSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayServices != nil {
+		in, out := &in.GatewayServices, &out.GatewayServices
+		*out = make([]GatewayServices, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = new(ExtensionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KuadrantSpec.
@@ -110,7 +301,7 @@ func (in *KuadrantStatus) DeepCopyInto(out *KuadrantStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -125,6 +316,23 @@ func (in *KuadrantStatus) DeepCopyInto(out *KuadrantStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Limitador != nil {
+		in, out := &in.Limitador, &out.Limitador
+		*out = new(LimitadorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorino != nil {
+		in, out := &in.Authorino, &out.Authorino
+		*out = new(AuthorinoStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]ExtensionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KuadrantStatus.
@@ -137,6 +345,71 @@ func (in *KuadrantStatus) DeepCopy() *KuadrantStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitadorSpec) DeepCopyInto(out *LimitadorSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1alpha1.Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRequirements != nil {
+		in, out := &in.ResourceRequirements, &out.ResourceRequirements
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(v1alpha1.PodDisruptionBudgetType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(v1alpha1.VerbosityLevel)
+		**out = **in
+	}
+	if in.MetricLabelsDefault != nil {
+		in, out := &in.MetricLabelsDefault, &out.MetricLabelsDefault
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitadorSpec.
+func (in *LimitadorSpec) DeepCopy() *LimitadorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitadorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitadorStatus) DeepCopyInto(out *LimitadorStatus) {
+	*out = *in
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitadorStatus.
+func (in *LimitadorStatus) DeepCopy() *LimitadorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitadorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MTLS) DeepCopyInto(out *MTLS) {
 	*out = *in
@@ -176,3 +449,33 @@ func (in *Observability) DeepCopy() *Observability {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteExtension) DeepCopyInto(out *RemoteExtension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteExtension.
+func (in *RemoteExtension) DeepCopy() *RemoteExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEndpoint) DeepCopyInto(out *ServiceEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEndpoint.
+func (in *ServiceEndpoint) DeepCopy() *ServiceEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -22,12 +22,12 @@ import (
 	"path"
 
 	"github.com/go-logr/logr"
-	"github.com/google/go-cmp/cmp"
 	authorinov1beta3 "github.com/kuadrant/authorino/api/v1beta3"
-	"github.com/kuadrant/limitador-operator/pkg/helpers"
 	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	exttypes "github.com/kuadrant/kuadrant-operator/pkg/extension/types"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -158,6 +158,10 @@ func (p *OIDCPolicy) GetTargetRefs() []gatewayapiv1alpha2.LocalPolicyTargetRefer
 	}
 }
 
+func (p *OIDCPolicy) GetPolicyStatus() exttypes.PolicyStatus {
+	return &p.Status
+}
+
 func (p *OIDCPolicy) GetTokenRequestURL() (string, error) {
 	var tokenURL *url.URL
 	var err error
@@ -281,22 +285,23 @@ type OIDCPolicyList struct {
 }
 
 func (s *OIDCPolicyStatus) Equals(other *OIDCPolicyStatus, logger logr.Logger) bool {
-	if s.ObservedGeneration != other.ObservedGeneration {
-		diff := cmp.Diff(s.ObservedGeneration, other.ObservedGeneration)
-		logger.V(1).Info("status observedGeneration not equal", "difference", diff)
-		return false
-	}
+	return exttypes.StatusEquals(s, other, logger)
+}
 
-	// Marshalling sorts by condition type
-	currentMarshaledJSON, _ := helpers.ConditionMarshal(s.Conditions)
-	otherMarshaledJSON, _ := helpers.ConditionMarshal(other.Conditions)
-	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
-		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
-		logger.V(1).Info("status conditions not equal", "difference", diff)
-		return false
-	}
+func (s *OIDCPolicyStatus) GetObservedGeneration() int64 {
+	return s.ObservedGeneration
+}
+
+func (s *OIDCPolicyStatus) SetObservedGeneration(generation int64) {
+	s.ObservedGeneration = generation
+}
+
+func (s *OIDCPolicyStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
 
-	return true
+func (s *OIDCPolicyStatus) SetConditions(conditions []metav1.Condition) {
+	s.Conditions = conditions
 }
 
 func init() {
@@ -6,14 +6,11 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
-	"slices"
 	"strings"
 
 	authorinov1beta3 "github.com/kuadrant/authorino/api/v1beta3"
 	"github.com/kuadrant/policy-machinery/machinery"
-	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -120,8 +117,8 @@ func (r *OIDCPolicyReconciler) Reconcile(ctx context.Context, request reconcile.
 
 	r.Logger.V(1).Info("Resolving ingress gateway info", "ingressGatewayData", ingressGatewayData)
 
-	oidcPolicyStatus, specErr := r.reconcileSpec(ctx, oidcPolicy, &ingressGatewayData)
-	statusResult, statusErr := r.reconcileStatus(ctx, oidcPolicy, oidcPolicyStatus)
+	conditions, specErr := r.reconcileSpec(ctx, oidcPolicy, &ingressGatewayData)
+	statusResult, statusErr := extcontroller.ReconcileStatus(ctx, r.Client, oidcPolicy, conditions, r.Logger)
 
 	if specErr != nil {
 		return ctrl.Result{}, specErr
@@ -140,64 +137,28 @@ func (r *OIDCPolicyReconciler) Reconcile(ctx context.Context, request reconcile.
 	return reconcile.Result{}, nil
 }
 
-func (r *OIDCPolicyReconciler) reconcileSpec(ctx context.Context, pol *v1alpha1.OIDCPolicy, igw *ingressGatewayInfo) (*v1alpha1.OIDCPolicyStatus, error) {
+func (r *OIDCPolicyReconciler) reconcileSpec(ctx context.Context, pol *v1alpha1.OIDCPolicy, igw *ingressGatewayInfo) ([]metav1.Condition, error) {
 	// Reconcile AuthPolicy for the oidc policy http route
 	mainAuthPol, err := r.reconcileMainAuthPolicy(ctx, pol, igw)
 	if err != nil {
 		r.Logger.Error(err, "Failed to reconcile main AuthPolicy")
-		return calculateErrorStatus(pol, err), err
+		return extcontroller.ErrorConditions(pol, err), err
 	}
 
 	// Reconcile HTTPRoute for the callback for exchanging code/token
 	if err = r.reconcileCallbackHTTPRoute(ctx, pol, igw); err != nil {
 		r.Logger.Error(err, "Failed to reconcile callback HTTPRoute")
-		return calculateErrorStatus(pol, err), err
+		return extcontroller.ErrorConditions(pol, err), err
 	}
 	// Reconcile AuthPolicy for the Token exchange flow with metadata http call
 	callbackPol, err := r.reconcileCallbackAuthPolicy(ctx, pol, igw)
 	if err != nil {
 		r.Logger.Error(err, "Failed to reconcile callback AuthPolicy")
-		return calculateErrorStatus(pol, err), err
+		return extcontroller.ErrorConditions(pol, err), err
 	}
 
 	// Check if AuthPolicies are enforced
-	for _, enforcedErr := range []error{
-		isAuthPolicyEnforced(mainAuthPol),
-		isAuthPolicyEnforced(callbackPol),
-	} {
-		if enforcedErr != nil {
-			return calculateEnforcedStatus(pol, enforcedErr), nil
-		}
-	}
-
-	return calculateEnforcedStatus(pol, nil),
-		nil
-}
-
-func (r *OIDCPolicyReconciler) reconcileStatus(ctx context.Context, pol *v1alpha1.OIDCPolicy, newStatus *v1alpha1.OIDCPolicyStatus) (ctrl.Result, error) {
-	equalStatus := pol.Status.Equals(newStatus, r.Logger)
-	r.Logger.Info("Status", "status is different", !equalStatus)
-	r.Logger.Info("Status", "generation is different", pol.Generation != pol.Status.ObservedGeneration)
-	if equalStatus && pol.Generation == pol.Status.ObservedGeneration {
-		// Steady state
-		r.Logger.Info("Status was not updated")
-		return reconcile.Result{}, nil
-	}
-
-	r.Logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", pol.Status.ObservedGeneration, newStatus.ObservedGeneration))
-
-	pol.Status = *newStatus
-	updateErr := r.Client.Status().Update(ctx, pol)
-	if updateErr != nil {
-		// Ignore conflicts, resource might just be outdated.
-		if errors.IsConflict(updateErr) {
-			r.Logger.Info("Failed to update status: resource might just be outdated")
-			return reconcile.Result{Requeue: true}, nil
-		}
-
-		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", updateErr)
-	}
-	return ctrl.Result{}, nil
+	return extcontroller.EnforcedConditions(pol, extcontroller.PoliciesEnforced(mainAuthPol, callbackPol)), nil
 }
 
 func (r *OIDCPolicyReconciler) reconcileMainAuthPolicy(ctx context.Context, pol *v1alpha1.OIDCPolicy, igw *ingressGatewayInfo) (*kuadrantv1.AuthPolicy, error) {
@@ -665,36 +626,3 @@ func getSecureFlag(protocol gatewayapiv1.ProtocolType) string {
 	}
 	return flag
 }
-
-func isAuthPolicyEnforced(authPolicy *kuadrantv1.AuthPolicy) error {
-	cond, found := lo.Find(authPolicy.Status.GetConditions(), func(c metav1.Condition) bool {
-		return c.Type == string(types.PolicyConditionEnforced)
-	})
-	if !found || cond.Status == metav1.ConditionFalse {
-		return fmt.Errorf("AuthPolicy %s is not enforced", authPolicy.Name)
-	}
-	return nil
-}
-
-func calculateErrorStatus(pol *v1alpha1.OIDCPolicy, specErr error) *v1alpha1.OIDCPolicyStatus {
-	newStatus := &v1alpha1.OIDCPolicyStatus{
-		ObservedGeneration: pol.Generation,
-		// Copy initial conditions. Otherwise, status will always be updated
-		Conditions: slices.Clone(pol.Status.Conditions),
-	}
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.AcceptedCondition(pol, specErr))
-	meta.RemoveStatusCondition(&newStatus.Conditions, string(types.PolicyConditionEnforced))
-	return newStatus
-}
-
-func calculateEnforcedStatus(pol *v1alpha1.OIDCPolicy, enforcedErr error) *v1alpha1.OIDCPolicyStatus {
-	newStatus := &v1alpha1.OIDCPolicyStatus{
-		ObservedGeneration: pol.Generation,
-		// Copy initial conditions. Otherwise, status will always be updated
-		Conditions: slices.Clone(pol.Status.Conditions),
-	}
-
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.AcceptedCondition(pol, nil))
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.EnforcedCondition(pol, enforcedErr, true))
-	return newStatus
-}
@@ -4,11 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"slices"
 
-	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -56,8 +53,11 @@ func (r *PlanPolicyReconciler) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, nil
 	}
 
-	planPolicyStatus, specErr := r.reconcileSpec(ctx, planPolicy, kuadrantCtx)
-	statusResult, statusErr := r.reconcileStatus(ctx, planPolicy, planPolicyStatus)
+	conditions, specErr := r.reconcileSpec(ctx, planPolicy, kuadrantCtx)
+	if err := extcontroller.ReportStatus(ctx, kuadrantCtx, planPolicy, &conditions); err != nil {
+		r.Logger.Error(err, "failed to report planpolicy status")
+	}
+	statusResult, statusErr := extcontroller.ReconcileStatus(ctx, r.Client, planPolicy, conditions, r.Logger)
 
 	if specErr != nil {
 		return reconcile.Result{}, specErr
@@ -74,54 +74,28 @@ func (r *PlanPolicyReconciler) Reconcile(ctx context.Context, request reconcile.
 	return reconcile.Result{}, nil
 }
 
-func (r *PlanPolicyReconciler) reconcileSpec(ctx context.Context, planPolicy *v1alpha1.PlanPolicy, kuadrantCtx types.KuadrantCtx) (*v1alpha1.PlanPolicyStatus, error) {
+func (r *PlanPolicyReconciler) reconcileSpec(ctx context.Context, planPolicy *v1alpha1.PlanPolicy, kuadrantCtx types.KuadrantCtx) ([]metav1.Condition, error) {
 	desiredRateLimitPolicy := r.buildDesiredRateLimitPolicy(planPolicy)
 	if err := controllerutil.SetControllerReference(planPolicy, desiredRateLimitPolicy, r.Scheme); err != nil {
 		r.Logger.Error(err, "failed to set controller reference")
-		return calculateErrorStatus(planPolicy, err), err
+		return extcontroller.ErrorConditions(planPolicy, err), err
 	}
 	rateLimitPolicy, err := kuadrantCtx.ReconcileObject(ctx, &kuadrantv1.RateLimitPolicy{}, desiredRateLimitPolicy, rlpSpecMutator)
 	if err != nil {
 		r.Logger.Error(err, "failed to reconcile desired ratelimitpolicy")
-		return calculateErrorStatus(planPolicy, err), err
+		return extcontroller.ErrorConditions(planPolicy, err), err
 	}
 
 	if err = kuadrantCtx.AddDataTo(ctx, planPolicy, types.DomainAuth, "plan", planPolicy.BuildCelExpression()); err != nil {
 		r.Logger.Error(err, "failed to add data to auth domain")
-		return calculateErrorStatus(planPolicy, err), err
-	}
-
-	if err = isRateLimitPolicyEnforced(rateLimitPolicy.(*kuadrantv1.RateLimitPolicy)); err != nil {
-		return calculateErrorStatus(planPolicy, err), err
+		return extcontroller.ErrorConditions(planPolicy, err), err
 	}
 
-	return calculateEnforcedStatus(planPolicy, nil), nil
-}
-
-func (r *PlanPolicyReconciler) reconcileStatus(ctx context.Context, pol *v1alpha1.PlanPolicy, newStatus *v1alpha1.PlanPolicyStatus) (reconcile.Result, error) {
-	equalStatus := pol.Status.Equals(newStatus, r.Logger)
-	r.Logger.Info("Status", "status is different", !equalStatus)
-	r.Logger.Info("Status", "generation is different", pol.Generation != pol.Status.ObservedGeneration)
-	if equalStatus && pol.Generation == pol.Status.ObservedGeneration {
-		// Steady state
-		r.Logger.Info("Status was not updated")
-		return reconcile.Result{}, nil
+	if err = extcontroller.PoliciesEnforced(rateLimitPolicy.(*kuadrantv1.RateLimitPolicy)); err != nil {
+		return extcontroller.ErrorConditions(planPolicy, err), err
 	}
 
-	r.Logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", pol.Status.ObservedGeneration, newStatus.ObservedGeneration))
-
-	pol.Status = *newStatus
-	updateErr := r.Client.Status().Update(ctx, pol)
-	if updateErr != nil {
-		// Ignore conflicts, resource might just be outdated.
-		if errors.IsConflict(updateErr) {
-			r.Logger.Info("Failed to update status: resource might just be outdated")
-			return reconcile.Result{Requeue: true}, nil
-		}
-
-		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", updateErr)
-	}
-	return reconcile.Result{}, nil
+	return extcontroller.EnforcedConditions(planPolicy, nil), nil
 }
 
 func (r *PlanPolicyReconciler) buildDesiredRateLimitPolicy(planPolicy *v1alpha1.PlanPolicy) *kuadrantv1.RateLimitPolicy {
@@ -159,37 +133,3 @@ func rlpSpecMutator(existingObj, desiredObj client.Object) (bool, error) {
 	}
 	return update, nil
 }
-
-func isRateLimitPolicyEnforced(rlPolicy *kuadrantv1.RateLimitPolicy) error {
-	cond, found := lo.Find(rlPolicy.Status.GetConditions(), func(c metav1.Condition) bool {
-		return c.Type == string(types.PolicyConditionEnforced)
-	})
-	if !found || cond.Status == metav1.ConditionFalse {
-		return fmt.Errorf("RateLimitPolicy %s is not enforced", rlPolicy.Name)
-	}
-	return nil
-}
-
-func calculateErrorStatus(pol *v1alpha1.PlanPolicy, specErr error) *v1alpha1.PlanPolicyStatus {
-	newStatus := &v1alpha1.PlanPolicyStatus{
-		ObservedGeneration: pol.Generation,
-		// Copy initial conditions. Otherwise, status will always be updated
-		Conditions: slices.Clone(pol.Status.Conditions),
-	}
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.AcceptedCondition(pol, specErr))
-	meta.RemoveStatusCondition(&newStatus.Conditions, string(types.PolicyConditionEnforced))
-
-	return newStatus
-}
-
-func calculateEnforcedStatus(pol *v1alpha1.PlanPolicy, enforcedErr error) *v1alpha1.PlanPolicyStatus {
-	newStatus := &v1alpha1.PlanPolicyStatus{
-		ObservedGeneration: pol.Generation,
-		// Copy initial conditions. Otherwise, status will always be updated
-		Conditions: slices.Clone(pol.Status.Conditions),
-	}
-
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.AcceptedCondition(pol, nil))
-	meta.SetStatusCondition(&newStatus.Conditions, *extcontroller.EnforcedCondition(pol, enforcedErr, true))
-	return newStatus
-}
@@ -12,6 +12,7 @@ import (
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	"github.com/kuadrant/kuadrant-operator/cmd/extensions/plan-policy/internal/controller"
 	extcontroller "github.com/kuadrant/kuadrant-operator/pkg/extension/controller"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
 var (
@@ -31,6 +32,7 @@ func main() {
 		WithReconciler(planPolicyReconciler.Reconcile).
 		For(&v1alpha1.PlanPolicy{}).
 		Owns(&kuadrantv1.RateLimitPolicy{}).
+		WithFeatures(extpb.FeatureRegisterMutator, extpb.FeatureDomainAuth).
 		Build()
 	if err != nil {
 		logger.Error(err, "unable to create controller")
@@ -18,12 +18,11 @@ package v1alpha1
 
 import (
 	"github.com/go-logr/logr"
-	"github.com/google/go-cmp/cmp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
-	extctrl "github.com/kuadrant/kuadrant-operator/pkg/extension/controller"
+	exttypes "github.com/kuadrant/kuadrant-operator/pkg/extension/types"
 )
 
 //+kubebuilder:object:root=true
@@ -63,6 +62,10 @@ func (p *TelemetryPolicy) GetTargetRefs() []gatewayapiv1alpha2.LocalPolicyTarget
 	}
 }
 
+func (p *TelemetryPolicy) GetPolicyStatus() exttypes.PolicyStatus {
+	return &p.Status
+}
+
 // MetricsSpec defines the configuration for telemetry metrics
 type MetricsSpec struct {
 	// Default metrics configuration that applies to all requests
@@ -93,22 +96,23 @@ type TelemetryPolicyStatus struct {
 }
 
 func (s *TelemetryPolicyStatus) Equals(other *TelemetryPolicyStatus, logger logr.Logger) bool {
-	if s.ObservedGeneration != other.ObservedGeneration {
-		diff := cmp.Diff(s.ObservedGeneration, other.ObservedGeneration)
-		logger.V(1).Info("status observedGeneration not equal", "difference", diff)
-		return false
-	}
+	return exttypes.StatusEquals(s, other, logger)
+}
 
-	// Marshalling sorts by condition type
-	currentMarshaledJSON, _ := extctrl.ConditionMarshal(s.Conditions)
-	otherMarshaledJSON, _ := extctrl.ConditionMarshal(other.Conditions)
-	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
-		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
-		logger.V(1).Info("status conditions not equal", "difference", diff)
-		return false
-	}
+func (s *TelemetryPolicyStatus) GetObservedGeneration() int64 {
+	return s.ObservedGeneration
+}
+
+func (s *TelemetryPolicyStatus) SetObservedGeneration(generation int64) {
+	s.ObservedGeneration = generation
+}
+
+func (s *TelemetryPolicyStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
 
-	return true
+func (s *TelemetryPolicyStatus) SetConditions(conditions []metav1.Condition) {
+	s.Conditions = conditions
 }
 
 //+kubebuilder:object:root=true
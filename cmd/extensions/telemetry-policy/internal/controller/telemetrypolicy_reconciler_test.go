@@ -0,0 +1,71 @@
+//go:build unit
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kuadrant/kuadrant-operator/cmd/extensions/telemetry-policy/api/v1alpha1"
+	exttesting "github.com/kuadrant/kuadrant-operator/pkg/extension/testing"
+	"github.com/kuadrant/kuadrant-operator/pkg/extension/types"
+)
+
+func TestTelemetryPolicyReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, v1alpha1.AddToScheme(scheme))
+
+	policy := &v1alpha1.TelemetryPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "TelemetryPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "telemetry", Namespace: "default", Generation: 1},
+		Spec: v1alpha1.TelemetryPolicySpec{
+			TargetRef: gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gatewayapiv1alpha2.LocalPolicyTargetReference{
+					Group: gatewayapiv1.GroupName,
+					Kind:  "Gateway",
+					Name:  "my-gateway",
+				},
+			},
+			Metrics: v1alpha1.MetricsSpec{
+				Default: v1alpha1.MetricsConfig{Labels: map[string]string{"user": "auth.identity.userid"}},
+			},
+		},
+	}
+	topology, err := exttesting.NewTopology(&gatewayapiv1.Gateway{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gatewayapiv1.GroupVersion.String(), Kind: "Gateway"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-gateway", Namespace: "default"},
+	})
+	assert.NilError(t, err)
+	kuadrant, err := exttesting.NewKuadrant(topology, exttesting.WithScheme(scheme), exttesting.WithObjects(policy))
+	assert.NilError(t, err)
+	defer kuadrant.Close()
+	ctx := kuadrant.Context(context.Background())
+
+	_, err = NewTelemetryPolicyReconciler().Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)}, kuadrant.KuadrantCtx())
+	assert.NilError(t, err)
+
+	mutators, err := kuadrant.Mutators(policy)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, mutators, []exttesting.Mutator{{
+		TargetRef:  "gateway.gateway.networking.k8s.io:default/my-gateway",
+		Domain:     types.DomainRequest,
+		Binding:    types.KuadrantMetricBinding("user"),
+		Expression: "auth.identity.userid",
+	}})
+
+	reconciled := &v1alpha1.TelemetryPolicy{}
+	assert.NilError(t, kuadrant.Client().Get(ctx, client.ObjectKeyFromObject(policy), reconciled))
+	assert.Equal(t, reconciled.Status.ObservedGeneration, int64(1))
+	assert.Assert(t, meta.IsStatusConditionTrue(reconciled.Status.Conditions, "Enforced"))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kuadrant/kuadrant-operator/cmd/extensions/telemetry-policy/api/v1alpha1"
 	"github.com/kuadrant/kuadrant-operator/cmd/extensions/telemetry-policy/internal/controller"
 	extcontroller "github.com/kuadrant/kuadrant-operator/pkg/extension/controller"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
 var (
@@ -27,6 +28,7 @@ func main() {
 		WithScheme(scheme).
 		WithReconciler(telemetryPolicyReconciler.Reconcile).
 		For(&v1alpha1.TelemetryPolicy{}).
+		WithFeatures(extpb.FeatureRegisterMutator, extpb.FeatureDomainRequest).
 		Build()
 	if err != nil {
 		logger.Error(err, "unable to create controller")
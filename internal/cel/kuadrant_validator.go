@@ -1,6 +1,8 @@
 package cel
 
 import (
+	"fmt"
+
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types/ref"
 	"github.com/samber/lo"
@@ -27,7 +29,7 @@ type Issue struct {
 
 func NewIssue(action wasm.Action, pathID string, err error) *Issue {
 	return &Issue{
-		policyKind: policyKindFromWasmServiceName(action.ServiceName),
+		policyKind: policyKindFromWasmServiceName(action.BaseServiceName()),
 		pathID:     pathID,
 		err:        err,
 	}
@@ -104,7 +106,7 @@ func NewRootValidatorBuilder() *ValidatorBuilder {
 }
 
 func ValidateWasmAction(action wasm.Action, validator *Validator) error {
-	pol := policyKindFromWasmServiceName(action.ServiceName)
+	pol := policyKindFromWasmServiceName(action.BaseServiceName())
 	for _, predicate := range action.Predicates {
 		if _, err := validator.Validate(pol, predicate); err != nil {
 			return err
@@ -116,15 +118,38 @@ func ValidateWasmAction(action wasm.Action, validator *Validator) error {
 				return err
 			}
 		}
+		for _, data := range conditionalData.Data {
+			expression, ok := data.Value.(*wasm.Expression)
+			if !ok || expression.ExpressionItem.Key != wasm.HitsAddendKey {
+				continue
+			}
+			if err := validateHitsAddend(pol, expression.ExpressionItem.Value, validator); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// validateHitsAddend checks the expression computing the increment of the counters evaluates to a number
+func validateHitsAddend(policyKind, expr string, validator *Validator) error {
+	ast, err := validator.Validate(policyKind, expr)
+	if err != nil {
+		return err
+	}
+	switch outputType := ast.OutputType(); outputType.Kind() {
+	case cel.IntType.Kind(), cel.UintType.Kind(), cel.DynType.Kind(), cel.AnyType.Kind():
+		return nil
+	default:
+		return fmt.Errorf("hits addend expression `%s` must evaluate to a number, got %s", expr, outputType)
+	}
+}
+
 func policyKindFromWasmServiceName(serviceName string) string {
 	switch serviceName {
 	case wasm.AuthServiceName:
 		return AuthPolicyKind
-	case wasm.RateLimitServiceName:
+	case wasm.RateLimitServiceName, wasm.RateLimitShadowServiceName:
 		return RateLimitPolicyKind
 	case wasm.RateLimitCheckServiceName:
 		return TokenRateLimitPolicyKind
@@ -136,3 +136,42 @@ func TestIssueCollectionGetByPolicyKind(t *testing.T) {
 	_, found = collection.GetByPolicyKind("non-existent")
 	assert.Equal(t, found, false)
 }
+
+func TestValidateWasmActionHitsAddend(t *testing.T) {
+	builder := NewRootValidatorBuilder()
+	builder.PushPolicyBinding(TokenRateLimitPolicyKind, RateLimitName, cel.AnyType)
+	validator, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	action := func(hitsAddend string) wasm.Action {
+		return wasm.Action{
+			ServiceName: wasm.RateLimitReportServiceName,
+			Scope:       "scope",
+			ConditionalData: []wasm.ConditionalData{
+				{
+					Data: []wasm.DataType{
+						{
+							Value: &wasm.Expression{
+								ExpressionItem: wasm.ExpressionItem{Key: "limit.a", Value: "1"},
+							},
+						},
+						{
+							Value: &wasm.Expression{
+								ExpressionItem: wasm.ExpressionItem{Key: wasm.HitsAddendKey, Value: hitsAddend},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	assert.NilError(t, ValidateWasmAction(action(`responseBodyJSON("/usage/total_tokens")`), validator))
+	assert.NilError(t, ValidateWasmAction(action(`responseBodyJSON("/usage/input_tokens") + 4 * responseBodyJSON("/usage/output_tokens")`), validator))
+	assert.NilError(t, ValidateWasmAction(action("0"), validator))
+	assert.ErrorContains(t, ValidateWasmAction(action(`responseBodyJSON("/usage/total_tokens"`), validator), "Syntax error")
+	assert.ErrorContains(t, ValidateWasmAction(action(`unknown.tokens`), validator), "undeclared reference to 'unknown'")
+	assert.ErrorContains(t, ValidateWasmAction(action(`"many"`), validator), "must evaluate to a number")
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/kuadrant/policy-machinery/controller"
@@ -13,6 +14,7 @@ import (
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 )
 
 type AuthPolicyValidator struct {
@@ -28,6 +30,7 @@ func (r *AuthPolicyValidator) Subscription() controller.Subscription {
 		Events: []controller.ResourceEventMatcher{
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind, EventType: ptr.To(controller.CreateEvent)},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind, EventType: ptr.To(controller.UpdateEvent)},
 		},
@@ -58,9 +61,15 @@ func (r *AuthPolicyValidator) Validate(ctx context.Context, _ []controller.Resou
 				res = controller.GatewaysResource.GroupResource()
 			case machinery.HTTPRouteGroupKind.Kind:
 				res = controller.HTTPRoutesResource.GroupResource()
+			case machinery.GRPCRouteGroupKind.Kind:
+				res = GRPCRoutesResource.GroupResource()
 			}
 			err = kuadrant.NewErrPolicyTargetNotFound(kuadrantv1.AuthPolicyGroupKind.Kind, ref, apierrors.NewNotFound(res, ref.GetName()))
 		}
+		// audit mode relies on the mode of the wasm actions, otherwise the denials would be enforced
+		if authPolicy, ok := policy.(*kuadrantv1.AuthPolicy); err == nil && ok && authPolicy.IsAudit() && !wasm.ShimFeatureSupported(WASMFilterImageURL, wasm.ShimFeatureActionMode) {
+			err = kuadrant.NewErrInvalid(kuadrantv1.AuthPolicyGroupKind.Kind, fmt.Errorf("audit mode is not supported by the wasm-shim image %s", WASMFilterImageURL))
+		}
 		return policy.GetLocator(), err
 	}))
 
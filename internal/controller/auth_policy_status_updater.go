@@ -48,6 +48,7 @@ func (r *AuthPolicyStatusUpdater) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantauthorino.AuthConfigGroupKind},
 			{Kind: &kuadrantistio.EnvoyFilterGroupKind},
@@ -141,17 +142,20 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 
 	// check the state of the rules of the policy in the effective policies
 	policyRuleKeys := lo.Keys(policy.Rules())
-	overridingPolicies := map[string][]string{}                     // policyRuleKey → locators of policies overriding the policy rule
-	affectedGateways := map[string]affectedGateway{}                // Gateway locator → {GatewayClass, Gateway}
-	affectedHTTPRouteRules := map[string]*machinery.HTTPRouteRule{} // pathID → HTTPRouteRule
-	setAffectedObjects := func(pathID string, gatewayClass *machinery.GatewayClass, gateway *machinery.Gateway, httpRouteRule *machinery.HTTPRouteRule) {
+	overridingPolicies := map[string][]string{}             // policyRuleKey → locators of policies overriding the policy rule
+	affectedGateways := map[string]affectedGateway{}        // Gateway locator → {GatewayClass, Gateway}
+	affectedRouteRules := map[string]machinery.Targetable{} // pathID → HTTPRouteRule or GRPCRouteRule
+	setAffectedObjects := func(pathID string, gatewayClass *machinery.GatewayClass, gateway *machinery.Gateway, routeRule machinery.Targetable) {
 		affectedGateways[gateway.GetLocator()] = affectedGateway{
 			gateway:      gateway,
 			gatewayClass: gatewayClass,
 		}
-		affectedHTTPRouteRules[pathID] = httpRouteRule
+		affectedRouteRules[pathID] = routeRule
 	}
 
+	// whether the denials of the policy are not enforced in any of the affected paths
+	auditOnly := policy.IsAudit()
+
 	var celValidationErrors []error
 	var celIssuesByPathID map[string][]*cel.Issue
 	var celIssuesFound bool
@@ -173,7 +177,7 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 			}
 		}
 
-		gatewayClass, gateway, listener, httpRoute, httpRouteRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+		gatewayClass, gateway, listener, route, routeRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
 		if err != nil {
 			if errors.As(err, &kuadrantpolicymachinery.ErrInvalidPath{}) {
 				logger.V(1).Info("skipping effectivePolicy for invalid path", "path", effectivePolicy.Path)
@@ -182,7 +186,7 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 			}
 			continue
 		}
-		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsHTTPRouteReady(httpRoute.HTTPRoute, gateway.Gateway, gatewayClass.Spec.ControllerName) {
+		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsRouteReady(route, gateway.Gateway, gatewayClass.Spec.ControllerName) {
 			continue
 		}
 		effectivePolicyRules := effectivePolicy.Spec.Rules()
@@ -196,13 +200,14 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 					overridingPolicies[policyRuleKey] = append(overridingPolicies[policyRuleKey], overriddenBy)
 					continue
 				}
-				// policy rule is in the effective policy, track the Gateway and the HTTPRouteRule affected by the policy
-				setAffectedObjects(pathID, gatewayClass, gateway, httpRouteRule)
+				// policy rule is in the effective policy, track the Gateway and the route rule affected by the policy
+				setAffectedObjects(pathID, gatewayClass, gateway, routeRule)
+				auditOnly = auditOnly && isEffectiveAuthPolicyAuditOnly(effectivePolicy)
 			}
 			continue
 		}
-		// effective policy has no rules, track the Gateway and the HTTPRouteRule affected by the policy
-		setAffectedObjects(pathID, gatewayClass, gateway, httpRouteRule)
+		// effective policy has no rules, track the Gateway and the route rule affected by the policy
+		setAffectedObjects(pathID, gatewayClass, gateway, routeRule)
 	}
 
 	if len(affectedGateways) == 0 { // no rules of the policy found in the effective policies
@@ -230,9 +235,9 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 
 	// check status of the authconfigs
 	isAuthConfigReady := authConfigReadyStatusFunc(state)
-	for pathID, httpRouteRule := range affectedHTTPRouteRules {
+	for pathID, routeRule := range affectedRouteRules {
 		authConfigName := AuthConfigNameForPath(pathID)
-		authConfig, found := lo.Find(topology.Objects().Children(httpRouteRule), func(authConfig machinery.Object) bool {
+		authConfig, found := lo.Find(topology.Objects().Children(routeRule), func(authConfig machinery.Object) bool {
 			return authConfig.GroupVersionKind().GroupKind() == kuadrantauthorino.AuthConfigGroupKind && authConfig.GetName() == authConfigName
 		})
 		if !found || !isAuthConfigReady(authConfig.(*controller.RuntimeObject).Object.(*authorinov1beta3.AuthConfig)) {
@@ -282,7 +287,11 @@ func (r *AuthPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.AuthPolic
 		return kuadrant.EnforcedCondition(policy, kuadrant.NewErrOutOfSync(policyKind, componentsToSync), false)
 	}
 
-	return kuadrant.EnforcedCondition(policy, nil, len(overridingPolicies) == 0)
+	enforcedCond := kuadrant.EnforcedCondition(policy, nil, len(overridingPolicies) == 0)
+	if auditOnly {
+		enforcedCond.Message += " (audit only: denials are logged but requests are allowed)"
+	}
+	return enforcedCond
 }
 
 func authorinoOperatorConditionToProperConditionFunc(condition authorinooperatorv1beta1.Condition, _ int) metav1.Condition {
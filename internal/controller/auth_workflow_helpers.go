@@ -62,15 +62,31 @@ func AuthClusterName(gatewayName string) string {
 	return fmt.Sprintf("kuadrant-auth-%s", gatewayName)
 }
 
-func authClusterPatch(host string, port int, mTLS bool) map[string]any {
+// authServiceClusterName returns the name of the cluster of the Authorino instance that enforces the policies of a gateway,
+// given its dedicated instance, if any
+func authServiceClusterName(dedicated *kuadrantv1beta1.ServiceEndpoint) string {
+	if dedicated == nil {
+		return kuadrant.KuadrantAuthClusterName
+	}
+	return kuadrant.DedicatedServiceClusterName(kuadrant.KuadrantAuthClusterName, dedicated.Host, dedicated.Port)
+}
+
+// authClusterPatch returns a builder of the patch that adds the auth cluster with the given name to the gateway
+func authClusterPatch(clusterName string) func(string, int, bool) map[string]any {
+	return func(host string, port int, mTLS bool) map[string]any {
+		return buildAuthClusterPatch(clusterName, host, port, mTLS)
+	}
+}
+
+func buildAuthClusterPatch(clusterName, host string, port int, mTLS bool) map[string]any {
 	patch := map[string]any{
-		"name":                   kuadrant.KuadrantAuthClusterName,
+		"name":                   clusterName,
 		"type":                   "STRICT_DNS",
 		"connect_timeout":        "1s",
 		"lb_policy":              "ROUND_ROBIN",
 		"http2_protocol_options": map[string]any{},
 		"load_assignment": map[string]any{
-			"cluster_name": kuadrant.KuadrantAuthClusterName,
+			"cluster_name": clusterName,
 			"endpoints": []map[string]any{
 				{
 					"lb_endpoints": []map[string]any{
@@ -165,8 +181,29 @@ func buildWasmActionsForAuth(pathID string, effectivePolicy EffectiveAuthPolicy)
 		Scope:       AuthConfigNameForPath(pathID),
 		Predicates:  spec.Predicates.Into(),
 	}
+	if isEffectiveAuthPolicyAuditOnly(effectivePolicy) && wasm.ShimFeatureSupported(WASMFilterImageURL, wasm.ShimFeatureActionMode) {
+		action.Mode = wasm.ActionModeShadow
+	}
+
+	return withServiceSettings([]wasm.Action{action}, spec.MergeableServiceSettings)
+}
 
-	return []wasm.Action{action}
+// isEffectiveAuthPolicyAuditOnly tells whether all the policies contributing rules to an effective auth policy are
+// in audit mode. Rules of policies in enforce mode always cause the denials of the effective policy to be enforced.
+func isEffectiveAuthPolicyAuditOnly(effectivePolicy EffectiveAuthPolicy) bool {
+	sources := lo.Uniq(lo.Map(lo.Values(effectivePolicy.Spec.Rules()), func(rule kuadrantv1.MergeableRule, _ int) string {
+		return rule.GetSource()
+	}))
+	if len(sources) == 0 {
+		return false
+	}
+	policies := kuadrantv1.PoliciesInPath(effectivePolicy.Path, func(p machinery.Policy) bool {
+		return lo.Contains(sources, p.GetLocator())
+	})
+	return len(policies) == len(sources) && lo.EveryBy(policies, func(p machinery.Policy) bool {
+		authPolicy, ok := p.(*kuadrantv1.AuthPolicy)
+		return ok && authPolicy.IsAudit()
+	})
 }
 
 func isAuthPolicyAcceptedAndNotDeletedFunc(state *sync.Map) func(machinery.Policy) bool {
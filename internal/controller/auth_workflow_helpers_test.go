@@ -0,0 +1,89 @@
+//go:build unit
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kuadrant/policy-machinery/machinery"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+)
+
+func TestIsEffectiveAuthPolicyAuditOnly(t *testing.T) {
+	authPolicy := func(name string, mode kuadrantv1.AuthPolicyMode) *kuadrantv1.AuthPolicy {
+		return &kuadrantv1.AuthPolicy{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       kuadrantv1.AuthPolicyGroupKind.Kind,
+				APIVersion: kuadrantv1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: kuadrantv1.AuthPolicySpec{
+				AuthPolicySpecProper: kuadrantv1.AuthPolicySpecProper{
+					Mode: mode,
+					AuthScheme: &kuadrantv1.AuthSchemeSpec{
+						Authentication: map[string]kuadrantv1.MergeableAuthenticationSpec{
+							name: {},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	effectivePolicy := func(policies ...*kuadrantv1.AuthPolicy) EffectiveAuthPolicy {
+		gateway := &machinery.Gateway{Gateway: &gatewayapiv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"}}}
+		attached := make([]machinery.Policy, 0, len(policies))
+		merged := &kuadrantv1.AuthPolicy{}
+		rules := map[string]kuadrantv1.MergeableRule{}
+		for _, policy := range policies {
+			attached = append(attached, policy)
+			for key, rule := range policy.Rules() {
+				rules[key] = rule
+			}
+		}
+		gateway.SetPolicies(attached)
+		merged.SetRules(rules)
+		return EffectiveAuthPolicy{Path: []machinery.Targetable{gateway}, Spec: *merged}
+	}
+
+	testCases := []struct {
+		name     string
+		policies []*kuadrantv1.AuthPolicy
+		expected bool
+	}{
+		{
+			name:     "policy in enforce mode",
+			policies: []*kuadrantv1.AuthPolicy{authPolicy("a", "")},
+			expected: false,
+		},
+		{
+			name:     "policy in audit mode",
+			policies: []*kuadrantv1.AuthPolicy{authPolicy("a", kuadrantv1.AuthPolicyModeAudit)},
+			expected: true,
+		},
+		{
+			name:     "all policies in audit mode",
+			policies: []*kuadrantv1.AuthPolicy{authPolicy("a", kuadrantv1.AuthPolicyModeAudit), authPolicy("b", kuadrantv1.AuthPolicyModeAudit)},
+			expected: true,
+		},
+		{
+			name:     "policy in audit mode merged with policy in enforce mode",
+			policies: []*kuadrantv1.AuthPolicy{authPolicy("a", kuadrantv1.AuthPolicyModeAudit), authPolicy("b", kuadrantv1.AuthPolicyModeEnforce)},
+			expected: false,
+		},
+		{
+			name:     "no rules",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, isEffectiveAuthPolicyAuditOnly(effectivePolicy(tc.policies...)), tc.expected)
+		})
+	}
+}
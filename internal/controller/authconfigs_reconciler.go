@@ -39,6 +39,7 @@ func (r *AuthConfigsReconciler) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantauthorino.AuthConfigGroupKind},
 		},
@@ -69,7 +70,7 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 	modifiedAuthConfigs := []string{}
 
 	for pathID, effectivePolicy := range effectivePoliciesMap {
-		_, _, _, httpRoute, httpRouteRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+		_, _, _, route, routeRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
 		if err != nil {
 			if errors.As(err, &kuadrantpolicymachinery.ErrInvalidPath{}) {
 				logger.V(1).Info("skipping authconfig reconcile for invalid path", "path", effectivePolicy.Path)
@@ -79,8 +80,8 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 
 			continue
 		}
-		httpRouteKey := k8stypes.NamespacedName{Name: httpRoute.GetName(), Namespace: httpRoute.GetNamespace()}
-		httpRouteRuleKey := httpRouteRule.Name
+		routeKey := k8stypes.NamespacedName{Name: route.GetName(), Namespace: route.GetNamespace()}
+		routeRuleKey := routeRule.GetName()
 
 		authConfigName := AuthConfigNameForPath(pathID)
 		desiredAuthConfig := r.buildDesiredAuthConfig(effectivePolicy, authConfigName, authConfigsNamespace)
@@ -88,7 +89,7 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 
 		resource := r.client.Resource(kuadrantauthorino.AuthConfigsResource).Namespace(desiredAuthConfig.GetNamespace())
 
-		existingAuthConfigObj, found := lo.Find(topology.Objects().Children(httpRouteRule), func(child machinery.Object) bool {
+		existingAuthConfigObj, found := lo.Find(topology.Objects().Children(routeRule), func(child machinery.Object) bool {
 			return child.GroupVersionKind().GroupKind() == kuadrantauthorino.AuthConfigGroupKind && child.GetName() == authConfigName && labels.Set(child.(*controller.RuntimeObject).GetLabels()).AsSelector().Matches(labels.Set(desiredAuthConfig.GetLabels()))
 		})
 
@@ -97,12 +98,12 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 			modifiedAuthConfigs = append(modifiedAuthConfigs, authConfigName)
 			desiredAuthConfigUnstructured, err := controller.Destruct(desiredAuthConfig)
 			if err != nil {
-				logger.Error(err, "failed to destruct authconfig object", "httpRoute", httpRouteKey.String(), "httpRouteRule", httpRouteRuleKey, "authconfig", desiredAuthConfig)
+				logger.Error(err, "failed to destruct authconfig object", "route", routeKey.String(), "routeRule", routeRuleKey, "authconfig", desiredAuthConfig)
 				continue
 			}
 
 			if _, err = resource.Create(ctx, desiredAuthConfigUnstructured, metav1.CreateOptions{}); err != nil {
-				logger.Error(err, "failed to create authconfig object", "httpRoute", httpRouteKey.String(), "httpRouteRule", httpRouteRuleKey, "authconfig", desiredAuthConfigUnstructured.Object)
+				logger.Error(err, "failed to create authconfig object", "route", routeKey.String(), "routeRule", routeRuleKey, "authconfig", desiredAuthConfigUnstructured.Object)
 				// TODO: handle error
 			}
 			continue
@@ -120,7 +121,7 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 		// delete
 		if utils.IsObjectTaggedToDelete(desiredAuthConfig) && !utils.IsObjectTaggedToDelete(existingAuthConfig) {
 			if err := resource.Delete(ctx, existingAuthConfig.GetName(), metav1.DeleteOptions{}); err != nil {
-				logger.Error(err, "failed to delete wasmplugin object", "httpRoute", httpRouteKey.String(), "httpRouteRule", httpRouteRuleKey, "authconfig", fmt.Sprintf("%s/%s", existingAuthConfig.GetNamespace(), existingAuthConfig.GetName()))
+				logger.Error(err, "failed to delete wasmplugin object", "route", routeKey.String(), "routeRule", routeRuleKey, "authconfig", fmt.Sprintf("%s/%s", existingAuthConfig.GetNamespace(), existingAuthConfig.GetName()))
 				// TODO: handle error
 			}
 			continue
@@ -131,11 +132,11 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 
 		existingAuthConfigUnstructured, err := controller.Destruct(existingAuthConfig)
 		if err != nil {
-			logger.Error(err, "failed to destruct authconfig object", "httpRoute", httpRouteKey.String(), "httpRouteRule", httpRouteRuleKey, "authconfig", existingAuthConfig)
+			logger.Error(err, "failed to destruct authconfig object", "route", routeKey.String(), "routeRule", routeRuleKey, "authconfig", existingAuthConfig)
 			continue
 		}
 		if _, err = resource.Update(ctx, existingAuthConfigUnstructured, metav1.UpdateOptions{}); err != nil {
-			logger.Error(err, "failed to update authconfig object", "httpRoute", httpRouteKey.String(), "httpRouteRule", httpRouteRuleKey, "authconfig", existingAuthConfigUnstructured.Object)
+			logger.Error(err, "failed to update authconfig object", "route", routeKey.String(), "routeRule", routeRuleKey, "authconfig", existingAuthConfigUnstructured.Object)
 			// TODO: handle error
 		}
 	}
@@ -160,7 +161,12 @@ func (r *AuthConfigsReconciler) Reconcile(ctx context.Context, _ []controller.Re
 }
 
 func (r *AuthConfigsReconciler) buildDesiredAuthConfig(effectivePolicy EffectiveAuthPolicy, name, namespace string) *authorinov1beta3.AuthConfig {
-	_, _, _, _, httpRouteRule, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+	_, _, _, _, routeRule, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+
+	routeRuleAnnotation := kuadrantauthorino.AuthConfigHTTPRouteRuleAnnotation
+	if _, ok := routeRule.(*machinery.GRPCRouteRule); ok {
+		routeRuleAnnotation = kuadrantauthorino.AuthConfigGRPCRouteRuleAnnotation
+	}
 
 	authConfig := &authorinov1beta3.AuthConfig{
 		TypeMeta: metav1.TypeMeta{
@@ -171,7 +177,7 @@ func (r *AuthConfigsReconciler) buildDesiredAuthConfig(effectivePolicy Effective
 			Name:      name,
 			Namespace: namespace,
 			Annotations: map[string]string{
-				kuadrantauthorino.AuthConfigHTTPRouteRuleAnnotation: httpRouteRule.GetLocator(),
+				routeRuleAnnotation: routeRule.GetLocator(),
 			},
 			Labels: AuthObjectLabels(),
 		},
@@ -273,12 +279,17 @@ func authorinoSpecsFromConfigs[T, U any](configs map[string]U, extractAuthorinoS
 }
 
 func equalAuthConfigs(existing, desired *authorinov1beta3.AuthConfig) bool {
-	// httprouterule back ref annotation
+	// httprouterule / grpcrouterule back ref annotation
 	existingAnnotations := existing.GetAnnotations()
 	desiredAnnotations := desired.GetAnnotations()
-	if existingAnnotations == nil || desiredAnnotations == nil || existingAnnotations[kuadrantauthorino.AuthConfigHTTPRouteRuleAnnotation] != desiredAnnotations[kuadrantauthorino.AuthConfigHTTPRouteRuleAnnotation] {
+	if existingAnnotations == nil || desiredAnnotations == nil {
 		return false
 	}
+	for _, annotation := range []string{kuadrantauthorino.AuthConfigHTTPRouteRuleAnnotation, kuadrantauthorino.AuthConfigGRPCRouteRuleAnnotation} {
+		if existingAnnotations[annotation] != desiredAnnotations[annotation] {
+			return false
+		}
+	}
 
 	// labels
 	existingLabels := existing.GetLabels()
@@ -51,6 +51,7 @@ func (a *AuthorinoIstioIntegrationReconciler) Subscription() *controller.Subscri
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 		},
 	}
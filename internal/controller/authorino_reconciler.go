@@ -8,8 +8,8 @@ import (
 	"github.com/kuadrant/policy-machinery/controller"
 	"github.com/kuadrant/policy-machinery/machinery"
 	"github.com/samber/lo"
-	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/utils/ptr"
 
@@ -31,30 +31,26 @@ func (r *AuthorinoReconciler) Subscription() *controller.Subscription {
 		ReconcileFunc: r.Reconcile,
 		Events: []controller.ResourceEventMatcher{
 			{Kind: ptr.To(v1beta1.KuadrantGroupKind), EventType: ptr.To(controller.CreateEvent)},
+			{Kind: ptr.To(v1beta1.KuadrantGroupKind), EventType: ptr.To(controller.UpdateEvent)},
+			{Kind: ptr.To(v1beta1.AuthorinoGroupKind), EventType: ptr.To(controller.UpdateEvent)},
 			{Kind: ptr.To(v1beta1.AuthorinoGroupKind), EventType: ptr.To(controller.DeleteEvent)},
 		},
 	}
 }
 
-func (r *AuthorinoReconciler) Reconcile(ctx context.Context, _ []controller.ResourceEvent, topology *machinery.Topology, _ error, _ *sync.Map) error {
+func (r *AuthorinoReconciler) Reconcile(ctx context.Context, events []controller.ResourceEvent, topology *machinery.Topology, _ error, _ *sync.Map) error {
 	logger := controller.LoggerFromContext(ctx).WithName("AuthorinoReconciler")
-	logger.V(1).Info("reconciling authorino resource", "status", "started")
-	defer logger.V(1).Info("reconciling authorino resource", "status", "completed")
 
-	kobj := GetKuadrantFromTopology(topology)
-	if kobj == nil {
+	if !lo.SomeBy(events, authorinoSpecEvent) {
+		logger.V(1).Info("no changes to the spec of the authorino resource, skipping")
 		return nil
 	}
 
-	aobjs := lo.FilterMap(topology.Objects().Objects().Children(kobj), func(item machinery.Object, _ int) (machinery.Object, bool) {
-		if item.GroupVersionKind().Kind == v1beta1.AuthorinoGroupKind.Kind {
-			return item, true
-		}
-		return nil, false
-	})
+	logger.V(1).Info("reconciling authorino resource", "status", "started")
+	defer logger.V(1).Info("reconciling authorino resource", "status", "completed")
 
-	if len(aobjs) > 0 {
-		logger.V(1).Info("authorino resource already exists, no need to create", "status", "skipping")
+	kobj := GetKuadrantFromTopology(topology)
+	if kobj == nil {
 		return nil
 	}
 
@@ -77,37 +73,71 @@ func (r *AuthorinoReconciler) Reconcile(ctx context.Context, _ []controller.Reso
 				},
 			},
 		},
-		Spec: v1beta2.AuthorinoSpec{
-			ClusterWide:            true,
-			SupersedingHostSubsets: true,
-			Listener: v1beta2.Listener{
-				Tls: v1beta2.Tls{
-					Enabled: ptr.To(false),
-				},
-			},
-			OIDCServer: v1beta2.OIDCServer{
-				Tls: v1beta2.Tls{
-					Enabled: ptr.To(false),
-				},
-			},
-		},
+		Spec: desiredAuthorinoSpec(kobj),
 	}
 
 	unstructuredAuthorino, err := controller.Destruct(authorino)
 	if err != nil {
 		logger.Error(err, "failed to destruct authorino", "status", "error")
+		return err
 	}
-	logger.V(1).Info("creating authorino resource", "status", "processing")
-	_, err = r.Client.Resource(v1beta1.AuthorinosResource).Namespace(authorino.Namespace).Create(ctx, unstructuredAuthorino, metav1.CreateOptions{})
+	// the status is owned by the authorino operator
+	unstructured.RemoveNestedField(unstructuredAuthorino.Object, "status")
+
+	logger.V(1).Info("applying authorino resource", "status", "processing")
+	_, err = r.Client.Resource(v1beta1.AuthorinosResource).Namespace(authorino.Namespace).Apply(ctx, unstructuredAuthorino.GetName(), unstructuredAuthorino, metav1.ApplyOptions{Force: true, FieldManager: FieldManagerName})
 	if err != nil {
-		if apiErrors.IsAlreadyExists(err) {
-			logger.V(1).Info("already created authorino resource", "status", "acceptable")
-		} else {
-			logger.Error(err, "failed to create authorino resource", "status", "error")
-		}
-	} else {
-		logger.Info("created authorino resource", "status", "acceptable")
+		logger.Error(err, "failed to apply authorino resource", "status", "error")
+		return err
 	}
 
 	return nil
 }
+
+// authorinoSpecEvent tells whether an event may require the managed Authorino to be applied.
+// Updates of the Authorino that leave its spec untouched, e.g. status updates, do not.
+func authorinoSpecEvent(event controller.ResourceEvent) bool {
+	if event.Kind != v1beta1.AuthorinoGroupKind || event.EventType != controller.UpdateEvent {
+		return true
+	}
+	if event.OldObject == nil || event.NewObject == nil {
+		return true
+	}
+	return event.OldObject.GetGeneration() != event.NewObject.GetGeneration()
+}
+
+// desiredAuthorinoSpec merges the authorino tuning of the kuadrant CR into the default spec of the managed Authorino
+func desiredAuthorinoSpec(kobj *v1beta1.Kuadrant) v1beta2.AuthorinoSpec {
+	spec := v1beta2.AuthorinoSpec{
+		ClusterWide:            true,
+		SupersedingHostSubsets: true,
+		Listener: v1beta2.Listener{
+			Tls: v1beta2.Tls{
+				Enabled: ptr.To(false),
+			},
+		},
+		OIDCServer: v1beta2.OIDCServer{
+			Tls: v1beta2.Tls{
+				Enabled: ptr.To(false),
+			},
+		},
+	}
+
+	tuning := kobj.Spec.Authorino
+	if tuning == nil {
+		return spec
+	}
+
+	spec.Replicas = tuning.Replicas
+	spec.LogLevel = tuning.LogLevel
+	spec.LogMode = tuning.LogMode
+	spec.EvaluatorCacheSize = tuning.EvaluatorCacheSize
+	if tuning.OIDCServer != nil {
+		spec.OIDCServer.Tls = tuning.OIDCServer.TLS
+	}
+	if tuning.Tracing != nil {
+		spec.Tracing = *tuning.Tracing
+	}
+
+	return spec
+}
@@ -0,0 +1,126 @@
+//go:build unit
+
+package controllers
+
+import (
+	"testing"
+
+	authorinooperatorv1beta1 "github.com/kuadrant/authorino-operator/api/v1beta1"
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/samber/lo"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+func TestDesiredAuthorinoSpec(t *testing.T) {
+	defaultSpec := func() authorinooperatorv1beta1.AuthorinoSpec {
+		return authorinooperatorv1beta1.AuthorinoSpec{
+			ClusterWide:            true,
+			SupersedingHostSubsets: true,
+			Listener: authorinooperatorv1beta1.Listener{
+				Tls: authorinooperatorv1beta1.Tls{Enabled: ptr.To(false)},
+			},
+			OIDCServer: authorinooperatorv1beta1.OIDCServer{
+				Tls: authorinooperatorv1beta1.Tls{Enabled: ptr.To(false)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		spec     *kuadrantv1beta1.AuthorinoSpec
+		expected func() authorinooperatorv1beta1.AuthorinoSpec
+	}{
+		{
+			name:     "no authorino tuning",
+			spec:     nil,
+			expected: defaultSpec,
+		},
+		{
+			name: "all fields are merged",
+			spec: &kuadrantv1beta1.AuthorinoSpec{
+				Replicas:           ptr.To(int32(2)),
+				LogLevel:           "debug",
+				LogMode:            "development",
+				EvaluatorCacheSize: ptr.To(10),
+				OIDCServer: &kuadrantv1beta1.AuthorinoOIDCServer{
+					TLS: authorinooperatorv1beta1.Tls{
+						Enabled:    ptr.To(true),
+						CertSecret: &corev1.LocalObjectReference{Name: "oidc-cert"},
+					},
+				},
+				Tracing: &authorinooperatorv1beta1.Tracing{Endpoint: "rpc://tempo:4317", Insecure: true},
+			},
+			expected: func() authorinooperatorv1beta1.AuthorinoSpec {
+				spec := defaultSpec()
+				spec.Replicas = ptr.To(int32(2))
+				spec.LogLevel = "debug"
+				spec.LogMode = "development"
+				spec.EvaluatorCacheSize = ptr.To(10)
+				spec.OIDCServer.Tls = authorinooperatorv1beta1.Tls{
+					Enabled:    ptr.To(true),
+					CertSecret: &corev1.LocalObjectReference{Name: "oidc-cert"},
+				}
+				spec.Tracing = authorinooperatorv1beta1.Tracing{Endpoint: "rpc://tempo:4317", Insecure: true}
+				return spec
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(subT *testing.T) {
+			kobj := &kuadrantv1beta1.Kuadrant{Spec: kuadrantv1beta1.KuadrantSpec{Authorino: tt.spec}}
+			assert.DeepEqual(subT, desiredAuthorinoSpec(kobj), tt.expected())
+		})
+	}
+}
+
+func TestAuthorinoSpecEvent(t *testing.T) {
+	authorino := func(generation int64, ready bool) *authorinooperatorv1beta1.Authorino {
+		return &authorinooperatorv1beta1.Authorino{
+			ObjectMeta: metav1.ObjectMeta{Name: "authorino", Namespace: "kuadrant-system", Generation: generation},
+			Status: authorinooperatorv1beta1.AuthorinoStatus{
+				Conditions: []authorinooperatorv1beta1.Condition{
+					{Type: authorinooperatorv1beta1.ConditionReady, Status: lo.Ternary(ready, corev1.ConditionTrue, corev1.ConditionFalse)},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		event    controller.ResourceEvent
+		expected bool
+	}{
+		{
+			name:     "kuadrant update",
+			event:    controller.ResourceEvent{Kind: kuadrantv1beta1.KuadrantGroupKind, EventType: controller.UpdateEvent},
+			expected: true,
+		},
+		{
+			name:     "authorino deleted",
+			event:    controller.ResourceEvent{Kind: kuadrantv1beta1.AuthorinoGroupKind, EventType: controller.DeleteEvent, OldObject: authorino(1, true)},
+			expected: true,
+		},
+		{
+			name:     "authorino spec updated",
+			event:    controller.ResourceEvent{Kind: kuadrantv1beta1.AuthorinoGroupKind, EventType: controller.UpdateEvent, OldObject: authorino(1, true), NewObject: authorino(2, true)},
+			expected: true,
+		},
+		{
+			name:     "authorino status updated",
+			event:    controller.ResourceEvent{Kind: kuadrantv1beta1.AuthorinoGroupKind, EventType: controller.UpdateEvent, OldObject: authorino(1, false), NewObject: authorino(1, true)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(subT *testing.T) {
+			assert.Equal(subT, authorinoSpecEvent(tt.event), tt.expected)
+		})
+	}
+}
@@ -3,17 +3,25 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/kuadrant/policy-machinery/machinery"
 	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	"github.com/kuadrant/kuadrant-operator/internal/extension"
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 )
 
 const (
@@ -51,6 +59,56 @@ func PolicyAffectedCondition(policyKind string, policies []machinery.Policy) met
 	return condition
 }
 
+// ExtensionPolicyAffectedCondition is the condition of an object targeted by extension policies of a kind, based on the
+// status the extensions reported for the policies: it is false as long as any of them reported a false condition
+func ExtensionPolicyAffectedCondition(policyKind string, statuses []extension.ReportedStatus) metav1.Condition {
+	condition := metav1.Condition{
+		Type:   PolicyAffectedConditionType(policyKind),
+		Status: metav1.ConditionTrue,
+		Reason: string(gatewayapiv1alpha2.PolicyReasonAccepted),
+		Message: fmt.Sprintf("Object affected by %s %s", policyKind, lo.Map(statuses, func(item extension.ReportedStatus, _ int) client.ObjectKey {
+			return client.ObjectKey{Name: item.Policy.Name, Namespace: item.Policy.Namespace}
+		})),
+	}
+
+	var problems []string
+	for _, status := range statuses {
+		for _, c := range status.Conditions {
+			if c.Status != metav1.ConditionFalse {
+				continue
+			}
+			if len(problems) == 0 {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = c.Reason
+			}
+			problems = append(problems, fmt.Sprintf("%s %s/%s: %s", policyKind, status.Policy.Namespace, status.Policy.Name, c.Message))
+		}
+	}
+	if len(problems) > 0 {
+		condition.Message = strings.Join(problems, "; ")
+	}
+
+	return condition
+}
+
+type extensionPolicyCondition struct {
+	policyKind string
+	// condition is nil when no policy of the kind targets the object
+	condition *metav1.Condition
+}
+
+// extensionPolicyConditions returns the conditions of the objects for each kind of extension policy that reported its status
+func extensionPolicyConditions(targets ...machinery.Targetable) []extensionPolicyCondition {
+	statuses := extension.ReportedStatusesFor(targets...)
+	return lo.Map(extension.ReportedPolicyKinds(), func(kind string, _ int) extensionPolicyCondition {
+		ofKind := lo.Filter(statuses, func(status extension.ReportedStatus, _ int) bool { return status.Policy.Kind == kind })
+		if len(ofKind) == 0 {
+			return extensionPolicyCondition{policyKind: kind}
+		}
+		return extensionPolicyCondition{policyKind: kind, condition: ptr.To(ExtensionPolicyAffectedCondition(kind, ofKind))}
+	})
+}
+
 func PolicyAffectedConditionType(policyKind string) string {
 	return fmt.Sprintf(PolicyAffectedConditionPattern, policyKind)
 }
@@ -83,3 +141,39 @@ func policyGroupKinds() []*schema.GroupKind {
 		&kuadrantv1.DNSPolicyGroupKind,
 	}
 }
+
+// wasmServiceClusters returns the clusters of the services called by the wasm module of a gateway,
+// i.e. the ones of the dedicated instances of the gateway set in the kuadrant cr or else the ones managed by kuadrant
+func wasmServiceClusters(kObj *kuadrantv1beta1.Kuadrant, gateway *machinery.Gateway) wasm.ServiceClusters {
+	return wasm.ServiceClusters{
+		Auth:      authServiceClusterName(kObj.AuthorinoForGateway(gateway.GetNamespace(), gateway.GetName())),
+		RateLimit: rateLimitServiceClusterName(kObj.LimitadorForGateway(gateway.GetNamespace(), gateway.GetName())),
+	}
+}
+
+// withServiceSettings points the wasm actions to variants of their services with the failure mode and timeout
+// of the effective policy, if set
+func withServiceSettings(actions []wasm.Action, settings kuadrantv1.MergeableServiceSettings) []wasm.Action {
+	if !settings.IsSet() {
+		return actions
+	}
+	return lo.Map(actions, func(action wasm.Action, _ int) wasm.Action {
+		return action.WithServiceOverrides(wasm.FailureModeType(settings.FailureMode), (*string)(settings.Timeout))
+	})
+}
+
+// explicitRouteHostnames returns the sorted hostnames of the routes attached to a listener that are within the scope of
+// the listener, excluding wildcard hostnames
+func explicitRouteHostnames(topology *machinery.Topology, listener *machinery.Listener) []string {
+	routes := lo.Filter(topology.Targetables().Children(listener), func(t machinery.Targetable, _ int) bool {
+		return kuadrantgatewayapi.RouteObject(t) != nil
+	})
+	hostnames := lo.FlatMap(routes, func(route machinery.Targetable, _ int) []string {
+		return lo.FilterMap(kuadrantgatewayapi.HostnamesFromListenerAndRoute(listener.Listener, route), func(hostname gatewayapiv1.Hostname, _ int) (string, bool) {
+			return string(hostname), !strings.HasPrefix(string(hostname), "*")
+		})
+	})
+	hostnames = lo.Uniq(hostnames)
+	sort.Strings(hostnames)
+	return hostnames
+}
@@ -0,0 +1,37 @@
+//go:build unit
+
+package controllers
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kuadrant/kuadrant-operator/internal/extension"
+)
+
+func TestExtensionPolicyAffectedCondition(t *testing.T) {
+	accepted := extension.ReportedStatus{
+		Policy:     extension.ResourceID{Kind: "PlanPolicy", Namespace: "ns", Name: "gold"},
+		Conditions: []metav1.Condition{{Type: "Enforced", Status: metav1.ConditionTrue, Reason: "Enforced"}},
+	}
+	failing := extension.ReportedStatus{
+		Policy: extension.ResourceID{Kind: "PlanPolicy", Namespace: "ns", Name: "silver"},
+		Conditions: []metav1.Condition{
+			{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"},
+			{Type: "Enforced", Status: metav1.ConditionFalse, Reason: "Unknown", Message: "RateLimitPolicy ns/silver: not enforced"},
+		},
+	}
+
+	condition := ExtensionPolicyAffectedCondition("PlanPolicy", []extension.ReportedStatus{accepted})
+	assert.Equal(t, condition.Type, "kuadrant.io/PlanPolicyAffected")
+	assert.Equal(t, condition.Status, metav1.ConditionTrue)
+	assert.Equal(t, condition.Reason, "Accepted")
+	assert.Equal(t, condition.Message, "Object affected by PlanPolicy [ns/gold]")
+
+	condition = ExtensionPolicyAffectedCondition("PlanPolicy", []extension.ReportedStatus{accepted, failing})
+	assert.Equal(t, condition.Status, metav1.ConditionFalse)
+	assert.Equal(t, condition.Reason, "Unknown")
+	assert.Equal(t, condition.Message, "PlanPolicy ns/silver: RateLimitPolicy ns/silver: not enforced")
+}
@@ -20,6 +20,7 @@ import (
 	kuadrantauthorino "github.com/kuadrant/kuadrant-operator/internal/authorino"
 	kuadrantenvoygateway "github.com/kuadrant/kuadrant-operator/internal/envoygateway"
 	kuadrantistio "github.com/kuadrant/kuadrant-operator/internal/istio"
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 )
 
 const (
@@ -28,7 +29,7 @@ const (
 )
 
 var (
-	WASMFilterImageURL = env.GetString("RELATED_IMAGE_WASMSHIM", "oci://quay.io/kuadrant/wasm-shim:latest")
+	WASMFilterImageURL = wasm.ShimImageURL
 	// protectedRegistry this defines a default protected registry. If this is in the wasm image URL we add a pull secret name to the WASMPLugin resource
 	ProtectedRegistry = env.GetString("PROTECTED_REGISTRY", "registry.redhat.io")
 
@@ -44,6 +45,7 @@ var (
 		{Kind: &machinery.GatewayClassGroupKind},
 		{Kind: &machinery.GatewayGroupKind},
 		{Kind: &machinery.HTTPRouteGroupKind},
+		{Kind: &machinery.GRPCRouteGroupKind},
 		{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 		{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 		{Kind: &kuadrantv1beta1.LimitadorGroupKind},
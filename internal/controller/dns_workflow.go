@@ -69,7 +69,7 @@ func LinkListenerToDNSRecord(objs controller.Store) machinery.LinkFunc {
 			return lo.FilterMap(listeners, func(l *machinery.Listener, _ int) (machinery.Object, bool) {
 				if dnsRecord, ok := child.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord); ok {
 					return l, l.GetNamespace() == dnsRecord.GetNamespace() &&
-						dnsRecord.GetName() == dnsRecordName(l.Gateway.Name, string(l.Name))
+						isDNSRecordOfListener(dnsRecord, l.Gateway.Name, string(l.Name))
 				}
 				return nil, false
 			})
@@ -13,18 +13,87 @@ import (
 	"github.com/kuadrant/dns-operator/pkg/builder"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	"github.com/kuadrant/kuadrant-operator/internal/utils"
 )
 
 const (
-	LabelListenerReference = "kuadrant.io/listener-name"
+	LabelListenerReference   = "kuadrant.io/listener-name"
+	LabelRouteHostnameRecord = "kuadrant.io/route-hostname-record"
 )
 
 func dnsRecordName(gatewayName, listenerName string) string {
 	return fmt.Sprintf("%s-%s", gatewayName, listenerName)
 }
 
-func desiredDNSRecord(gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy *kuadrantv1.DNSPolicy, targetListener gatewayapiv1.Listener) (*kuadrantdnsv1alpha1.DNSRecord, error) {
-	rootHost := string(*targetListener.Hostname)
+// dnsRouteRecordName returns the name of the DNSRecord of a route hostname within a gateway listener
+func dnsRouteRecordName(gatewayName, listenerName, hostname string) string {
+	return fmt.Sprintf("%s-%s", dnsRecordName(gatewayName, listenerName), utils.ToBase36HashLen(hostname, 8))
+}
+
+// dnsRecordNameForProvider returns the name of the DNSRecord of a provider of a policy with multiple providers.
+// The name ends with a hash of the name of the provider, so it does not depend on the position of the provider in the
+// policy, and the hash is shorter than the one of the route hostnames, so it does not collide with a route record.
+func dnsRecordNameForProvider(baseName string, providerRef kuadrantdnsv1alpha1.ProviderRef) string {
+	return fmt.Sprintf("%s-%s", baseName, utils.ToBase36HashLen(providerRef.Name, 6))
+}
+
+// isDNSRecordOfListener returns true if the DNSRecord was created for the listener of the gateway, either for the
+// hostname of the listener or for a hostname of a route attached to the listener, and for any of the providers
+func isDNSRecordOfListener(dnsRecord *kuadrantdnsv1alpha1.DNSRecord, gatewayName, listenerName string) bool {
+	baseName := dnsRecordName(gatewayName, listenerName)
+	if _, isRouteRecord := dnsRecord.GetLabels()[LabelRouteHostnameRecord]; isRouteRecord {
+		baseName = dnsRouteRecordName(gatewayName, listenerName, dnsRecord.Spec.RootHost)
+	}
+	if dnsRecord.GetName() == baseName {
+		return true
+	}
+	return dnsRecord.Spec.ProviderRef != nil && dnsRecord.GetName() == dnsRecordNameForProvider(baseName, *dnsRecord.Spec.ProviderRef)
+}
+
+// desiredDNSRecords builds the DNSRecords for the hostname of the listener, one per provider of the policy
+func desiredDNSRecords(gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy *kuadrantv1.DNSPolicy, targetListener gatewayapiv1.Listener) ([]*kuadrantdnsv1alpha1.DNSRecord, error) {
+	return buildDNSRecords(dnsRecordName(gateway.Name, string(targetListener.Name)), string(*targetListener.Hostname), gateway, clusterID, dnsPolicy, targetListener)
+}
+
+// desiredRouteDNSRecords builds the DNSRecords for a hostname of a route attached to the listener, one per provider of
+// the policy
+func desiredRouteDNSRecords(gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy *kuadrantv1.DNSPolicy, targetListener gatewayapiv1.Listener, hostname string) ([]*kuadrantdnsv1alpha1.DNSRecord, error) {
+	dnsRecords, err := buildDNSRecords(dnsRouteRecordName(gateway.Name, string(targetListener.Name), hostname), hostname, gateway, clusterID, dnsPolicy, targetListener)
+	if err != nil {
+		return nil, err
+	}
+	for _, dnsRecord := range dnsRecords {
+		dnsRecord.Labels[LabelRouteHostnameRecord] = "true"
+	}
+	return dnsRecords, nil
+}
+
+// buildDNSRecords builds a DNSRecord for each provider of the policy, or a single DNSRecord without provider if the
+// policy does not reference any. The record of a single provider keeps the given name, like the records of the policies
+// created before multiple providers were supported.
+func buildDNSRecords(name, rootHost string, gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy *kuadrantv1.DNSPolicy, targetListener gatewayapiv1.Listener) ([]*kuadrantdnsv1alpha1.DNSRecord, error) {
+	dnsRecord, err := buildDNSRecord(name, rootHost, gateway, clusterID, dnsPolicy, targetListener)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dnsPolicy.Spec.ProviderRefs) == 0 {
+		return []*kuadrantdnsv1alpha1.DNSRecord{dnsRecord}, nil
+	}
+
+	dnsRecords := make([]*kuadrantdnsv1alpha1.DNSRecord, 0, len(dnsPolicy.Spec.ProviderRefs))
+	for _, providerRef := range dnsPolicy.Spec.ProviderRefs {
+		providerRecord := dnsRecord.DeepCopy()
+		if len(dnsPolicy.Spec.ProviderRefs) > 1 {
+			providerRecord.Name = dnsRecordNameForProvider(name, providerRef)
+		}
+		providerRecord.Spec.ProviderRef = &kuadrantdnsv1alpha1.ProviderRef{Name: providerRef.Name}
+		dnsRecords = append(dnsRecords, providerRecord)
+	}
+	return dnsRecords, nil
+}
+
+func buildDNSRecord(name, rootHost string, gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy *kuadrantv1.DNSPolicy, targetListener gatewayapiv1.Listener) (*kuadrantdnsv1alpha1.DNSRecord, error) {
 	var healthCheckSpec *kuadrantdnsv1alpha1.HealthCheckSpec
 
 	if dnsPolicy.Spec.HealthCheck != nil {
@@ -40,7 +109,7 @@ func desiredDNSRecord(gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy
 
 	dnsRecord := &kuadrantdnsv1alpha1.DNSRecord{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      dnsRecordName(gateway.Name, string(targetListener.Name)),
+			Name:      name,
 			Namespace: dnsPolicy.Namespace,
 			Labels:    CommonLabels(),
 		},
@@ -58,14 +127,9 @@ func desiredDNSRecord(gateway *gatewayapiv1.Gateway, clusterID string, dnsPolicy
 		dnsRecord.Spec.Delegate = true
 	}
 
-	// Currently we only allow a single providerRef to be added. When that changes, we will need to update this to deal with multiple records.
-	if len(dnsPolicy.Spec.ProviderRefs) > 0 {
-		dnsRecord.Spec.ProviderRef = &dnsPolicy.Spec.ProviderRefs[0]
-	}
-
 	dnsRecord.Labels[LabelListenerReference] = string(targetListener.Name)
 
-	endpoints, err := buildEndpoints(clusterID, string(*targetListener.Hostname), gateway, dnsPolicy)
+	endpoints, err := buildEndpoints(clusterID, rootHost, gateway, dnsPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate dns record for a gateway %s in %s ns: %w", gateway.Name, gateway.Namespace, err)
 	}
@@ -203,6 +203,7 @@ var NegativePolarityConditions []string
 func propagateRecordConditions(records []*kuadrantdnsv1alpha1.DNSRecord, policyStatus *kuadrantv1.DNSPolicyStatus) {
 	//reset conditions
 	policyStatus.RecordConditions = map[string][]metav1.Condition{}
+	providerRecordConditions := map[string]map[string][]metav1.Condition{}
 
 	for _, record := range records {
 		var allConditions []metav1.Condition
@@ -232,6 +233,26 @@ func propagateRecordConditions(records []*kuadrantdnsv1alpha1.DNSRecord, policyS
 			policyStatus.RecordConditions[record.Spec.RootHost] = append(
 				policyStatus.RecordConditions[record.Spec.RootHost],
 				condition)
+
+			if record.Spec.ProviderRef != nil {
+				provider := record.Spec.ProviderRef.Name
+				if providerRecordConditions[provider] == nil {
+					providerRecordConditions[provider] = map[string][]metav1.Condition{}
+				}
+				providerRecordConditions[provider][record.Spec.RootHost] = append(
+					providerRecordConditions[provider][record.Spec.RootHost],
+					condition)
+			}
 		}
 	}
+
+	providers := lo.Keys(providerRecordConditions)
+	slices.Sort(providers)
+	policyStatus.ProviderRecordConditions = nil
+	for _, provider := range providers {
+		policyStatus.ProviderRecordConditions = append(policyStatus.ProviderRecordConditions, kuadrantv1.ProviderRecordConditions{
+			Name:             provider,
+			RecordConditions: providerRecordConditions[provider],
+		})
+	}
 }
@@ -138,6 +138,45 @@ func Test_propagateRecordConditions(t *testing.T) {
 				}
 			},
 		},
+		{
+			Name: "Conditions are also listed by provider",
+			Records: []*kuadrantdnsv1alpha1.DNSRecord{
+				{
+					Spec: kuadrantdnsv1alpha1.DNSRecordSpec{RootHost: rootHost, ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "internal"}},
+					Status: kuadrantdnsv1alpha1.DNSRecordStatus{
+						Conditions: []metav1.Condition{
+							healthyProviderCondition,
+						},
+					},
+				},
+				{
+					Spec: kuadrantdnsv1alpha1.DNSRecordSpec{RootHost: rootHost, ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "public"}},
+					Status: kuadrantdnsv1alpha1.DNSRecordStatus{
+						HealthCheck: &kuadrantdnsv1alpha1.HealthCheckStatus{
+							Conditions: []metav1.Condition{
+								unhealthyProbesCondition,
+							},
+						},
+					},
+				},
+			},
+			PolicyStatus: &kuadrantv1.DNSPolicyStatus{},
+			Validate: func(t *testing.T, policyStatus *kuadrantv1.DNSPolicyStatus) {
+				if conditions := policyStatus.RecordConditions[rootHost]; len(conditions) != 1 || !reflect.DeepEqual(conditions[0], unhealthyProbesCondition) {
+					t.Fatalf("expected the unhealthy probes condition for root host, found %v", conditions)
+				}
+				if len(policyStatus.ProviderRecordConditions) != 1 {
+					t.Fatalf("expected conditions for the public provider only, found %v", policyStatus.ProviderRecordConditions)
+				}
+				providerConditions := policyStatus.ProviderRecordConditions[0]
+				if providerConditions.Name != "public" {
+					t.Fatalf("expected conditions for the public provider, found %s", providerConditions.Name)
+				}
+				if conditions := providerConditions.RecordConditions[rootHost]; len(conditions) != 1 || !reflect.DeepEqual(conditions[0], unhealthyProbesCondition) {
+					t.Fatalf("expected the unhealthy probes condition for the public provider, found %v", conditions)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
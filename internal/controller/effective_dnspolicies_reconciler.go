@@ -44,6 +44,8 @@ func (r *EffectiveDNSPoliciesReconciler) Subscription() controller.Subscription
 		ReconcileFunc: r.reconcile,
 		Events: []controller.ResourceEventMatcher{
 			{Kind: &machinery.GatewayGroupKind},
+			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.DNSPolicyGroupKind},
 			{Kind: &DNSRecordGroupKind},
 		},
@@ -96,8 +98,21 @@ func (r *EffectiveDNSPoliciesReconciler) reconcile(ctx context.Context, _ []cont
 			lLogger := pLogger.WithValues("listener", listener.GetLocator())
 
 			gateway := listener.Gateway
-			if listener.Hostname == nil || *listener.Hostname == "" {
+			listenerHasHostname := listener.Hostname != nil && *listener.Hostname != ""
+
+			var routeHostnames []string
+			if policy.Spec.RouteHostnames {
+				routeHostnames = routeHostnamesForListener(topology, listener)
+			}
+
+			existingRecords := lo.Filter(topology.Objects().Children(listener), func(o machinery.Object, _ int) bool {
+				_, ok := o.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord)
+				return ok && o.GetNamespace() == listener.GetNamespace()
+			})
+
+			if !listenerHasHostname && len(routeHostnames) == 0 {
 				lLogger.Info("listener has no hostname assigned, skipping")
+				r.deleteStaleRecords(ctx, existingRecords, nil)
 				continue
 			}
 
@@ -116,90 +131,35 @@ func (r *EffectiveDNSPoliciesReconciler) reconcile(ctx context.Context, _ []cont
 				gatewayHasAttachedRoutes = true
 			}
 
-			desiredRecord, err := desiredDNSRecord(gateway.Gateway, clusterID, policy, *listener.Listener)
+			desiredRecords, err := r.desiredRecordsForListener(clusterID, policy, listener, listenerHasHostname, routeHostnames)
 			if err != nil {
-				lLogger.Error(err, "failed to build desired dns record")
+				lLogger.Error(err, "failed to build desired dns records")
 				continue
 			}
-			if err = controllerutil.SetControllerReference(policy, desiredRecord, r.scheme); err != nil {
-				lLogger.Error(err, "failed to set owner reference on desired record")
-				continue
-			}
-
-			resource := r.client.Resource(DNSRecordResource).Namespace(desiredRecord.GetNamespace())
-
-			existingRecordObj, recordExists := lo.Find(topology.Objects().Children(listener), func(o machinery.Object) bool {
-				_, ok := o.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord)
-				return ok && o.GetNamespace() == listener.GetNamespace() && o.GetName() == dnsRecordName(listener.Gateway.Name, string(listener.Name))
-			})
+			retainExistingRecordNames(existingRecords, desiredRecords)
 
-			if len(desiredRecord.Spec.Endpoints) == 0 {
-				policyErrors[policy.GetLocator()] = ErrNoAddresses
-			}
-
-			//Update
-			if recordExists {
-				rLogger := lLogger.WithValues("record", existingRecordObj.GetLocator())
-
-				existingRecord := existingRecordObj.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord)
-
-				// Deal with the potential deletion of a record first
-				if !hasAttachedRoute || len(desiredRecord.Spec.Endpoints) == 0 {
-					if !hasAttachedRoute {
-						rLogger.V(1).Info("listener has no attached routes, deleting record for listener")
-					} else {
-						rLogger.V(1).Info("no endpoint addresses for DNSRecord, deleting record for listener")
-					}
-					r.deleteRecord(ctx, existingRecordObj)
-					continue
+			recreating := false
+			for _, desiredRecord := range desiredRecords {
+				if len(desiredRecord.Spec.Endpoints) == 0 {
+					policyErrors[policy.GetLocator()] = ErrNoAddresses
 				}
 
-				if !canUpdateDNSRecord(ctx, existingRecord, desiredRecord) {
-					rLogger.V(1).Info("unable to update record, deleting record for listener and re-creating")
-					r.deleteRecord(ctx, existingRecordObj)
+				existingRecordObj, _ := lo.Find(existingRecords, func(o machinery.Object) bool {
+					return o.GetName() == desiredRecord.GetName()
+				})
+				if !r.reconcileRecord(controller.LoggerIntoContext(ctx, lLogger), existingRecordObj, desiredRecord, hasAttachedRoute) {
+					recreating = true
 					break
 				}
-
-				if reflect.DeepEqual(existingRecord.Spec, desiredRecord.Spec) {
-					rLogger.V(1).Info("dns record is up to date, nothing to do")
-					continue
-				}
-				existingRecord.Spec = desiredRecord.Spec
-
-				un, err := controller.Destruct(existingRecord)
-				if err != nil {
-					lLogger.Error(err, "unable to destruct dns record")
-					continue
-				}
-
-				rLogger.V(1).Info("updating record for listener")
-				if _, uErr := resource.Update(ctx, un, metav1.UpdateOptions{}); uErr != nil {
-					rLogger.Error(uErr, "unable to update dns record")
-				}
-				continue
 			}
 
-			if !hasAttachedRoute {
-				lLogger.V(1).Info("listener has no attached routes, skipping record create for listener")
-				continue
+			// a record deleted to be re-created stops the reconciliation of the listeners of the policy, which is
+			// resumed by the event of the deletion
+			if recreating {
+				break
 			}
 
-			if len(desiredRecord.Spec.Endpoints) == 0 {
-				lLogger.V(1).Info("record for listener has no addresses, skipping record create for listener")
-				continue
-			}
-
-			un, err := controller.Destruct(desiredRecord)
-			if err != nil {
-				lLogger.Error(err, "unable to destruct dns record")
-				continue
-			}
-
-			//Create
-			lLogger.V(1).Info("creating DNS record for listener")
-			if _, cErr := resource.Create(ctx, un, metav1.CreateOptions{}); cErr != nil && !apierrors.IsAlreadyExists(cErr) {
-				lLogger.Error(cErr, "unable to create dns record")
-			}
+			r.deleteStaleRecords(ctx, existingRecords, desiredRecords)
 		}
 
 		if !gatewayHasAddresses {
@@ -216,6 +176,149 @@ func (r *EffectiveDNSPoliciesReconciler) reconcile(ctx context.Context, _ []cont
 	return r.deleteOrphanDNSRecords(controller.LoggerIntoContext(ctx, logger), topology)
 }
 
+// reconcileRecord creates, updates or deletes the DNSRecord of a listener, depending on the desired record and on the
+// existing one, if any. It returns false if the existing record cannot be updated and was deleted to be re-created.
+func (r *EffectiveDNSPoliciesReconciler) reconcileRecord(ctx context.Context, existingRecordObj machinery.Object, desiredRecord *kuadrantdnsv1alpha1.DNSRecord, hasAttachedRoute bool) bool {
+	logger := controller.LoggerFromContext(ctx)
+
+	resource := r.client.Resource(DNSRecordResource).Namespace(desiredRecord.GetNamespace())
+
+	//Update
+	if existingRecordObj != nil {
+		rLogger := logger.WithValues("record", existingRecordObj.GetLocator())
+
+		existingRecord := existingRecordObj.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord)
+
+		// Deal with the potential deletion of a record first
+		if !hasAttachedRoute || len(desiredRecord.Spec.Endpoints) == 0 {
+			if !hasAttachedRoute {
+				rLogger.V(1).Info("listener has no attached routes, deleting record for listener")
+			} else {
+				rLogger.V(1).Info("no endpoint addresses for DNSRecord, deleting record for listener")
+			}
+			r.deleteRecord(ctx, existingRecordObj)
+			return true
+		}
+
+		if !canUpdateDNSRecord(ctx, existingRecord, desiredRecord) {
+			rLogger.V(1).Info("unable to update record, deleting record for listener and re-creating")
+			r.deleteRecord(ctx, existingRecordObj)
+			return false
+		}
+
+		if reflect.DeepEqual(existingRecord.Spec, desiredRecord.Spec) {
+			rLogger.V(1).Info("dns record is up to date, nothing to do")
+			return true
+		}
+		existingRecord.Spec = desiredRecord.Spec
+
+		un, err := controller.Destruct(existingRecord)
+		if err != nil {
+			logger.Error(err, "unable to destruct dns record")
+			return true
+		}
+
+		rLogger.V(1).Info("updating record for listener")
+		if _, uErr := resource.Update(ctx, un, metav1.UpdateOptions{}); uErr != nil {
+			rLogger.Error(uErr, "unable to update dns record")
+		}
+		return true
+	}
+
+	if !hasAttachedRoute {
+		logger.V(1).Info("listener has no attached routes, skipping record create for listener")
+		return true
+	}
+
+	if len(desiredRecord.Spec.Endpoints) == 0 {
+		logger.V(1).Info("record for listener has no addresses, skipping record create for listener")
+		return true
+	}
+
+	un, err := controller.Destruct(desiredRecord)
+	if err != nil {
+		logger.Error(err, "unable to destruct dns record")
+		return true
+	}
+
+	//Create
+	logger.V(1).Info("creating DNS record for listener", "rootHost", desiredRecord.Spec.RootHost)
+	if _, cErr := resource.Create(ctx, un, metav1.CreateOptions{}); cErr != nil && !apierrors.IsAlreadyExists(cErr) {
+		logger.Error(cErr, "unable to create dns record")
+	}
+	return true
+}
+
+// desiredRecordsForListener builds the DNSRecords of the hostname of the listener and of the given route hostnames,
+// one per provider of the policy
+func (r *EffectiveDNSPoliciesReconciler) desiredRecordsForListener(clusterID string, policy *kuadrantv1.DNSPolicy, listener *machinery.Listener, listenerHasHostname bool, routeHostnames []string) ([]*kuadrantdnsv1alpha1.DNSRecord, error) {
+	var desiredRecords []*kuadrantdnsv1alpha1.DNSRecord
+	if listenerHasHostname {
+		records, err := desiredDNSRecords(listener.Gateway.Gateway, clusterID, policy, *listener.Listener)
+		if err != nil {
+			return nil, err
+		}
+		desiredRecords = append(desiredRecords, records...)
+	}
+	for _, hostname := range routeHostnames {
+		records, err := desiredRouteDNSRecords(listener.Gateway.Gateway, clusterID, policy, *listener.Listener, hostname)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build dns records for route hostname %s: %w", hostname, err)
+		}
+		desiredRecords = append(desiredRecords, records...)
+	}
+	for _, desiredRecord := range desiredRecords {
+		if err := controllerutil.SetControllerReference(policy, desiredRecord, r.scheme); err != nil {
+			return nil, fmt.Errorf("failed to set owner reference on desired record: %w", err)
+		}
+	}
+	return desiredRecords, nil
+}
+
+// retainExistingRecordNames names the desired DNSRecords after the existing records of the same hostname and provider, so
+// that a change of the number of providers of the policy, which changes the names of the desired records, does not
+// delete and re-create the records of the providers that are kept
+func retainExistingRecordNames(existingRecords []machinery.Object, desiredRecords []*kuadrantdnsv1alpha1.DNSRecord) {
+	for _, desiredRecord := range desiredRecords {
+		existingRecordObj, found := lo.Find(existingRecords, func(o machinery.Object) bool {
+			return isSameDNSRecord(o.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord), desiredRecord)
+		})
+		if found {
+			desiredRecord.Name = existingRecordObj.GetName()
+		}
+	}
+}
+
+// isSameDNSRecord returns true if both DNSRecords are for the same hostname, kind of hostname and provider
+func isSameDNSRecord(a, b *kuadrantdnsv1alpha1.DNSRecord) bool {
+	_, aIsRouteRecord := a.GetLabels()[LabelRouteHostnameRecord]
+	_, bIsRouteRecord := b.GetLabels()[LabelRouteHostnameRecord]
+	if a.Spec.RootHost != b.Spec.RootHost || aIsRouteRecord != bIsRouteRecord {
+		return false
+	}
+	if a.Spec.ProviderRef == nil || b.Spec.ProviderRef == nil {
+		return a.Spec.ProviderRef == b.Spec.ProviderRef
+	}
+	return a.Spec.ProviderRef.Name == b.Spec.ProviderRef.Name
+}
+
+// deleteStaleRecords deletes the existing DNSRecords of a listener that are no longer desired, e.g. because a route was
+// detached from the listener, its hostnames changed or a provider was removed from the policy
+func (r *EffectiveDNSPoliciesReconciler) deleteStaleRecords(ctx context.Context, existingRecords []machinery.Object, desiredRecords []*kuadrantdnsv1alpha1.DNSRecord) {
+	for _, existingRecordObj := range staleRecords(existingRecords, desiredRecords) {
+		r.deleteRecord(ctx, existingRecordObj)
+	}
+}
+
+// staleRecords returns the existing DNSRecords that are not desired
+func staleRecords(existingRecords []machinery.Object, desiredRecords []*kuadrantdnsv1alpha1.DNSRecord) []machinery.Object {
+	return lo.Filter(existingRecords, func(existingRecordObj machinery.Object, _ int) bool {
+		return !lo.ContainsBy(desiredRecords, func(desiredRecord *kuadrantdnsv1alpha1.DNSRecord) bool {
+			return desiredRecord.GetName() == existingRecordObj.GetName()
+		})
+	})
+}
+
 // deleteOrphanDNSRecords deletes any DNSRecord resources that exist in the topology but have no parent targettable, policy or path back to the policy.
 func (r *EffectiveDNSPoliciesReconciler) deleteOrphanDNSRecords(ctx context.Context, topology *machinery.Topology) error {
 	logger := controller.LoggerFromContext(ctx).WithName("deleteOrphanDNSRecords")
@@ -255,6 +358,15 @@ func (r *EffectiveDNSPoliciesReconciler) deleteOrphanDNSRecords(ctx context.Cont
 				return true
 			}
 
+			//Provider removed from policy
+			if policy, ok := pPolicies[0].(*kuadrantv1.DNSPolicy); ok {
+				record := item.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord)
+				if !isProviderOfPolicy(record.Spec.ProviderRef, policy) {
+					rLogger.Info("dns record provider is not referenced by the policy, deleting", "policy", pPolicies[0])
+					return true
+				}
+			}
+
 			return false
 		}
 		return false
@@ -282,6 +394,17 @@ func (r *EffectiveDNSPoliciesReconciler) deleteRecord(ctx context.Context, obj m
 	}
 }
 
+// isProviderOfPolicy returns true if the provider of a DNSRecord is one of the providers referenced by the policy, or
+// if neither the record nor the policy reference a provider
+func isProviderOfPolicy(providerRef *kuadrantdnsv1alpha1.ProviderRef, policy *kuadrantv1.DNSPolicy) bool {
+	if providerRef == nil {
+		return len(policy.Spec.ProviderRefs) == 0
+	}
+	return lo.ContainsBy(policy.Spec.ProviderRefs, func(p kuadrantdnsv1alpha1.ProviderRef) bool {
+		return p.Name == providerRef.Name
+	})
+}
+
 // listenersForPolicy returns an array of listeners that are targeted by the given policy.
 // If the target is a Listener a single element array containing that listener is returned.
 // If the target is a Gateway all listeners that do not have a DNS policy explicitly attached are returned.
@@ -303,6 +426,14 @@ func listenersForPolicy(_ context.Context, topology *machinery.Topology, policy
 	}))
 }
 
+// routeHostnamesForListener returns the explicit hostnames of the routes attached to a listener, excluding wildcard
+// hostnames and the hostname of the listener itself
+func routeHostnamesForListener(topology *machinery.Topology, listener *machinery.Listener) []string {
+	return lo.Filter(explicitRouteHostnames(topology, listener), func(hostname string, _ int) bool {
+		return listener.Hostname == nil || hostname != string(*listener.Hostname)
+	})
+}
+
 // canUpdateDNSRecord returns true if the current record can be updated to the desired.
 func canUpdateDNSRecord(ctx context.Context, current, desired *kuadrantdnsv1alpha1.DNSRecord) bool {
 	logger := controller.LoggerFromContext(ctx)
@@ -313,6 +444,12 @@ func canUpdateDNSRecord(ctx context.Context, current, desired *kuadrantdnsv1alph
 		return false
 	}
 
+	// a record moved to another provider must be deleted from the previous provider first
+	if !reflect.DeepEqual(current.Spec.ProviderRef, desired.Spec.ProviderRef) {
+		logger.V(1).Info("provider for existing record has changed")
+		return false
+	}
+
 	// DNSRecord doesn't currently support record type changes due to a limitation of the dns operator
 	// https://github.com/Kuadrant/dns-operator/issues/287
 	for _, curEp := range current.Spec.Endpoints {
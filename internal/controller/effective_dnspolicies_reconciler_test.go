@@ -4,11 +4,22 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	externaldns "sigs.k8s.io/external-dns/endpoint"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	kuadrantdnsv1alpha1 "github.com/kuadrant/dns-operator/api/v1alpha1"
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	"github.com/kuadrant/kuadrant-operator/internal/utils"
 )
 
 func Test_canUpdateDNSRecord(t *testing.T) {
@@ -126,6 +137,22 @@ func Test_canUpdateDNSRecord(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "different providers",
+			current: &kuadrantdnsv1alpha1.DNSRecord{
+				Spec: kuadrantdnsv1alpha1.DNSRecordSpec{
+					RootHost:    "foo.example.com",
+					ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "public"},
+				},
+			},
+			desired: &kuadrantdnsv1alpha1.DNSRecord{
+				Spec: kuadrantdnsv1alpha1.DNSRecordSpec{
+					RootHost:    "foo.example.com",
+					ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "internal"},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -135,3 +162,359 @@ func Test_canUpdateDNSRecord(t *testing.T) {
 		})
 	}
 }
+
+func Test_routeHostnamesForListener(t *testing.T) {
+	tests := []struct {
+		name             string
+		listenerHostname *gatewayapiv1.Hostname
+		routeHostnames   [][]gatewayapiv1.Hostname
+		want             []string
+	}{
+		{
+			name:             "wildcard listener",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("*.apps.example.com")),
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"web.apps.example.com", "*.apps.example.com", "other.com"},
+				{"api.apps.example.com", "web.apps.example.com"},
+			},
+			want: []string{"api.apps.example.com", "web.apps.example.com"},
+		},
+		{
+			name:             "listener hostname is not repeated",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("api.example.com")),
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"api.example.com"},
+			},
+			want: []string{},
+		},
+		{
+			name: "listener without hostname",
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"api.example.com"},
+				{},
+			},
+			want: []string{"api.example.com"},
+		},
+		{
+			name:             "no routes",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("*.apps.example.com")),
+			want:             []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := machinery.BuildGateway(func(g *gatewayapiv1.Gateway) {
+				g.Spec.Listeners[0].Hostname = tt.listenerHostname
+			})
+			httpRoutes := lo.Map(tt.routeHostnames, func(hostnames []gatewayapiv1.Hostname, i int) *gatewayapiv1.HTTPRoute {
+				return machinery.BuildHTTPRoute(func(r *gatewayapiv1.HTTPRoute) {
+					r.Name = fmt.Sprintf("my-http-route-%d", i)
+					r.Spec.Hostnames = hostnames
+				})
+			})
+			topology, err := machinery.NewGatewayAPITopology(
+				machinery.WithGatewayClasses(machinery.BuildGatewayClass()),
+				machinery.WithGateways(gateway),
+				machinery.WithHTTPRoutes(httpRoutes...),
+				machinery.ExpandGatewayListeners(),
+			)
+			if err != nil {
+				t.Fatalf("failed to build topology: %v", err)
+			}
+			listener, found := lo.Find(topology.Targetables().Items(), func(t machinery.Targetable) bool {
+				_, ok := t.(*machinery.Listener)
+				return ok
+			})
+			if !found {
+				t.Fatal("listener not found in the topology")
+			}
+			if got := routeHostnamesForListener(topology, listener.(*machinery.Listener)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("routeHostnamesForListener() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isDNSRecordOfListener(t *testing.T) {
+	routeRecord := func(name, rootHost string) *kuadrantdnsv1alpha1.DNSRecord {
+		return &kuadrantdnsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{LabelRouteHostnameRecord: "true"}},
+			Spec:       kuadrantdnsv1alpha1.DNSRecordSpec{RootHost: rootHost},
+		}
+	}
+	tests := []struct {
+		name      string
+		dnsRecord *kuadrantdnsv1alpha1.DNSRecord
+		want      bool
+	}{
+		{
+			name:      "listener record",
+			dnsRecord: &kuadrantdnsv1alpha1.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: "my-gateway-my-listener"}},
+			want:      true,
+		},
+		{
+			name:      "route hostname record",
+			dnsRecord: routeRecord(dnsRouteRecordName("my-gateway", "my-listener", "api.example.com"), "api.example.com"),
+			want:      true,
+		},
+		{
+			name:      "route hostname record of another listener",
+			dnsRecord: routeRecord(dnsRouteRecordName("my-gateway", "other-listener", "api.example.com"), "api.example.com"),
+			want:      false,
+		},
+		{
+			name: "record of an additional provider",
+			dnsRecord: &kuadrantdnsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: dnsRecordNameForProvider("my-gateway-my-listener", kuadrantdnsv1alpha1.ProviderRef{Name: "public"})},
+				Spec:       kuadrantdnsv1alpha1.DNSRecordSpec{ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "public"}},
+			},
+			want: true,
+		},
+		{
+			name: "route hostname record of an additional provider",
+			dnsRecord: func() *kuadrantdnsv1alpha1.DNSRecord {
+				record := routeRecord(dnsRecordNameForProvider(dnsRouteRecordName("my-gateway", "my-listener", "api.example.com"), kuadrantdnsv1alpha1.ProviderRef{Name: "public"}), "api.example.com")
+				record.Spec.ProviderRef = &kuadrantdnsv1alpha1.ProviderRef{Name: "public"}
+				return record
+			}(),
+			want: true,
+		},
+		{
+			name: "record of another provider",
+			dnsRecord: &kuadrantdnsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: dnsRecordNameForProvider("my-gateway-my-listener", kuadrantdnsv1alpha1.ProviderRef{Name: "public"})},
+				Spec:       kuadrantdnsv1alpha1.DNSRecordSpec{ProviderRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "internal"}},
+			},
+			want: false,
+		},
+		{
+			name: "route hostname record without label",
+			dnsRecord: &kuadrantdnsv1alpha1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: dnsRouteRecordName("my-gateway", "my-listener", "api.example.com")},
+				Spec:       kuadrantdnsv1alpha1.DNSRecordSpec{RootHost: "api.example.com"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDNSRecordOfListener(tt.dnsRecord, "my-gateway", "my-listener"); got != tt.want {
+				t.Errorf("isDNSRecordOfListener() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_desiredDNSRecords(t *testing.T) {
+	gateway := machinery.BuildGateway(func(g *gatewayapiv1.Gateway) {
+		g.Spec.Listeners[0].Hostname = ptr.To(gatewayapiv1.Hostname("api.example.com"))
+		g.Status.Addresses = []gatewayapiv1.GatewayStatusAddress{
+			{Type: ptr.To(gatewayapiv1.IPAddressType), Value: "1.1.1.1"},
+		}
+	})
+
+	tests := []struct {
+		name          string
+		providerRefs  []kuadrantdnsv1alpha1.ProviderRef
+		wantNames     []string
+		wantProviders []*kuadrantdnsv1alpha1.ProviderRef
+	}{
+		{
+			name:          "no providers",
+			wantNames:     []string{"my-gateway-my-listener"},
+			wantProviders: []*kuadrantdnsv1alpha1.ProviderRef{nil},
+		},
+		{
+			name:          "single provider",
+			providerRefs:  []kuadrantdnsv1alpha1.ProviderRef{{Name: "public"}},
+			wantNames:     []string{"my-gateway-my-listener"},
+			wantProviders: []*kuadrantdnsv1alpha1.ProviderRef{{Name: "public"}},
+		},
+		{
+			name:          "multiple providers",
+			providerRefs:  []kuadrantdnsv1alpha1.ProviderRef{{Name: "public"}, {Name: "internal"}},
+			wantNames:     []string{"my-gateway-my-listener-" + utils.ToBase36HashLen("public", 6), "my-gateway-my-listener-" + utils.ToBase36HashLen("internal", 6)},
+			wantProviders: []*kuadrantdnsv1alpha1.ProviderRef{{Name: "public"}, {Name: "internal"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dnsPolicy := &kuadrantv1.DNSPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-dns-policy", Namespace: "my-namespace"},
+				Spec:       kuadrantv1.DNSPolicySpec{ProviderRefs: tt.providerRefs},
+			}
+			records, err := desiredDNSRecords(gateway, "cluster-id", dnsPolicy, gateway.Spec.Listeners[0])
+			if err != nil {
+				t.Fatalf("desiredDNSRecords() unexpected error: %v", err)
+			}
+			names := lo.Map(records, func(r *kuadrantdnsv1alpha1.DNSRecord, _ int) string { return r.Name })
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("desiredDNSRecords() names = %v, want %v", names, tt.wantNames)
+			}
+			providers := lo.Map(records, func(r *kuadrantdnsv1alpha1.DNSRecord, _ int) *kuadrantdnsv1alpha1.ProviderRef {
+				return r.Spec.ProviderRef
+			})
+			if !reflect.DeepEqual(providers, tt.wantProviders) {
+				t.Errorf("desiredDNSRecords() providers = %v, want %v", providers, tt.wantProviders)
+			}
+			for _, record := range records {
+				if record.Spec.RootHost != "api.example.com" || len(record.Spec.Endpoints) == 0 {
+					t.Errorf("desiredDNSRecords() unexpected record spec %v", record.Spec)
+				}
+				if !isDNSRecordOfListener(record, "my-gateway", "my-listener") {
+					t.Errorf("desiredDNSRecords() record %s is not linked to the listener", record.Name)
+				}
+			}
+		})
+	}
+}
+
+func Test_desiredDNSRecordsRemovingFirstProvider(t *testing.T) {
+	gateway := machinery.BuildGateway(func(g *gatewayapiv1.Gateway) {
+		g.Spec.Listeners[0].Hostname = ptr.To(gatewayapiv1.Hostname("api.example.com"))
+		g.Status.Addresses = []gatewayapiv1.GatewayStatusAddress{
+			{Type: ptr.To(gatewayapiv1.IPAddressType), Value: "1.1.1.1"},
+		}
+	})
+	recordNames := func(providerRefs ...kuadrantdnsv1alpha1.ProviderRef) map[string]string {
+		dnsPolicy := &kuadrantv1.DNSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-dns-policy", Namespace: "my-namespace"},
+			Spec:       kuadrantv1.DNSPolicySpec{ProviderRefs: providerRefs},
+		}
+		records, err := desiredDNSRecords(gateway, "cluster-id", dnsPolicy, gateway.Spec.Listeners[0])
+		if err != nil {
+			t.Fatalf("desiredDNSRecords() unexpected error: %v", err)
+		}
+		return lo.SliceToMap(records, func(r *kuadrantdnsv1alpha1.DNSRecord) (string, string) {
+			return r.Spec.ProviderRef.Name, r.Name
+		})
+	}
+
+	before := recordNames(kuadrantdnsv1alpha1.ProviderRef{Name: "public"}, kuadrantdnsv1alpha1.ProviderRef{Name: "internal"}, kuadrantdnsv1alpha1.ProviderRef{Name: "backup"})
+	after := recordNames(kuadrantdnsv1alpha1.ProviderRef{Name: "internal"}, kuadrantdnsv1alpha1.ProviderRef{Name: "backup"})
+
+	// the suffix of a route hostname record has a different length, so a provider record can never take its name
+	routeRecordName := dnsRouteRecordName("my-gateway", "my-listener", "api.example.com")
+	for _, provider := range []string{"internal", "backup"} {
+		if before[provider] != after[provider] {
+			t.Errorf("record of provider %s renamed from %s to %s", provider, before[provider], after[provider])
+		}
+		if len(after[provider]) == len(routeRecordName) {
+			t.Errorf("record of provider %s named %s like a route hostname record", provider, after[provider])
+		}
+	}
+}
+
+func Test_retainExistingRecordNames(t *testing.T) {
+	gateway := machinery.BuildGateway(func(g *gatewayapiv1.Gateway) {
+		g.Spec.Listeners[0].Hostname = ptr.To(gatewayapiv1.Hostname("api.example.com"))
+		g.Status.Addresses = []gatewayapiv1.GatewayStatusAddress{
+			{Type: ptr.To(gatewayapiv1.IPAddressType), Value: "1.1.1.1"},
+		}
+	})
+	desiredRecords := func(providerRefs ...kuadrantdnsv1alpha1.ProviderRef) []*kuadrantdnsv1alpha1.DNSRecord {
+		dnsPolicy := &kuadrantv1.DNSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-dns-policy", Namespace: "my-namespace"},
+			Spec:       kuadrantv1.DNSPolicySpec{ProviderRefs: providerRefs},
+		}
+		records, err := desiredDNSRecords(gateway, "cluster-id", dnsPolicy, gateway.Spec.Listeners[0])
+		if err != nil {
+			t.Fatalf("desiredDNSRecords() unexpected error: %v", err)
+		}
+		return records
+	}
+	// reconcile returns the records that exist once the desired records are reconciled, and the deleted ones
+	reconcile := func(existing []machinery.Object, desired []*kuadrantdnsv1alpha1.DNSRecord) ([]machinery.Object, []string) {
+		retainExistingRecordNames(existing, desired)
+		deleted := lo.Map(staleRecords(existing, desired), func(o machinery.Object, _ int) string { return o.GetName() })
+		return lo.Map(desired, func(r *kuadrantdnsv1alpha1.DNSRecord, _ int) machinery.Object {
+			return &controller.RuntimeObject{Object: r}
+		}), deleted
+	}
+	recordOfProvider := func(records []machinery.Object, provider string) string {
+		record, _ := lo.Find(records, func(o machinery.Object) bool {
+			return o.(*controller.RuntimeObject).Object.(*kuadrantdnsv1alpha1.DNSRecord).Spec.ProviderRef.Name == provider
+		})
+		return record.GetName()
+	}
+
+	public := kuadrantdnsv1alpha1.ProviderRef{Name: "public"}
+	internal := kuadrantdnsv1alpha1.ProviderRef{Name: "internal"}
+
+	oneProvider, _ := reconcile(nil, desiredRecords(public))
+	publicRecordName := recordOfProvider(oneProvider, "public")
+
+	twoProviders, deleted := reconcile(oneProvider, desiredRecords(public, internal))
+	if len(deleted) > 0 {
+		t.Errorf("adding a provider deleted records %v", deleted)
+	}
+	if got := recordOfProvider(twoProviders, "public"); got != publicRecordName {
+		t.Errorf("adding a provider renamed the record of the retained provider from %s to %s", publicRecordName, got)
+	}
+	internalRecordName := recordOfProvider(twoProviders, "internal")
+	if internalRecordName == publicRecordName {
+		t.Errorf("records of both providers named %s", publicRecordName)
+	}
+
+	backToOneProvider, deleted := reconcile(twoProviders, desiredRecords(public))
+	if !reflect.DeepEqual(deleted, []string{internalRecordName}) {
+		t.Errorf("removing a provider deleted records %v, want %v", deleted, []string{internalRecordName})
+	}
+	if got := recordOfProvider(backToOneProvider, "public"); got != publicRecordName {
+		t.Errorf("removing a provider renamed the record of the retained provider from %s to %s", publicRecordName, got)
+	}
+}
+
+func Test_isProviderOfPolicy(t *testing.T) {
+	policyWithProviders := func(names ...string) *kuadrantv1.DNSPolicy {
+		return &kuadrantv1.DNSPolicy{
+			Spec: kuadrantv1.DNSPolicySpec{
+				ProviderRefs: lo.Map(names, func(name string, _ int) kuadrantdnsv1alpha1.ProviderRef {
+					return kuadrantdnsv1alpha1.ProviderRef{Name: name}
+				}),
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		providerRef *kuadrantdnsv1alpha1.ProviderRef
+		policy      *kuadrantv1.DNSPolicy
+		want        bool
+	}{
+		{
+			name:   "no provider",
+			policy: policyWithProviders(),
+			want:   true,
+		},
+		{
+			name:   "record without provider",
+			policy: policyWithProviders("public"),
+			want:   false,
+		},
+		{
+			name:        "policy without providers",
+			providerRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "public"},
+			policy:      policyWithProviders(),
+			want:        false,
+		},
+		{
+			name:        "provider referenced by the policy",
+			providerRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "internal"},
+			policy:      policyWithProviders("public", "internal"),
+			want:        true,
+		},
+		{
+			name:        "provider removed from the policy",
+			providerRef: &kuadrantdnsv1alpha1.ProviderRef{Name: "internal"},
+			policy:      policyWithProviders("public"),
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isProviderOfPolicy(tt.providerRef, tt.policy); got != tt.want {
+				t.Errorf("isProviderOfPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/client-go/dynamic"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 )
 
 type EffectiveRateLimitPolicy struct {
@@ -54,18 +55,15 @@ func (r *EffectiveRateLimitPolicyReconciler) calculateEffectivePolicies(ctx cont
 
 	targetables := topology.Targetables()
 	gatewayClasses := targetables.Children(kuadrant) // assumes only and all valid gateway classes are linked to kuadrant in the topology
-	httpRouteRules := targetables.Items(func(o machinery.Object) bool {
-		_, ok := o.(*machinery.HTTPRouteRule)
-		return ok
-	})
+	routeRules := targetables.Items(kuadrantpolicymachinery.IsRouteRule)
 
-	logger.V(1).Info("calculating effective rate limit policies", "httpRouteRules", len(httpRouteRules))
+	logger.V(1).Info("calculating effective rate limit policies", "routeRules", len(routeRules))
 
 	effectivePolicies := EffectiveRateLimitPolicies{}
 
 	for _, gatewayClass := range gatewayClasses {
-		for _, httpRouteRule := range httpRouteRules {
-			paths := targetables.Paths(gatewayClass, httpRouteRule) // this may be expensive in clusters with many gateway classes - an alternative is to deep search the topology for httprouterules from each gatewayclass, keeping record of the paths
+		for _, routeRule := range routeRules {
+			paths := targetables.Paths(gatewayClass, routeRule) // this may be expensive in clusters with many gateway classes - an alternative is to deep search the topology for route rules from each gatewayclass, keeping record of the paths
 			for i := range paths {
 				if effectivePolicy := kuadrantv1.EffectivePolicyForPath[*kuadrantv1.RateLimitPolicy](paths[i], isRateLimitPolicyAcceptedAndNotDeletedFunc(state)); effectivePolicy != nil {
 					pathID := kuadrantv1.PathID(paths[i])
@@ -37,6 +37,8 @@ func (t *EffectiveTLSPoliciesReconciler) Subscription() *controller.Subscription
 	return &controller.Subscription{
 		Events: []controller.ResourceEventMatcher{
 			{Kind: &machinery.GatewayGroupKind},
+			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.TLSPolicyGroupKind},
 			{Kind: &CertManagerCertificateKind},
 		},
@@ -67,8 +69,6 @@ func (t *EffectiveTLSPoliciesReconciler) Reconcile(ctx context.Context, _ []cont
 			continue // No policies to process
 		}
 
-		hostname := getListenerHostname(l)
-
 		for _, certRef := range l.TLS.CertificateRefs {
 			secretRef := getSecretReference(certRef, l)
 
@@ -84,7 +84,13 @@ func (t *EffectiveTLSPoliciesReconciler) Reconcile(ctx context.Context, _ []cont
 					continue
 				}
 
-				cert := buildCertManagerCertificate(l, tlsPolicy, secretRef, []string{hostname})
+				hosts := getCertificateHostnames(topology, l, tlsPolicy)
+				if len(hosts) == 0 {
+					logger.V(1).Info("listener has no attached routes with explicit hostnames, skipping certificate", "listener", l.GetLocator(), "name", tlsPolicy.Name, "namespace", tlsPolicy.Namespace)
+					continue
+				}
+
+				cert := buildCertManagerCertificate(l, tlsPolicy, secretRef, hosts)
 				if err := controllerutil.SetControllerReference(tlsPolicy, cert, t.scheme); err != nil {
 					logger.Error(err, "failed to set owner reference on certificate", "name", tlsPolicy.Name, "namespace", tlsPolicy.Namespace, "uid", tlsPolicy.GetUID())
 					continue
@@ -195,6 +201,17 @@ func getListenerHostname(l *machinery.Listener) string {
 	return hostname
 }
 
+// getCertificateHostnames returns the hostnames of the certificate of a listener: the hostname of the listener or, if
+// the policy sets routeHostnames, the explicit hostnames of the routes attached to the listener
+func getCertificateHostnames(topology *machinery.Topology, l *machinery.Listener, tlsPolicy *kuadrantv1.TLSPolicy) []string {
+	if tlsPolicy.Spec.RouteHostnames {
+		return explicitRouteHostnames(topology, l)
+	}
+	// Gateway API hostname explicitly disallows IP addresses, so this
+	// should be OK.
+	return []string{getListenerHostname(l)}
+}
+
 func getSecretReference(certRef gatewayapiv1.SecretObjectReference, l *machinery.Listener) corev1.ObjectReference {
 	secretRef := corev1.ObjectReference{
 		Name: string(certRef.Name),
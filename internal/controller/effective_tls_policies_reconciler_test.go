@@ -3,13 +3,18 @@
 package controllers
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 )
 
 // Helper function tests largely based on cert manager https://github.com/cert-manager/cert-manager/blob/master/pkg/controller/certificate-shim/sync_test.go
@@ -260,3 +265,80 @@ func Test_validateGatewayListenerBlock(t *testing.T) {
 		})
 	}
 }
+
+func Test_getCertificateHostnames(t *testing.T) {
+	tests := []struct {
+		name             string
+		listenerHostname *gatewayapiv1.Hostname
+		routeHostnames   [][]gatewayapiv1.Hostname
+		policyRoutes     bool
+		want             []string
+	}{
+		{
+			name:             "listener hostname",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("*.apps.example.com")),
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"web.apps.example.com"},
+			},
+			want: []string{"*.apps.example.com"},
+		},
+		{
+			name:             "route hostnames",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("*.apps.example.com")),
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"web.apps.example.com", "*.apps.example.com", "other.com"},
+				{"api.apps.example.com", "web.apps.example.com"},
+			},
+			policyRoutes: true,
+			want:         []string{"api.apps.example.com", "web.apps.example.com"},
+		},
+		{
+			name:             "route hostnames include the listener hostname",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("api.example.com")),
+			routeHostnames: [][]gatewayapiv1.Hostname{
+				{"api.example.com"},
+			},
+			policyRoutes: true,
+			want:         []string{"api.example.com"},
+		},
+		{
+			name:             "route hostnames without routes",
+			listenerHostname: ptr.To(gatewayapiv1.Hostname("*.apps.example.com")),
+			policyRoutes:     true,
+			want:             []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := machinery.BuildGateway(func(g *gatewayapiv1.Gateway) {
+				g.Spec.Listeners[0].Hostname = tt.listenerHostname
+			})
+			httpRoutes := lo.Map(tt.routeHostnames, func(hostnames []gatewayapiv1.Hostname, i int) *gatewayapiv1.HTTPRoute {
+				return machinery.BuildHTTPRoute(func(r *gatewayapiv1.HTTPRoute) {
+					r.Name = fmt.Sprintf("my-http-route-%d", i)
+					r.Spec.Hostnames = hostnames
+				})
+			})
+			topology, err := machinery.NewGatewayAPITopology(
+				machinery.WithGatewayClasses(machinery.BuildGatewayClass()),
+				machinery.WithGateways(gateway),
+				machinery.WithHTTPRoutes(httpRoutes...),
+				machinery.ExpandGatewayListeners(),
+			)
+			if err != nil {
+				t.Fatalf("failed to build topology: %v", err)
+			}
+			listener, found := lo.Find(topology.Targetables().Items(), func(t machinery.Targetable) bool {
+				_, ok := t.(*machinery.Listener)
+				return ok
+			})
+			if !found {
+				t.Fatal("listener not found in the topology")
+			}
+			policy := &kuadrantv1.TLSPolicy{Spec: kuadrantv1.TLSPolicySpec{RouteHostnames: tt.policyRoutes}}
+			if got := getCertificateHostnames(topology, listener.(*machinery.Listener), policy); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getCertificateHostnames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
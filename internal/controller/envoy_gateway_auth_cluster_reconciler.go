@@ -20,7 +20,6 @@ import (
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	kuadrantenvoygateway "github.com/kuadrant/kuadrant-operator/internal/envoygateway"
-	kuadrant "github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 )
 
@@ -40,6 +39,7 @@ func (r *EnvoyGatewayAuthClusterReconciler) Subscription() controller.Subscripti
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantenvoygateway.EnvoyPatchPolicyGroupKind},
 		},
@@ -86,7 +86,7 @@ func (r *EnvoyGatewayAuthClusterReconciler) Reconcile(ctx context.Context, _ []c
 	for _, gateway := range gateways {
 		gatewayKey := k8stypes.NamespacedName{Name: gateway.GetName(), Namespace: gateway.GetNamespace()}
 
-		desiredEnvoyPatchPolicy, err := r.buildDesiredEnvoyPatchPolicy(authorino, gateway)
+		desiredEnvoyPatchPolicy, err := r.buildDesiredEnvoyPatchPolicy(authorino, kuadrant.AuthorinoForGateway(gateway.GetNamespace(), gateway.GetName()), gateway)
 		if err != nil {
 			logger.Error(err, "failed to build desired envoy patch policy")
 			continue
@@ -158,7 +158,7 @@ func (r *EnvoyGatewayAuthClusterReconciler) Reconcile(ctx context.Context, _ []c
 	return nil
 }
 
-func (r *EnvoyGatewayAuthClusterReconciler) buildDesiredEnvoyPatchPolicy(authorino *authorinooperatorv1beta1.Authorino, gateway *machinery.Gateway) (*envoygatewayv1alpha1.EnvoyPatchPolicy, error) {
+func (r *EnvoyGatewayAuthClusterReconciler) buildDesiredEnvoyPatchPolicy(authorino *authorinooperatorv1beta1.Authorino, dedicated *kuadrantv1beta1.ServiceEndpoint, gateway *machinery.Gateway) (*envoygatewayv1alpha1.EnvoyPatchPolicy, error) {
 	envoyPatchPolicy := &envoygatewayv1alpha1.EnvoyPatchPolicy{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       kuadrantenvoygateway.EnvoyPatchPolicyGroupKind.Kind,
@@ -190,7 +190,11 @@ func (r *EnvoyGatewayAuthClusterReconciler) buildDesiredEnvoyPatchPolicy(authori
 	}
 
 	authorinoServiceInfo := authorinoServiceInfoFromAuthorino(authorino)
-	jsonPatches, err := kuadrantenvoygateway.BuildEnvoyPatchPolicyClusterPatch(kuadrant.KuadrantAuthClusterName, authorinoServiceInfo.Host, int(authorinoServiceInfo.Port), false, authClusterPatch)
+	if dedicated != nil {
+		authorinoServiceInfo.Host, authorinoServiceInfo.Port = dedicated.Host, dedicated.Port
+	}
+	clusterName := authServiceClusterName(dedicated)
+	jsonPatches, err := kuadrantenvoygateway.BuildEnvoyPatchPolicyClusterPatch(clusterName, authorinoServiceInfo.Host, int(authorinoServiceInfo.Port), false, authClusterPatch(clusterName))
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +49,7 @@ func (r *EnvoyGatewayExtensionReconciler) Subscription() controller.Subscription
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
@@ -64,7 +65,7 @@ func (r *EnvoyGatewayExtensionReconciler) Reconcile(ctx context.Context, _ []con
 	defer logger.V(1).Info("finished building envoy gateway extension")
 
 	// build wasm plugin configs for each gateway
-	wasmConfigs, err := r.buildWasmConfigs(ctx, state)
+	wasmConfigs, err := r.buildWasmConfigs(ctx, topology, state)
 	if err != nil {
 		if errors.Is(err, ErrMissingStateEffectiveAuthPolicies) || errors.Is(err, ErrMissingStateEffectiveRateLimitPolicies) {
 			logger.V(1).Info(err.Error())
@@ -154,7 +155,7 @@ func (r *EnvoyGatewayExtensionReconciler) Reconcile(ctx context.Context, _ []con
 }
 
 // buildWasmConfigs returns a map of envoy gateway gateway locators to an ordered list of corresponding wasm policies
-func (r *EnvoyGatewayExtensionReconciler) buildWasmConfigs(ctx context.Context, state *sync.Map) (map[string]wasm.Config, error) {
+func (r *EnvoyGatewayExtensionReconciler) buildWasmConfigs(ctx context.Context, topology *machinery.Topology, state *sync.Map) (map[string]wasm.Config, error) {
 	logger := controller.LoggerFromContext(ctx).WithName("EnvoyGatewayExtensionReconciler").WithName("buildWasmConfigs")
 
 	effectiveAuthPolicies, ok := state.Load(StateEffectiveAuthPolicies)
@@ -194,6 +195,8 @@ func (r *EnvoyGatewayExtensionReconciler) buildWasmConfigs(ctx context.Context,
 	paths := lo.UniqBy(allPaths, func(e lo.Entry[string, []machinery.Targetable]) string { return e.Key })
 
 	wasmActionSets := kuadrantgatewayapi.GrouppedHTTPRouteMatchConfigs{}
+	serviceClusters := map[string]wasm.ServiceClusters{}
+	kObj := GetKuadrantFromTopology(topology)
 	celValidationIssues := celvalidator.NewIssueCollection()
 
 	// build the wasm policies for each topological path that contains an effective rate limit policy affecting an envoy gateway gateway
@@ -275,16 +278,17 @@ func (r *EnvoyGatewayExtensionReconciler) buildWasmConfigs(ctx context.Context,
 			continue
 		}
 		wasmActionSets.Add(gateway.GetLocator(), wasmActionSetsForPath...)
+		serviceClusters[gateway.GetLocator()] = wasmServiceClusters(kObj, gateway)
 	}
 
 	if !celValidationIssues.IsEmpty() {
 		state.Store(celvalidator.StateCELValidationErrors, celValidationIssues)
 	}
 
-	wasmConfigs := lo.MapValues(wasmActionSets.Sorted(), func(configs kuadrantgatewayapi.SortableHTTPRouteMatchConfigs, _ string) wasm.Config {
+	wasmConfigs := lo.MapValues(wasmActionSets.Sorted(), func(configs kuadrantgatewayapi.SortableHTTPRouteMatchConfigs, gatewayLocator string) wasm.Config {
 		return wasm.BuildConfigForActionSet(lo.Map(configs, func(c kuadrantgatewayapi.HTTPRouteMatchConfig, _ int) wasm.ActionSet {
 			return c.Config.(wasm.ActionSet)
-		}), &logger)
+		}), serviceClusters[gatewayLocator], &logger)
 	})
 
 	return wasmConfigs, nil
@@ -21,7 +21,6 @@ import (
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	kuadrantenvoygateway "github.com/kuadrant/kuadrant-operator/internal/envoygateway"
-	kuadrant "github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 )
 
@@ -41,6 +40,7 @@ func (r *EnvoyGatewayRateLimitClusterReconciler) Subscription() controller.Subsc
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 			{Kind: &kuadrantenvoygateway.EnvoyPatchPolicyGroupKind},
@@ -107,7 +107,7 @@ func (r *EnvoyGatewayRateLimitClusterReconciler) Reconcile(ctx context.Context,
 	for _, gateway := range gateways {
 		gatewayKey := k8stypes.NamespacedName{Name: gateway.GetName(), Namespace: gateway.GetNamespace()}
 
-		desiredEnvoyPatchPolicy, err := r.buildDesiredEnvoyPatchPolicy(limitador, gateway)
+		desiredEnvoyPatchPolicy, err := r.buildDesiredEnvoyPatchPolicy(limitador, kuadrant.LimitadorForGateway(gateway.GetNamespace(), gateway.GetName()), gateway)
 		if err != nil {
 			logger.Error(err, "failed to build desired envoy patch policy")
 			continue
@@ -179,7 +179,7 @@ func (r *EnvoyGatewayRateLimitClusterReconciler) Reconcile(ctx context.Context,
 	return nil
 }
 
-func (r *EnvoyGatewayRateLimitClusterReconciler) buildDesiredEnvoyPatchPolicy(limitador *limitadorv1alpha1.Limitador, gateway *machinery.Gateway) (*envoygatewayv1alpha1.EnvoyPatchPolicy, error) {
+func (r *EnvoyGatewayRateLimitClusterReconciler) buildDesiredEnvoyPatchPolicy(limitador *limitadorv1alpha1.Limitador, dedicated *kuadrantv1beta1.ServiceEndpoint, gateway *machinery.Gateway) (*envoygatewayv1alpha1.EnvoyPatchPolicy, error) {
 	envoyPatchPolicy := &envoygatewayv1alpha1.EnvoyPatchPolicy{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       kuadrantenvoygateway.EnvoyPatchPolicyGroupKind.Kind,
@@ -210,7 +210,15 @@ func (r *EnvoyGatewayRateLimitClusterReconciler) buildDesiredEnvoyPatchPolicy(li
 		},
 	}
 
-	jsonPatches, err := kuadrantenvoygateway.BuildEnvoyPatchPolicyClusterPatch(kuadrant.KuadrantRateLimitClusterName, limitador.Status.Service.Host, int(limitador.Status.Service.Ports.GRPC), false, rateLimitClusterPatch)
+	var host string
+	var port int
+	if dedicated != nil {
+		host, port = dedicated.Host, int(dedicated.Port)
+	} else {
+		host, port = limitador.Status.Service.Host, int(limitador.Status.Service.Ports.GRPC)
+	}
+	clusterName := rateLimitServiceClusterName(dedicated)
+	jsonPatches, err := kuadrantenvoygateway.BuildEnvoyPatchPolicyClusterPatch(clusterName, host, port, false, rateLimitClusterPatch(clusterName))
 	if err != nil {
 		return nil, err
 	}
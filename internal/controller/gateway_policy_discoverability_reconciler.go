@@ -18,6 +18,7 @@ import (
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 )
 
 type GatewayPolicyDiscoverabilityReconciler struct {
@@ -31,6 +32,7 @@ func NewGatewayPolicyDiscoverabilityReconciler(client *dynamic.DynamicClient) *G
 func (r *GatewayPolicyDiscoverabilityReconciler) Subscription() *controller.Subscription {
 	return &controller.Subscription{
 		Events: []controller.ResourceEventMatcher{
+			{Kind: &kuadrantv1beta1.KuadrantGroupKind}, // extension status reports trigger an event on the kuadrant cr
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
@@ -94,6 +96,14 @@ func buildGatewayStatus(ctx context.Context, syncMap *sync.Map, gw *machinery.Ga
 		updatePolicyConditions(ctx, syncMap, gw, policyKind, status, logger)
 	}
 
+	for _, c := range extensionPolicyConditions(gw) {
+		if c.condition == nil {
+			removeConditionIfExists(&status.Conditions, PolicyAffectedConditionType(c.policyKind), logger, gw.GetName())
+		} else {
+			addOrUpdateCondition(&status.Conditions, *c.condition, gw.GetGeneration(), logger)
+		}
+	}
+
 	return status
 }
 
@@ -20,6 +20,8 @@ import (
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 	"github.com/kuadrant/kuadrant-operator/internal/utils"
 )
@@ -35,8 +37,10 @@ func NewHTTPRoutePolicyDiscoverabilityReconciler(client *dynamic.DynamicClient)
 func (r *HTTPRoutePolicyDiscoverabilityReconciler) Subscription() *controller.Subscription {
 	return &controller.Subscription{
 		Events: []controller.ResourceEventMatcher{
+			{Kind: &kuadrantv1beta1.KuadrantGroupKind}, // extension status reports trigger an event on the kuadrant cr
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
@@ -50,59 +54,75 @@ func (r *HTTPRoutePolicyDiscoverabilityReconciler) Subscription() *controller.Su
 func (r *HTTPRoutePolicyDiscoverabilityReconciler) reconcile(ctx context.Context, _ []controller.ResourceEvent, topology *machinery.Topology, _ error, s *sync.Map) error {
 	logger := controller.LoggerFromContext(ctx).WithName("HTTPRoutePolicyDiscoverabilityReconciler").WithName("reconcile")
 
-	httpRoutes := lo.FilterMap(topology.Targetables().Items(), func(item machinery.Targetable, _ int) (*machinery.HTTPRoute, bool) {
-		ob, ok := item.(*machinery.HTTPRoute)
-		return ob, ok
+	routes := topology.Targetables().Items(func(item machinery.Object) bool {
+		switch item.(type) {
+		case *machinery.HTTPRoute, *machinery.GRPCRoute:
+			return true
+		}
+		return false
 	})
 
 	policyKinds := policyGroupKinds()
 
-	for _, route := range httpRoutes {
-		routeStatusParents := route.Status.DeepCopy().Parents
+	for _, route := range routes {
+		routeObject := kuadrantgatewayapi.RouteObject(route)
+		routeStatus := kuadrantgatewayapi.RouteStatus(route)
+		routeStatusParents := routeStatus.DeepCopy().Parents
+		path := getRoutePath(topology, route)
+		gateways := lo.FilterMap(path, func(item machinery.Targetable, _ int) (*machinery.Gateway, bool) {
+			ob, ok := item.(*machinery.Gateway)
+			return ob, ok
+		})
 
 		for _, policyKind := range policyKinds {
-			path := getRoutePath(topology, route)
-			gateways := lo.FilterMap(path, func(item machinery.Targetable, _ int) (*machinery.Gateway, bool) {
-				ob, ok := item.(*machinery.Gateway)
-				return ob, ok
-			})
-
 			policies := kuadrantv1.PoliciesInPath(path, func(policy machinery.Policy) bool {
 				return policy.GroupVersionKind().GroupKind() == *policyKind && IsPolicyAccepted(ctx, policy, s)
 			})
 
 			if len(policies) == 0 {
-				routeStatusParents = removePolicyConditions(routeStatusParents, gateways, policyKind, route, logger)
+				routeStatusParents = removePolicyConditions(routeStatusParents, gateways, policyKind, routeObject, logger)
 			} else {
-				routeStatusParents = addPolicyConditions(routeStatusParents, gateways, policies, policyKind, route, logger)
+				routeStatusParents = addPolicyConditions(routeStatusParents, gateways, PolicyAffectedCondition(policyKind.Kind, policies), routeObject, logger)
 			}
 		}
 
-		if !equality.Semantic.DeepEqual(routeStatusParents, route.Status.Parents) {
-			route.Status.Parents = routeStatusParents
-			if err := r.updateRouteStatus(ctx, route, logger); err != nil {
+		for _, c := range extensionPolicyConditions(path...) {
+			if c.condition == nil {
+				routeStatusParents = removePolicyConditions(routeStatusParents, gateways, &schema.GroupKind{Kind: c.policyKind}, routeObject, logger)
+			} else {
+				routeStatusParents = addPolicyConditions(routeStatusParents, gateways, *c.condition, routeObject, logger)
+			}
+		}
+
+		if !equality.Semantic.DeepEqual(routeStatusParents, routeStatus.Parents) {
+			routeStatus.Parents = routeStatusParents
+			if err := r.updateRouteStatus(ctx, routeObject, logger); err != nil {
 				if strings.Contains(err.Error(), "StorageError: invalid object") {
 					logger.Info("possible error updating resource", "err", err, "possible_cause", "resource has being removed from the cluster already")
 					continue
 				}
-				logger.Error(err, "unable to update route status", "name", route.GetName(), "namespace", route.GetNamespace(), "uid", route.GetUID())
+				logger.Error(err, "unable to update route status", "kind", route.GroupVersionKind().Kind, "name", route.GetName(), "namespace", route.GetNamespace(), "uid", routeObject.GetUID())
 			}
 		}
 	}
 	return nil
 }
 
-func (r *HTTPRoutePolicyDiscoverabilityReconciler) updateRouteStatus(ctx context.Context, route *machinery.HTTPRoute, logger logr.Logger) error {
-	obj, err := controller.Destruct(route.HTTPRoute)
+func (r *HTTPRoutePolicyDiscoverabilityReconciler) updateRouteStatus(ctx context.Context, route client.Object, logger logr.Logger) error {
+	obj, err := controller.Destruct(route)
 	if err != nil {
 		logger.Error(err, "unable to destruct route", "name", route.GetName(), "namespace", route.GetNamespace(), "uid", route.GetUID())
 		return err
 	}
-	_, err = r.Client.Resource(controller.HTTPRoutesResource).Namespace(route.GetNamespace()).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+	resource := controller.HTTPRoutesResource
+	if _, ok := route.(*gatewayapiv1.GRPCRoute); ok {
+		resource = GRPCRoutesResource
+	}
+	_, err = r.Client.Resource(resource).Namespace(route.GetNamespace()).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
 	return err
 }
 
-func getRoutePath(topology *machinery.Topology, route *machinery.HTTPRoute) []machinery.Targetable {
+func getRoutePath(topology *machinery.Topology, route machinery.Targetable) []machinery.Targetable {
 	path := []machinery.Targetable{route}
 	for _, listener := range topology.Targetables().Parents(route) {
 		path = append(path, listener)
@@ -113,10 +133,10 @@ func getRoutePath(topology *machinery.Topology, route *machinery.HTTPRoute) []ma
 	})
 }
 
-func removePolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus, gateways []*machinery.Gateway, policyKind *schema.GroupKind, route *machinery.HTTPRoute, logger logr.Logger) []gatewayapiv1.RouteParentStatus {
+func removePolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus, gateways []*machinery.Gateway, policyKind *schema.GroupKind, route client.Object, logger logr.Logger) []gatewayapiv1.RouteParentStatus {
 	conditionType := PolicyAffectedConditionType(policyKind.Kind)
 	for _, gw := range gateways {
-		i := utils.Index(routeStatusParents, FindRouteParentStatusFunc(route.HTTPRoute, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
+		i := utils.Index(routeStatusParents, FindRouteParentStatusFunc(route, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
 		if i < 0 {
 			logger.V(1).Info("cannot find parent status, skipping")
 			continue
@@ -134,8 +154,7 @@ func removePolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus,
 	return routeStatusParents
 }
 
-func addPolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus, gateways []*machinery.Gateway, policies []machinery.Policy, policyKind *schema.GroupKind, route *machinery.HTTPRoute, logger logr.Logger) []gatewayapiv1.RouteParentStatus {
-	condition := PolicyAffectedCondition(policyKind.Kind, policies)
+func addPolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus, gateways []*machinery.Gateway, condition metav1.Condition, route client.Object, logger logr.Logger) []gatewayapiv1.RouteParentStatus {
 	for _, gw := range gateways {
 		i := ensureRouteParentStatus(&routeStatusParents, route, gw)
 		if currentCondition := meta.FindStatusCondition(routeStatusParents[i].Conditions, condition.Type); currentCondition != nil &&
@@ -151,8 +170,8 @@ func addPolicyConditions(routeStatusParents []gatewayapiv1.RouteParentStatus, ga
 	return routeStatusParents
 }
 
-func ensureRouteParentStatus(routeStatusParents *[]gatewayapiv1.RouteParentStatus, route *machinery.HTTPRoute, gw *machinery.Gateway) int {
-	i := utils.Index(*routeStatusParents, FindRouteParentStatusFunc(route.HTTPRoute, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
+func ensureRouteParentStatus(routeStatusParents *[]gatewayapiv1.RouteParentStatus, route client.Object, gw *machinery.Gateway) int {
+	i := utils.Index(*routeStatusParents, FindRouteParentStatusFunc(route, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
 	if i < 0 {
 		*routeStatusParents = append(*routeStatusParents, gatewayapiv1.RouteParentStatus{
 			ControllerName: kuadrant.ControllerName,
@@ -163,12 +182,12 @@ func ensureRouteParentStatus(routeStatusParents *[]gatewayapiv1.RouteParentStatu
 			},
 			Conditions: []metav1.Condition{},
 		})
-		i = utils.Index(*routeStatusParents, FindRouteParentStatusFunc(route.HTTPRoute, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
+		i = utils.Index(*routeStatusParents, FindRouteParentStatusFunc(route, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gw.GetName()}, kuadrant.ControllerName))
 	}
 	return i
 }
 
-func FindRouteParentStatusFunc(route *gatewayapiv1.HTTPRoute, gatewayKey client.ObjectKey, controllerName gatewayapiv1.GatewayController) func(gatewayapiv1.RouteParentStatus) bool {
+func FindRouteParentStatusFunc(route client.Object, gatewayKey client.ObjectKey, controllerName gatewayapiv1.GatewayController) func(gatewayapiv1.RouteParentStatus) bool {
 	return func(p gatewayapiv1.RouteParentStatus) bool {
 		return *p.ParentRef.Kind == "Gateway" &&
 			p.ControllerName == controllerName &&
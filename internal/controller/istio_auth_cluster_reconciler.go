@@ -40,6 +40,7 @@ func (r *IstioAuthClusterReconciler) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
 			{Kind: &kuadrantistio.EnvoyFilterGroupKind},
 		},
@@ -86,7 +87,7 @@ func (r *IstioAuthClusterReconciler) Reconcile(ctx context.Context, _ []controll
 	for _, gateway := range gateways {
 		gatewayKey := k8stypes.NamespacedName{Name: gateway.GetName(), Namespace: gateway.GetNamespace()}
 
-		desiredEnvoyFilter, err := r.buildDesiredEnvoyFilter(authorino, gateway, kuadrant.IsMTLSAuthorinoEnabled())
+		desiredEnvoyFilter, err := r.buildDesiredEnvoyFilter(authorino, kuadrant.AuthorinoForGateway(gateway.GetNamespace(), gateway.GetName()), gateway, kuadrant.IsMTLSAuthorinoEnabled())
 		if err != nil {
 			logger.Error(err, "failed to build desired envoy filter")
 			continue
@@ -156,7 +157,7 @@ func (r *IstioAuthClusterReconciler) Reconcile(ctx context.Context, _ []controll
 	return nil
 }
 
-func (r *IstioAuthClusterReconciler) buildDesiredEnvoyFilter(authorino *authorinooperatorv1beta1.Authorino, gateway *machinery.Gateway, mtls bool) (*istioclientgonetworkingv1alpha3.EnvoyFilter, error) {
+func (r *IstioAuthClusterReconciler) buildDesiredEnvoyFilter(authorino *authorinooperatorv1beta1.Authorino, dedicated *kuadrantv1beta1.ServiceEndpoint, gateway *machinery.Gateway, mtls bool) (*istioclientgonetworkingv1alpha3.EnvoyFilter, error) {
 	envoyFilter := &istioclientgonetworkingv1alpha3.EnvoyFilter{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       kuadrantistio.EnvoyFilterGroupKind.Kind,
@@ -189,7 +190,12 @@ func (r *IstioAuthClusterReconciler) buildDesiredEnvoyFilter(authorino *authorin
 	}
 
 	authorinoServiceInfo := authorinoServiceInfoFromAuthorino(authorino)
-	configPatches, err := kuadrantistio.BuildEnvoyFilterClusterPatch(authorinoServiceInfo.Host, int(authorinoServiceInfo.Port), mtls, authClusterPatch)
+	if dedicated != nil {
+		// the mtls settings of the kuadrant cr only apply to the authorino instance managed by kuadrant
+		authorinoServiceInfo.Host, authorinoServiceInfo.Port = dedicated.Host, dedicated.Port
+		mtls = false
+	}
+	configPatches, err := kuadrantistio.BuildEnvoyFilterClusterPatch(authorinoServiceInfo.Host, int(authorinoServiceInfo.Port), mtls, authClusterPatch(authServiceClusterName(dedicated)))
 	if err != nil {
 		return nil, err
 	}
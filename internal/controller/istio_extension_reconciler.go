@@ -48,6 +48,7 @@ func (r *IstioExtensionReconciler) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1.AuthPolicyGroupKind},
@@ -63,7 +64,7 @@ func (r *IstioExtensionReconciler) Reconcile(ctx context.Context, _ []controller
 	defer logger.V(1).Info("finished building istio extension")
 
 	// build wasm plugin configs for each gateway
-	wasmConfigs, err := r.buildWasmConfigs(ctx, state)
+	wasmConfigs, err := r.buildWasmConfigs(ctx, topology, state)
 	if err != nil {
 		if errors.Is(err, ErrMissingStateEffectiveAuthPolicies) || errors.Is(err, ErrMissingStateEffectiveRateLimitPolicies) {
 			logger.V(1).Info(err.Error())
@@ -165,7 +166,8 @@ func mergeAndVerify(actions []wasm.Action) ([]wasm.Action, error) {
 		lastAction := &result[len(result)-1]
 
 		if lastAction.Scope == currentAction.Scope &&
-			lastAction.ServiceName == currentAction.ServiceName && lastAction.ServiceName != wasm.AuthServiceName {
+			lastAction.ServiceName == currentAction.ServiceName && lastAction.BaseServiceName() != wasm.AuthServiceName &&
+			lastAction.Mode == currentAction.Mode && lastAction.ResponseBodyFormat == currentAction.ResponseBodyFormat {
 			lastAction.ConditionalData = append(lastAction.ConditionalData, currentAction.ConditionalData...)
 		} else {
 			result = append(result, currentAction)
@@ -202,7 +204,7 @@ func mergeAndVerify(actions []wasm.Action) ([]wasm.Action, error) {
 }
 
 // buildWasmConfigs returns a map of istio gateway locators to an ordered list of corresponding wasm policies
-func (r *IstioExtensionReconciler) buildWasmConfigs(ctx context.Context, state *sync.Map) (map[string]wasm.Config, error) {
+func (r *IstioExtensionReconciler) buildWasmConfigs(ctx context.Context, topology *machinery.Topology, state *sync.Map) (map[string]wasm.Config, error) {
 	logger := controller.LoggerFromContext(ctx).WithName("IstioExtensionReconciler").WithName("buildWasmConfigs")
 	logger.Info("build Wasm configuration", "status", "started")
 	logger.Info("build Wasm configuration", "status", "completed")
@@ -244,6 +246,8 @@ func (r *IstioExtensionReconciler) buildWasmConfigs(ctx context.Context, state *
 	paths := lo.UniqBy(allPaths, func(e lo.Entry[string, []machinery.Targetable]) string { return e.Key })
 
 	wasmActionSets := kuadrantgatewayapi.GrouppedHTTPRouteMatchConfigs{}
+	serviceClusters := map[string]wasm.ServiceClusters{}
+	kObj := GetKuadrantFromTopology(topology)
 	celValidationIssues := celvalidator.NewIssueCollection()
 
 	// build the wasm policies for each topological path that contains an effective rate limit policy affecting an istio gateway
@@ -329,16 +333,17 @@ func (r *IstioExtensionReconciler) buildWasmConfigs(ctx context.Context, state *
 			continue
 		}
 		wasmActionSets.Add(gateway.GetLocator(), wasmActionSetsForPath...)
+		serviceClusters[gateway.GetLocator()] = wasmServiceClusters(kObj, gateway)
 	}
 
 	if !celValidationIssues.IsEmpty() {
 		state.Store(celvalidator.StateCELValidationErrors, celValidationIssues)
 	}
 
-	wasmConfigs := lo.MapValues(wasmActionSets.Sorted(), func(configs kuadrantgatewayapi.SortableHTTPRouteMatchConfigs, _ string) wasm.Config {
+	wasmConfigs := lo.MapValues(wasmActionSets.Sorted(), func(configs kuadrantgatewayapi.SortableHTTPRouteMatchConfigs, gatewayLocator string) wasm.Config {
 		return wasm.BuildConfigForActionSet(lo.Map(configs, func(c kuadrantgatewayapi.HTTPRouteMatchConfig, _ int) wasm.ActionSet {
 			return c.Config.(wasm.ActionSet)
-		}), &logger)
+		}), serviceClusters[gatewayLocator], &logger)
 	})
 
 	return wasmConfigs, nil
@@ -41,6 +41,7 @@ func (r *IstioRateLimitClusterReconciler) Subscription() controller.Subscription
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 			{Kind: &kuadrantistio.EnvoyFilterGroupKind},
@@ -104,7 +105,7 @@ func (r *IstioRateLimitClusterReconciler) Reconcile(ctx context.Context, _ []con
 	for _, gateway := range gateways {
 		gatewayKey := k8stypes.NamespacedName{Name: gateway.GetName(), Namespace: gateway.GetNamespace()}
 
-		desiredEnvoyFilter, err := r.buildDesiredEnvoyFilter(limitador, gateway, kuadrant.IsMTLSLimitadorEnabled())
+		desiredEnvoyFilter, err := r.buildDesiredEnvoyFilter(limitador, kuadrant.LimitadorForGateway(gateway.GetNamespace(), gateway.GetName()), gateway, kuadrant.IsMTLSLimitadorEnabled())
 		if err != nil {
 			logger.Error(err, "failed to build desired envoy filter")
 			continue
@@ -178,7 +179,7 @@ func (r *IstioRateLimitClusterReconciler) Reconcile(ctx context.Context, _ []con
 	return nil
 }
 
-func (r *IstioRateLimitClusterReconciler) buildDesiredEnvoyFilter(limitador *limitadorv1alpha1.Limitador, gateway *machinery.Gateway, mtls bool) (*istioclientgonetworkingv1alpha3.EnvoyFilter, error) {
+func (r *IstioRateLimitClusterReconciler) buildDesiredEnvoyFilter(limitador *limitadorv1alpha1.Limitador, dedicated *kuadrantv1beta1.ServiceEndpoint, gateway *machinery.Gateway, mtls bool) (*istioclientgonetworkingv1alpha3.EnvoyFilter, error) {
 	envoyFilter := &istioclientgonetworkingv1alpha3.EnvoyFilter{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       kuadrantistio.EnvoyFilterGroupKind.Kind,
@@ -210,11 +211,19 @@ func (r *IstioRateLimitClusterReconciler) buildDesiredEnvoyFilter(limitador *lim
 		},
 	}
 
-	limitadorService := limitador.Status.Service
-	if limitadorService == nil {
-		return nil, ErrMissingLimitadorServiceInfo
+	var host string
+	var port int
+	if dedicated != nil {
+		// the mtls settings of the kuadrant cr only apply to the limitador instance managed by kuadrant
+		host, port, mtls = dedicated.Host, int(dedicated.Port), false
+	} else {
+		limitadorService := limitador.Status.Service
+		if limitadorService == nil {
+			return nil, ErrMissingLimitadorServiceInfo
+		}
+		host, port = limitadorService.Host, int(limitadorService.Ports.GRPC)
 	}
-	configPatches, err := kuadrantistio.BuildEnvoyFilterClusterPatch(limitador.Status.Service.Host, int(limitador.Status.Service.Ports.GRPC), mtls, rateLimitClusterPatch)
+	configPatches, err := kuadrantistio.BuildEnvoyFilterClusterPatch(host, port, mtls, rateLimitClusterPatch(rateLimitServiceClusterName(dedicated)))
 	if err != nil {
 		return nil, err
 	}
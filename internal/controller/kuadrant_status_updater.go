@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
 	"github.com/kuadrant/policy-machinery/controller"
 	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -19,6 +21,7 @@ import (
 
 	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	"github.com/kuadrant/kuadrant-operator/internal/authorino"
+	"github.com/kuadrant/kuadrant-operator/internal/extension"
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 )
 
@@ -32,6 +35,12 @@ type KuadrantStatusUpdater struct {
 	isGatewayProviderInstalled   bool
 	isLimitadorOperatorInstalled bool
 	isAuthorinoOperatorInstalled bool
+	extensions                   ExtensionsHealthProvider
+}
+
+// ExtensionsHealthProvider reports the health of the extensions run by the operator
+type ExtensionsHealthProvider interface {
+	Health() []extension.ExtensionHealth
 }
 
 func NewKuadrantStatusUpdater(client *dynamic.DynamicClient, isGatewayAPIInstalled, isGatewayProviderInstalled, isLimitadorOperatorInstalled, isAuthorinoOperatorInstalled bool) *KuadrantStatusUpdater {
@@ -44,6 +53,12 @@ func NewKuadrantStatusUpdater(client *dynamic.DynamicClient, isGatewayAPIInstall
 	}
 }
 
+// WithExtensions sets the provider of the health of the extensions reflected in the status
+func (r *KuadrantStatusUpdater) WithExtensions(extensions ExtensionsHealthProvider) *KuadrantStatusUpdater {
+	r.extensions = extensions
+	return r
+}
+
 func (r *KuadrantStatusUpdater) Subscription() *controller.Subscription {
 	return &controller.Subscription{ReconcileFunc: r.Reconcile, Events: []controller.ResourceEventMatcher{
 		{Kind: ptr.To(kuadrantv1beta1.AuthorinoGroupKind), EventType: ptr.To(controller.CreateEvent)},
@@ -56,6 +71,7 @@ func (r *KuadrantStatusUpdater) Subscription() *controller.Subscription {
 		{Kind: &machinery.GatewayClassGroupKind},
 		{Kind: &machinery.GatewayGroupKind},
 		{Kind: &machinery.HTTPRouteGroupKind},
+		{Kind: &machinery.GRPCRouteGroupKind},
 	},
 	}
 }
@@ -118,6 +134,9 @@ func (r *KuadrantStatusUpdater) calculateStatus(topology *machinery.Topology, lo
 		ObservedGeneration: kObj.Status.ObservedGeneration,
 		MtlsAuthorino:      mtlsAuthorino(kObj, state),
 		MtlsLimitador:      mtlsLimitador(kObj, state),
+		Limitador:          limitadorStatus(topology),
+		Authorino:          authorinoStatus(topology),
+		Extensions:         extensionsStatus(r.extensions),
 	}
 
 	availableCond := r.readyCondition(topology, logger)
@@ -145,6 +164,64 @@ func mtlsLimitador(kObj *kuadrantv1beta1.Kuadrant, state *sync.Map) *bool {
 	return ptr.To(kObj.IsMTLSLimitadorEnabled() && len(effectiveRateLimitPoliciesMap) > 0)
 }
 
+func limitadorStatus(topology *machinery.Topology) *kuadrantv1beta1.LimitadorStatus {
+	limitadorObj := GetLimitadorFromTopology(topology)
+	if limitadorObj == nil {
+		return nil
+	}
+
+	var verbosity *int
+	if limitadorObj.Spec.Verbosity != nil {
+		verbosity = ptr.To(int(*limitadorObj.Spec.Verbosity))
+	}
+
+	return &kuadrantv1beta1.LimitadorStatus{
+		Replicas:  limitadorObj.GetReplicas(),
+		Storage:   kuadrantv1beta1.LimitadorStorageType(limitadorObj.Spec.Storage),
+		Verbosity: verbosity,
+	}
+}
+
+func extensionsStatus(extensions ExtensionsHealthProvider) []kuadrantv1beta1.ExtensionStatus {
+	if extensions == nil {
+		return nil
+	}
+	return lo.Map(extensions.Health(), func(health extension.ExtensionHealth, _ int) kuadrantv1beta1.ExtensionStatus {
+		status := kuadrantv1beta1.ExtensionStatus{
+			Name:              health.Name,
+			Executable:        health.Executable,
+			State:             string(health.State),
+			Restarts:          int32(health.Restarts), // #nosec G115
+			LastError:         health.LastError,
+			Mutators:          int32(health.Mutators),      // #nosec G115
+			Subscriptions:     int32(health.Subscriptions), // #nosec G115
+			Address:           health.Address,
+			APIVersion:        health.APIVersion,
+			Incompatibilities: health.Incompatibilities,
+		}
+		if health.LastPingLatency != nil {
+			// rounded so the status is not updated on every negligible change of latency
+			status.LastPingLatency = &metav1.Duration{Duration: health.LastPingLatency.Round(time.Millisecond)}
+		}
+		return status
+	})
+}
+
+func authorinoStatus(topology *machinery.Topology) *kuadrantv1beta1.AuthorinoStatus {
+	authorinoObj := GetAuthorinoFromTopology(topology)
+	if authorinoObj == nil {
+		return nil
+	}
+
+	return &kuadrantv1beta1.AuthorinoStatus{
+		Replicas:           ptr.Deref(authorinoObj.Spec.Replicas, 1),
+		LogLevel:           authorinoObj.Spec.LogLevel,
+		EvaluatorCacheSize: authorinoObj.Spec.EvaluatorCacheSize,
+		OIDCServerTLS:      ptr.To(ptr.Deref(authorinoObj.Spec.OIDCServer.Tls.Enabled, true)),
+		TracingEndpoint:    authorinoObj.Spec.Tracing.Endpoint,
+	}
+}
+
 func (r *KuadrantStatusUpdater) readyCondition(topology *machinery.Topology, logger logr.Logger) *metav1.Condition {
 	cond := &metav1.Condition{
 		Type:    ReadyConditionType,
@@ -167,6 +244,13 @@ func (r *KuadrantStatusUpdater) readyCondition(topology *machinery.Topology, log
 		return cond
 	}
 
+	if reason := checkGatewayLimitadorsReady(topology); reason != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = "LimitadorNotReady"
+		cond.Message = *reason
+		return cond
+	}
+
 	if reason := checkAuthorinoAvailable(topology, logger); reason != nil {
 		cond.Status = metav1.ConditionFalse
 		cond.Reason = "AuthorinoNotReady"
@@ -205,6 +289,31 @@ func (r *KuadrantStatusUpdater) isMissingDependency() kuadrant.PolicyError {
 	return nil
 }
 
+// checkGatewayLimitadorsReady checks that the dedicated Limitador of each entry of gatewayServices in the kuadrant CR
+// is a Limitador custom resource in the cluster that is ready, as the limits of the gateways are written to it
+func checkGatewayLimitadorsReady(topology *machinery.Topology) *string {
+	kObj := GetKuadrantFromTopology(topology)
+	if kObj == nil {
+		return nil
+	}
+
+	for _, services := range kObj.Spec.GatewayServices {
+		if services.Limitador == nil {
+			continue
+		}
+		endpoint := fmt.Sprintf("%s:%d", services.Limitador.Host, services.Limitador.Port)
+		limitadorObj := GetLimitadorByEndpoint(topology, *services.Limitador)
+		if limitadorObj == nil {
+			return ptr.To(fmt.Sprintf("no Limitador resource found with service %s", endpoint))
+		}
+		if !meta.IsStatusConditionTrue(limitadorObj.Status.Conditions, limitadorv1alpha1.StatusConditionReady) {
+			return ptr.To(fmt.Sprintf("Limitador %s/%s of service %s is not ready", limitadorObj.GetNamespace(), limitadorObj.GetName(), endpoint))
+		}
+	}
+
+	return nil
+}
+
 func checkLimitadorReady(topology *machinery.Topology, logger logr.Logger) *string {
 	limitadorObj := GetLimitadorFromTopology(topology)
 	if limitadorObj == nil {
@@ -50,6 +50,7 @@ func (l *LimitadorIstioIntegrationReconciler) Subscription() *controller.Subscri
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
 	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 	"github.com/kuadrant/kuadrant-operator/internal/ratelimit"
 	"github.com/kuadrant/kuadrant-operator/internal/utils"
@@ -34,6 +35,7 @@ func (r *LimitadorLimitsReconciler) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1beta1.LimitadorGroupKind},
@@ -46,17 +48,29 @@ func (r *LimitadorLimitsReconciler) Reconcile(ctx context.Context, _ []controlle
 	logger.Info("Limitador limits reconciler", "status", "started")
 	defer logger.Info("Limitador limits reconciler", "status", "completed")
 
-	limitador := GetLimitadorFromTopology(topology)
-	if limitador == nil {
+	limitadors := limitadorsToReconcile(topology)
+	if len(limitadors) == 0 {
 		logger.V(1).Info("not limitador resources found in topology")
 		return nil
 	}
 
-	desiredLimits := r.buildLimitadorLimits(ctx, state)
+	desiredLimits := r.buildLimitadorLimits(ctx, topology, state)
+
+	for _, limitador := range limitadors {
+		r.reconcileLimitadorLimits(ctx, limitador, desiredLimits[limitadorKey(limitador)], state)
+	}
+
+	return nil
+}
+
+func (r *LimitadorLimitsReconciler) reconcileLimitadorLimits(ctx context.Context, limitador *limitadorv1alpha1.Limitador, desiredLimits []limitadorv1alpha1.RateLimit, state *sync.Map) {
+	logger := controller.LoggerFromContext(ctx).WithName("LimitadorLimitsReconciler").WithValues("limitador", limitadorKey(limitador))
+
+	desiredLimits = utils.GetEmptySliceIfNil(desiredLimits)
 
 	if ratelimit.LimitadorRateLimits(limitador.Spec.Limits).EqualTo(desiredLimits) {
 		logger.Info("limitador object is up to date, nothing to do", "status", "skipping")
-		return nil
+		return
 	}
 
 	state.Store(StateLimitadorLimitsModified, true)
@@ -65,7 +79,7 @@ func (r *LimitadorLimitsReconciler) Reconcile(ctx context.Context, _ []controlle
 
 	obj, err := controller.Destruct(limitador)
 	if err != nil {
-		return err // should never happen
+		return // should never happen
 	}
 
 	logger.V(1).Info("updating limitador object", "limitador", obj.Object)
@@ -76,32 +90,32 @@ func (r *LimitadorLimitsReconciler) Reconcile(ctx context.Context, _ []controlle
 		// TODO: handle error
 	}
 
-	logger.V(1).Info("finished updating limitador object", "limitador", (k8stypes.NamespacedName{Name: limitador.GetName(), Namespace: limitador.GetNamespace()}).String())
-
-	return nil
+	logger.V(1).Info("finished updating limitador object")
 }
 
-func (r *LimitadorLimitsReconciler) buildLimitadorLimits(ctx context.Context, state *sync.Map) []limitadorv1alpha1.RateLimit {
+// buildLimitadorLimits returns the limits of the effective policies grouped by the Limitador that enforces the gateway of each path
+func (r *LimitadorLimitsReconciler) buildLimitadorLimits(ctx context.Context, topology *machinery.Topology, state *sync.Map) map[string][]limitadorv1alpha1.RateLimit {
 	logger := controller.LoggerFromContext(ctx).WithName("LimitadorLimitsReconciler").WithName("buildLimitadorLimits")
 
-	rateLimitIndex := ratelimit.NewIndex()
+	rateLimitIndexes := map[string]*ratelimit.Index{}
 
 	// both RateLimitPolicies and TokenRateLimitPolicies together
-	r.processEffectivePolicies(ctx, state, rateLimitIndex)
-
-	logger.V(1).Info("finished building limitador limits", "limits", rateLimitIndex.Len())
+	r.processEffectivePolicies(ctx, topology, state, rateLimitIndexes)
 
-	return rateLimitIndex.ToRateLimits()
+	return lo.MapValues(rateLimitIndexes, func(rateLimitIndex *ratelimit.Index, limitador string) []limitadorv1alpha1.RateLimit {
+		logger.V(1).Info("finished building limitador limits", "limitador", limitador, "limits", rateLimitIndex.Len())
+		return rateLimitIndex.ToRateLimits()
+	})
 }
 
-func (r *LimitadorLimitsReconciler) processEffectivePolicies(ctx context.Context, state *sync.Map, rateLimitIndex *ratelimit.Index) {
+func (r *LimitadorLimitsReconciler) processEffectivePolicies(ctx context.Context, topology *machinery.Topology, state *sync.Map, rateLimitIndexes map[string]*ratelimit.Index) {
 	logger := controller.LoggerFromContext(ctx).WithName("LimitadorLimitsReconciler").WithName("processEffectivePolicies")
 	// RateLimitPolicies
 	if effectivePolicies, ok := state.Load(StateEffectiveRateLimitPolicies); ok {
 		effectivePoliciesMap := effectivePolicies.(EffectiveRateLimitPolicies)
 		logger.V(1).Info("processing rate limit policies", "count", len(effectivePoliciesMap))
 		for pathID, effectivePolicy := range effectivePoliciesMap {
-			r.processPolicyRules(ctx, pathID, effectivePolicy.Path, effectivePolicy.Spec.Rules(), state, rateLimitIndex)
+			r.processPolicyRules(ctx, topology, pathID, effectivePolicy.Path, effectivePolicy.Spec.Rules(), state, rateLimitIndexes)
 		}
 	}
 
@@ -110,19 +124,30 @@ func (r *LimitadorLimitsReconciler) processEffectivePolicies(ctx context.Context
 		effectivePoliciesMap := effectivePolicies.(EffectiveTokenRateLimitPolicies)
 		logger.V(1).Info("processing token rate limit policies", "count", len(effectivePoliciesMap))
 		for pathID, effectivePolicy := range effectivePoliciesMap {
-			r.processPolicyRules(ctx, pathID, effectivePolicy.Path, effectivePolicy.Spec.Rules(), state, rateLimitIndex)
+			r.processPolicyRules(ctx, topology, pathID, effectivePolicy.Path, effectivePolicy.Spec.Rules(), state, rateLimitIndexes)
 		}
 	}
 }
 
-func (r *LimitadorLimitsReconciler) processPolicyRules(ctx context.Context, pathID string, path []machinery.Targetable, rules map[string]kuadrantv1.MergeableRule, state *sync.Map, rateLimitIndex *ratelimit.Index) {
+func (r *LimitadorLimitsReconciler) processPolicyRules(ctx context.Context, topology *machinery.Topology, pathID string, path []machinery.Targetable, rules map[string]kuadrantv1.MergeableRule, state *sync.Map, rateLimitIndexes map[string]*ratelimit.Index) {
 	logger := controller.LoggerFromContext(ctx).WithName("LimitadorLimitsReconciler").WithName("processPolicyRules")
-	_, _, _, httpRoute, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
-	limitsNamespace := LimitsNamespaceFromRoute(httpRoute.HTTPRoute)
+	_, gateway, _, route, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
+
+	limitador := GetLimitadorForGateway(topology, gateway)
+	if limitador == nil {
+		logger.V(1).Info("no limitador found for the gateway, skipping", "gateway", gateway.GetLocator(), "path", pathID)
+		return
+	}
+	rateLimitIndex, ok := rateLimitIndexes[limitadorKey(limitador)]
+	if !ok {
+		rateLimitIndex = ratelimit.NewIndex()
+		rateLimitIndexes[limitadorKey(limitador)] = rateLimitIndex
+	}
+	limitsNamespace := LimitsNamespaceFromRoute(kuadrantgatewayapi.RouteObject(route))
 
 	limitRules := lo.Filter(lo.Entries(rules),
 		func(r lo.Entry[string, kuadrantv1.MergeableRule], _ int) bool {
-			return r.Key != kuadrantv1.RulesKeyTopLevelPredicates
+			return r.Key != kuadrantv1.RulesKeyTopLevelPredicates && r.Key != kuadrantv1.RulesKeyServiceSettings
 		},
 	)
 
@@ -144,18 +169,22 @@ func (r *LimitadorLimitsReconciler) processPolicyRules(ctx context.Context, path
 		switch limit := limitSpec.(type) {
 		case *kuadrantv1.Limit:
 			limitIdentifier := LimitNameToLimitadorIdentifier(k8stypes.NamespacedName{Name: policy.GetName(), Namespace: policy.GetNamespace()}, limitKey)
+			namespace := limitsNamespace
+			if limit.IsShadow() {
+				namespace = ShadowLimitsNamespace(limitsNamespace)
+			}
 			rateLimits := lo.Map(limit.Rates, func(rate kuadrantv1.Rate, _ int) limitadorv1alpha1.RateLimit {
 				maxValue, seconds := rate.ToSeconds()
 				return limitadorv1alpha1.RateLimit{
 					Name:       limitKey,
-					Namespace:  limitsNamespace,
+					Namespace:  namespace,
 					MaxValue:   maxValue,
 					Seconds:    seconds,
 					Conditions: []string{fmt.Sprintf("descriptors[0][\"%s\"] == \"1\"", limitIdentifier)},
 					Variables:  utils.GetEmptySliceIfNil(limit.CountersAsStringList()),
 				}
 			})
-			rateLimitIndex.Set(fmt.Sprintf("%s/%s", limitsNamespace, limitIdentifier), rateLimits)
+			rateLimitIndex.Set(fmt.Sprintf("%s/%s", namespace, limitIdentifier), rateLimits)
 
 		case *kuadrantv1alpha1.TokenLimit:
 			limitIdentifier := TokenLimitNameToLimitadorIdentifier(k8stypes.NamespacedName{Name: policy.GetName(), Namespace: policy.GetNamespace()}, limitKey)
@@ -190,3 +219,27 @@ func isRateLimitingPolicyAcceptedAndNotDeletedFunc(state *sync.Map) func(machine
 		return rlpPredicate(policy) || trlpPredicate(policy)
 	}
 }
+
+// limitadorsToReconcile returns the Limitador managed by kuadrant-operator and the dedicated Limitadors of the gateways
+// set in the kuadrant CR that are in the topology
+func limitadorsToReconcile(topology *machinery.Topology) []*limitadorv1alpha1.Limitador {
+	var limitadors []*limitadorv1alpha1.Limitador
+	if limitador := GetLimitadorFromTopology(topology); limitador != nil {
+		limitadors = append(limitadors, limitador)
+	}
+	if kObj := GetKuadrantFromTopology(topology); kObj != nil {
+		for _, services := range kObj.Spec.GatewayServices {
+			if services.Limitador == nil {
+				continue
+			}
+			if limitador := GetLimitadorByEndpoint(topology, *services.Limitador); limitador != nil {
+				limitadors = append(limitadors, limitador)
+			}
+		}
+	}
+	return lo.UniqBy(limitadors, limitadorKey)
+}
+
+func limitadorKey(limitador *limitadorv1alpha1.Limitador) string {
+	return k8stypes.NamespacedName{Name: limitador.GetName(), Namespace: limitador.GetNamespace()}.String()
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 )
 
+const defaultLimitadorMetricLabels = "descriptors[1]"
+
 type LimitadorReconciler struct {
 	Client *dynamic.DynamicClient
 }
@@ -30,6 +32,7 @@ func (r *LimitadorReconciler) Subscription() *controller.Subscription {
 		ReconcileFunc: r.Reconcile,
 		Events: []controller.ResourceEventMatcher{
 			{Kind: ptr.To(v1beta1.KuadrantGroupKind), EventType: ptr.To(controller.CreateEvent)},
+			{Kind: ptr.To(v1beta1.KuadrantGroupKind), EventType: ptr.To(controller.UpdateEvent)},
 			{Kind: ptr.To(v1beta1.LimitadorGroupKind)},
 		},
 	}
@@ -64,9 +67,7 @@ func (r *LimitadorReconciler) Reconcile(ctx context.Context, _ []controller.Reso
 				},
 			},
 		},
-		Spec: limitadorv1alpha1.LimitadorSpec{
-			MetricLabelsDefault: ptr.To("descriptors[1]"),
-		},
+		Spec: desiredLimitadorSpec(kobj),
 	}
 
 	unstructuredLimitador, err := controller.Destruct(limitador)
@@ -83,3 +84,26 @@ func (r *LimitadorReconciler) Reconcile(ctx context.Context, _ []controller.Reso
 
 	return nil
 }
+
+// desiredLimitadorSpec merges the limitador tuning of the kuadrant CR into the spec of the managed Limitador
+func desiredLimitadorSpec(kobj *v1beta1.Kuadrant) limitadorv1alpha1.LimitadorSpec {
+	spec := limitadorv1alpha1.LimitadorSpec{
+		MetricLabelsDefault: ptr.To(defaultLimitadorMetricLabels),
+	}
+
+	tuning := kobj.Spec.Limitador
+	if tuning == nil {
+		return spec
+	}
+
+	spec.Replicas = tuning.Replicas
+	spec.Storage = tuning.Storage
+	spec.ResourceRequirements = tuning.ResourceRequirements
+	spec.PodDisruptionBudget = tuning.PodDisruptionBudget
+	spec.Verbosity = tuning.Verbosity
+	if tuning.MetricLabelsDefault != nil {
+		spec.MetricLabelsDefault = tuning.MetricLabelsDefault
+	}
+
+	return spec
+}
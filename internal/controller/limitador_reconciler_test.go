@@ -0,0 +1,82 @@
+//go:build unit
+
+package controllers
+
+import (
+	"testing"
+
+	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+func TestDesiredLimitadorSpec(t *testing.T) {
+	resources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+	}
+
+	tests := []struct {
+		name     string
+		spec     *kuadrantv1beta1.LimitadorSpec
+		expected limitadorv1alpha1.LimitadorSpec
+	}{
+		{
+			name: "no limitador tuning",
+			spec: nil,
+			expected: limitadorv1alpha1.LimitadorSpec{
+				MetricLabelsDefault: ptr.To("descriptors[1]"),
+			},
+		},
+		{
+			name: "metric labels default overridden",
+			spec: &kuadrantv1beta1.LimitadorSpec{
+				MetricLabelsDefault: ptr.To("descriptors[0]"),
+			},
+			expected: limitadorv1alpha1.LimitadorSpec{
+				MetricLabelsDefault: ptr.To("descriptors[0]"),
+			},
+		},
+		{
+			name: "all fields are merged",
+			spec: &kuadrantv1beta1.LimitadorSpec{
+				Replicas: ptr.To(3),
+				Storage: &limitadorv1alpha1.Storage{
+					Redis: &limitadorv1alpha1.Redis{
+						ConfigSecretRef: &corev1.LocalObjectReference{Name: "redis-config"},
+					},
+				},
+				ResourceRequirements: resources,
+				PodDisruptionBudget: &limitadorv1alpha1.PodDisruptionBudgetType{
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				},
+				Verbosity: ptr.To(limitadorv1alpha1.VerbosityLevel(3)),
+			},
+			expected: limitadorv1alpha1.LimitadorSpec{
+				Replicas: ptr.To(3),
+				Storage: &limitadorv1alpha1.Storage{
+					Redis: &limitadorv1alpha1.Redis{
+						ConfigSecretRef: &corev1.LocalObjectReference{Name: "redis-config"},
+					},
+				},
+				ResourceRequirements: resources,
+				PodDisruptionBudget: &limitadorv1alpha1.PodDisruptionBudgetType{
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				},
+				Verbosity:           ptr.To(limitadorv1alpha1.VerbosityLevel(3)),
+				MetricLabelsDefault: ptr.To("descriptors[1]"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(subT *testing.T) {
+			kobj := &kuadrantv1beta1.Kuadrant{Spec: kuadrantv1beta1.KuadrantSpec{Limitador: tt.spec}}
+			assert.DeepEqual(subT, desiredLimitadorSpec(kobj), tt.expected)
+		})
+	}
+}
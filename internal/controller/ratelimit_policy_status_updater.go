@@ -45,6 +45,7 @@ func (r *RateLimitPolicyStatusUpdater) Subscription() controller.Subscription {
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1.RateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1beta1.LimitadorGroupKind},
 			{Kind: &kuadrantistio.EnvoyFilterGroupKind},
@@ -89,6 +90,9 @@ func (r *RateLimitPolicyStatusUpdater) UpdateStatus(ctx context.Context, _ []con
 		} else {
 			enforcedCond := r.enforcedCondition(policy, topology, state)
 			meta.SetStatusCondition(&newStatus.Conditions, *enforcedCond)
+			if enforcedCond.Status == metav1.ConditionTrue {
+				newStatus.ShadowLimits = shadowLimits(policy)
+			}
 		}
 
 		equalStatus := equality.Semantic.DeepEqual(newStatus, policy.Status)
@@ -119,6 +123,19 @@ func (r *RateLimitPolicyStatusUpdater) UpdateStatus(ctx context.Context, _ []con
 	return nil
 }
 
+// shadowLimits returns the sorted names of the limits of the policy in shadow mode
+func shadowLimits(policy *kuadrantv1.RateLimitPolicy) []string {
+	names := lo.FilterMap(lo.Entries(policy.Rules()), func(entry lo.Entry[string, kuadrantv1.MergeableRule], _ int) (string, bool) {
+		limit, ok := entry.Value.GetSpec().(*kuadrantv1.Limit)
+		return entry.Key, ok && limit.IsShadow()
+	})
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (r *RateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.RateLimitPolicy, topology *machinery.Topology, state *sync.Map) *metav1.Condition {
 	kObj := GetKuadrantFromTopology(topology)
 	if kObj == nil {
@@ -162,8 +179,8 @@ func (r *RateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.Rate
 			}
 		}
 
-		gatewayClass, gateway, listener, httpRoute, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
-		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsHTTPRouteReady(httpRoute.HTTPRoute, gateway.Gateway, gatewayClass.Spec.ControllerName) {
+		gatewayClass, gateway, listener, route, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsRouteReady(route, gateway.Gateway, gatewayClass.Spec.ControllerName) {
 			continue
 		}
 		effectivePolicyRules := effectivePolicy.Spec.Rules()
@@ -202,12 +219,16 @@ func (r *RateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1.Rate
 	if limitadorLimitsModified, stateLimitadorLimitsModifiedPresent := state.Load(StateLimitadorLimitsModified); stateLimitadorLimitsModifiedPresent && limitadorLimitsModified.(bool) {
 		componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
 	} else {
-		limitador := GetLimitadorFromTopology(topology)
-		if limitador == nil {
-			return kuadrant.EnforcedCondition(policy, kuadrant.NewErrSystemResource("limitador"), false)
-		}
-		if !meta.IsStatusConditionTrue(limitador.Status.Conditions, limitadorv1alpha1.StatusConditionReady) {
-			componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
+		// the limits of each gateway are enforced by the Limitador of the gateway
+		for _, g := range affectedGateways {
+			limitador := GetLimitadorForGateway(topology, g.gateway)
+			if limitador == nil {
+				return kuadrant.EnforcedCondition(policy, kuadrant.NewErrSystemResource("limitador"), false)
+			}
+			if !meta.IsStatusConditionTrue(limitador.Status.Conditions, limitadorv1alpha1.StatusConditionReady) {
+				componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
+				break
+			}
 		}
 	}
 
@@ -16,12 +16,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8stypes "k8s.io/apimachinery/pkg/types"
-	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 	"github.com/kuadrant/kuadrant-operator/internal/wasm"
@@ -62,10 +63,42 @@ func GetLimitadorFromTopology(topology *machinery.Topology) *limitadorv1alpha1.L
 	return limitador
 }
 
-func LimitsNamespaceFromRoute(route *gatewayapiv1.HTTPRoute) string {
+// GetLimitadorForGateway returns the Limitador custom resource that enforces the limits of a gateway:
+// the dedicated Limitador of the gateway set in the kuadrant CR, if any, or the Limitador managed by kuadrant-operator otherwise.
+// It returns nil if the dedicated Limitador of the gateway is not in the topology.
+func GetLimitadorForGateway(topology *machinery.Topology, gateway machinery.Object) *limitadorv1alpha1.Limitador {
+	endpoint := GetKuadrantFromTopology(topology).LimitadorForGateway(gateway.GetNamespace(), gateway.GetName())
+	if endpoint == nil {
+		return GetLimitadorFromTopology(topology)
+	}
+	return GetLimitadorByEndpoint(topology, *endpoint)
+}
+
+// GetLimitadorByEndpoint returns the Limitador custom resource whose service exposes the gRPC endpoint, if any
+func GetLimitadorByEndpoint(topology *machinery.Topology, endpoint kuadrantv1beta1.ServiceEndpoint) *limitadorv1alpha1.Limitador {
+	limitadorObj, found := lo.Find(topology.Objects().Items(), func(obj machinery.Object) bool {
+		if obj.GroupVersionKind().GroupKind() != kuadrantv1beta1.LimitadorGroupKind {
+			return false
+		}
+		service := obj.(*controller.RuntimeObject).Object.(*limitadorv1alpha1.Limitador).Status.Service
+		return service != nil && service.Host == endpoint.Host && service.Ports.GRPC == endpoint.Port
+	})
+	if !found {
+		return nil
+	}
+	return limitadorObj.(*controller.RuntimeObject).Object.(*limitadorv1alpha1.Limitador)
+}
+
+func LimitsNamespaceFromRoute(route client.Object) string {
 	return k8stypes.NamespacedName{Name: route.GetName(), Namespace: route.GetNamespace()}.String()
 }
 
+// ShadowLimitsNamespace returns the Limitador namespace of the limits in shadow mode of a limits namespace,
+// so the counters of shadow limits are kept apart from the enforced ones
+func ShadowLimitsNamespace(limitsNamespace string) string {
+	return "shadow/" + limitsNamespace
+}
+
 func LimitNameToLimitadorIdentifier(rlpKey k8stypes.NamespacedName, uniqueLimitName string) string {
 	identifier := "limit."
 
@@ -101,15 +134,31 @@ func RateLimitClusterName(gatewayName string) string {
 	return fmt.Sprintf("kuadrant-ratelimiting-%s", gatewayName)
 }
 
-func rateLimitClusterPatch(host string, port int, mTLS bool) map[string]any {
+// rateLimitServiceClusterName returns the name of the cluster of the Limitador instance that enforces the policies of a gateway,
+// given its dedicated instance, if any
+func rateLimitServiceClusterName(dedicated *kuadrantv1beta1.ServiceEndpoint) string {
+	if dedicated == nil {
+		return kuadrant.KuadrantRateLimitClusterName
+	}
+	return kuadrant.DedicatedServiceClusterName(kuadrant.KuadrantRateLimitClusterName, dedicated.Host, dedicated.Port)
+}
+
+// rateLimitClusterPatch returns a builder of the patch that adds the rate limit cluster with the given name to the gateway
+func rateLimitClusterPatch(clusterName string) func(string, int, bool) map[string]any {
+	return func(host string, port int, mTLS bool) map[string]any {
+		return buildRateLimitClusterPatch(clusterName, host, port, mTLS)
+	}
+}
+
+func buildRateLimitClusterPatch(clusterName, host string, port int, mTLS bool) map[string]any {
 	base := map[string]any{
-		"name":                   kuadrant.KuadrantRateLimitClusterName,
+		"name":                   clusterName,
 		"type":                   "STRICT_DNS",
 		"connect_timeout":        "1s",
 		"lb_policy":              "ROUND_ROBIN",
 		"http2_protocol_options": map[string]any{},
 		"load_assignment": map[string]any{
-			"cluster_name": kuadrant.KuadrantRateLimitClusterName,
+			"cluster_name": clusterName,
 			"endpoints": []map[string]any{
 				{
 					"lb_endpoints": []map[string]any{
@@ -178,8 +227,10 @@ func rateLimitClusterPatch(host string, port int, mTLS bool) map[string]any {
 //
 // The only action of the rule is the ratelimit service, whose data includes the activation of the limit
 // and any counter qualifier of the limit.
+// Limits in shadow mode are scoped to the shadow limits namespace and reported to the ratelimit shadow service,
+// a ratelimit report service that increments their counters without checking them, so the request is never denied.
 func wasmActionFromLimit(limit *kuadrantv1.Limit, limitIdentifier, scope string, topLevelPredicates kuadrantv1.WhenPredicates) wasm.Action {
-	return wasm.Action{
+	action := wasm.Action{
 		ServiceName: wasm.RateLimitServiceName,
 		Scope:       scope,
 		ConditionalData: []wasm.ConditionalData{
@@ -189,6 +240,19 @@ func wasmActionFromLimit(limit *kuadrantv1.Limit, limitIdentifier, scope string,
 			},
 		},
 	}
+	if limit.IsShadow() {
+		action.ServiceName = wasm.RateLimitShadowServiceName
+		action.Scope = ShadowLimitsNamespace(scope)
+		action.ConditionalData[0].Data = append(action.ConditionalData[0].Data, wasm.DataType{
+			Value: &wasm.Expression{
+				ExpressionItem: wasm.ExpressionItem{
+					Key:   wasm.HitsAddendKey,
+					Value: "1",
+				},
+			},
+		})
+	}
+	return action
 }
 
 func wasmDataFromLimit(limitIdentifier string, limit *kuadrantv1.Limit) []wasm.DataType {
@@ -326,7 +390,7 @@ func wasmActionsFromTokenLimit(tokenLimit *kuadrantv1alpha1.TokenLimit, limitIde
 	requestPhaseData = append(requestPhaseData, wasm.DataType{
 		Value: &wasm.Expression{
 			ExpressionItem: wasm.ExpressionItem{
-				Key:   "ratelimit.hits_addend",
+				Key:   wasm.HitsAddendKey,
 				Value: "0",
 			},
 		},
@@ -349,8 +413,8 @@ func wasmActionsFromTokenLimit(tokenLimit *kuadrantv1alpha1.TokenLimit, limitIde
 	responsePhaseData = append(responsePhaseData, wasm.DataType{
 		Value: &wasm.Expression{
 			ExpressionItem: wasm.ExpressionItem{
-				Key:   "ratelimit.hits_addend",
-				Value: "responseBodyJSON(\"/usage/total_tokens\")",
+				Key:   wasm.HitsAddendKey,
+				Value: tokenLimit.HitsAddend(),
 			},
 		},
 	})
@@ -365,12 +429,15 @@ func wasmActionsFromTokenLimit(tokenLimit *kuadrantv1alpha1.TokenLimit, limitIde
 			},
 		},
 	}
+	if tokenLimit.IsStreaming() && wasm.ShimFeatureSupported(WASMFilterImageURL, wasm.ShimFeatureEventStreamResponseBody) {
+		responseAction.ResponseBodyFormat = wasm.ResponseBodyFormatEventStream
+	}
 
 	return []wasm.Action{requestAction, responseAction}
 }
 
 func buildWasmActionsForRateLimit(effectivePolicy EffectiveRateLimitPolicy, policyPredicate func(machinery.Policy) bool) []wasm.Action {
-	return buildWasmActionsForAnyRateLimit(
+	actions := buildWasmActionsForAnyRateLimit(
 		effectivePolicy.Path,
 		effectivePolicy.Spec.Rules(),
 		kuadrantv1.RulesKeyTopLevelPredicates,
@@ -383,6 +450,7 @@ func buildWasmActionsForRateLimit(effectivePolicy EffectiveRateLimitPolicy, poli
 			return wasmActionFromLimit(limit, limitIdentifier, scope, predicates)
 		},
 	)
+	return withServiceSettings(actions, effectivePolicy.Spec.Spec.Proper().MergeableServiceSettings)
 }
 
 func buildWasmActionsForTokenRateLimit(effectivePolicy EffectiveTokenRateLimitPolicy, policyPredicate func(machinery.Policy) bool) []wasm.Action {
@@ -390,14 +458,17 @@ func buildWasmActionsForTokenRateLimit(effectivePolicy EffectiveTokenRateLimitPo
 	rules := effectivePolicy.Spec.Rules()
 	policiesInPath := kuadrantv1.PoliciesInPath(path, policyPredicate)
 
-	_, _, _, httpRoute, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
-	limitsNamespace := LimitsNamespaceFromRoute(httpRoute.HTTPRoute)
+	_, _, _, route, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
+	limitsNamespace := LimitsNamespaceFromRoute(kuadrantgatewayapi.RouteObject(route))
 
 	topLevelRules, limitRules := lo.FilterReject(lo.Entries(rules),
 		func(r lo.Entry[string, kuadrantv1.MergeableRule], _ int) bool {
 			return r.Key == kuadrantv1.RulesKeyTopLevelPredicates
 		},
 	)
+	limitRules = lo.Reject(limitRules, func(r lo.Entry[string, kuadrantv1.MergeableRule], _ int) bool {
+		return r.Key == kuadrantv1.RulesKeyServiceSettings
+	})
 
 	var topLevelWhenPredicates kuadrantv1.WhenPredicates
 	if len(topLevelRules) > 0 {
@@ -426,7 +497,7 @@ func buildWasmActionsForTokenRateLimit(effectivePolicy EffectiveTokenRateLimitPo
 		allActions = append(allActions, tokenActions...)
 	}
 
-	return allActions
+	return withServiceSettings(allActions, effectivePolicy.Spec.Spec.Proper().MergeableServiceSettings)
 }
 
 // buildWasmActionsForAnyRateLimit is the generic implementation used by both rate limit policy types
@@ -440,14 +511,17 @@ func buildWasmActionsForAnyRateLimit(
 ) []wasm.Action {
 	policiesInPath := kuadrantv1.PoliciesInPath(path, policyPredicate)
 
-	_, _, _, httpRoute, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
-	limitsNamespace := LimitsNamespaceFromRoute(httpRoute.HTTPRoute)
+	_, _, _, route, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(path)
+	limitsNamespace := LimitsNamespaceFromRoute(kuadrantgatewayapi.RouteObject(route))
 
 	topLevelRules, limitRules := lo.FilterReject(lo.Entries(rules),
 		func(r lo.Entry[string, kuadrantv1.MergeableRule], _ int) bool {
 			return r.Key == topLevelPredicatesKey
 		},
 	)
+	limitRules = lo.Reject(limitRules, func(r lo.Entry[string, kuadrantv1.MergeableRule], _ int) bool {
+		return r.Key == kuadrantv1.RulesKeyServiceSettings
+	})
 
 	var topLevelWhenPredicates kuadrantv1.WhenPredicates
 	if len(topLevelRules) > 0 {
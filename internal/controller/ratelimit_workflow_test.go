@@ -7,9 +7,16 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 )
 
@@ -227,6 +234,40 @@ func TestWasmActionFromLimit(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "limit in shadow mode",
+			limit: &kuadrantv1.Limit{
+				Mode: kuadrantv1.LimitModeShadow,
+			},
+			limitIdentifier: "limit.myLimit__d681f6c3",
+			scope:           "my-ns/my-route",
+			expectedAction: wasm.Action{
+				ServiceName: wasm.RateLimitShadowServiceName,
+				Scope:       "shadow/my-ns/my-route",
+				ConditionalData: []wasm.ConditionalData{
+					{
+						Data: []wasm.DataType{
+							{
+								Value: &wasm.Expression{
+									ExpressionItem: wasm.ExpressionItem{
+										Key:   "limit.myLimit__d681f6c3",
+										Value: "1",
+									},
+								},
+							},
+							{
+								Value: &wasm.Expression{
+									ExpressionItem: wasm.ExpressionItem{
+										Key:   wasm.HitsAddendKey,
+										Value: "1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -238,3 +279,94 @@ func TestWasmActionFromLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLimitadorForGateway(t *testing.T) {
+	kObj := &kuadrantv1beta1.Kuadrant{
+		TypeMeta:   metav1.TypeMeta{Kind: "Kuadrant", APIVersion: "kuadrant.io/v1beta1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "kuadrant", Namespace: "kuadrant-system", UID: "kuadrant"},
+		Spec: kuadrantv1beta1.KuadrantSpec{
+			GatewayServices: []kuadrantv1beta1.GatewayServices{
+				{
+					Gateways:  []kuadrantv1beta1.GatewayReference{{Name: "gw-a", Namespace: "tenant-a"}},
+					Limitador: &kuadrantv1beta1.ServiceEndpoint{Host: "limitador-tenant-a.tenant-a.svc.cluster.local", Port: 8081},
+				},
+				{
+					Gateways:  []kuadrantv1beta1.GatewayReference{{Name: "gw-b", Namespace: "tenant-b"}},
+					Limitador: &kuadrantv1beta1.ServiceEndpoint{Host: "limitador-tenant-b.tenant-b.svc.cluster.local", Port: 8081},
+				},
+			},
+		},
+	}
+	newLimitador := func(name, namespace string, service *limitadorv1alpha1.LimitadorService) *limitadorv1alpha1.Limitador {
+		return &limitadorv1alpha1.Limitador{
+			TypeMeta:   metav1.TypeMeta{Kind: "Limitador", APIVersion: "limitador.kuadrant.io/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: k8stypes.UID(namespace + "/" + name)},
+			Status:     limitadorv1alpha1.LimitadorStatus{Service: service},
+		}
+	}
+	managedLimitador := newLimitador("limitador", "kuadrant-system", &limitadorv1alpha1.LimitadorService{
+		Host:  "limitador-limitador.kuadrant-system.svc.cluster.local",
+		Ports: limitadorv1alpha1.Ports{HTTP: 8080, GRPC: 8081},
+	})
+	tenantALimitador := newLimitador("tenant-a", "tenant-a", &limitadorv1alpha1.LimitadorService{
+		Host:  "limitador-tenant-a.tenant-a.svc.cluster.local",
+		Ports: limitadorv1alpha1.Ports{HTTP: 8080, GRPC: 8081},
+	})
+	// the service of the limitador of tenant b has not been created yet
+	tenantBLimitador := newLimitador("tenant-b", "tenant-b", nil)
+
+	store := controller.Store{}
+	objects := []machinery.Object{kObj}
+	for _, limitador := range []*limitadorv1alpha1.Limitador{managedLimitador, tenantALimitador, tenantBLimitador} {
+		store[string(limitador.GetUID())] = limitador
+		objects = append(objects, &controller.RuntimeObject{Object: limitador})
+	}
+	store[string(kObj.GetUID())] = kObj
+	topology, err := machinery.NewTopology(
+		machinery.WithObjects(objects...),
+		machinery.WithLinks(kuadrantv1beta1.LinkKuadrantToLimitador(store)),
+	)
+	if err != nil {
+		t.Fatalf("failed to create topology: %v", err)
+	}
+
+	newGateway := func(name, namespace string) *machinery.Gateway {
+		return &machinery.Gateway{Gateway: &gatewayapiv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}}
+	}
+
+	testCases := []struct {
+		name     string
+		gateway  *machinery.Gateway
+		expected *limitadorv1alpha1.Limitador
+	}{
+		{
+			name:     "gateway without dedicated limitador",
+			gateway:  newGateway("gw", "default"),
+			expected: managedLimitador,
+		},
+		{
+			name:     "gateway with dedicated limitador",
+			gateway:  newGateway("gw-a", "tenant-a"),
+			expected: tenantALimitador,
+		},
+		{
+			name:     "gateway with dedicated limitador not found",
+			gateway:  newGateway("gw-b", "tenant-b"),
+			expected: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(subT *testing.T) {
+			if limitador := GetLimitadorForGateway(topology, tc.gateway); limitador != tc.expected {
+				subT.Errorf("unexpected limitador, expected(%v), got (%v)", tc.expected, limitador)
+			}
+		})
+	}
+
+	t.Run("limitadors to reconcile", func(subT *testing.T) {
+		limitadors := lo.Map(limitadorsToReconcile(topology), func(l *limitadorv1alpha1.Limitador, _ int) string { return limitadorKey(l) })
+		if diff := cmp.Diff(limitadors, []string{"kuadrant-system/limitador", "tenant-a/tenant-a"}); diff != "" {
+			subT.Errorf("unexpected limitadors (-got +want):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	"k8s.io/utils/ptr"
+
+	"github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+// RemoteExtensionsRegistry registers the extensions that run outside of the operator pod
+type RemoteExtensionsRegistry interface {
+	SetRemoteExtensions(names []string) error
+}
+
+// RemoteExtensionsReconciler registers the remote extensions listed in the kuadrant CR, and unregisters the others
+type RemoteExtensionsReconciler struct {
+	registry RemoteExtensionsRegistry
+}
+
+func NewRemoteExtensionsReconciler(registry RemoteExtensionsRegistry) *RemoteExtensionsReconciler {
+	return &RemoteExtensionsReconciler{registry: registry}
+}
+
+func (r *RemoteExtensionsReconciler) Subscription() *controller.Subscription {
+	return &controller.Subscription{
+		ReconcileFunc: r.Reconcile,
+		Events: []controller.ResourceEventMatcher{
+			{Kind: ptr.To(v1beta1.KuadrantGroupKind)},
+		},
+	}
+}
+
+func (r *RemoteExtensionsReconciler) Reconcile(ctx context.Context, _ []controller.ResourceEvent, topology *machinery.Topology, _ error, _ *sync.Map) error {
+	logger := controller.LoggerFromContext(ctx).WithName("RemoteExtensionsReconciler")
+	logger.V(1).Info("reconciling remote extensions", "status", "started")
+	defer logger.V(1).Info("reconciling remote extensions", "status", "completed")
+
+	names := remoteExtensionNames(GetKuadrantFromTopology(topology))
+	if err := r.registry.SetRemoteExtensions(names); err != nil {
+		logger.Error(err, "failed to register remote extensions", "status", "error")
+		return err
+	}
+
+	return nil
+}
+
+// remoteExtensionNames returns the names of the remote extensions listed in the kuadrant CR, if any
+func remoteExtensionNames(kobj *v1beta1.Kuadrant) []string {
+	if kobj == nil || kobj.Spec.Extensions == nil {
+		return nil
+	}
+	return lo.Map(kobj.Spec.Extensions.Remote, func(remote v1beta1.RemoteExtension, _ int) string {
+		return remote.Name
+	})
+}
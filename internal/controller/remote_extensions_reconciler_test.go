@@ -0,0 +1,80 @@
+//go:build unit
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kuadrant/policy-machinery/machinery"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+type fakeRemoteExtensionsRegistry struct {
+	names []string
+	err   error
+}
+
+func (f *fakeRemoteExtensionsRegistry) SetRemoteExtensions(names []string) error {
+	f.names = names
+	return f.err
+}
+
+func TestRemoteExtensionsReconciler(t *testing.T) {
+	kuadrant := func(extensions *kuadrantv1beta1.ExtensionsSpec) *kuadrantv1beta1.Kuadrant {
+		return &kuadrantv1beta1.Kuadrant{
+			TypeMeta:   metav1.TypeMeta{Kind: "Kuadrant", APIVersion: kuadrantv1beta1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: "kuadrant", Namespace: "kuadrant-system"},
+			Spec:       kuadrantv1beta1.KuadrantSpec{Extensions: extensions},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		kuadrant *kuadrantv1beta1.Kuadrant
+		expected []string
+	}{
+		{
+			name:     "no kuadrant",
+			expected: nil,
+		},
+		{
+			name:     "no extensions",
+			kuadrant: kuadrant(nil),
+			expected: nil,
+		},
+		{
+			name: "remote extensions",
+			kuadrant: kuadrant(&kuadrantv1beta1.ExtensionsSpec{
+				Remote: []kuadrantv1beta1.RemoteExtension{{Name: "plan-policy"}, {Name: "telemetry-policy"}},
+			}),
+			expected: []string{"plan-policy", "telemetry-policy"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(subT *testing.T) {
+			var objects []machinery.Object
+			if tc.kuadrant != nil {
+				objects = append(objects, tc.kuadrant)
+			}
+			topology, err := machinery.NewTopology(machinery.WithObjects(objects...))
+			assert.NilError(subT, err)
+
+			registry := &fakeRemoteExtensionsRegistry{names: []string{"previous"}}
+			assert.NilError(subT, NewRemoteExtensionsReconciler(registry).Reconcile(context.Background(), nil, topology, nil, nil))
+			assert.DeepEqual(subT, registry.names, tc.expected)
+		})
+	}
+
+	t.Run("registration error", func(subT *testing.T) {
+		topology, err := machinery.NewTopology()
+		assert.NilError(subT, err)
+		registry := &fakeRemoteExtensionsRegistry{err: errors.New("remote extensions are not enabled")}
+		assert.ErrorContains(subT, NewRemoteExtensionsReconciler(registry).Reconcile(context.Background(), nil, topology, nil, nil), "not enabled")
+	})
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/kuadrant/kuadrant-operator/internal/observability"
 	"github.com/kuadrant/kuadrant-operator/internal/openshift"
 	"github.com/kuadrant/kuadrant-operator/internal/openshift/consoleplugin"
+	kuadrantpolicymachinery "github.com/kuadrant/kuadrant-operator/internal/policymachinery"
 	"github.com/kuadrant/kuadrant-operator/internal/utils"
 )
 
@@ -56,6 +57,7 @@ var (
 	kuadrantManagedLabelKey = "kuadrant.io/managed"
 
 	ConfigMapGroupKind = schema.GroupKind{Group: corev1.GroupName, Kind: "ConfigMap"}
+	GRPCRoutesResource = gwapiv1.SchemeGroupVersion.WithResource("grpcroutes")
 )
 
 // gateway-api permissions
@@ -65,6 +67,8 @@ var (
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/finalizers,verbs=update
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes/status,verbs=get;update;patch
 
 // kuadrant permissions
 //+kubebuilder:rbac:groups=kuadrant.io,resources=kuadrants,verbs=get;list;watch;update;patch
@@ -79,6 +83,8 @@ var (
 //+kubebuilder:rbac:groups="",resources=leases,verbs=get;list;watch;create;update;patch;delete
 
 func NewPolicyMachineryController(manager ctrlruntime.Manager, client *dynamic.DynamicClient, logger logr.Logger) (*controller.Controller, error) {
+	bootOptions := NewBootOptionsBuilder(manager, client, logger)
+
 	// Base options
 	controllerOpts := []controller.ControllerOption{
 		controller.ManagedBy(manager),
@@ -151,13 +157,12 @@ func NewPolicyMachineryController(manager ctrlruntime.Manager, client *dynamic.D
 			ConfigMapGroupKind,
 			kuadrantv1beta1.DeploymentGroupKind,
 		),
-		controller.WithObjectLinks(
+		bootOptions.withObjectLinks(
 			kuadrantv1beta1.LinkKuadrantToGatewayClasses,
 		),
 	}
 
 	// Boot options and reconciler based on detected dependencies
-	bootOptions := NewBootOptionsBuilder(manager, client, logger)
 	options, err := bootOptions.getOptions()
 	if err != nil {
 		return nil, err
@@ -185,6 +190,7 @@ type BootOptionsBuilder struct {
 
 	// Internal configurations
 	isGatewayAPIInstalled         bool
+	isGRPCRouteInstalled          bool
 	isEnvoyGatewayInstalled       bool
 	isIstioInstalled              bool
 	isCertManagerInstalled        bool
@@ -195,6 +201,15 @@ type BootOptionsBuilder struct {
 	isAuthorinoOperatorInstalled  bool
 	isPrometheusOperatorInstalled bool
 	isUsingExtensions             bool
+	extensionManager              *extension.Manager
+	objectLinks                   []controller.LinkFunc
+}
+
+// withObjectLinks returns the controller option for the given object links and records them, so they can be applied
+// to the topologies built with GRPCRoutes too
+func (b *BootOptionsBuilder) withObjectLinks(objectLinks ...controller.LinkFunc) controller.ControllerOption {
+	b.objectLinks = append(b.objectLinks, objectLinks...)
+	return controller.WithObjectLinks(objectLinks...)
 }
 
 func (b *BootOptionsBuilder) getOptions() ([]controller.ControllerOption, error) {
@@ -291,6 +306,24 @@ func (b *BootOptionsBuilder) getGatewayAPIOptions() ([]controller.ControllerOpti
 		)),
 	)
 
+	b.isGRPCRouteInstalled, err = kuadrantgatewayapi.IsGRPCRouteInstalled(b.manager.GetRESTMapper())
+	if err != nil {
+		return nil, err
+	}
+	if !b.isGRPCRouteInstalled {
+		b.logger.Info("gateway api grpcroute is not installed, skipping grpcroute watches")
+		return opts, nil
+	}
+
+	// the topology builder of the controller ignores the GRPCRoutes; they are added to the topology by the reconciler
+	opts = append(opts,
+		controller.WithRunnable("grpcroute watcher", controller.Watch(
+			&gwapiv1.GRPCRoute{},
+			GRPCRoutesResource,
+			metav1.NamespaceAll,
+		)),
+	)
+
 	return opts, nil
 }
 
@@ -322,7 +355,7 @@ func (b *BootOptionsBuilder) getEnvoyGatewayOptions() ([]controller.ControllerOp
 			envoygateway.EnvoyPatchPolicyGroupKind,
 			envoygateway.EnvoyExtensionPolicyGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			envoygateway.LinkGatewayToEnvoyPatchPolicy,
 			envoygateway.LinkGatewayToEnvoyExtensionPolicy,
 		),
@@ -366,7 +399,7 @@ func (b *BootOptionsBuilder) getIstioOptions() ([]controller.ControllerOption, e
 			istio.WasmPluginGroupKind,
 			istio.PeerAuthenticationGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			istio.LinkGatewayToEnvoyFilter,
 			istio.LinkGatewayToWasmPlugin,
 			istio.LinkKuadrantToPeerAuthentication,
@@ -388,7 +421,7 @@ func (b *BootOptionsBuilder) getCertManagerOptions() ([]controller.ControllerOpt
 		return opts, nil
 	}
 
-	opts = append(opts, certManagerControllerOpts()...)
+	opts = append(opts, b.certManagerControllerOpts()...)
 
 	return opts, nil
 }
@@ -451,7 +484,7 @@ func (b *BootOptionsBuilder) getDNSOperatorOptions() ([]controller.ControllerOpt
 		controller.WithObjectKinds(
 			DNSRecordGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			LinkListenerToDNSRecord,
 			LinkDNSPolicyToDNSRecord,
 		),
@@ -481,7 +514,7 @@ func (b *BootOptionsBuilder) getLimitadorOperatorOptions() ([]controller.Control
 		controller.WithObjectKinds(
 			kuadrantv1beta1.LimitadorGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			kuadrantv1beta1.LinkKuadrantToLimitador,
 			kuadrantv1beta1.LinkLimitadorToDeployment,
 		),
@@ -518,9 +551,10 @@ func (b *BootOptionsBuilder) getAuthorinoOperatorOptions() ([]controller.Control
 			kuadrantv1beta1.AuthorinoGroupKind,
 			authorino.AuthConfigGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			kuadrantv1beta1.LinkKuadrantToAuthorino,
 			authorino.LinkHTTPRouteRuleToAuthConfig,
+			authorino.LinkGRPCRouteRuleToAuthConfig,
 		),
 	)
 
@@ -561,7 +595,7 @@ func (b *BootOptionsBuilder) getObservabilityOptions() ([]controller.ControllerO
 			observability.ServiceMonitorGroupKind,
 			observability.PodMonitorGroupKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			kuadrantv1beta1.LinkKuadrantToServiceMonitor,
 			kuadrantv1beta1.LinkKuadrantToPodMonitor,
 		),
@@ -572,6 +606,7 @@ func (b *BootOptionsBuilder) getObservabilityOptions() ([]controller.ControllerO
 
 // getExtensionsOptions configures controller options for Kuadrant extensions.
 // Extensions are dynamically discovered from the EXTENSIONS_DIR (default: "/extensions").
+// Remote extensions, which run outside of the operator pod, are registered in the kuadrant CR.
 func (b *BootOptionsBuilder) getExtensionsOptions() []controller.ControllerOption {
 	var opts []controller.ControllerOption
 
@@ -584,7 +619,7 @@ func (b *BootOptionsBuilder) getExtensionsOptions() []controller.ControllerOptio
 
 	extensionsDir := env.GetString("EXTENSIONS_DIR", "/extensions")
 
-	extManager, err := extension.NewManager(extensionsDir, b.logger.WithName("extensions"), log.Sync, b.client)
+	extManager, err := extension.NewManager(extensionsDir, operatorNamespace, b.logger.WithName("extensions"), log.Sync, b.client, remoteExtensionsOptions())
 	if err != nil {
 		if errors.Is(err, extension.ErrNoExtensionsFound) {
 			b.logger.Info("No extensions found, skipping extension manager", "directory", extensionsDir)
@@ -594,6 +629,7 @@ func (b *BootOptionsBuilder) getExtensionsOptions() []controller.ControllerOptio
 		return opts
 	}
 	extManager.SetChangeNotifier(extManager.TriggerReconciliation)
+	b.extensionManager = &extManager
 
 	opts = append(opts, controller.WithRunnable(
 		"extension manager",
@@ -604,6 +640,16 @@ func (b *BootOptionsBuilder) getExtensionsOptions() []controller.ControllerOptio
 	return opts
 }
 
+// remoteExtensionsOptions configures the server the remote extensions registered in the kuadrant CR connect to, at
+// REMOTE_EXTENSIONS_ADDRESS (default: ":50051") with mutual tls, using the tls.crt, tls.key and ca.crt files in
+// REMOTE_EXTENSIONS_TLS_DIR (default: "/etc/kuadrant/extensions/tls")
+func remoteExtensionsOptions() *extension.RemoteOptions {
+	return &extension.RemoteOptions{
+		Address: env.GetString("REMOTE_EXTENSIONS_ADDRESS", ":50051"),
+		TLSDir:  env.GetString("REMOTE_EXTENSIONS_TLS_DIR", "/etc/kuadrant/extensions/tls"),
+	}
+}
+
 func getKuadrantWatcherPredicate() ctrlruntimepredicate.TypedPredicate[*kuadrantv1beta1.Kuadrant] {
 	return ctrlruntimepredicate.TypedFuncs[*kuadrantv1beta1.Kuadrant]{
 		UpdateFunc: func(e event.TypedUpdateEvent[*kuadrantv1beta1.Kuadrant]) bool {
@@ -652,10 +698,19 @@ func (b *BootOptionsBuilder) Reconciler() controller.ReconcileFunc {
 			NewAuthorinoReconciler(b.client).Subscription().Reconcile)
 	}
 
+	if b.extensionManager != nil {
+		mainWorkflow.Tasks = append(mainWorkflow.Tasks,
+			NewRemoteExtensionsReconciler(b.extensionManager).Subscription().Reconcile)
+	}
+
+	if b.isGRPCRouteInstalled {
+		return kuadrantpolicymachinery.NewGRPCRouteTopologyBuilder(b.objectLinks...).Reconcile(mainWorkflow.Run)
+	}
+
 	return mainWorkflow.Run
 }
 
-func certManagerControllerOpts() []controller.ControllerOption {
+func (b *BootOptionsBuilder) certManagerControllerOpts() []controller.ControllerOption {
 	return []controller.ControllerOption{
 		controller.WithRunnable("certificate watcher", controller.Watch(
 			&certmanagerv1.Certificate{},
@@ -692,7 +747,7 @@ func certManagerControllerOpts() []controller.ControllerOption {
 			CertManagerIssuerKind,
 			CertManagerClusterIssuerKind,
 		),
-		controller.WithObjectLinks(
+		b.withObjectLinks(
 			LinkListenerToCertificateFunc,
 			LinkTLSPolicyToIssuerFunc,
 			LinkTLSPolicyToClusterIssuerFunc,
@@ -710,9 +765,14 @@ func initWorkflow(client *dynamic.DynamicClient) *controller.Workflow {
 }
 
 func (b *BootOptionsBuilder) finalStepsWorkflow() *controller.Workflow {
+	kuadrantStatusUpdater := NewKuadrantStatusUpdater(b.client, b.isGatewayAPIInstalled, b.isGatewayProviderInstalled(), b.isLimitadorOperatorInstalled, b.isAuthorinoOperatorInstalled)
+	if b.extensionManager != nil {
+		kuadrantStatusUpdater.WithExtensions(b.extensionManager)
+	}
+
 	workflow := &controller.Workflow{
 		Tasks: []controller.ReconcileFunc{
-			NewKuadrantStatusUpdater(b.client, b.isGatewayAPIInstalled, b.isGatewayProviderInstalled(), b.isLimitadorOperatorInstalled, b.isAuthorinoOperatorInstalled).Subscription().Reconcile,
+			kuadrantStatusUpdater.Subscription().Reconcile,
 		},
 	}
 
@@ -0,0 +1,122 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+)
+
+const (
+	tlsPolicyNameLabel           = "tls_policy_name"
+	tlsPolicyNamespaceLabel      = "tls_policy_namespace"
+	tlsCertificateNameLabel      = "certificate_name"
+	tlsCertificateNamespaceLabel = "certificate_namespace"
+)
+
+var (
+	tlsPolicyCertificateExpiryDesc = prometheus.NewDesc(
+		"kuadrant_tls_policy_certificate_expiry_days",
+		"Days until the expiry of a certificate managed by a TLS Policy",
+		[]string{tlsPolicyNameLabel, tlsPolicyNamespaceLabel, tlsCertificateNameLabel, tlsCertificateNamespaceLabel},
+		nil,
+	)
+
+	tlsPolicyCertificateRenewalDesc = prometheus.NewDesc(
+		"kuadrant_tls_policy_certificate_renewal_days",
+		"Days until the next renewal of a certificate managed by a TLS Policy",
+		[]string{tlsPolicyNameLabel, tlsPolicyNamespaceLabel, tlsCertificateNameLabel, tlsCertificateNamespaceLabel},
+		nil,
+	)
+
+	tlsPolicyCertificates = newTLSPolicyCertificateCollector(time.Now)
+)
+
+// tlsPolicyCertificateCollector exposes the time left until the expiry and renewal of the certificates listed in the
+// status of the TLS policies. The time left is computed when the metrics are collected, so that it does not go stale
+// between reconciliations.
+type tlsPolicyCertificateCollector struct {
+	sync.RWMutex
+	certificates map[types.NamespacedName][]kuadrantv1.CertificateStatus
+	now          func() time.Time
+}
+
+func newTLSPolicyCertificateCollector(now func() time.Time) *tlsPolicyCertificateCollector {
+	return &tlsPolicyCertificateCollector{
+		certificates: make(map[types.NamespacedName][]kuadrantv1.CertificateStatus),
+		now:          now,
+	}
+}
+
+// Set replaces the certificates of a TLS policy
+func (c *tlsPolicyCertificateCollector) Set(policy types.NamespacedName, certificates []kuadrantv1.CertificateStatus) {
+	c.Lock()
+	defer c.Unlock()
+	c.certificates[policy] = certificates
+}
+
+// Retain removes the certificates of all TLS policies other than the given ones
+func (c *tlsPolicyCertificateCollector) Retain(policies []types.NamespacedName) {
+	c.Lock()
+	defer c.Unlock()
+	keep := make(map[types.NamespacedName]struct{}, len(policies))
+	for _, policy := range policies {
+		keep[policy] = struct{}{}
+	}
+	for policy := range c.certificates {
+		if _, ok := keep[policy]; !ok {
+			delete(c.certificates, policy)
+		}
+	}
+}
+
+func (c *tlsPolicyCertificateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- tlsPolicyCertificateExpiryDesc
+	ch <- tlsPolicyCertificateRenewalDesc
+}
+
+func (c *tlsPolicyCertificateCollector) Collect(ch chan<- prometheus.Metric) {
+	c.RLock()
+	defer c.RUnlock()
+	now := c.now()
+	for policy, certificates := range c.certificates {
+		for _, certificate := range certificates {
+			labels := []string{policy.Name, policy.Namespace, certificate.Name, certificate.Namespace}
+			if certificate.NotAfter != nil {
+				ch <- prometheus.MustNewConstMetric(tlsPolicyCertificateExpiryDesc, prometheus.GaugeValue, daysUntil(now, certificate.NotAfter.Time), labels...)
+			}
+			if certificate.RenewalTime != nil {
+				ch <- prometheus.MustNewConstMetric(tlsPolicyCertificateRenewalDesc, prometheus.GaugeValue, daysUntil(now, certificate.RenewalTime.Time), labels...)
+			}
+		}
+	}
+}
+
+func daysUntil(now, t time.Time) float64 {
+	return t.Sub(now).Hours() / 24
+}
+
+func init() {
+	metrics.Registry.MustRegister(tlsPolicyCertificates)
+}
@@ -0,0 +1,66 @@
+//go:build unit
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+)
+
+func Test_tlsPolicyCertificateCollector(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	collector := newTLSPolicyCertificateCollector(func() time.Time { return now })
+
+	policy := types.NamespacedName{Name: "my-tls-policy", Namespace: "my-namespace"}
+	otherPolicy := types.NamespacedName{Name: "other-tls-policy", Namespace: "my-namespace"}
+
+	collector.Set(policy, []kuadrantv1.CertificateStatus{
+		{
+			Name:        "my-gateway-web",
+			Namespace:   "my-namespace",
+			NotAfter:    ptrTime(now.Add(30 * 24 * time.Hour)),
+			RenewalTime: ptrTime(now.Add(12 * time.Hour)),
+		},
+		{
+			// not issued yet
+			Name:      "my-gateway-api",
+			Namespace: "my-namespace",
+		},
+	})
+	collector.Set(otherPolicy, []kuadrantv1.CertificateStatus{
+		{
+			Name:      "other-gateway-web",
+			Namespace: "my-namespace",
+			NotAfter:  ptrTime(now.Add(-24 * time.Hour)),
+		},
+	})
+
+	expected := `
+# HELP kuadrant_tls_policy_certificate_expiry_days Days until the expiry of a certificate managed by a TLS Policy
+# TYPE kuadrant_tls_policy_certificate_expiry_days gauge
+kuadrant_tls_policy_certificate_expiry_days{certificate_name="my-gateway-web",certificate_namespace="my-namespace",tls_policy_name="my-tls-policy",tls_policy_namespace="my-namespace"} 30
+kuadrant_tls_policy_certificate_expiry_days{certificate_name="other-gateway-web",certificate_namespace="my-namespace",tls_policy_name="other-tls-policy",tls_policy_namespace="my-namespace"} -1
+# HELP kuadrant_tls_policy_certificate_renewal_days Days until the next renewal of a certificate managed by a TLS Policy
+# TYPE kuadrant_tls_policy_certificate_renewal_days gauge
+kuadrant_tls_policy_certificate_renewal_days{certificate_name="my-gateway-web",certificate_namespace="my-namespace",tls_policy_name="my-tls-policy",tls_policy_namespace="my-namespace"} 0.5
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+
+	collector.Retain([]types.NamespacedName{otherPolicy})
+	if got := testutil.CollectAndCount(collector); got != 1 {
+		t.Errorf("CollectAndCount() = %v, want 1", got)
+	}
+}
+
+func ptrTime(t time.Time) *metav1.Time {
+	return &metav1.Time{Time: t}
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -15,9 +16,11 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
@@ -52,6 +55,11 @@ func (t *TLSPolicyStatusUpdater) UpdateStatus(ctx context.Context, _ []controlle
 
 	policies := lo.Filter(topology.Policies().Items(), filterForTLSPolicies)
 
+	// Forget the certificates of policies that no longer exist
+	tlsPolicyCertificates.Retain(lo.Map(policies, func(p machinery.Policy, _ int) types.NamespacedName {
+		return types.NamespacedName{Name: p.GetName(), Namespace: p.GetNamespace()}
+	}))
+
 	for _, policy := range policies {
 		p := policy.(*kuadrantv1.TLSPolicy)
 		if p.DeletionTimestamp != nil {
@@ -76,6 +84,9 @@ func (t *TLSPolicyStatusUpdater) UpdateStatus(ctx context.Context, _ []controlle
 			meta.SetStatusCondition(&newStatus.Conditions, *enforcedCond)
 		}
 
+		newStatus.Certificates = certificatesStatus(p, topology)
+		tlsPolicyCertificates.Set(client.ObjectKeyFromObject(p), newStatus.Certificates)
+
 		// Nothing to do
 		equalStatus := equality.Semantic.DeepEqual(newStatus, p.Status)
 		if equalStatus && p.Generation == p.Status.ObservedGeneration {
@@ -171,31 +182,21 @@ func (t *TLSPolicyStatusUpdater) isCertificatesReady(p machinery.Policy, topolog
 		return errors.New("invalid policy")
 	}
 
-	// Get all listeners where the gateway or listener contains this policy
-	listeners := lo.FilterMap(topology.Targetables().Items(), func(t machinery.Targetable, _ int) (*machinery.Listener, bool) {
-		l, ok := t.(*machinery.Listener)
-		return l, ok && (lo.Contains(l.Policies(), p) || lo.Contains(l.Gateway.Policies(), p))
-	})
+	listeners := listenersForTLSPolicy(policy, topology)
 
 	if len(listeners) == 0 {
 		return errors.New("no valid gateways found")
 	}
 
 	for _, l := range listeners {
-		expectedCertificates := expectedCertificatesForListener(l, policy)
+		expectedCertificates := expectedCertificatesForListener(topology, l, policy)
 
 		for _, cert := range expectedCertificates {
-			objs := topology.Objects().Children(l)
-			obj, ok := lo.Find(objs, func(o machinery.Object) bool {
-				return o.GroupVersionKind().GroupKind() == CertManagerCertificateKind && o.GetNamespace() == cert.GetNamespace() && o.GetName() == cert.GetName()
-			})
-
+			c, ok := findCertificateOfListener(topology, l, cert)
 			if !ok {
 				return errors.New("certificate not found")
 			}
 
-			c := obj.(*controller.RuntimeObject).Object.(*certmanagerv1.Certificate)
-
 			conditions := utils.Map(c.Status.Conditions, func(c certmanagerv1.CertificateCondition) metav1.Condition {
 				return metav1.Condition{Reason: c.Reason, Status: metav1.ConditionStatus(c.Status), Type: string(c.Type), Message: c.Message}
 			})
@@ -218,7 +219,7 @@ func (t *TLSPolicyStatusUpdater) isCertificatesReady(p machinery.Policy, topolog
 	return nil
 }
 
-func expectedCertificatesForListener(l *machinery.Listener, tlsPolicy *kuadrantv1.TLSPolicy) []*certmanagerv1.Certificate {
+func expectedCertificatesForListener(topology *machinery.Topology, l *machinery.Listener, tlsPolicy *kuadrantv1.TLSPolicy) []*certmanagerv1.Certificate {
 	// Not valid - so no need to check if cert is ready since there should not be one created
 	err := validateGatewayListenerBlock(field.NewPath(""), *l.Listener, l.Gateway).ToAggregate()
 	if err != nil {
@@ -227,14 +228,86 @@ func expectedCertificatesForListener(l *machinery.Listener, tlsPolicy *kuadrantv
 
 	certs := make([]*certmanagerv1.Certificate, 0)
 
-	hostname := getListenerHostname(l)
+	// No hostnames to issue a certificate for - so no certificate is expected
+	hosts := getCertificateHostnames(topology, l, tlsPolicy)
+	if len(hosts) == 0 {
+		return certs
+	}
 
 	for _, certRef := range l.TLS.CertificateRefs {
 		secretRef := getSecretReference(certRef, l)
-		// Gateway API hostname explicitly disallows IP addresses, so this
-		// should be OK.
-		certs = append(certs, buildCertManagerCertificate(l, tlsPolicy, secretRef, []string{hostname}))
+		certs = append(certs, buildCertManagerCertificate(l, tlsPolicy, secretRef, hosts))
 	}
 
 	return certs
 }
+
+// listenersForTLSPolicy returns all listeners where the gateway or listener contains the policy
+func listenersForTLSPolicy(policy *kuadrantv1.TLSPolicy, topology *machinery.Topology) []*machinery.Listener {
+	return lo.FilterMap(topology.Targetables().Items(), func(t machinery.Targetable, _ int) (*machinery.Listener, bool) {
+		l, ok := t.(*machinery.Listener)
+		return l, ok && (lo.Contains(l.Policies(), machinery.Policy(policy)) || lo.Contains(l.Gateway.Policies(), machinery.Policy(policy)))
+	})
+}
+
+func findCertificateOfListener(topology *machinery.Topology, l *machinery.Listener, cert *certmanagerv1.Certificate) (*certmanagerv1.Certificate, bool) {
+	obj, ok := lo.Find(topology.Objects().Children(l), func(o machinery.Object) bool {
+		return o.GroupVersionKind().GroupKind() == CertManagerCertificateKind && o.GetNamespace() == cert.GetNamespace() && o.GetName() == cert.GetName()
+	})
+	if !ok {
+		return nil, false
+	}
+	return obj.(*controller.RuntimeObject).Object.(*certmanagerv1.Certificate), true
+}
+
+// certificatesStatus returns the status of the existing certificates expected for the listeners of the policy, sorted
+// by namespace and name
+func certificatesStatus(policy *kuadrantv1.TLSPolicy, topology *machinery.Topology) []kuadrantv1.CertificateStatus {
+	var statuses []kuadrantv1.CertificateStatus
+
+	for _, l := range listenersForTLSPolicy(policy, topology) {
+		for _, cert := range expectedCertificatesForListener(topology, l, policy) {
+			c, ok := findCertificateOfListener(topology, l, cert)
+			if !ok {
+				continue
+			}
+			statuses = append(statuses, kuadrantv1.CertificateStatus{
+				Name:              c.Name,
+				Namespace:         c.Namespace,
+				Gateway:           l.Gateway.Name,
+				Listener:          string(l.Name),
+				NotAfter:          c.Status.NotAfter,
+				RenewalTime:       c.Status.RenewalTime,
+				LastFailureTime:   c.Status.LastFailureTime,
+				LastFailureReason: lastFailureReason(c),
+			})
+		}
+	}
+
+	slices.SortFunc(statuses, func(a, b kuadrantv1.CertificateStatus) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return statuses
+}
+
+// lastFailureReason returns the reason of the last failed issuance of a certificate: the reason of the Issuing
+// condition if issuing failed, otherwise the reason of the Ready condition if the certificate is not ready
+func lastFailureReason(c *certmanagerv1.Certificate) string {
+	if c.Status.LastFailureTime == nil {
+		return ""
+	}
+
+	conditions := utils.Map(c.Status.Conditions, func(c certmanagerv1.CertificateCondition) metav1.Condition {
+		return metav1.Condition{Reason: c.Reason, Status: metav1.ConditionStatus(c.Status), Type: string(c.Type), Message: c.Message}
+	})
+
+	if cond := meta.FindStatusCondition(conditions, string(certmanagerv1.CertificateConditionIssuing)); cond != nil && cond.Status == metav1.ConditionFalse {
+		return cond.Reason
+	}
+	if cond := meta.FindStatusCondition(conditions, string(certmanagerv1.CertificateConditionReady)); cond != nil && cond.Status != metav1.ConditionTrue {
+		return cond.Reason
+	}
+
+	return ""
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	certmanv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	certmanmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -474,3 +475,167 @@ func TestTLSPolicyStatusTask_enforcedCondition(t *testing.T) {
 		})
 	}
 }
+
+func Test_certificatesStatus(t *testing.T) {
+	const (
+		ns     = "default"
+		gwName = "kuadrant-gateway"
+	)
+
+	notAfter := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	renewalTime := metav1.NewTime(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC))
+	lastFailureTime := metav1.NewTime(time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC))
+
+	policy := &kuadrantv1.TLSPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "TLSPolicy", APIVersion: kuadrantv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "kuadrant-tls-policy", Namespace: ns, UID: types.UID(rand.String(9))},
+		Spec: kuadrantv1.TLSPolicySpec{
+			TargetRef: gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gatewayapiv1alpha2.LocalPolicyTargetReference{
+					Name:  gwName,
+					Kind:  "Gateway",
+					Group: gatewayapiv1alpha2.GroupName,
+				},
+			},
+		},
+	}
+
+	listener := func(name string) gatewayapiv1.Listener {
+		return gatewayapiv1.Listener{
+			Name:     gatewayapiv1.SectionName(name),
+			Hostname: ptr.To(gatewayapiv1.Hostname(name + ".example.com")),
+			TLS: &gatewayapiv1.GatewayTLSConfig{
+				CertificateRefs: []gatewayapiv1.SecretObjectReference{{
+					Group: ptr.To[gatewayapiv1.Group]("core"),
+					Kind:  ptr.To[gatewayapiv1.Kind]("Secret"),
+					Name:  gatewayapiv1.ObjectName(name),
+				}},
+				Mode: ptr.To(gatewayapiv1.TLSModeTerminate),
+			},
+		}
+	}
+	gw := &gatewayapiv1.Gateway{
+		TypeMeta:   metav1.TypeMeta{Kind: "Gateway", APIVersion: gatewayapiv1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: gwName, Namespace: ns, UID: types.UID(rand.String(9))},
+		Spec: gatewayapiv1.GatewaySpec{
+			Listeners: []gatewayapiv1.Listener{listener("web"), listener("api"), listener("missing")},
+		},
+	}
+
+	certificate := func(listenerName string, status certmanv1.CertificateStatus) *certmanv1.Certificate {
+		return &certmanv1.Certificate{
+			TypeMeta:   metav1.TypeMeta{Kind: certmanv1.CertificateKind, APIVersion: certmanv1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: certName(gwName, gatewayapiv1.SectionName(listenerName)), Namespace: ns, UID: types.UID(rand.String(9))},
+			Status:     status,
+		}
+	}
+	webCert := certificate("web", certmanv1.CertificateStatus{
+		NotAfter:    &notAfter,
+		RenewalTime: &renewalTime,
+		Conditions: []certmanv1.CertificateCondition{
+			{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionTrue, Reason: "Ready"},
+		},
+	})
+	apiCert := certificate("api", certmanv1.CertificateStatus{
+		LastFailureTime: &lastFailureTime,
+		Conditions: []certmanv1.CertificateCondition{
+			{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionFalse, Reason: "DoesNotExist"},
+			{Type: certmanv1.CertificateConditionIssuing, Status: certmanmetav1.ConditionFalse, Reason: "Failed"},
+		},
+	})
+
+	store := controller.Store{string(gw.UID): gw, string(policy.UID): policy, string(webCert.UID): webCert, string(apiCert.UID): apiCert}
+	topology, err := machinery.NewGatewayAPITopology(
+		machinery.WithGateways(gw),
+		machinery.WithGatewayAPITopologyPolicies(policy),
+		machinery.ExpandGatewayListeners(),
+		machinery.WithGatewayAPITopologyObjects(
+			&controller.RuntimeObject{Object: webCert},
+			&controller.RuntimeObject{Object: apiCert},
+		),
+		machinery.WithGatewayAPITopologyLinks(LinkListenerToCertificateFunc(store)),
+	)
+	if err != nil {
+		t.Fatalf("failed to build topology: %v", err)
+	}
+
+	want := []kuadrantv1.CertificateStatus{
+		{
+			Name:              apiCert.Name,
+			Namespace:         ns,
+			Gateway:           gwName,
+			Listener:          "api",
+			LastFailureTime:   &lastFailureTime,
+			LastFailureReason: "Failed",
+		},
+		{
+			Name:        webCert.Name,
+			Namespace:   ns,
+			Gateway:     gwName,
+			Listener:    "web",
+			NotAfter:    &notAfter,
+			RenewalTime: &renewalTime,
+		},
+	}
+	if got := certificatesStatus(policy, topology); !reflect.DeepEqual(got, want) {
+		t.Errorf("certificatesStatus() = %v, want %v", got, want)
+	}
+}
+
+func Test_lastFailureReason(t *testing.T) {
+	lastFailureTime := metav1.Now()
+
+	tests := []struct {
+		name   string
+		status certmanv1.CertificateStatus
+		want   string
+	}{
+		{
+			name: "never failed",
+			status: certmanv1.CertificateStatus{
+				Conditions: []certmanv1.CertificateCondition{
+					{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionFalse, Reason: "DoesNotExist"},
+				},
+			},
+			want: "",
+		},
+		{
+			name: "issuing failed",
+			status: certmanv1.CertificateStatus{
+				LastFailureTime: &lastFailureTime,
+				Conditions: []certmanv1.CertificateCondition{
+					{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionTrue, Reason: "Ready"},
+					{Type: certmanv1.CertificateConditionIssuing, Status: certmanmetav1.ConditionFalse, Reason: "Failed"},
+				},
+			},
+			want: "Failed",
+		},
+		{
+			name: "not ready",
+			status: certmanv1.CertificateStatus{
+				LastFailureTime: &lastFailureTime,
+				Conditions: []certmanv1.CertificateCondition{
+					{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionFalse, Reason: "IncorrectIssuer"},
+				},
+			},
+			want: "IncorrectIssuer",
+		},
+		{
+			name: "recovered",
+			status: certmanv1.CertificateStatus{
+				LastFailureTime: &lastFailureTime,
+				Conditions: []certmanv1.CertificateCondition{
+					{Type: certmanv1.CertificateConditionReady, Status: certmanmetav1.ConditionTrue, Reason: "Ready"},
+				},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastFailureReason(&certmanv1.Certificate{Status: tt.status}); got != tt.want {
+				t.Errorf("lastFailureReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
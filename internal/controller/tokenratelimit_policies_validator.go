@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/kuadrant/policy-machinery/controller"
@@ -17,6 +18,7 @@ import (
 
 	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 )
 
 type TokenRateLimitPolicyValidator struct {
@@ -32,6 +34,7 @@ func (r *TokenRateLimitPolicyValidator) Subscription() controller.Subscription {
 		Events: []controller.ResourceEventMatcher{
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind, EventType: ptr.To(controller.CreateEvent)},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind, EventType: ptr.To(controller.UpdateEvent)},
 		},
@@ -64,9 +67,15 @@ func (r *TokenRateLimitPolicyValidator) Validate(ctx context.Context, _ []contro
 				res = controller.GatewaysResource.GroupResource()
 			case machinery.HTTPRouteGroupKind.Kind:
 				res = controller.HTTPRoutesResource.GroupResource()
+			case machinery.GRPCRouteGroupKind.Kind:
+				res = GRPCRoutesResource.GroupResource()
 			}
 			err = kuadrant.NewErrPolicyTargetNotFound(kuadrantv1alpha1.TokenRateLimitPolicyGroupKind.Kind, ref, apierrors.NewNotFound(res, ref.GetName()))
 		}
+		// the tokens of streamed responses can only be accounted by a wasm-shim that reads event-stream response bodies
+		if trlp, ok := policy.(*kuadrantv1alpha1.TokenRateLimitPolicy); err == nil && ok && len(streamingTokenLimits(trlp)) > 0 && !wasm.ShimFeatureSupported(WASMFilterImageURL, wasm.ShimFeatureEventStreamResponseBody) {
+			err = kuadrant.NewErrInvalid(kuadrantv1alpha1.TokenRateLimitPolicyGroupKind.Kind, fmt.Errorf("streaming usage is not supported by the wasm-shim image %s", WASMFilterImageURL))
+		}
 		return policy.GetLocator(), err
 	}))
 
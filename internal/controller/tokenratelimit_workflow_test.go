@@ -58,6 +58,7 @@ func TestWasmActionsFromTokenLimit(t *testing.T) {
 		limitIdentifier    string
 		scope              string
 		topLevelPredicates kuadrantv1.WhenPredicates
+		shimFeatures       string
 		expectedActions    []wasm.Action
 	}{
 		{
@@ -364,10 +365,211 @@ func TestWasmActionsFromTokenLimit(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "token limit with custom usage expression",
+			tokenLimit: &kuadrantv1alpha1.TokenLimit{
+				Usage: &kuadrantv1alpha1.TokenUsage{
+					Expression: `responseBodyJSON("/usage/input_tokens") + 4 * responseBodyJSON("/usage/output_tokens")`,
+				},
+			},
+			limitIdentifier: "tokenlimit.weighted__d681f6c3",
+			scope:           "my-ns/my-route",
+			expectedActions: []wasm.Action{
+				{
+					ServiceName: wasm.RateLimitCheckServiceName,
+					Scope:       "my-ns/my-route",
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.weighted__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ServiceName: wasm.RateLimitReportServiceName,
+					Scope:       "my-ns/my-route",
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.weighted__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: `responseBodyJSON("/usage/input_tokens") + 4 * responseBodyJSON("/usage/output_tokens")`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "token limit with streaming usage",
+			tokenLimit: &kuadrantv1alpha1.TokenLimit{
+				Usage: &kuadrantv1alpha1.TokenUsage{
+					Streaming: true,
+				},
+			},
+			limitIdentifier: "tokenlimit.streaming__d681f6c3",
+			scope:           "my-ns/my-route",
+			shimFeatures:    string(wasm.ShimFeatureEventStreamResponseBody),
+			expectedActions: []wasm.Action{
+				{
+					ServiceName: wasm.RateLimitCheckServiceName,
+					Scope:       "my-ns/my-route",
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.streaming__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ServiceName:        wasm.RateLimitReportServiceName,
+					Scope:              "my-ns/my-route",
+					ResponseBodyFormat: wasm.ResponseBodyFormatEventStream,
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.streaming__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: `responseBodyJSON("/usage/total_tokens")`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "token limit with streaming usage not supported by the wasm-shim",
+			tokenLimit: &kuadrantv1alpha1.TokenLimit{
+				Usage: &kuadrantv1alpha1.TokenUsage{
+					Streaming: true,
+				},
+			},
+			limitIdentifier: "tokenlimit.streaming__d681f6c3",
+			scope:           "my-ns/my-route",
+			expectedActions: []wasm.Action{
+				{
+					ServiceName: wasm.RateLimitCheckServiceName,
+					Scope:       "my-ns/my-route",
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.streaming__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ServiceName: wasm.RateLimitReportServiceName,
+					Scope:       "my-ns/my-route",
+					ConditionalData: []wasm.ConditionalData{
+						{
+							Predicates: []string{},
+							Data: []wasm.DataType{
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "tokenlimit.streaming__d681f6c3",
+											Value: "1",
+										},
+									},
+								},
+								{
+									Value: &wasm.Expression{
+										ExpressionItem: wasm.ExpressionItem{
+											Key:   "ratelimit.hits_addend",
+											Value: `responseBodyJSON("/usage/total_tokens")`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("WASM_SHIM_FEATURES", tc.shimFeatures)
 			computedActions := wasmActionsFromTokenLimit(tc.tokenLimit, tc.limitIdentifier, tc.scope, tc.topLevelPredicates)
 			if diff := cmp.Diff(tc.expectedActions, computedActions); diff != "" {
 				t.Errorf("unexpected wasm actions (-want +got):\n%s", diff)
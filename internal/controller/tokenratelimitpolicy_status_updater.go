@@ -45,6 +45,7 @@ func (r *TokenRateLimitPolicyStatusUpdater) Subscription() controller.Subscripti
 			{Kind: &machinery.GatewayClassGroupKind},
 			{Kind: &machinery.GatewayGroupKind},
 			{Kind: &machinery.HTTPRouteGroupKind},
+			{Kind: &machinery.GRPCRouteGroupKind},
 			{Kind: &kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
 			{Kind: &kuadrantv1beta1.LimitadorGroupKind},
 			{Kind: &kuadrantistio.EnvoyFilterGroupKind},
@@ -89,6 +90,9 @@ func (r *TokenRateLimitPolicyStatusUpdater) UpdateStatus(ctx context.Context, _
 		} else {
 			enforcedCond := r.enforcedCondition(policy, topology, state)
 			meta.SetStatusCondition(&newStatus.Conditions, *enforcedCond)
+			if enforcedCond.Status == metav1.ConditionTrue {
+				newStatus.StreamingLimits = streamingTokenLimits(policy)
+			}
 		}
 
 		equalStatus := equality.Semantic.DeepEqual(newStatus, policy.Status)
@@ -115,6 +119,18 @@ func (r *TokenRateLimitPolicyStatusUpdater) UpdateStatus(ctx context.Context, _
 	return nil
 }
 
+// streamingTokenLimits returns the sorted names of the limits of the policy that account tokens from streamed responses
+func streamingTokenLimits(policy *kuadrantv1alpha1.TokenRateLimitPolicy) []string {
+	names := lo.FilterMap(lo.Entries(policy.Spec.Proper().Limits), func(entry lo.Entry[string, kuadrantv1alpha1.TokenLimit], _ int) (string, bool) {
+		return entry.Key, entry.Value.IsStreaming()
+	})
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (r *TokenRateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1alpha1.TokenRateLimitPolicy, topology *machinery.Topology, state *sync.Map) *metav1.Condition {
 	kObj := GetKuadrantFromTopology(topology)
 	if kObj == nil {
@@ -158,8 +174,8 @@ func (r *TokenRateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1
 			}
 		}
 
-		gatewayClass, gateway, listener, httpRoute, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
-		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsHTTPRouteReady(httpRoute.HTTPRoute, gateway.Gateway, gatewayClass.Spec.ControllerName) {
+		gatewayClass, gateway, listener, route, _, _ := kuadrantpolicymachinery.ObjectsInRequestPath(effectivePolicy.Path)
+		if !kuadrantgatewayapi.IsListenerReady(listener.Listener, gateway.Gateway) || !kuadrantgatewayapi.IsRouteReady(route, gateway.Gateway, gatewayClass.Spec.ControllerName) {
 			continue
 		}
 		effectivePolicyRules := effectivePolicy.Spec.Rules()
@@ -222,12 +238,16 @@ func (r *TokenRateLimitPolicyStatusUpdater) enforcedCondition(policy *kuadrantv1
 	if limitadorLimitsModified, stateLimitadorLimitsModifiedPresent := state.Load(StateLimitadorLimitsModified); stateLimitadorLimitsModifiedPresent && limitadorLimitsModified.(bool) {
 		componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
 	} else {
-		limitador := GetLimitadorFromTopology(topology)
-		if limitador == nil {
-			return kuadrant.EnforcedCondition(policy, kuadrant.NewErrSystemResource("limitador"), false)
-		}
-		if !meta.IsStatusConditionTrue(limitador.Status.Conditions, limitadorv1alpha1.StatusConditionReady) {
-			componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
+		// the limits of each gateway are enforced by the Limitador of the gateway
+		for _, g := range affectedGateways {
+			limitador := GetLimitadorForGateway(topology, g.gateway)
+			if limitador == nil {
+				return kuadrant.EnforcedCondition(policy, kuadrant.NewErrSystemResource("limitador"), false)
+			}
+			if !meta.IsStatusConditionTrue(limitador.Status.Conditions, limitadorv1alpha1.StatusConditionReady) {
+				componentsToSync = append(componentsToSync, kuadrantv1beta1.LimitadorGroupKind.Kind)
+				break
+			}
 		}
 	}
 
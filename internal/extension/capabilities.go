@@ -0,0 +1,111 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
+	kuadrant "github.com/kuadrant/kuadrant-operator/pkg/cel/ext"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+// supportedAPIVersions are the versions of the extension API kuadrant-operator serves
+var supportedAPIVersions = []string{extpb.APIVersion}
+
+// supportedFeatures returns the features of kuadrant-operator the extensions can require.
+// The response domain is only supported when the wasm-shim evaluates the response data of its config.
+func supportedFeatures() []string {
+	features := []string{
+		extpb.FeatureResolve,
+		extpb.FeatureSubscribe,
+		extpb.FeatureRegisterMutator,
+		extpb.FeatureClearPolicy,
+		extpb.FeatureReportStatus,
+		extpb.FeatureDomainAuth,
+		extpb.FeatureDomainRequest,
+	}
+	if wasm.ShimFeatureSupported(wasm.ShimImageURL, wasm.ShimFeatureResponseData) {
+		features = append(features, extpb.FeatureDomainResponse)
+	}
+	return features
+}
+
+// Capabilities are the requirements an extension negotiated with kuadrant-operator
+type Capabilities struct {
+	// APIVersion is the version of the API the extension is built against
+	APIVersion string
+	// Incompatibilities are the requirements of the extension kuadrant-operator does not support
+	Incompatibilities []string
+}
+
+// negotiateCapabilities returns the requirements of an extension kuadrant-operator does not support
+func negotiateCapabilities(request *extpb.GetCapabilitiesRequest) []string {
+	var incompatibilities []string
+	if !slices.Contains(supportedAPIVersions, request.GetApiVersion()) {
+		incompatibilities = append(incompatibilities, fmt.Sprintf("api version %q is not supported, supported versions: %s", request.GetApiVersion(), strings.Join(supportedAPIVersions, ", ")))
+	}
+	if request.GetCelVersion() > kuadrant.LatestVersion {
+		incompatibilities = append(incompatibilities, fmt.Sprintf("cel library version %d is not supported, latest version: %d", request.GetCelVersion(), kuadrant.LatestVersion))
+	}
+	features := supportedFeatures()
+	for _, feature := range request.GetFeatures() {
+		if !slices.Contains(features, feature) {
+			incompatibilities = append(incompatibilities, fmt.Sprintf("feature %q is not supported", feature))
+		}
+	}
+	return incompatibilities
+}
+
+func (s *extensionService) GetCapabilities(ctx context.Context, request *extpb.GetCapabilitiesRequest) (*extpb.GetCapabilitiesResponse, error) {
+	if request == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+
+	incompatibilities := negotiateCapabilities(request)
+	name, ok := extensionNameFromContext(ctx)
+	if ok {
+		s.capabilitiesMu.Lock()
+		if s.capabilities == nil {
+			s.capabilities = make(map[string]Capabilities)
+		}
+		s.capabilities[name] = Capabilities{APIVersion: request.GetApiVersion(), Incompatibilities: incompatibilities}
+		s.capabilitiesMu.Unlock()
+	}
+	if len(incompatibilities) > 0 {
+		s.logger.Error(errors.New(strings.Join(incompatibilities, "; ")), "extension requires capabilities kuadrant-operator does not support", "extension", name)
+	}
+
+	return &extpb.GetCapabilitiesResponse{
+		ApiVersions:       supportedAPIVersions,
+		CelVersion:        kuadrant.LatestVersion,
+		Features:          supportedFeatures(),
+		Incompatibilities: incompatibilities,
+	}, nil
+}
+
+// extensionCapabilities returns the capabilities negotiated by an extension, if it did
+func (s *extensionService) extensionCapabilities(name string) (Capabilities, bool) {
+	s.capabilitiesMu.Lock()
+	defer s.capabilitiesMu.Unlock()
+	capabilities, ok := s.capabilities[name]
+	return capabilities, ok
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/assert"
+
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
+	kuadrant "github.com/kuadrant/kuadrant-operator/pkg/cel/ext"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	ctx := contextWithExtensionName(context.Background(), "plan-policy")
+
+	response, err := service.GetCapabilities(ctx, &extpb.GetCapabilitiesRequest{
+		ApiVersion: extpb.APIVersion,
+		CelVersion: kuadrant.LatestVersion,
+		Features:   []string{extpb.FeatureRegisterMutator, extpb.FeatureDomainAuth},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, response.GetApiVersions(), []string{extpb.APIVersion})
+	assert.Equal(t, response.GetCelVersion(), kuadrant.LatestVersion)
+	assert.Equal(t, len(response.GetIncompatibilities()), 0)
+	capabilities, ok := service.extensionCapabilities("plan-policy")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, capabilities, Capabilities{APIVersion: extpb.APIVersion})
+
+	response, err = service.GetCapabilities(ctx, &extpb.GetCapabilitiesRequest{
+		ApiVersion: "v2",
+		CelVersion: kuadrant.LatestVersion + 1,
+		Features:   []string{extpb.FeatureReportStatus, "Teleport"},
+	})
+	assert.NilError(t, err, "incompatible extensions are not rejected")
+	assert.DeepEqual(t, response.GetIncompatibilities(), []string{
+		`api version "v2" is not supported, supported versions: v1`,
+		"cel library version 2 is not supported, latest version: 1",
+		`feature "Teleport" is not supported`,
+	})
+	capabilities, _ = service.extensionCapabilities("plan-policy")
+	assert.Equal(t, capabilities.APIVersion, "v2")
+	assert.DeepEqual(t, capabilities.Incompatibilities, response.GetIncompatibilities())
+
+	_, ok = service.extensionCapabilities("telemetry-policy")
+	assert.Assert(t, !ok, "extensions that did not negotiate have no capabilities")
+}
+
+func TestGetCapabilitiesDomainResponse(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	request := &extpb.GetCapabilitiesRequest{
+		ApiVersion: extpb.APIVersion,
+		CelVersion: kuadrant.LatestVersion,
+		Features:   []string{extpb.FeatureDomainResponse},
+	}
+
+	t.Setenv("WASM_SHIM_FEATURES", "")
+	response, err := service.GetCapabilities(context.Background(), request)
+	assert.NilError(t, err)
+	assert.Assert(t, !slices.Contains(response.GetFeatures(), extpb.FeatureDomainResponse))
+	assert.DeepEqual(t, response.GetIncompatibilities(), []string{`feature "DomainResponse" is not supported`})
+
+	t.Setenv("WASM_SHIM_FEATURES", string(wasm.ShimFeatureResponseData))
+	response, err = service.GetCapabilities(context.Background(), request)
+	assert.NilError(t, err)
+	assert.Assert(t, slices.Contains(response.GetFeatures(), extpb.FeatureDomainResponse))
+	assert.Equal(t, len(response.GetIncompatibilities()), 0)
+}
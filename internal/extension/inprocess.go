@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/kuadrant/policy-machinery/machinery"
+)
+
+// InProcessService is the extension service of kuadrant-operator backed by a topology it is given, instead of the one
+// of the cluster, and by data stores of its own. It serves extensions tested in-process.
+type InProcessService struct {
+	*extensionService
+}
+
+func NewInProcessService(logger logr.Logger) *InProcessService {
+	return &InProcessService{
+		extensionService: &extensionService{
+			dag:            newNilGuardedPointer[StateAwareDAG](),
+			registeredData: NewRegisteredDataStore(),
+			reportedStatus: NewReportedStatusStore(),
+			logger:         logger.WithName("extensionService"),
+		},
+	}
+}
+
+// SetTopology replaces the topology the expressions are evaluated against, which notifies the subscriptions whose value
+// changed
+func (s *InProcessService) SetTopology(topology *machinery.Topology) {
+	s.dag.set(StateAwareDAG{topology: topology, state: &sync.Map{}})
+}
+
+// SetChangeNotifier sets the function called every time the extensions change the data they registered
+func (s *InProcessService) SetChangeNotifier(notifier ChangeNotifier) {
+	s.changeNotifier = notifier
+}
+
+// PolicyData returns the mutators and subscriptions registered for the policy
+func (s *InProcessService) PolicyData(policy ResourceID) (PersistedPolicyData, error) {
+	return s.registeredData.PolicyData(policy)
+}
+
+// ReportedStatus returns the status reported for the policy, if any
+func (s *InProcessService) ReportedStatus(policy ResourceID) (ReportedStatus, bool) {
+	return s.reportedStatus.Get(policy)
+}
+
+// Subscribers returns the number of subscriptions streams notified of the topology changes
+func (s *InProcessService) Subscribers() int {
+	s.dag.mu.Lock()
+	defer s.dag.mu.Unlock()
+	return len(s.dag.updates)
+}
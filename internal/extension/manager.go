@@ -24,13 +24,18 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/cel-go/cel"
 	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
 	authorinov1beta3 "github.com/kuadrant/authorino/api/v1beta3"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +49,8 @@ import (
 
 var ErrNoExtensionsFound = errors.New("no extensions found")
 
+const rehydrateTimeout = 30 * time.Second
+
 type ChangeNotifier func(reason string) error
 
 type Manager struct {
@@ -53,17 +60,25 @@ type Manager struct {
 	logger     logr.Logger
 	sync       io.Writer
 	client     dynamic.Interface
+	supervisor *supervisor
+	remote     *RemoteServer
 }
 
 type Extension interface {
 	Start() error
 	Stop() error
 	Name() string
+	Executable() string
+	IsAlive() bool
+	ExitError() error
 }
 
-func NewManager(location string, logger logr.Logger, sync io.Writer, client dynamic.Interface) (Manager, error) {
+// NewManager discovers the extensions in the location and restores the data they registered before the operator
+// restarted, persisted in configmaps of the given namespace. With remote options, remote extensions can be registered
+// later on, see SetRemoteExtensions.
+func NewManager(location, namespace string, logger logr.Logger, sync io.Writer, client dynamic.Interface, remote *RemoteOptions) (Manager, error) {
 	names := discoverExtensions(logger, location)
-	if len(names) == 0 {
+	if len(names) == 0 && remote == nil {
 		return Manager{}, ErrNoExtensionsFound
 	}
 
@@ -73,6 +88,17 @@ func NewManager(location string, logger logr.Logger, sync io.Writer, client dyna
 	service := newExtensionService(BlockingDAG, logger)
 	logger = logger.WithName("extension")
 
+	if client != nil {
+		extService := service.(*extensionService)
+		extService.setPersister(NewConfigMapPersister(client, namespace))
+		ctx, cancel := context.WithTimeout(context.Background(), rehydrateTimeout)
+		if e := extService.Rehydrate(ctx); e != nil {
+			// extensions register their data again as they reconcile their policies
+			logger.Error(e, "failed to restore the data registered by extensions")
+		}
+		cancel()
+	}
+
 	for _, name := range names {
 		if oopExtension, e := NewOOPExtension(name, location, service, logger, sync); e == nil {
 			extensions = append(extensions, &oopExtension)
@@ -85,6 +111,11 @@ func NewManager(location string, logger logr.Logger, sync io.Writer, client dyna
 		}
 	}
 
+	var remoteServer *RemoteServer
+	if remote != nil {
+		remoteServer = NewRemoteServer(*remote, service, logger)
+	}
+
 	return Manager{
 		extensions,
 		service,
@@ -92,14 +123,47 @@ func NewManager(location string, logger logr.Logger, sync io.Writer, client dyna
 		logger,
 		sync,
 		client,
+		newManagerSupervisor(extensions, service, logger),
+		remoteServer,
 	}, err
 }
 
+// newManagerSupervisor returns a supervisor that clears the data registered by the extensions when they die
+// and triggers a reconciliation when their state changes
+func newManagerSupervisor(extensions []Extension, service extpb.ExtensionServiceServer, logger logr.Logger) *supervisor {
+	s := newSupervisor(extensions, DefaultBackoffPolicy, logger)
+	extService, ok := service.(*extensionService)
+	if !ok {
+		return s
+	}
+	s.onDeath = func(name string) {
+		clearedMutators, clearedSubscriptions := extService.ClearExtensionData(name)
+		logger.V(1).Info("cleared data of dead extension", "extension", name, "mutators", clearedMutators, "subscriptions", clearedSubscriptions)
+	}
+	s.onChange = func(reason string) {
+		if extService.changeNotifier == nil {
+			return
+		}
+		if err := extService.changeNotifier(reason); err != nil {
+			logger.Error(err, "failed to trigger change notification", "reason", reason)
+		}
+	}
+	return s
+}
+
 func (m *Manager) Start() error {
 	var err error
 
+	if service, ok := m.service.(*extensionService); ok && service.writer != nil {
+		service.writer.Start()
+	}
+
 	for _, extension := range m.extensions {
-		if e := extension.Start(); e != nil {
+		e := extension.Start()
+		if m.supervisor != nil {
+			m.supervisor.started(extension.Name(), e)
+		}
+		if e != nil {
 			if err == nil {
 				err = fmt.Errorf("%s: %w", extension.Name(), e)
 			} else {
@@ -108,12 +172,58 @@ func (m *Manager) Start() error {
 		}
 	}
 
+	if m.supervisor != nil {
+		m.supervisor.run()
+	}
+
+	// listens once the supervisor runs, so that remote extensions registered meanwhile start it themselves
+	if m.remote != nil && len(m.remote.Extensions()) > 0 {
+		if e := m.remote.Start(); e != nil {
+			if err == nil {
+				err = fmt.Errorf("remote extensions: %w", e)
+			} else {
+				err = fmt.Errorf("%w; remote extensions: %w", err, e)
+			}
+		}
+	}
+
 	return err
 }
 
+// Health returns the health of the extensions, sorted by name
+func (m *Manager) Health() []ExtensionHealth {
+	if m.supervisor == nil {
+		return nil
+	}
+	health := m.supervisor.Health()
+	if service, ok := m.service.(*extensionService); ok {
+		for i := range health {
+			health[i].LastPingLatency = service.lastPingLatency(health[i].Name)
+			health[i].Mutators, health[i].Subscriptions = service.countExtensionData(health[i].Name)
+			if capabilities, ok := service.extensionCapabilities(health[i].Name); ok {
+				health[i].APIVersion = capabilities.APIVersion
+				health[i].Incompatibilities = capabilities.Incompatibilities
+			}
+		}
+	}
+	if m.remote != nil {
+		for i := range health {
+			if remote, ok := m.remote.extension(health[i].Name); ok {
+				health[i].Address = remote.Address()
+			}
+		}
+	}
+	return health
+}
+
 func (m *Manager) Stop() error {
 	var err error
 
+	if m.supervisor != nil {
+		m.supervisor.stop()
+		defer m.supervisor.stopped()
+	}
+
 	for _, extension := range m.extensions {
 		if e := extension.Stop(); e != nil {
 			if err == nil {
@@ -124,6 +234,70 @@ func (m *Manager) Stop() error {
 		}
 	}
 
+	if m.remote != nil {
+		for _, extension := range m.remote.Extensions() {
+			_ = extension.Stop()
+		}
+		m.remote.Stop()
+	}
+
+	if service, ok := m.service.(*extensionService); ok && service.writer != nil {
+		service.writer.Stop()
+	}
+
+	return err
+}
+
+// SetRemoteExtensions registers the remote extensions of the given names, and unregisters the others, whose data is
+// cleared. The operator listens for remote extensions as long as any is registered.
+func (m *Manager) SetRemoteExtensions(names []string) error {
+	var err error
+	names = lo.Uniq(names)
+	if conflicts := lo.Filter(names, func(name string, _ int) bool {
+		return lo.ContainsBy(m.extensions, func(extension Extension) bool { return extension.Name() == name })
+	}); len(conflicts) > 0 {
+		err = fmt.Errorf("%s: an extension with the same name was discovered locally", strings.Join(conflicts, ", "))
+		names, _ = lo.Difference(names, conflicts)
+	}
+
+	if m.remote == nil {
+		if len(names) > 0 {
+			return errors.New("remote extensions are not enabled")
+		}
+		return err
+	}
+
+	added, removed := m.remote.SetExtensions(names)
+	for _, extension := range removed {
+		m.logger.Info("unregistering remote extension", "extension", extension.Name())
+		if m.supervisor != nil {
+			m.supervisor.remove(extension.Name())
+		}
+		_ = extension.Stop()
+		if service, ok := m.service.(*extensionService); ok {
+			service.ClearExtensionData(extension.Name())
+		}
+	}
+	for _, extension := range added {
+		m.logger.Info("registering remote extension", "extension", extension.Name())
+		if m.supervisor != nil {
+			m.supervisor.add(extension)
+		}
+	}
+
+	if len(names) == 0 {
+		m.remote.Stop()
+		return err
+	}
+	if m.supervisor != nil && m.supervisor.running() {
+		if e := m.remote.Start(); e != nil {
+			if err == nil {
+				err = fmt.Errorf("remote extensions: %w", e)
+			} else {
+				err = fmt.Errorf("%w; remote extensions: %w", err, e)
+			}
+		}
+	}
 	return err
 }
 
@@ -239,12 +413,162 @@ type extensionService struct {
 	registeredData *RegisteredDataStore
 	changeNotifier ChangeNotifier
 	logger         logr.Logger
+	// policies registered by each extension, so their data can be cleared when the extension dies
+	extensionPolicies   map[string]map[ResourceID]struct{}
+	extensionPoliciesMu sync.Mutex
+	// latency of the last ping of each extension
+	pingLatencies   map[string]time.Duration
+	pingLatenciesMu sync.RWMutex
+	// capabilities negotiated by each extension
+	capabilities   map[string]Capabilities
+	capabilitiesMu sync.Mutex
+	// persister durably stores the registered data, if set, through the writer
+	persister RegisteredDataPersister
+	writer    *registeredDataWriter
+	// reportedStatus holds the statuses reported for the policies
+	reportedStatus *ReportedStatusStore
 	extpb.UnimplementedExtensionServiceServer
 }
 
-func (s *extensionService) Ping(_ context.Context, _ *extpb.PingRequest) (*extpb.PongResponse, error) {
+type extensionNameContextKey struct{}
+
+func contextWithExtensionName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, extensionNameContextKey{}, name)
+}
+
+func extensionNameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(extensionNameContextKey{}).(string)
+	return name, ok
+}
+
+// trackPolicy records the policy as registered by the extension the call comes from, if known
+func (s *extensionService) trackPolicy(ctx context.Context, policy ResourceID) {
+	name, ok := extensionNameFromContext(ctx)
+	if !ok {
+		return
+	}
+	s.extensionPoliciesMu.Lock()
+	defer s.extensionPoliciesMu.Unlock()
+	if s.extensionPolicies == nil {
+		s.extensionPolicies = make(map[string]map[ResourceID]struct{})
+	}
+	if s.extensionPolicies[name] == nil {
+		s.extensionPolicies[name] = make(map[ResourceID]struct{})
+	}
+	s.extensionPolicies[name][policy] = struct{}{}
+}
+
+// countExtensionData returns the number of mutators and subscriptions of all the policies registered by an extension
+func (s *extensionService) countExtensionData(name string) (mutators int, subscriptions int) {
+	s.extensionPoliciesMu.Lock()
+	defer s.extensionPoliciesMu.Unlock()
+
+	for policy := range s.extensionPolicies[name] {
+		m, subs := s.registeredData.CountPolicyData(policy)
+		mutators += m
+		subscriptions += subs
+	}
+	return mutators, subscriptions
+}
+
+func (s *extensionService) lastPingLatency(name string) *time.Duration {
+	s.pingLatenciesMu.RLock()
+	defer s.pingLatenciesMu.RUnlock()
+	if latency, ok := s.pingLatencies[name]; ok {
+		return &latency
+	}
+	return nil
+}
+
+// policyExtension returns the name of the extension that registered a policy, if known
+func (s *extensionService) policyExtension(policy ResourceID) string {
+	s.extensionPoliciesMu.Lock()
+	defer s.extensionPoliciesMu.Unlock()
+	for name, policies := range s.extensionPolicies {
+		if _, ok := policies[policy]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// setPersister sets the persister that durably stores the registered data
+func (s *extensionService) setPersister(persister RegisteredDataPersister) {
+	s.persister = persister
+	s.writer = newRegisteredDataWriter(persister, s.policyData, s.logger)
+}
+
+// policyData returns the data registered for a policy, as it is persisted
+func (s *extensionService) policyData(policy ResourceID) (PersistedPolicyData, error) {
+	data, err := s.registeredData.PolicyData(policy)
+	if err != nil {
+		return data, err
+	}
+	data.Extension = s.policyExtension(policy)
+	return data, nil
+}
+
+// persistPolicy schedules the data registered for a policy to be durably stored, as it is in the store by then
+func (s *extensionService) persistPolicy(policy ResourceID) {
+	if s.writer == nil {
+		return
+	}
+	s.writer.Mark(policy)
+}
+
+// Rehydrate restores the persisted data registered by the extensions into the store
+func (s *extensionService) Rehydrate(ctx context.Context) error {
+	if s.persister == nil {
+		return nil
+	}
+	persisted, err := s.persister.LoadAll(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, data := range persisted {
+		if err := s.registeredData.Restore(data); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s/%s: %w", data.Policy.Kind, data.Policy.Namespace, data.Policy.Name, err))
+			continue
+		}
+		if data.Extension != "" {
+			s.trackPolicy(contextWithExtensionName(ctx, data.Extension), data.Policy)
+		}
+		s.writer.Restored(data.Policy)
+	}
+	s.logger.Info("restored data registered by extensions", "policies", len(persisted)-len(errs))
+	return errors.Join(errs...)
+}
+
+// ClearExtensionData clears the mutators, subscriptions and reported statuses of all the policies registered by an extension
+func (s *extensionService) ClearExtensionData(name string) (clearedMutators int, clearedSubscriptions int) {
+	s.extensionPoliciesMu.Lock()
+	policies := s.extensionPolicies[name]
+	delete(s.extensionPolicies, name)
+	s.extensionPoliciesMu.Unlock()
+
+	for policy := range policies {
+		s.reportedStatus.Delete(policy)
+		mutators, subscriptions := s.registeredData.ClearPolicyData(policy)
+		clearedMutators += mutators
+		clearedSubscriptions += subscriptions
+		s.persistPolicy(policy)
+	}
+	return clearedMutators, clearedSubscriptions
+}
+
+func (s *extensionService) Ping(ctx context.Context, request *extpb.PingRequest) (*extpb.PongResponse, error) {
+	in := time.Now()
+	if name, ok := extensionNameFromContext(ctx); ok && request.GetOut() != nil {
+		s.pingLatenciesMu.Lock()
+		if s.pingLatencies == nil {
+			s.pingLatencies = make(map[string]time.Duration)
+		}
+		s.pingLatencies[name] = in.Sub(request.GetOut().AsTime())
+		s.pingLatenciesMu.Unlock()
+	}
 	return &extpb.PongResponse{
-		In: timestamppb.New(time.Now()),
+		In: timestamppb.New(in),
 	}, nil
 }
 
@@ -252,6 +576,7 @@ func newExtensionService(dag *nilGuardedPointer[StateAwareDAG], logger logr.Logg
 	service := &extensionService{
 		dag:            dag,
 		registeredData: NewRegisteredDataStore(),
+		reportedStatus: GlobalReportedStatusStore,
 		logger:         logger.WithName("extensionService"),
 	}
 
@@ -269,9 +594,15 @@ func (s *extensionService) Subscribe(request *extpb.SubscribeRequest, stream grp
 		return fmt.Errorf("policy_kind is required for subscription")
 	}
 
-	channel := BlockingDAG.newUpdateChannel()
+	channel := s.dag.newUpdateChannel()
+	defer s.dag.removeUpdateChannel(channel)
 	for {
-		dag := <-channel
+		var dag StateAwareDAG
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case dag = <-channel:
+		}
 		opts := []cel.EnvOption{
 			kuadrant.CelExt(&dag),
 		}
@@ -281,7 +612,8 @@ func (s *extensionService) Subscribe(request *extpb.SubscribeRequest, stream grp
 			for key, sub := range subscriptions {
 				if prg, err := env.Program(sub.CAst); err == nil {
 					if newVal, _, err := prg.Eval(sub.Input); err == nil {
-						if equalResult := celtypes.Equal(newVal, sub.Val); !celtypes.IsBool(equalResult) || equalResult != celtypes.True {
+						// the value of a subscription restored from persisted data is unknown until evaluated
+						if sub.Val == nil || !isTrue(celtypes.Equal(newVal, sub.Val)) {
 							s.registeredData.UpdateSubscriptionValue(key.Policy, key.Expression, newVal)
 							if err := stream.Send(&extpb.SubscribeResponse{Event: &extpb.Event{
 								Metadata: sub.Input["self"].(*extpb.Policy).Metadata,
@@ -296,7 +628,7 @@ func (s *extensionService) Subscribe(request *extpb.SubscribeRequest, stream grp
 	}
 }
 
-func (s *extensionService) Resolve(_ context.Context, request *extpb.ResolveRequest) (*extpb.ResolveResponse, error) {
+func (s *extensionService) Resolve(ctx context.Context, request *extpb.ResolveRequest) (*extpb.ResolveResponse, error) {
 	dag, success := s.dag.getWaitWithTimeout(1 * time.Minute)
 	if !success {
 		return nil, fmt.Errorf("unable to get to a dag in time")
@@ -334,12 +666,14 @@ func (s *extensionService) Resolve(_ context.Context, request *extpb.ResolveRequ
 			Namespace: request.Policy.Metadata.Namespace,
 			Name:      request.Policy.Metadata.Name,
 		}
+		s.trackPolicy(ctx, policyID)
 		s.registeredData.SetSubscription(policyID, request.Expression, Subscription{
 			CAst:       cAst,
 			Input:      input,
 			Val:        val,
 			PolicyKind: request.Policy.Metadata.Kind,
 		})
+		s.persistPolicy(policyID)
 	}
 
 	if err != nil {
@@ -355,8 +689,7 @@ func (s *extensionService) Resolve(_ context.Context, request *extpb.ResolveRequ
 	}, nil
 }
 
-func (s *extensionService) RegisterMutator(_ context.Context, request *extpb.RegisterMutatorRequest) (*emptypb.Empty, error) {
-	// we should probably parse / check the cel expression here
+func (s *extensionService) RegisterMutator(ctx context.Context, request *extpb.RegisterMutatorRequest) (*emptypb.Empty, error) {
 	if request == nil {
 		return nil, errors.New("request cannot be nil")
 	}
@@ -375,6 +708,14 @@ func (s *extensionService) RegisterMutator(_ context.Context, request *extpb.Reg
 	if len(request.Policy.TargetRefs) == 0 {
 		return nil, errors.New("policy must have target references")
 	}
+	if request.Domain == extpb.Domain_DOMAIN_RESPONSE && !wasm.ShimFeatureSupported(wasm.ShimImageURL, wasm.ShimFeatureResponseData) {
+		return nil, status.Errorf(codes.FailedPrecondition, "domain %s is not supported by the wasm-shim image %s", request.Domain, wasm.ShimImageURL)
+	}
+
+	cAst, err := checkMutatorExpression(request.Domain, request.Expression)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid expression for binding %q in domain %s: %v", request.Binding, request.Domain, err)
+	}
 
 	policyID := ResourceID{
 		Kind:      request.Policy.Metadata.Kind,
@@ -386,13 +727,15 @@ func (s *extensionService) RegisterMutator(_ context.Context, request *extpb.Reg
 		Policy:     policyID,
 		Binding:    request.Binding,
 		Expression: request.Expression,
-		CAst:       nil, //todo
+		CAst:       cAst,
 	}
 
+	s.trackPolicy(ctx, policyID)
 	for _, pbTargetRef := range request.Policy.TargetRefs {
 		targetRefLocator := createLocatorFromProtobuf(pbTargetRef)
 		s.registeredData.Set(policyID, targetRefLocator, request.Domain, request.Binding, entry)
 	}
+	s.persistPolicy(policyID)
 
 	// Trigger notifier when mutators are registered
 	if s.changeNotifier != nil {
@@ -406,7 +749,7 @@ func (s *extensionService) RegisterMutator(_ context.Context, request *extpb.Reg
 	return &emptypb.Empty{}, nil
 }
 
-func (s *extensionService) ClearPolicy(_ context.Context, request *extpb.ClearPolicyRequest) (*extpb.ClearPolicyResponse, error) {
+func (s *extensionService) ClearPolicy(ctx context.Context, request *extpb.ClearPolicyRequest) (*extpb.ClearPolicyResponse, error) {
 	if request == nil {
 		return nil, errors.New("request cannot be nil")
 	}
@@ -427,9 +770,11 @@ func (s *extensionService) ClearPolicy(_ context.Context, request *extpb.ClearPo
 	}
 
 	clearedMutators, clearedSubscriptions := s.registeredData.ClearPolicyData(policyID)
+	s.persistPolicy(policyID)
+	clearedStatus := s.reportedStatus.Delete(policyID)
 
-	// Trigger notifier when mutators are cleared
-	if clearedMutators > 0 && s.changeNotifier != nil {
+	// Trigger notifier when mutators or the reported status are cleared
+	if (clearedMutators > 0 || clearedStatus) && s.changeNotifier != nil {
 		reason := fmt.Sprintf("mutators cleared for policy %s/%s", request.Policy.Metadata.Namespace, request.Policy.Metadata.Name)
 		if err := s.changeNotifier(reason); err != nil {
 			s.logger.Error(err, "failed to trigger change notification", "reason", reason)
@@ -442,6 +787,57 @@ func (s *extensionService) ClearPolicy(_ context.Context, request *extpb.ClearPo
 	}, nil
 }
 
+func (s *extensionService) ReportStatus(ctx context.Context, request *extpb.ReportStatusRequest) (*extpb.ReportStatusResponse, error) {
+	if request == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+	if request.Policy == nil {
+		return nil, errors.New("policy cannot be nil")
+	}
+	if request.Policy.Metadata == nil {
+		return nil, errors.New("policy metadata cannot be nil")
+	}
+	if request.Policy.Metadata.Kind == "" || request.Policy.Metadata.Namespace == "" || request.Policy.Metadata.Name == "" {
+		return nil, errors.New("policy kind, namespace, and name must be specified")
+	}
+
+	policyID := ResourceID{
+		Kind:      request.Policy.Metadata.Kind,
+		Namespace: request.Policy.Metadata.Namespace,
+		Name:      request.Policy.Metadata.Name,
+	}
+	conditions := extpb.ToConditions(request.Conditions)
+
+	s.trackPolicy(ctx, policyID)
+	changed := s.reportedStatus.Set(ReportedStatus{
+		Policy:            policyID,
+		TargetRefLocators: lo.Map(request.Policy.TargetRefs, func(t *extpb.TargetRef, _ int) string { return createLocatorFromProtobuf(t) }),
+		Conditions:        conditions,
+	})
+
+	// Trigger notifier so the status is surfaced on the targeted objects
+	if changed && s.changeNotifier != nil {
+		reason := fmt.Sprintf("status reported for policy %s/%s", request.Policy.Metadata.Namespace, request.Policy.Metadata.Name)
+		if err := s.changeNotifier(reason); err != nil {
+			s.logger.Error(err, "failed to trigger change notification", "reason", reason)
+		}
+	}
+
+	// the conditions of the kuadrant policies owned by the policy are added once the topology is known
+	if s.dag != nil {
+		if dag := s.dag.get(); dag != nil && dag.topology != nil {
+			conditions = ownedPolicyConditions(conditions, policyID, dag.topology)
+		}
+	}
+	return &extpb.ReportStatusResponse{
+		Conditions: extpb.ConvertConditions(conditions),
+	}, nil
+}
+
+func isTrue(val ref.Val) bool {
+	return celtypes.IsBool(val) && val == celtypes.True
+}
+
 // Creates a locator matching the definition in policy-machinery
 func createLocatorFromProtobuf(pbTargetRef *extpb.TargetRef) string {
 	groupKind := pbTargetRef.Kind
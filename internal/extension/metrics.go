@@ -0,0 +1,63 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	extensionNameLabel  = "extension"
+	extensionStateLabel = "state"
+)
+
+var (
+	extensionState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kuadrant_extension_state",
+			Help: "Extension state",
+		},
+		[]string{extensionNameLabel, extensionStateLabel})
+
+	extensionRestarts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kuadrant_extension_restarts_total",
+			Help: "Number of times an extension was restarted after exiting",
+		},
+		[]string{extensionNameLabel})
+)
+
+func setExtensionStateMetric(name string, state ExtensionState) {
+	for _, s := range []ExtensionState{ExtensionStateRunning, ExtensionStateRestarting, ExtensionStateFailed, ExtensionStateStopped, ExtensionStateDisconnected} {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		extensionState.WithLabelValues(name, string(s)).Set(value)
+	}
+}
+
+// deleteExtensionMetrics deletes the metrics of an extension that is no longer registered
+func deleteExtensionMetrics(name string) {
+	extensionState.DeletePartialMatch(map[string]string{extensionNameLabel: name})
+	extensionRestarts.DeleteLabelValues(name)
+}
+
+func init() {
+	metrics.Registry.MustRegister(extensionState, extensionRestarts)
+}
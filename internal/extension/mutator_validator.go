@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+
+	celvalidator "github.com/kuadrant/kuadrant-operator/internal/cel"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+// mutatorValidators holds the validators of the expressions of the mutators, one per domain.
+// The expressions of every domain are evaluated after auth, so they can all refer to the `auth` binding.
+var mutatorValidators = sync.OnceValues(func() (map[extpb.Domain]*celvalidator.Validator, error) {
+	// expressions of the auth domain are evaluated by Authorino
+	authBuilder := celvalidator.NewValidatorBuilder()
+	authBuilder.AddBinding("request", cel.AnyType)
+	authBuilder.AddBinding("source", cel.AnyType)
+	authBuilder.AddBinding("destination", cel.AnyType)
+	authBuilder.AddBinding("metadata", cel.AnyType)
+	authBuilder.PushPolicyBinding(celvalidator.AuthPolicyKind, celvalidator.AuthPolicyName, cel.AnyType)
+	authValidator, err := authBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// expressions of the request domain are evaluated by the wasm shim
+	requestBuilder := celvalidator.NewRootValidatorBuilder()
+	requestBuilder.PushPolicyBinding(celvalidator.AuthPolicyKind, celvalidator.AuthPolicyName, cel.AnyType)
+	requestValidator, err := requestBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// expressions of the response domain are evaluated by the wasm shim, once the upstream has responded
+	responseBuilder := celvalidator.NewRootValidatorBuilder()
+	responseBuilder.AddBinding("response", cel.AnyType)
+	responseBuilder.PushPolicyBinding(celvalidator.AuthPolicyKind, celvalidator.AuthPolicyName, cel.AnyType)
+	responseValidator, err := responseBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[extpb.Domain]*celvalidator.Validator{
+		extpb.Domain_DOMAIN_AUTH:     authValidator,
+		extpb.Domain_DOMAIN_REQUEST:  requestValidator,
+		extpb.Domain_DOMAIN_RESPONSE: responseValidator,
+	}, nil
+})
+
+// checkMutatorExpression parses and type-checks the expression of a mutator against the environment of its domain
+func checkMutatorExpression(domain extpb.Domain, expression string) (*cel.Ast, error) {
+	validators, err := mutatorValidators()
+	if err != nil {
+		return nil, err
+	}
+	validator, ok := validators[domain]
+	if !ok {
+		return nil, fmt.Errorf("unsupported domain %s", domain)
+	}
+	return validator.Validate(celvalidator.AuthPolicyKind, expression)
+}
@@ -0,0 +1,107 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+func TestCheckMutatorExpression(t *testing.T) {
+	testCases := []struct {
+		name       string
+		domain     extpb.Domain
+		expression string
+		valid      bool
+	}{
+		{name: "literal", domain: extpb.Domain_DOMAIN_AUTH, expression: "'gold'", valid: true},
+		{name: "auth binding in auth domain", domain: extpb.Domain_DOMAIN_AUTH, expression: "auth.identity.sub", valid: true},
+		{name: "metadata binding in auth domain", domain: extpb.Domain_DOMAIN_AUTH, expression: "metadata.filter_metadata", valid: true},
+		{name: "auth binding in request domain", domain: extpb.Domain_DOMAIN_REQUEST, expression: "auth.identity.sub", valid: true},
+		{name: "request body in request domain", domain: extpb.Domain_DOMAIN_REQUEST, expression: "requestBodyJSON('/model')", valid: true},
+		{name: "request body in auth domain", domain: extpb.Domain_DOMAIN_AUTH, expression: "requestBodyJSON('/model')", valid: false},
+		{name: "connection binding in auth domain", domain: extpb.Domain_DOMAIN_AUTH, expression: "connection.id", valid: false},
+		{name: "undeclared binding", domain: extpb.Domain_DOMAIN_REQUEST, expression: "ratelimit.hits", valid: false},
+		{name: "syntax error", domain: extpb.Domain_DOMAIN_REQUEST, expression: "request.headers[", valid: false},
+		{name: "type error", domain: extpb.Domain_DOMAIN_REQUEST, expression: "1 + 'a'", valid: false},
+		{name: "response binding in response domain", domain: extpb.Domain_DOMAIN_RESPONSE, expression: "string(response.code)", valid: true},
+		{name: "response body in response domain", domain: extpb.Domain_DOMAIN_RESPONSE, expression: "responseBodyJSON('/usage/total_tokens')", valid: true},
+		{name: "response binding in request domain", domain: extpb.Domain_DOMAIN_REQUEST, expression: "response.code", valid: false},
+		{name: "unspecified domain", domain: extpb.Domain_DOMAIN_UNSPECIFIED, expression: "'gold'", valid: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cAst, err := checkMutatorExpression(tc.domain, tc.expression)
+			if tc.valid {
+				assert.NilError(t, err)
+				assert.Assert(t, cAst != nil)
+				assert.Assert(t, cAst.IsChecked())
+			} else {
+				assert.Assert(t, err != nil)
+			}
+		})
+	}
+}
+
+func TestRegisterMutatorRejectsInvalidExpression(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	policy := &extpb.Policy{
+		Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: "plans"},
+		TargetRefs: []*extpb.TargetRef{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "gw", Namespace: "default"}},
+	}
+	targetRefLocator := "gateway.gateway.networking.k8s.io:default/gw"
+
+	_, err := service.RegisterMutator(context.Background(), &extpb.RegisterMutatorRequest{
+		Policy:     policy,
+		Domain:     extpb.Domain_DOMAIN_AUTH,
+		Binding:    "plan",
+		Expression: "auth.identity.tier ==",
+	})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+	assert.ErrorContains(t, err, "plan")
+	assert.Equal(t, len(service.registeredData.GetAllForTargetRef(targetRefLocator, extpb.Domain_DOMAIN_AUTH)), 0)
+
+	_, err = service.RegisterMutator(context.Background(), &extpb.RegisterMutatorRequest{
+		Policy:     policy,
+		Domain:     extpb.Domain_DOMAIN_AUTH,
+		Binding:    "plan",
+		Expression: "auth.identity.tier == 'gold' ? 'gold' : 'free'",
+	})
+	assert.NilError(t, err)
+	entries := service.registeredData.GetAllForTargetRef(targetRefLocator, extpb.Domain_DOMAIN_AUTH)
+	assert.Equal(t, len(entries), 1)
+	assert.Assert(t, entries[0].CAst != nil)
+	assert.Assert(t, entries[0].CAst.IsChecked())
+}
+
+func TestRegisterMutatorResponseDomain(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	request := &extpb.RegisterMutatorRequest{
+		Policy: &extpb.Policy{
+			Metadata:   &extpb.Metadata{Kind: "TelemetryPolicy", Namespace: "default", Name: "telemetry"},
+			TargetRefs: []*extpb.TargetRef{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "gw", Namespace: "default"}},
+		},
+		Domain:     extpb.Domain_DOMAIN_RESPONSE,
+		Binding:    "metrics.labels.status",
+		Expression: "string(response.code)",
+	}
+	targetRefLocator := "gateway.gateway.networking.k8s.io:default/gw"
+
+	t.Setenv("WASM_SHIM_FEATURES", "")
+	_, err := service.RegisterMutator(context.Background(), request)
+	assert.Equal(t, status.Code(err), codes.FailedPrecondition, "the response domain requires a wasm-shim that evaluates the response data")
+	assert.Equal(t, len(service.registeredData.GetAllForTargetRef(targetRefLocator, extpb.Domain_DOMAIN_RESPONSE)), 0)
+
+	t.Setenv("WASM_SHIM_FEATURES", string(wasm.ShimFeatureResponseData))
+	_, err = service.RegisterMutator(context.Background(), request)
+	assert.NilError(t, err)
+	assert.Equal(t, len(service.registeredData.GetAllForTargetRef(targetRefLocator, extpb.Domain_DOMAIN_RESPONSE)), 1)
+}
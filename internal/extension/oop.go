@@ -18,6 +18,7 @@ package extension
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -47,6 +48,8 @@ type OOPExtension struct {
 	sync       io.Writer
 	serverMu   sync.Mutex
 	monitorWg  sync.WaitGroup
+	exitMu     sync.Mutex
+	exitErr    error
 }
 
 func NewOOPExtension(name string, location string, service extpb.ExtensionServiceServer, logger logr.Logger, sync io.Writer) (OOPExtension, error) {
@@ -74,6 +77,10 @@ func (p *OOPExtension) Name() string {
 	return p.name
 }
 
+func (p *OOPExtension) Executable() string {
+	return p.executable
+}
+
 func (p *OOPExtension) Start() error {
 	p.logger.Info("starting...")
 
@@ -102,12 +109,16 @@ func (p *OOPExtension) Start() error {
 	}
 	p.logger.Info("started")
 
+	p.setExitError(nil)
+
 	go func() {
-		if e := cmd.Wait(); e != nil {
+		e := cmd.Wait()
+		if e != nil {
 			p.logger.Error(e, fmt.Sprintf("Extension %q finished with an error", p.name))
 		}
 		// wait for stderr
 		p.monitorWg.Wait()
+		p.setExitError(e)
 	}()
 
 	// only set this, if we successfully started it all
@@ -119,6 +130,19 @@ func (p *OOPExtension) IsAlive() bool {
 	return p.cmd != nil && p.cmd.Process.Signal(syscall.Signal(0)) == nil
 }
 
+// ExitError returns the error the process of the extension last exited with, if any
+func (p *OOPExtension) ExitError() error {
+	p.exitMu.Lock()
+	defer p.exitMu.Unlock()
+	return p.exitErr
+}
+
+func (p *OOPExtension) setExitError(err error) {
+	p.exitMu.Lock()
+	defer p.exitMu.Unlock()
+	p.exitErr = err
+}
+
 func (p *OOPExtension) Stop() error {
 	p.logger.Info("stopping...")
 	var err error
@@ -170,7 +194,7 @@ func (p *OOPExtension) startServer() error {
 			return err
 		}
 
-		server := grpc.NewServer()
+		server := grpc.NewServer(grpc.UnaryInterceptor(p.withExtensionName))
 		extpb.RegisterExtensionServiceServer(server, p.service)
 		p.server = server
 
@@ -184,6 +208,11 @@ func (p *OOPExtension) startServer() error {
 	return nil
 }
 
+// withExtensionName tells the service which extension a call comes from
+func (p *OOPExtension) withExtensionName(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(contextWithExtensionName(ctx, p.name), req)
+}
+
 func (p *OOPExtension) stopServer() error {
 	p.serverMu.Lock()
 	server := p.server
@@ -0,0 +1,404 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/cel-go/cel"
+	"github.com/kuadrant/policy-machinery/controller"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	kuadrant "github.com/kuadrant/kuadrant-operator/pkg/cel/ext"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+const (
+	// RegisteredDataLabel labels the configmaps that persist the data registered by the extensions
+	RegisteredDataLabel = "kuadrant.io/extension-data"
+
+	registeredDataKey          = "registeredData"
+	registeredDataFieldManager = "kuadrant-operator"
+
+	// defaultPersistInterval is how long changes to the registered data are batched before they are persisted
+	defaultPersistInterval = 1 * time.Second
+)
+
+// PersistedPolicyData is the data registered by an extension for a policy, as persisted across restarts of the operator
+type PersistedPolicyData struct {
+	Policy        ResourceID              `json:"policy"`
+	Extension     string                  `json:"extension,omitempty"`
+	Mutators      []PersistedMutator      `json:"mutators,omitempty"`
+	Subscriptions []PersistedSubscription `json:"subscriptions,omitempty"`
+}
+
+func (d PersistedPolicyData) IsEmpty() bool {
+	return len(d.Mutators) == 0 && len(d.Subscriptions) == 0
+}
+
+type PersistedMutator struct {
+	TargetRefLocator string       `json:"targetRef"`
+	Domain           extpb.Domain `json:"domain"`
+	Binding          string       `json:"binding"`
+	Expression       string       `json:"expression"`
+}
+
+type PersistedSubscription struct {
+	Expression string `json:"expression"`
+	PolicyKind string `json:"policyKind"`
+	// Policy is the policy the expression is evaluated against, in protobuf JSON
+	Policy json.RawMessage `json:"policy"`
+}
+
+// RegisteredDataPersister durably stores the data registered by the extensions, one policy at a time
+type RegisteredDataPersister interface {
+	// Save stores the data of a policy, or deletes it when empty
+	Save(ctx context.Context, data PersistedPolicyData) error
+	LoadAll(ctx context.Context) ([]PersistedPolicyData, error)
+}
+
+// configMapPersister persists the data of each policy in a configmap of the operator namespace
+type configMapPersister struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+func NewConfigMapPersister(client dynamic.Interface, namespace string) RegisteredDataPersister {
+	return &configMapPersister{client: client, namespace: namespace}
+}
+
+func (p *configMapPersister) Save(ctx context.Context, data PersistedPolicyData) error {
+	resource := p.client.Resource(controller.ConfigMapsResource).Namespace(p.namespace)
+	name := registeredDataConfigMapName(data.Policy)
+
+	if data.IsEmpty() {
+		if err := resource.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: p.namespace,
+			Labels:    map[string]string{RegisteredDataLabel: "true"},
+		},
+		Data: map[string]string{
+			registeredDataKey: string(raw),
+		},
+	}
+	obj, err := controller.Destruct(cm)
+	if err != nil {
+		return err
+	}
+	_, err = resource.Apply(ctx, name, obj, metav1.ApplyOptions{Force: true, FieldManager: registeredDataFieldManager})
+	return err
+}
+
+func (p *configMapPersister) LoadAll(ctx context.Context) ([]PersistedPolicyData, error) {
+	list, err := p.client.Resource(controller.ConfigMapsResource).Namespace(p.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=true", RegisteredDataLabel),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []PersistedPolicyData
+	for _, item := range list.Items {
+		raw, found, err := unstructured.NestedString(item.Object, "data", registeredDataKey)
+		if err != nil || !found {
+			continue
+		}
+		var data PersistedPolicyData
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			return nil, fmt.Errorf("configmap %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// registeredDataWriter persists the data registered for the policies in the background, off the path of the calls of
+// the extensions. Changes are batched for an interval, and the data of a policy is only saved when it differs from the
+// last snapshot persisted.
+type registeredDataWriter struct {
+	persister RegisteredDataPersister
+	// snapshot returns the data of a policy, as it is to be persisted
+	snapshot func(policy ResourceID) (PersistedPolicyData, error)
+	interval time.Duration
+	logger   logr.Logger
+
+	mu      sync.Mutex
+	pending map[ResourceID]struct{}
+	// saved holds the last snapshot persisted for each policy that has data
+	saved map[ResourceID]string
+	wake  chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+func newRegisteredDataWriter(persister RegisteredDataPersister, snapshot func(ResourceID) (PersistedPolicyData, error), logger logr.Logger) *registeredDataWriter {
+	return &registeredDataWriter{
+		persister: persister,
+		snapshot:  snapshot,
+		interval:  defaultPersistInterval,
+		logger:    logger,
+		pending:   make(map[ResourceID]struct{}),
+		saved:     make(map[ResourceID]string),
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Mark schedules the data of a policy to be persisted, if it changed
+func (w *registeredDataWriter) Mark(policy ResourceID) {
+	w.mu.Lock()
+	w.pending[policy] = struct{}{}
+	w.mu.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Restored records the data of a policy restored from the persister as already persisted
+func (w *registeredDataWriter) Restored(policy ResourceID) {
+	data, err := w.snapshot(policy)
+	if err != nil || data.IsEmpty() {
+		return
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.saved[policy] = string(raw)
+}
+
+// Start persists the marked policies in the background, until stopped
+func (w *registeredDataWriter) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	go w.run(w.stop, w.done)
+}
+
+// Stop stops persisting in the background, once the policies marked so far are persisted
+func (w *registeredDataWriter) Stop() {
+	w.mu.Lock()
+	stop, done := w.stop, w.done
+	w.stop, w.done = nil, nil
+	w.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (w *registeredDataWriter) run(stop, done chan struct{}) {
+	defer close(done)
+	for {
+		select {
+		case <-stop:
+			w.Flush(context.Background())
+			return
+		case <-w.wake:
+		}
+
+		select {
+		case <-stop:
+		case <-time.After(w.interval):
+		}
+		w.Flush(context.Background())
+	}
+}
+
+// Flush persists the data of the marked policies that changed since last persisted. The policies that fail to be
+// persisted are marked again.
+func (w *registeredDataWriter) Flush(ctx context.Context) {
+	w.mu.Lock()
+	pending := w.pending
+	w.pending = make(map[ResourceID]struct{})
+	w.mu.Unlock()
+
+	for policy := range pending {
+		if err := w.save(ctx, policy); err != nil {
+			w.logger.Error(err, "failed to persist registered data", "policy", policy)
+			w.Mark(policy)
+		}
+	}
+}
+
+func (w *registeredDataWriter) save(ctx context.Context, policy ResourceID) error {
+	data, err := w.snapshot(policy)
+	if err != nil {
+		return err
+	}
+
+	var raw []byte
+	if !data.IsEmpty() {
+		if raw, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+
+	w.mu.Lock()
+	saved, wasSaved := w.saved[policy]
+	w.mu.Unlock()
+	if data.IsEmpty() && !wasSaved || wasSaved && saved == string(raw) {
+		return nil
+	}
+
+	if err := w.persister.Save(ctx, data); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if data.IsEmpty() {
+		delete(w.saved, policy)
+	} else {
+		w.saved[policy] = string(raw)
+	}
+	return nil
+}
+
+func registeredDataConfigMapName(policy ResourceID) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", policy.Kind, policy.Namespace, policy.Name)))
+	return fmt.Sprintf("kuadrant-extension-data-%s", hex.EncodeToString(hash[:8]))
+}
+
+// PolicyData returns a snapshot of the data registered for a policy, in the form it is persisted
+func (r *RegisteredDataStore) PolicyData(policy ResourceID) (PersistedPolicyData, error) {
+	data := PersistedPolicyData{Policy: policy}
+
+	r.dataMutex.RLock()
+	for key, entry := range r.dataProviders {
+		if key.Policy != policy {
+			continue
+		}
+		data.Mutators = append(data.Mutators, PersistedMutator{
+			TargetRefLocator: key.TargetRefLocator,
+			Domain:           key.Domain,
+			Binding:          key.Binding,
+			Expression:       entry.Expression,
+		})
+	}
+	r.dataMutex.RUnlock()
+
+	r.subsMutex.RLock()
+	defer r.subsMutex.RUnlock()
+	for key, sub := range r.subscriptions {
+		if key.Policy != policy {
+			continue
+		}
+		var pbPolicy []byte
+		if self, ok := sub.Input["self"].(*extpb.Policy); ok {
+			var err error
+			if pbPolicy, err = protojson.Marshal(self); err != nil {
+				return data, err
+			}
+		}
+		data.Subscriptions = append(data.Subscriptions, PersistedSubscription{
+			Expression: key.Expression,
+			PolicyKind: sub.PolicyKind,
+			Policy:     pbPolicy,
+		})
+	}
+
+	// stable order, so the persisted data only changes when the registered data does
+	sort.Slice(data.Mutators, func(i, j int) bool {
+		a, b := data.Mutators[i], data.Mutators[j]
+		return fmt.Sprintf("%s|%d|%s", a.TargetRefLocator, a.Domain, a.Binding) < fmt.Sprintf("%s|%d|%s", b.TargetRefLocator, b.Domain, b.Binding)
+	})
+	sort.Slice(data.Subscriptions, func(i, j int) bool {
+		return data.Subscriptions[i].Expression < data.Subscriptions[j].Expression
+	})
+
+	return data, nil
+}
+
+// Restore adds persisted data of a policy to the store.
+// The expressions of the mutators and subscriptions are compiled again; the values of the subscriptions are unknown until the next evaluation.
+func (r *RegisteredDataStore) Restore(data PersistedPolicyData) error {
+	for _, mutator := range data.Mutators {
+		cAst, err := checkMutatorExpression(mutator.Domain, mutator.Expression)
+		if err != nil {
+			return fmt.Errorf("mutator %q: %w", mutator.Binding, err)
+		}
+		r.Set(data.Policy, mutator.TargetRefLocator, mutator.Domain, mutator.Binding, DataProviderEntry{
+			Policy:     data.Policy,
+			Binding:    mutator.Binding,
+			Expression: mutator.Expression,
+			CAst:       cAst,
+		})
+	}
+
+	if len(data.Subscriptions) == 0 {
+		return nil
+	}
+
+	env, err := cel.NewEnv(kuadrant.CelExt(&StateAwareDAG{}))
+	if err != nil {
+		return err
+	}
+	for _, subscription := range data.Subscriptions {
+		policy := &extpb.Policy{}
+		if err := protojson.Unmarshal(subscription.Policy, policy); err != nil {
+			return fmt.Errorf("subscription %q: %w", subscription.Expression, err)
+		}
+		cAst, issues := env.Compile(subscription.Expression)
+		if issues.Err() != nil {
+			return fmt.Errorf("subscription %q: %w", subscription.Expression, issues.Err())
+		}
+		r.SetSubscription(data.Policy, subscription.Expression, Subscription{
+			CAst:       cAst,
+			Input:      map[string]any{"self": policy},
+			PolicyKind: subscription.PolicyKind,
+		})
+	}
+	return nil
+}
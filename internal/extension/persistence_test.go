@@ -0,0 +1,196 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/assert"
+
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+type memoryPersister struct {
+	mu    sync.Mutex
+	data  map[ResourceID]PersistedPolicyData
+	saves int
+}
+
+func (p *memoryPersister) Save(_ context.Context, data PersistedPolicyData) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.saves++
+	if data.IsEmpty() {
+		delete(p.data, data.Policy)
+		return nil
+	}
+	p.data[data.Policy] = data
+	return nil
+}
+
+func (p *memoryPersister) saveCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.saves
+}
+
+func (p *memoryPersister) LoadAll(_ context.Context) ([]PersistedPolicyData, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var result []PersistedPolicyData
+	for _, data := range p.data {
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+func TestRegisteredDataPersistence(t *testing.T) {
+	persister := &memoryPersister{data: map[ResourceID]PersistedPolicyData{}}
+	policy := &extpb.Policy{
+		Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: "plans"},
+		TargetRefs: []*extpb.TargetRef{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "gw", Namespace: "default"}},
+	}
+	policyID := ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "plans"}
+	targetRefLocator := "gateway.gateway.networking.k8s.io:default/gw"
+
+	// registered before the restart
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	service.setPersister(persister)
+	ctx := contextWithExtensionName(context.Background(), "plan-policy")
+
+	_, err := service.RegisterMutator(ctx, &extpb.RegisterMutatorRequest{
+		Policy:     policy,
+		Domain:     extpb.Domain_DOMAIN_REQUEST,
+		Binding:    "plan",
+		Expression: "'gold'",
+	})
+	assert.NilError(t, err)
+	service.registeredData.SetSubscription(policyID, "self.findGateways()", Subscription{
+		Input:      map[string]any{"self": policy},
+		PolicyKind: "PlanPolicy",
+	})
+	service.persistPolicy(policyID)
+	service.writer.Flush(ctx)
+
+	persisted, ok := persister.data[policyID]
+	assert.Assert(t, ok)
+	assert.Equal(t, persisted.Extension, "plan-policy")
+	assert.DeepEqual(t, persisted.Mutators, []PersistedMutator{{
+		TargetRefLocator: targetRefLocator,
+		Domain:           extpb.Domain_DOMAIN_REQUEST,
+		Binding:          "plan",
+		Expression:       "'gold'",
+	}})
+	assert.Equal(t, len(persisted.Subscriptions), 1)
+
+	// restored after the restart
+	restarted := newExtensionService(nil, logr.Discard()).(*extensionService)
+	restarted.setPersister(persister)
+	assert.NilError(t, restarted.Rehydrate(context.Background()))
+
+	entries := restarted.registeredData.GetAllForTargetRef(targetRefLocator, extpb.Domain_DOMAIN_REQUEST)
+	assert.Equal(t, len(entries), 1)
+	assert.Equal(t, entries[0].Binding, "plan")
+	assert.Equal(t, entries[0].Expression, "'gold'")
+
+	subscription, ok := restarted.registeredData.GetSubscription(policyID, "self.findGateways()")
+	assert.Assert(t, ok)
+	assert.Assert(t, subscription.CAst != nil)
+	assert.Assert(t, subscription.Val == nil)
+	assert.Equal(t, subscription.PolicyKind, "PlanPolicy")
+	assert.Equal(t, subscription.Input["self"].(*extpb.Policy).GetMetadata().GetName(), "plans")
+
+	// the restored data is still cleared when its extension dies
+	clearedMutators, clearedSubscriptions := restarted.ClearExtensionData("plan-policy")
+	assert.Equal(t, clearedMutators, 1)
+	assert.Equal(t, clearedSubscriptions, 1)
+	restarted.writer.Flush(context.Background())
+	assert.Equal(t, len(persister.data), 0)
+}
+
+func TestRegisteredDataWriterOnlySavesChanges(t *testing.T) {
+	persister := &memoryPersister{data: map[ResourceID]PersistedPolicyData{}}
+	policy := &extpb.Policy{Metadata: &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: "plans"}}
+	policyID := ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "plans"}
+	ctx := context.Background()
+
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	service.setPersister(persister)
+	subscribe := func(expression string) {
+		service.registeredData.SetSubscription(policyID, expression, Subscription{
+			Input:      map[string]any{"self": policy},
+			PolicyKind: "PlanPolicy",
+		})
+		service.persistPolicy(policyID)
+		service.writer.Flush(ctx)
+	}
+
+	subscribe("self.findGateways()")
+	assert.Equal(t, persister.saveCount(), 1)
+
+	// subscribing again to the same expression does not change the data
+	subscribe("self.findGateways()")
+	assert.Equal(t, persister.saveCount(), 1)
+
+	subscribe("self.findAuthPolicies()")
+	assert.Equal(t, persister.saveCount(), 2)
+
+	service.registeredData.ClearPolicyData(policyID)
+	service.persistPolicy(policyID)
+	service.writer.Flush(ctx)
+	assert.Equal(t, persister.saveCount(), 3)
+	assert.Equal(t, len(persister.data), 0)
+
+	// nothing left to delete
+	service.persistPolicy(policyID)
+	service.writer.Flush(ctx)
+	assert.Equal(t, persister.saveCount(), 3)
+
+	// the restored data is not saved again until it changes
+	subscribe("self.findGateways()")
+	restarted := newExtensionService(nil, logr.Discard()).(*extensionService)
+	restarted.setPersister(persister)
+	assert.NilError(t, restarted.Rehydrate(ctx))
+	saves := persister.saveCount()
+	restarted.persistPolicy(policyID)
+	restarted.writer.Flush(ctx)
+	assert.Equal(t, persister.saveCount(), saves)
+}
+
+func TestRegisteredDataWriterPersistsInBackground(t *testing.T) {
+	persister := &memoryPersister{data: map[ResourceID]PersistedPolicyData{}}
+	policyID := ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "plans"}
+
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	service.setPersister(persister)
+	service.writer.interval = time.Millisecond
+	service.writer.Start()
+	defer service.writer.Stop()
+
+	service.registeredData.SetSubscription(policyID, "self.findGateways()", Subscription{
+		Input:      map[string]any{"self": &extpb.Policy{Metadata: &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: "plans"}}},
+		PolicyKind: "PlanPolicy",
+	})
+	service.persistPolicy(policyID)
+	waitFor(t, func() bool { return persister.saveCount() == 1 })
+
+	// the marked policies are persisted when stopped
+	service.writer.interval = time.Hour
+	service.registeredData.ClearPolicyData(policyID)
+	service.persistPolicy(policyID)
+	service.writer.Stop()
+	assert.Equal(t, persister.saveCount(), 2)
+}
+
+func TestRegisteredDataConfigMapName(t *testing.T) {
+	a := registeredDataConfigMapName(ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "a"})
+	b := registeredDataConfigMapName(ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "b"})
+
+	assert.Assert(t, a != b)
+	assert.Equal(t, a, registeredDataConfigMapName(ResourceID{Kind: "PlanPolicy", Namespace: "default", Name: "a"}))
+	assert.Equal(t, len(a), len("kuadrant-extension-data-")+16)
+}
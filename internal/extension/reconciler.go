@@ -2,19 +2,31 @@ package extension
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/kuadrant/policy-machinery/controller"
 	"github.com/kuadrant/policy-machinery/machinery"
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
+var ErrKuadrantNotFound = errors.New("kuadrant not found")
+
 // nilGuardedPointer is an atomic pointer that provides blocking behavior
 // until the pointer is set to a non-nil value.
 type nilGuardedPointer[T any] struct {
@@ -56,6 +68,25 @@ func (ngp *nilGuardedPointer[T]) newUpdateChannel() chan T {
 	return channel
 }
 
+// removeUpdateChannel stops sending updates to the channel. Updates sent meanwhile are drained, so set does not block.
+func (ngp *nilGuardedPointer[T]) removeUpdateChannel(channel chan T) {
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-channel:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ngp.mu.Lock()
+	ngp.updates = lo.Without(ngp.updates, channel)
+	ngp.mu.Unlock()
+	close(done)
+}
+
 // get returns the current value of the pointer without blocking.
 //
 //lint:ignore U1000
@@ -124,9 +155,7 @@ type StateAwareDAG struct {
 
 func (d *StateAwareDAG) FindGatewaysFor(targetRefs []*extpb.TargetRef) ([]*extpb.Gateway, error) {
 	chain := d.topology.All().Items(func(o machinery.Object) bool {
-		return len(lo.Filter(targetRefs, func(t *extpb.TargetRef, _ int) bool {
-			return t.Name == o.GetName() && t.Kind == o.GroupVersionKind().Kind
-		})) > 0
+		return matchesTargetRefs(o, targetRefs)
 	})
 
 	gateways := make([]*extpb.Gateway, 0)
@@ -138,7 +167,7 @@ func (d *StateAwareDAG) FindGatewaysFor(targetRefs []*extpb.TargetRef) ([]*extpb
 		chain = append(chain, parents...)
 		chainSize = len(chain)
 		if gw, ok := object.(*machinery.Gateway); ok && gw != nil {
-			gateways = append(gateways, toGw(*gw))
+			gateways = append(gateways, extpb.ConvertGateway(gw.Gateway))
 		}
 	}
 
@@ -146,11 +175,128 @@ func (d *StateAwareDAG) FindGatewaysFor(targetRefs []*extpb.TargetRef) ([]*extpb
 		return gw.GetMetadata().GetNamespace() + "/" + gw.GetMetadata().GetName()
 	}), nil
 }
+
+// FindListenersFor returns the listeners targeted by the target references: all the listeners of a targeted gateway,
+// unless a section name is set, and the listeners a targeted route attaches to
+func (d *StateAwareDAG) FindListenersFor(targetRefs []*extpb.TargetRef) ([]*extpb.GatewayListener, error) {
+	listeners := make([]*extpb.GatewayListener, 0)
+
+	for _, targetRef := range targetRefs {
+		targets := d.topology.All().Items(func(o machinery.Object) bool {
+			return matchesTargetRefs(o, []*extpb.TargetRef{targetRef})
+		})
+		for _, target := range targets {
+			var candidates []machinery.Object
+			switch target.(type) {
+			case *machinery.Gateway:
+				candidates = []machinery.Object{target}
+			default:
+				candidates = d.topology.All().Parents(target)
+			}
+			for _, candidate := range candidates {
+				switch o := candidate.(type) {
+				case *machinery.Listener:
+					listeners = append(listeners, toGatewayListener(o.Gateway, *o.Listener))
+				case *machinery.Gateway:
+					for _, l := range o.Spec.Listeners {
+						if targetRef.SectionName != "" && targetRef.SectionName != string(l.Name) {
+							continue
+						}
+						listeners = append(listeners, toGatewayListener(o, l))
+					}
+				}
+			}
+		}
+	}
+
+	return lo.UniqBy(listeners, func(l *extpb.GatewayListener) string {
+		return l.GetGateway().GetNamespace() + "/" + l.GetGateway().GetName() + "#" + l.GetListener().GetName()
+	}), nil
+}
+
+// FindHTTPRoutesFor returns the HTTPRoutes targeted by the target references, or attached to the targeted gateways
+func (d *StateAwareDAG) FindHTTPRoutesFor(targetRefs []*extpb.TargetRef) ([]*extpb.HTTPRoute, error) {
+	chain := d.topology.All().Items(func(o machinery.Object) bool {
+		return matchesTargetRefs(o, targetRefs)
+	})
+
+	routes := make([]*extpb.HTTPRoute, 0)
+	chainSize := len(chain)
+
+	for i := 0; i < chainSize; i++ {
+		switch object := chain[i].(type) {
+		case *machinery.HTTPRoute:
+			routes = append(routes, extpb.ConvertHTTPRoute(object.HTTPRoute))
+		case *machinery.HTTPRouteRule:
+			routes = append(routes, extpb.ConvertHTTPRoute(object.HTTPRoute.HTTPRoute))
+		case *machinery.GatewayClass, *machinery.Gateway, *machinery.Listener:
+			chain = append(chain, d.topology.All().Children(object)...)
+			chainSize = len(chain)
+		}
+	}
+
+	return lo.UniqBy(routes, func(r *extpb.HTTPRoute) string {
+		return r.GetMetadata().GetNamespace() + "/" + r.GetMetadata().GetName()
+	}), nil
+}
+
+// FindEffectivePoliciesFor returns the effective policies of a kind for the paths of the topology, from a gateway class
+// down to an HTTPRoute rule, that go through any of the target references. Policies being deleted are ignored.
+func (d *StateAwareDAG) FindEffectivePoliciesFor(targetRefs []*extpb.TargetRef, policyType machinery.Policy) ([]*extpb.EffectivePolicy, error) {
+	targetables := d.topology.Targetables()
+	gatewayClasses := targetables.Items(func(o machinery.Object) bool {
+		_, ok := o.(*machinery.GatewayClass)
+		return ok
+	})
+	httpRouteRules := targetables.Items(func(o machinery.Object) bool {
+		_, ok := o.(*machinery.HTTPRouteRule)
+		return ok
+	})
+	isPolicyOfType := func(policy machinery.Policy) bool {
+		o, ok := policy.(metav1.Object)
+		return reflect.TypeOf(policy) == reflect.TypeOf(policyType) && (!ok || o.GetDeletionTimestamp() == nil)
+	}
+
+	effectivePolicies := make([]*extpb.EffectivePolicy, 0)
+
+	for _, gatewayClass := range gatewayClasses {
+		for _, httpRouteRule := range httpRouteRules {
+			for _, path := range targetables.Paths(gatewayClass, httpRouteRule) {
+				if !lo.SomeBy(path, func(t machinery.Targetable) bool { return matchesTargetRefs(t, targetRefs) }) {
+					continue
+				}
+				effectivePolicy := kuadrantv1.EffectivePolicyForPath[machinery.Policy](path, isPolicyOfType)
+				if effectivePolicy == nil {
+					continue
+				}
+				pbEffectivePolicy, err := toEffectivePolicy(*effectivePolicy, path)
+				if err != nil {
+					return nil, err
+				}
+				effectivePolicies = append(effectivePolicies, pbEffectivePolicy)
+			}
+		}
+	}
+
+	return effectivePolicies, nil
+}
+
+// Kuadrant returns the oldest Kuadrant CR that is not being deleted
+func (d *StateAwareDAG) Kuadrant() (*extpb.Kuadrant, error) {
+	kuadrants := lo.FilterMap(d.topology.Objects().Roots(), func(root machinery.Object, _ int) (controller.Object, bool) {
+		o, ok := root.(*kuadrantv1beta1.Kuadrant)
+		return o, ok && o.GetDeletionTimestamp() == nil
+	})
+	if len(kuadrants) == 0 {
+		return nil, ErrKuadrantNotFound
+	}
+	sort.Sort(controller.ObjectsByCreationTimestamp(kuadrants))
+	return extpb.ConvertKuadrant(kuadrants[0].(*kuadrantv1beta1.Kuadrant)), nil
+}
+
 func (d *StateAwareDAG) FindPoliciesFor(targetRefs []*extpb.TargetRef, policyType machinery.Policy) ([]*extpb.Policy, error) {
 	initialTargets := d.topology.All().Items(func(o machinery.Object) bool {
-		return len(lo.Filter(targetRefs, func(t *extpb.TargetRef, _ int) bool {
-			return t.Name == o.GetName() && t.Kind == o.GroupVersionKind().Kind
-		})) > 0
+		return matchesTargetRefs(o, targetRefs)
 	})
 
 	chain := make([]machinery.Object, 0)
@@ -191,21 +337,16 @@ func (d *StateAwareDAG) FindPoliciesFor(targetRefs []*extpb.TargetRef, policyTyp
 	}), nil
 }
 
-func toGw(gw machinery.Gateway) *extpb.Gateway {
-	return &extpb.Gateway{
-		Metadata: &extpb.Metadata{
-			Name:      gw.Name,
-			Namespace: gw.Namespace,
-		},
-		Spec: &extpb.GatewaySpec{
-			GatewayClassName: string(gw.Spec.GatewayClassName),
-			Listeners:        toListeners(gw.Spec.Listeners),
-		},
-	}
+// matchesTargetRefs tells whether an object is referred to by any of the target references
+func matchesTargetRefs(o machinery.Object, targetRefs []*extpb.TargetRef) bool {
+	return lo.ContainsBy(targetRefs, func(t *extpb.TargetRef) bool {
+		return t.Name == o.GetName() && t.Kind == o.GroupVersionKind().Kind
+	})
 }
 
+// toPolicy converts a policy, along with its spec if it is a Kuadrant policy
 func toPolicy(policy machinery.Policy) *extpb.Policy {
-	return &extpb.Policy{
+	pbPolicy := &extpb.Policy{
 		Metadata: &extpb.Metadata{
 			Group:     policy.GroupVersionKind().Group,
 			Kind:      policy.GroupVersionKind().Kind,
@@ -214,6 +355,28 @@ func toPolicy(policy machinery.Policy) *extpb.Policy {
 		},
 		TargetRefs: toTargetRefs(policy.GetTargetRefs()),
 	}
+	if spec := toPolicySpec(policy); spec != nil {
+		if pbSpec, err := anypb.New(spec); err == nil {
+			pbPolicy.Spec = pbSpec
+		}
+	}
+	return pbPolicy
+}
+
+func toPolicySpec(policy machinery.Policy) proto.Message {
+	switch p := policy.(type) {
+	case *kuadrantv1.AuthPolicy:
+		return extpb.ConvertAuthPolicy(p).GetSpec()
+	case *kuadrantv1.RateLimitPolicy:
+		return extpb.ConvertRateLimitPolicy(p).GetSpec()
+	case *kuadrantv1.DNSPolicy:
+		return extpb.ConvertDNSPolicy(p).GetSpec()
+	case *kuadrantv1.TLSPolicy:
+		return extpb.ConvertTLSPolicy(p).GetSpec()
+	case *kuadrantv1alpha1.TokenRateLimitPolicy:
+		return extpb.ConvertTokenRateLimitPolicy(p).GetSpec()
+	}
+	return nil
 }
 
 func toTargetRefs(targetRefs []machinery.PolicyTargetReference) []*extpb.TargetRef {
@@ -230,19 +393,50 @@ func toTargetRefs(targetRefs []machinery.PolicyTargetReference) []*extpb.TargetR
 	return trs
 }
 
-func toListeners(listeners []v1.Listener) []*extpb.Listener {
-	ls := make([]*extpb.Listener, len(listeners))
-	for i, l := range listeners {
-		listener := extpb.Listener{}
-		if l.Hostname != nil {
-			listener.Hostname = string(*l.Hostname)
+func toGatewayListener(gw *machinery.Gateway, listener v1.Listener) *extpb.GatewayListener {
+	pbGateway := extpb.ConvertGateway(gw.Gateway)
+	pbListener, _ := lo.Find(pbGateway.GetSpec().GetListeners(), func(l *extpb.Listener) bool {
+		return l.GetName() == string(listener.Name)
+	})
+	return &extpb.GatewayListener{
+		Gateway:  pbGateway.GetMetadata(),
+		Listener: pbListener,
+	}
+}
+
+func toEffectivePolicy(policy machinery.Policy, path []machinery.Targetable) (*extpb.EffectivePolicy, error) {
+	effectivePolicy := &extpb.EffectivePolicy{
+		Kind: policy.GroupVersionKind().Kind,
+		Path: lo.Map(path, func(targetable machinery.Targetable, _ int) *extpb.TargetRef {
+			return &extpb.TargetRef{
+				Group:     targetable.GroupVersionKind().Group,
+				Kind:      targetable.GroupVersionKind().Kind,
+				Name:      targetable.GetName(),
+				Namespace: targetable.GetNamespace(),
+			}
+		}),
+		Rules: make(map[string]*extpb.MergeableRule),
+	}
+
+	mergeablePolicy, ok := policy.(kuadrantv1.MergeablePolicy)
+	if !ok {
+		return effectivePolicy, nil
+	}
+	for name, rule := range mergeablePolicy.Rules() {
+		specJSON, err := json.Marshal(rule.GetSpec())
+		if err != nil {
+			return nil, err
+		}
+		spec := &structpb.Value{}
+		if err := spec.UnmarshalJSON(specJSON); err != nil {
+			return nil, err
 		}
-		if l.Protocol != "" {
-			listener.Protocol = string(l.Protocol)
+		effectivePolicy.Rules[name] = &extpb.MergeableRule{
+			Spec:   spec,
+			Source: rule.GetSource(),
 		}
-		ls[i] = &listener
 	}
-	return ls
+	return effectivePolicy, nil
 }
 
 func Reconcile(_ context.Context, _ []controller.ResourceEvent, topology *machinery.Topology, _ error, state *sync.Map) error {
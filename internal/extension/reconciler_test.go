@@ -3,6 +3,8 @@
 package extension
 
 import (
+	"errors"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -18,6 +20,8 @@ import (
 	machinerycontroller "github.com/kuadrant/policy-machinery/controller"
 	"github.com/kuadrant/policy-machinery/machinery"
 
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
 	v1 "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
@@ -361,6 +365,183 @@ func TestStateAwareDAG(t *testing.T) {
 	})
 }
 
+func TestStateAwareDAGQueries(t *testing.T) {
+	kuadrant := &kuadrantv1beta1.Kuadrant{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kuadrantv1beta1.GroupVersion.String(), Kind: "Kuadrant"},
+		ObjectMeta: metav1.ObjectMeta{Name: "kuadrant", Namespace: "kuadrant-system", UID: "kuadrant"},
+		Spec: kuadrantv1beta1.KuadrantSpec{
+			Observability: kuadrantv1beta1.Observability{Enable: true},
+			MTLS:          &kuadrantv1beta1.MTLS{Enable: true, Limitador: ptr.To(false)},
+		},
+	}
+	gatewayClass := BuildGatewayClass()
+	gatewayClass.UID = "gatewayclass"
+	gateway := BuildGateway(func(g *gwapiv1.Gateway) {
+		g.Spec.Listeners = append(g.Spec.Listeners, gwapiv1.Listener{
+			Name:     "https",
+			Hostname: ptr.To[gwapiv1.Hostname]("*.example.com"),
+			Port:     443,
+			Protocol: gwapiv1.HTTPSProtocolType,
+		})
+	})
+	httpRoute := BuildHTTPRoute(func(r *gwapiv1.HTTPRoute) {
+		r.Spec.ParentRefs[0].SectionName = ptr.To[gwapiv1.SectionName]("https")
+		r.Spec.Hostnames = []gwapiv1.Hostname{"api.example.com"}
+		r.Spec.Rules[0].Matches = []gwapiv1.HTTPRouteMatch{
+			{
+				Path:   &gwapiv1.HTTPPathMatch{Type: ptr.To(gwapiv1.PathMatchPathPrefix), Value: ptr.To("/v1")},
+				Method: ptr.To[gwapiv1.HTTPMethod]("GET"),
+			},
+		}
+	})
+	rateLimitPolicy := &kuadrantv1.RateLimitPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kuadrantv1.GroupVersion.String(), Kind: "RateLimitPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway-rlp", Namespace: "my-namespace"},
+		Spec: kuadrantv1.RateLimitPolicySpec{
+			TargetRef: gwapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gwapiv1alpha2.LocalPolicyTargetReference{
+					Group: gwapiv1.GroupName,
+					Kind:  "Gateway",
+					Name:  "my-gateway",
+				},
+			},
+			RateLimitPolicySpecProper: kuadrantv1.RateLimitPolicySpecProper{
+				Limits: map[string]kuadrantv1.Limit{
+					"global": {Rates: []kuadrantv1.Rate{{Limit: 10, Window: "1m"}}},
+				},
+			},
+		},
+	}
+
+	store := make(machinerycontroller.Store)
+	store[string(kuadrant.UID)] = kuadrant
+	store[string(gatewayClass.UID)] = gatewayClass
+
+	topology, err := machinery.NewGatewayAPITopology(
+		machinery.WithGatewayClasses(gatewayClass),
+		machinery.WithGateways(gateway),
+		machinery.ExpandGatewayListeners(),
+		machinery.WithHTTPRoutes(httpRoute),
+		machinery.ExpandHTTPRouteRules(),
+		machinery.WithGatewayAPITopologyPolicies(rateLimitPolicy),
+		machinery.WithGatewayAPITopologyObjects(kuadrant),
+		machinery.WithGatewayAPITopologyLinks(
+			kuadrantv1beta1.LinkKuadrantToGatewayClasses(store),
+		),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create topology: %v", err)
+	}
+
+	dag := StateAwareDAG{topology: topology, state: &sync.Map{}}
+	gatewayTargetRef := []*v1.TargetRef{{Group: gwapiv1.GroupName, Kind: "Gateway", Name: "my-gateway"}}
+	routeTargetRef := []*v1.TargetRef{{Group: gwapiv1.GroupName, Kind: "HTTPRoute", Name: "my-http-route"}}
+
+	t.Run("FindListenersFor()", func(t *testing.T) {
+		listeners, err := dag.FindListenersFor(gatewayTargetRef)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(listeners) != 2 {
+			t.Fatalf("Expected 2 listeners of the gateway, got %d", len(listeners))
+		}
+
+		listeners, err = dag.FindListenersFor([]*v1.TargetRef{{Group: gwapiv1.GroupName, Kind: "Gateway", Name: "my-gateway", SectionName: "https"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(listeners) != 1 || listeners[0].GetListener().GetPort() != 443 || listeners[0].GetListener().GetHostname() != "*.example.com" {
+			t.Fatalf("Expected the https listener, got %v", listeners)
+		}
+
+		listeners, err = dag.FindListenersFor(routeTargetRef)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(listeners) != 1 || listeners[0].GetListener().GetName() != "https" || listeners[0].GetGateway().GetName() != "my-gateway" {
+			t.Fatalf("Expected the https listener the route attaches to, got %v", listeners)
+		}
+	})
+
+	t.Run("FindHTTPRoutesFor()", func(t *testing.T) {
+		for _, targetRefs := range [][]*v1.TargetRef{gatewayTargetRef, routeTargetRef} {
+			routes, err := dag.FindHTTPRoutesFor(targetRefs)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(routes) != 1 {
+				t.Fatalf("Expected 1 route, got %d", len(routes))
+			}
+			route := routes[0]
+			if route.GetMetadata().GetName() != "my-http-route" || route.GetSpec().GetHostnames()[0] != "api.example.com" {
+				t.Fatalf("Unexpected route %v", route)
+			}
+			parentRef := route.GetSpec().GetParentRefs()[0]
+			if parentRef.GetName() != "my-gateway" || parentRef.Kind != nil || parentRef.GetSectionName() != "https" {
+				t.Fatalf("Unexpected parent reference %v", parentRef)
+			}
+			match := route.GetSpec().GetRules()[0].GetMatches()[0]
+			if match.GetPath().GetType() != "PathPrefix" || match.GetPath().GetValue() != "/v1" || match.GetMethod() != "GET" {
+				t.Fatalf("Unexpected match %v", match)
+			}
+		}
+	})
+
+	t.Run("FindEffectivePoliciesFor()", func(t *testing.T) {
+		effectivePolicies, err := dag.FindEffectivePoliciesFor(routeTargetRef, &kuadrantv1.RateLimitPolicy{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(effectivePolicies) != 1 {
+			t.Fatalf("Expected 1 effective policy, got %d", len(effectivePolicies))
+		}
+		effectivePolicy := effectivePolicies[0]
+		if effectivePolicy.GetKind() != "RateLimitPolicy" {
+			t.Errorf("Expected kind RateLimitPolicy, got %s", effectivePolicy.GetKind())
+		}
+		kinds := lo.Map(effectivePolicy.GetPath(), func(t *v1.TargetRef, _ int) string { return t.GetKind() })
+		if !reflect.DeepEqual(kinds, []string{"GatewayClass", "Gateway", "Listener", "HTTPRoute", "HTTPRouteRule"}) {
+			t.Errorf("Unexpected path %v", kinds)
+		}
+		rule, ok := effectivePolicy.GetRules()["global"]
+		if !ok {
+			t.Fatalf("Expected the global limit in the effective policy, got %v", effectivePolicy.GetRules())
+		}
+		if rule.GetSource() != rateLimitPolicy.GetLocator() {
+			t.Errorf("Expected source %s, got %s", rateLimitPolicy.GetLocator(), rule.GetSource())
+		}
+		if limit := rule.GetSpec().GetStructValue().GetFields()["rates"].GetListValue().GetValues()[0].GetStructValue().GetFields()["limit"].GetNumberValue(); limit != 10 {
+			t.Errorf("Expected limit 10, got %v", limit)
+		}
+
+		effectivePolicies, err = dag.FindEffectivePoliciesFor(routeTargetRef, &kuadrantv1.AuthPolicy{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(effectivePolicies) != 0 {
+			t.Fatalf("Expected no effective auth policy, got %d", len(effectivePolicies))
+		}
+	})
+
+	t.Run("Kuadrant()", func(t *testing.T) {
+		pbKuadrant, err := dag.Kuadrant()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if pbKuadrant.GetMetadata().GetName() != "kuadrant" || !pbKuadrant.GetSpec().GetObservability().GetEnable() {
+			t.Fatalf("Unexpected kuadrant %v", pbKuadrant)
+		}
+		if mtls := pbKuadrant.GetSpec().GetMtls(); !mtls.GetEnable() || mtls.Authorino != nil || mtls.Limitador == nil || mtls.GetLimitador() {
+			t.Fatalf("Unexpected mtls %v", mtls)
+		}
+
+		emptyTopology, _ := machinery.NewTopology()
+		if _, err := (&StateAwareDAG{topology: emptyTopology}).Kuadrant(); !errors.Is(err, ErrKuadrantNotFound) {
+			t.Fatalf("Expected ErrKuadrantNotFound, got %v", err)
+		}
+	})
+}
+
 func TestNilGuardedPointer(t *testing.T) {
 	t.Run("set and get", func(t *testing.T) {
 		ptr := newNilGuardedPointer[string]()
@@ -645,7 +826,7 @@ func TestNilGuardedPointer(t *testing.T) {
 }
 
 func TestConversionFunctions(t *testing.T) {
-	t.Run("toGw conversion", func(t *testing.T) {
+	t.Run("gateway conversion", func(t *testing.T) {
 		gateway := machinery.Gateway{
 			Gateway: &gwapiv1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
@@ -672,7 +853,7 @@ func TestConversionFunctions(t *testing.T) {
 			},
 		}
 
-		result := toGw(gateway)
+		result := v1.ConvertGateway(gateway.Gateway)
 
 		if result.Metadata.Name != "test-gateway" {
 			t.Errorf("Expected name 'test-gateway', got %s", result.Metadata.Name)
@@ -686,11 +867,17 @@ func TestConversionFunctions(t *testing.T) {
 		if len(result.Spec.Listeners) != 2 {
 			t.Errorf("Expected 2 listeners, got %d", len(result.Spec.Listeners))
 		}
-		if result.Spec.Listeners[0].Hostname != "example.com" {
-			t.Errorf("Expected hostname 'example.com', got %s", result.Spec.Listeners[0].Hostname)
+		if result.Spec.Listeners[0].GetHostname() != "example.com" {
+			t.Errorf("Expected hostname 'example.com', got %s", result.Spec.Listeners[0].GetHostname())
 		}
-		if result.Spec.Listeners[1].Hostname != "" {
-			t.Errorf("Expected empty hostname for second listener, got %s", result.Spec.Listeners[1].Hostname)
+		if result.Spec.Listeners[1].Hostname != nil {
+			t.Errorf("Expected no hostname for second listener, got %s", result.Spec.Listeners[1].GetHostname())
+		}
+		if result.Spec.Listeners[1].Port != 443 {
+			t.Errorf("Expected port 443 for second listener, got %d", result.Spec.Listeners[1].Port)
+		}
+		if result.Metadata.Group != gwapiv1.GroupName || result.Metadata.Kind != "Gateway" {
+			t.Errorf("Expected kind 'Gateway' of group '%s', got %s of %s", gwapiv1.GroupName, result.Metadata.Kind, result.Metadata.Group)
 		}
 	})
 
@@ -732,6 +919,45 @@ func TestConversionFunctions(t *testing.T) {
 		if result.TargetRefs[0].Name != "test-gateway" {
 			t.Errorf("Expected target ref name 'test-gateway', got %s", result.TargetRefs[0].Name)
 		}
+		if result.Spec != nil {
+			t.Errorf("Expected no spec for a policy of an unknown kind, got %s", result.Spec.GetTypeUrl())
+		}
+	})
+
+	t.Run("toPolicy conversion of a Kuadrant policy", func(t *testing.T) {
+		policy := &kuadrantv1.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rlp",
+				Namespace: "test-namespace",
+			},
+			Spec: kuadrantv1.RateLimitPolicySpec{
+				TargetRef: gwapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+					LocalPolicyTargetReference: gwapiv1alpha2.LocalPolicyTargetReference{
+						Group: gwapiv1.GroupName,
+						Kind:  "Gateway",
+						Name:  "test-gateway",
+					},
+				},
+				RateLimitPolicySpecProper: kuadrantv1.RateLimitPolicySpecProper{
+					Limits: map[string]kuadrantv1.Limit{
+						"global": {Rates: []kuadrantv1.Rate{{Limit: 10, Window: "1m"}}},
+					},
+				},
+			},
+		}
+
+		result := toPolicy(policy)
+
+		spec := &v1.RateLimitPolicySpec{}
+		if err := result.Spec.UnmarshalTo(spec); err != nil {
+			t.Fatalf("Expected a RateLimitPolicySpec, got %v", err)
+		}
+		if spec.Limits["global"].GetRates()[0].GetLimit() != 10 {
+			t.Errorf("Expected a limit of 10, got %v", spec.Limits["global"])
+		}
+		if spec.TargetRef.GetName() != "test-gateway" {
+			t.Errorf("Expected target ref name 'test-gateway', got %s", spec.TargetRef.GetName())
+		}
 	})
 
 	t.Run("toTargetRefs conversion", func(t *testing.T) {
@@ -768,32 +994,42 @@ func TestConversionFunctions(t *testing.T) {
 		}
 	})
 
-	t.Run("toListeners conversion", func(t *testing.T) {
-		listeners := []gwapiv1.Listener{
-			{
-				Name:     "http",
-				Hostname: ptr.To[gwapiv1.Hostname]("example.com"),
-				Port:     80,
-				Protocol: gwapiv1.HTTPProtocolType,
-			},
-			{
-				Name:     "https",
-				Port:     443,
-				Protocol: gwapiv1.HTTPSProtocolType,
-				// No hostname to test nil handling
+	t.Run("toGatewayListener conversion", func(t *testing.T) {
+		gateway := &machinery.Gateway{
+			Gateway: &gwapiv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-gateway",
+					Namespace: "test-namespace",
+				},
+				Spec: gwapiv1.GatewaySpec{
+					Listeners: []gwapiv1.Listener{
+						{
+							Name:     "http",
+							Hostname: ptr.To[gwapiv1.Hostname]("example.com"),
+							Port:     80,
+							Protocol: gwapiv1.HTTPProtocolType,
+						},
+						{
+							Name:     "https",
+							Port:     443,
+							Protocol: gwapiv1.HTTPSProtocolType,
+							// No hostname to test nil handling
+						},
+					},
+				},
 			},
 		}
 
-		result := toListeners(listeners)
+		result := toGatewayListener(gateway, gateway.Spec.Listeners[1])
 
-		if len(result) != 2 {
-			t.Errorf("Expected 2 listeners, got %d", len(result))
+		if result.Gateway.Name != "test-gateway" {
+			t.Errorf("Expected gateway name 'test-gateway', got %s", result.Gateway.Name)
 		}
-		if result[0].Hostname != "example.com" {
-			t.Errorf("Expected hostname 'example.com', got %s", result[0].Hostname)
+		if result.Listener.Name != "https" {
+			t.Errorf("Expected listener 'https', got %s", result.Listener.Name)
 		}
-		if result[1].Hostname != "" {
-			t.Errorf("Expected empty hostname for second listener, got %s", result[1].Hostname)
+		if result.Listener.Hostname != nil {
+			t.Errorf("Expected no hostname, got %s", result.Listener.GetHostname())
 		}
 	})
 }
@@ -62,22 +62,22 @@ func (r *MutatorRegistry) RegisterWasmConfigMutator(mutator WasmConfigMutator) {
 }
 
 func (r *MutatorRegistry) ApplyAuthConfigMutators(authConfig *authorinov1beta3.AuthConfig, path []machinery.Targetable) error {
-	_, gateway, _, httpRoute, _, err := kuadrantmachinery.ObjectsInRequestPath(path)
+	_, gateway, _, route, _, err := kuadrantmachinery.ObjectsInRequestPath(path)
 	if err != nil {
 		return err
 	}
 
 	targetRefs := []machinery.PolicyTargetReference{
-		// HTTPRoute - for extension policies targeting this specific route
+		// HTTPRoute or GRPCRoute - for extension policies targeting this specific route
 		machinery.LocalPolicyTargetReferenceWithSectionName{
 			LocalPolicyTargetReferenceWithSectionName: gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
 				LocalPolicyTargetReference: gatewayapiv1alpha2.LocalPolicyTargetReference{
 					Group: gatewayapiv1alpha2.Group("gateway.networking.k8s.io"),
-					Kind:  gatewayapiv1alpha2.Kind("HTTPRoute"),
-					Name:  gatewayapiv1alpha2.ObjectName(httpRoute.GetName()),
+					Kind:  gatewayapiv1alpha2.Kind(route.GroupVersionKind().Kind),
+					Name:  gatewayapiv1alpha2.ObjectName(route.GetName()),
 				},
 			},
-			PolicyNamespace: httpRoute.GetNamespace(),
+			PolicyNamespace: route.GetNamespace(),
 		},
 		// Gateway - for extension policies targeting the parent gateway
 		machinery.LocalPolicyTargetReferenceWithSectionName{
@@ -144,9 +144,9 @@ func ApplyAuthConfigMutators(authConfig *authorinov1beta3.AuthConfig, path []mac
 }
 
 type ResourceID struct {
-	Kind      string
-	Namespace string
-	Name      string
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 type DataProviderEntry struct {
@@ -368,6 +368,27 @@ func (r *RegisteredDataStore) ClearPolicyData(policy ResourceID) (clearedMutator
 	return clearedMutators, clearedSubscriptions
 }
 
+// CountPolicyData returns the number of mutators and subscriptions registered for a policy
+func (r *RegisteredDataStore) CountPolicyData(policy ResourceID) (mutators int, subscriptions int) {
+	r.dataMutex.RLock()
+	r.subsMutex.RLock()
+	defer r.dataMutex.RUnlock()
+	defer r.subsMutex.RUnlock()
+
+	for key := range r.dataProviders {
+		if key.Policy == policy {
+			mutators++
+		}
+	}
+
+	for key := range r.subscriptions {
+		if key.Policy == policy {
+			subscriptions++
+		}
+	}
+	return mutators, subscriptions
+}
+
 func (r *RegisteredDataStore) GetPolicySubscriptions(policy ResourceID) []SubscriptionKey {
 	r.subsMutex.RLock()
 	defer r.subsMutex.RUnlock()
@@ -446,20 +467,27 @@ func (m *RegisteredDataMutator[TResource]) mutateAuthConfig(authConfig *authorin
 
 // mutateWasmConfig handles WasmConfig-specific mutations
 func (m *RegisteredDataMutator[TResource]) mutateWasmConfig(wasmConfig *wasm.Config, targetRefs []machinery.PolicyTargetReference) error {
-	requestData := make(map[string]string)
+	wasmConfig.RequestData = m.wasmData(targetRefs, extpb.Domain_DOMAIN_REQUEST)
+
+	wasmConfig.ResponseData = m.wasmData(targetRefs, extpb.Domain_DOMAIN_RESPONSE)
+
+	return nil
+}
+
+// wasmData returns the bindings of a domain registered for the target references, the first target reference winning
+func (m *RegisteredDataMutator[TResource]) wasmData(targetRefs []machinery.PolicyTargetReference, domain extpb.Domain) map[string]string {
+	data := make(map[string]string)
 
 	for _, targetRef := range targetRefs {
-		providerEntries := m.store.GetAllForTargetRef(targetRef.GetLocator(), extpb.Domain_DOMAIN_REQUEST)
+		providerEntries := m.store.GetAllForTargetRef(targetRef.GetLocator(), domain)
 
 		for _, entry := range providerEntries {
 			// Add if it doesn't exist
-			if _, exists := requestData[entry.Binding]; !exists {
-				requestData[entry.Binding] = entry.Expression
+			if _, exists := data[entry.Binding]; !exists {
+				data[entry.Binding] = entry.Expression
 			}
 		}
 	}
 
-	wasmConfig.RequestData = requestData
-
-	return nil
+	return data
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/google/cel-go/common/types/ref"
 	authorinov1beta3 "github.com/kuadrant/authorino/api/v1beta3"
 
+	"github.com/kuadrant/kuadrant-operator/internal/wasm"
 	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 	"github.com/kuadrant/policy-machinery/machinery"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -644,6 +645,36 @@ func TestRegisteredDataMutator(t *testing.T) {
 			t.Errorf("Expected 1 kuadrant property, got %d", len(kuadrantMetadata.Json.Properties))
 		}
 	})
+
+	t.Run("mutate wasm config with request and response data", func(t *testing.T) {
+		store := NewRegisteredDataStore()
+		mutator := NewRegisteredDataMutator[*wasm.Config](store)
+
+		policy := testResourceID("TelemetryPolicy", "ns1", "telemetry")
+		mockTargetRef := createMockGatewayTargetRef()
+		store.Set(policy, mockTargetRef.GetLocator(), extpb.Domain_DOMAIN_REQUEST, "metrics.labels.user", DataProviderEntry{
+			Policy:     policy,
+			Binding:    "metrics.labels.user",
+			Expression: "auth.identity.user",
+		})
+		store.Set(policy, mockTargetRef.GetLocator(), extpb.Domain_DOMAIN_RESPONSE, "metrics.labels.status", DataProviderEntry{
+			Policy:     policy,
+			Binding:    "metrics.labels.status",
+			Expression: "response.code",
+		})
+
+		wasmConfig := &wasm.Config{}
+		if err := mutator.Mutate(wasmConfig, []machinery.PolicyTargetReference{mockTargetRef}); err != nil {
+			t.Fatalf("Expected no error: %v", err)
+		}
+
+		if len(wasmConfig.RequestData) != 1 || wasmConfig.RequestData["metrics.labels.user"] != "auth.identity.user" {
+			t.Errorf("Unexpected request data: %v", wasmConfig.RequestData)
+		}
+		if len(wasmConfig.ResponseData) != 1 || wasmConfig.ResponseData["metrics.labels.status"] != "response.code" {
+			t.Errorf("Unexpected response data: %v", wasmConfig.ResponseData)
+		}
+	})
 }
 
 func TestMutatorRegistry(t *testing.T) {
@@ -0,0 +1,358 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+// defaultRemoteLivenessTimeout is how long a remote extension can go without calling the operator before it is
+// considered dead. Extensions built with the SDK ping the operator every 30 seconds.
+const defaultRemoteLivenessTimeout = 90 * time.Second
+
+// RemoteOptions configures the server the extensions that run outside of the operator pod, e.g. as sidecars or
+// services of their own, connect to over TCP with mutual TLS. The remote extensions themselves are registered later,
+// see RemoteServer.SetExtensions.
+type RemoteOptions struct {
+	// Address the operator listens on for the remote extensions
+	Address string
+	// TLSConfig of the server, which must require and verify the client certificates. If not set, the certificates
+	// are read from TLSDir on every handshake.
+	TLSConfig *tls.Config
+	// TLSDir is the directory of the tls.crt, tls.key and ca.crt files of the server
+	TLSDir string
+}
+
+// LoadServerTLSConfig returns the tls config of the server the remote extensions connect to, from the tls.crt, tls.key
+// and ca.crt files in the directory. The client certificates must be signed by the ca.
+// The files are read again on every handshake, so that renewed certificates are used without restarting the operator.
+func LoadServerTLSConfig(dir string) (*tls.Config, error) {
+	if _, err := loadServerTLSConfig(dir); err != nil {
+		return nil, err
+	}
+	return reloadingServerTLSConfig(dir), nil
+}
+
+func reloadingServerTLSConfig(dir string) *tls.Config {
+	return &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return loadServerTLSConfig(dir)
+		},
+	}
+}
+
+func loadServerTLSConfig(dir string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	ca, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to parse client ca")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// RemoteServer serves the extension service over TCP with mutual TLS. Calls are only accepted from the remote
+// extensions registered with it, identified by the common name of their client certificate.
+type RemoteServer struct {
+	address    string
+	tlsConfig  *tls.Config
+	tlsDir     string
+	service    extpb.ExtensionServiceServer
+	logger     logr.Logger
+	mu         sync.Mutex
+	extensions map[string]*RemoteExtension
+	server     *grpc.Server
+	listener   net.Listener
+}
+
+func NewRemoteServer(options RemoteOptions, service extpb.ExtensionServiceServer, logger logr.Logger) *RemoteServer {
+	return &RemoteServer{
+		address:    options.Address,
+		tlsConfig:  options.TLSConfig,
+		tlsDir:     options.TLSDir,
+		service:    service,
+		extensions: map[string]*RemoteExtension{},
+		logger:     logger.WithName("remote"),
+	}
+}
+
+// SetExtensions registers the remote extensions of the given names, and unregisters the others. It returns the
+// extensions that were added and removed.
+func (r *RemoteServer) SetExtensions(names []string) (added, removed []*RemoteExtension) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, extension := range r.extensions {
+		if !slices.Contains(names, name) {
+			delete(r.extensions, name)
+			removed = append(removed, extension)
+		}
+	}
+	for _, name := range names {
+		if _, ok := r.extensions[name]; ok {
+			continue
+		}
+		extension := newRemoteExtension(name, r.logger)
+		r.extensions[name] = extension
+		added = append(added, extension)
+	}
+	return added, removed
+}
+
+// Extensions returns the remote extensions the server accepts calls from
+func (r *RemoteServer) Extensions() []*RemoteExtension {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Collect(maps.Values(r.extensions))
+}
+
+func (r *RemoteServer) extension(name string) (*RemoteExtension, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	extension, ok := r.extensions[name]
+	return extension, ok
+}
+
+// Addr returns the address the server listens on, once started
+func (r *RemoteServer) Addr() net.Addr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.listener == nil {
+		return nil
+	}
+	return r.listener.Addr()
+}
+
+func (r *RemoteServer) Start() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.server != nil {
+		return nil
+	}
+
+	tlsConfig := r.tlsConfig
+	if tlsConfig == nil && r.tlsDir != "" {
+		// the certificates may be mounted later on, until which the handshakes fail
+		if _, err := loadServerTLSConfig(r.tlsDir); err != nil {
+			r.logger.Error(err, "certificates of the remote extensions are not available", "directory", r.tlsDir)
+		}
+		tlsConfig = reloadingServerTLSConfig(r.tlsDir)
+	}
+	if tlsConfig == nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+		return errors.New("remote extensions require mutual tls")
+	}
+
+	ln, err := net.Listen("tcp", r.address)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(r.unaryInterceptor),
+		grpc.StreamInterceptor(r.streamInterceptor),
+	)
+	extpb.RegisterExtensionServiceServer(server, r.service)
+	r.server = server
+	r.listener = ln
+
+	go func() {
+		if err := server.Serve(ln); err != nil {
+			r.logger.Error(err, "failed to serve remote extensions")
+		}
+	}()
+	r.logger.Info("listening for remote extensions", "address", ln.Addr().String())
+	return nil
+}
+
+func (r *RemoteServer) Stop() {
+	r.mu.Lock()
+	server := r.server
+	r.server = nil
+	r.listener = nil
+	r.mu.Unlock()
+
+	if server != nil {
+		server.Stop()
+		r.logger.Info("stopped listening for remote extensions")
+	}
+}
+
+func (r *RemoteServer) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := r.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (r *RemoteServer) streamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := r.authorize(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &remoteServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// authorize identifies the remote extension a call comes from by the common name of its verified client certificate
+func (r *RemoteServer) authorize(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unknown peer")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	name := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	extension, ok := r.extension(name)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%q is not a registered remote extension", name)
+	}
+	extension.seen(p.Addr)
+	return contextWithExtensionName(ctx, name), nil
+}
+
+type remoteServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *remoteServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RemoteExtension is an extension running outside of the operator pod. It is alive as long as it keeps calling the
+// operator; the operator cannot restart it, only wait for it to connect again.
+type RemoteExtension struct {
+	name            string
+	livenessTimeout time.Duration
+	logger          logr.Logger
+	mu              sync.Mutex
+	lastSeen        time.Time
+	address         string
+	// onSeen is called every time the extension calls the operator
+	onSeen func()
+}
+
+func newRemoteExtension(name string, logger logr.Logger) *RemoteExtension {
+	return &RemoteExtension{
+		name:            name,
+		livenessTimeout: defaultRemoteLivenessTimeout,
+		logger:          logger.WithName(name),
+	}
+}
+
+func (r *RemoteExtension) Name() string {
+	return r.name
+}
+
+// Executable is empty, as the binary of a remote extension is not managed by the operator
+func (r *RemoteExtension) Executable() string {
+	return ""
+}
+
+// Address returns the network address the extension last called the operator from
+func (r *RemoteExtension) Address() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.address
+}
+
+// Start waits for the extension to connect, which it is given the liveness timeout to do
+func (r *RemoteExtension) Start() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastSeen = time.Now()
+	r.logger.Info("waiting for remote extension")
+	return nil
+}
+
+func (r *RemoteExtension) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastSeen = time.Time{}
+	return nil
+}
+
+func (r *RemoteExtension) IsAlive() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !r.lastSeen.IsZero() && time.Since(r.lastSeen) < r.livenessTimeout
+}
+
+// ExitError returns why the extension is considered dead, if it is
+func (r *RemoteExtension) ExitError() error {
+	if r.IsAlive() {
+		return nil
+	}
+	return fmt.Errorf("no call received from remote extension in %s", r.livenessTimeout)
+}
+
+func (r *RemoteExtension) seen(addr net.Addr) {
+	r.mu.Lock()
+	r.lastSeen = time.Now()
+	if addr != nil {
+		r.address = addr.String()
+	}
+	onSeen := r.onSeen
+	r.mu.Unlock()
+
+	if onSeen != nil {
+		onSeen()
+	}
+}
+
+func (r *RemoteExtension) setOnSeen(onSeen func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onSeen = onSeen
+}
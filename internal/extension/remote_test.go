@@ -0,0 +1,233 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/assert"
+
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NilError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestRemoteServer(t *testing.T) {
+	ca := newTestCA(t)
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	server := NewRemoteServer(RemoteOptions{
+		Address: "127.0.0.1:0",
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{ca.issue(t, "kuadrant-operator", x509.ExtKeyUsageServerAuth)},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    ca.pool,
+			MinVersion:   tls.VersionTLS12,
+		},
+	}, service, logr.Discard())
+	assert.NilError(t, server.Start())
+	defer server.Stop()
+
+	added, _ := server.SetExtensions([]string{"plan-policy"})
+	assert.Equal(t, len(added), 1)
+	extension := added[0]
+	assert.Assert(t, !extension.IsAlive(), "not alive until started")
+	assert.NilError(t, extension.Start())
+	assert.Assert(t, extension.IsAlive(), "alive while waiting for the extension to connect")
+
+	ping := func(certificates ...tls.Certificate) error {
+		conn, err := grpc.NewClient(server.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates: certificates,
+			RootCAs:      ca.pool,
+			MinVersion:   tls.VersionTLS12,
+		})))
+		assert.NilError(t, err)
+		defer conn.Close()
+		_, err = extpb.NewExtensionServiceClient(conn).Ping(context.Background(), &extpb.PingRequest{Out: timestamppb.Now()})
+		return err
+	}
+
+	assert.NilError(t, ping(ca.issue(t, "plan-policy", x509.ExtKeyUsageClientAuth)))
+	assert.Assert(t, service.lastPingLatency("plan-policy") != nil, "calls are attributed to the extension of the client certificate")
+	assert.Assert(t, extension.Address() != "")
+
+	err := ping(ca.issue(t, "unknown", x509.ExtKeyUsageClientAuth))
+	assert.Equal(t, status.Code(err), codes.PermissionDenied)
+	assert.Assert(t, service.lastPingLatency("unknown") == nil)
+
+	assert.Assert(t, ping() != nil, "clients without a certificate are rejected")
+
+	extension.livenessTimeout = 0
+	assert.Assert(t, !extension.IsAlive(), "dead once it stops calling the operator")
+	assert.ErrorContains(t, extension.ExitError(), "no call received from remote extension")
+
+	added, removed := server.SetExtensions(nil)
+	assert.Equal(t, len(added), 0)
+	assert.Equal(t, len(removed), 1)
+	assert.Equal(t, removed[0], extension)
+	err = ping(ca.issue(t, "plan-policy", x509.ExtKeyUsageClientAuth))
+	assert.Equal(t, status.Code(err), codes.PermissionDenied, "unregistered extensions are rejected")
+}
+
+func TestLoadServerTLSConfigReloadsCertificates(t *testing.T) {
+	dir := t.TempDir()
+	writeCerts := func(ca *testCA) {
+		cert := ca.issue(t, "kuadrant-operator", x509.ExtKeyUsageServerAuth)
+		key, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+		assert.NilError(t, err)
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, "tls.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, "tls.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}), 0o600))
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, "ca.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+	}
+
+	_, err := LoadServerTLSConfig(dir)
+	assert.ErrorContains(t, err, "failed to load server certificate")
+
+	first := newTestCA(t)
+	writeCerts(first)
+	tlsConfig, err := LoadServerTLSConfig(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, tlsConfig.ClientAuth, tls.RequireAndVerifyClientCert)
+
+	handshakeConfig := func() *tls.Config {
+		config, err := tlsConfig.GetConfigForClient(&tls.ClientHelloInfo{})
+		assert.NilError(t, err)
+		return config
+	}
+	serverCert := func(config *tls.Config) *x509.Certificate {
+		cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		assert.NilError(t, err)
+		return cert
+	}
+
+	config := handshakeConfig()
+	assert.NilError(t, serverCert(config).CheckSignatureFrom(first.cert))
+	assert.Assert(t, config.ClientCAs.Equal(first.pool))
+
+	second := newTestCA(t)
+	writeCerts(second)
+	config = handshakeConfig()
+	assert.NilError(t, serverCert(config).CheckSignatureFrom(second.cert), "renewed certificate used on the next handshake")
+	assert.Assert(t, config.ClientCAs.Equal(second.pool))
+}
+
+func TestRemoteServerRequiresMutualTLS(t *testing.T) {
+	server := NewRemoteServer(RemoteOptions{
+		Address:   "127.0.0.1:0",
+		TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}, newExtensionService(nil, logr.Discard()), logr.Discard())
+	assert.ErrorContains(t, server.Start(), "remote extensions require mutual tls")
+}
+
+func TestNewManagerWithRemoteExtensions(t *testing.T) {
+	_, err := NewManager(t.TempDir(), "kuadrant-system", logr.Discard(), nil, nil, nil)
+	assert.Equal(t, err, ErrNoExtensionsFound)
+
+	manager, err := NewManager(t.TempDir(), "kuadrant-system", logr.Discard(), nil, nil, &RemoteOptions{Address: "127.0.0.1:0"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(manager.Health()), 0, "remote extensions are registered later on")
+
+	assert.NilError(t, manager.SetRemoteExtensions([]string{"plan-policy"}))
+	health := manager.Health()
+	assert.Equal(t, len(health), 1)
+	assert.Equal(t, health[0].Name, "plan-policy")
+	assert.Equal(t, health[0].Executable, "")
+	assert.Equal(t, health[0].State, ExtensionStateStopped)
+	assert.Assert(t, manager.remote.Addr() == nil, "not listening until the manager starts")
+
+	assert.NilError(t, manager.SetRemoteExtensions(nil))
+	assert.Equal(t, len(manager.Health()), 0)
+
+	withoutRemote, err := NewManager(t.TempDir(), "kuadrant-system", logr.Discard(), nil, nil, nil)
+	assert.Equal(t, err, ErrNoExtensionsFound)
+	assert.ErrorContains(t, withoutRemote.SetRemoteExtensions([]string{"plan-policy"}), "remote extensions are not enabled")
+}
+
+func TestManagerRegistersRemoteExtensionsWhileRunning(t *testing.T) {
+	ca := newTestCA(t)
+	manager, err := NewManager(t.TempDir(), "kuadrant-system", logr.Discard(), nil, nil, &RemoteOptions{
+		Address: "127.0.0.1:0",
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{ca.issue(t, "kuadrant-operator", x509.ExtKeyUsageServerAuth)},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    ca.pool,
+			MinVersion:   tls.VersionTLS12,
+		},
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, manager.Start())
+	defer func() { _ = manager.Stop() }()
+	assert.Assert(t, manager.remote.Addr() == nil, "not listening without remote extensions")
+
+	assert.NilError(t, manager.SetRemoteExtensions([]string{"plan-policy"}))
+	assert.Assert(t, manager.remote.Addr() != nil, "listening once a remote extension is registered")
+	health := manager.Health()
+	assert.Equal(t, len(health), 1)
+	assert.Equal(t, health[0].State, ExtensionStateRunning, "waiting for the extension to connect")
+
+	assert.NilError(t, manager.SetRemoteExtensions(nil))
+	assert.Equal(t, len(manager.Health()), 0)
+	assert.Assert(t, manager.remote.Addr() == nil, "no longer listening without remote extensions")
+}
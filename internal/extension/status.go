@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
+	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
+)
+
+// ReportedStatus is the status an extension reported for one of its policies
+type ReportedStatus struct {
+	Policy ResourceID
+	// TargetRefLocators are the locators of the objects targeted by the policy
+	TargetRefLocators []string
+	Conditions        []metav1.Condition
+}
+
+type ReportedStatusStore struct {
+	statuses map[ResourceID]ReportedStatus
+	// kinds of all the policies that ever reported their status, so their conditions can be removed once they stop
+	kinds map[string]struct{}
+	mutex sync.RWMutex
+}
+
+// GlobalReportedStatusStore holds the statuses reported by the extensions, surfaced by the policy discoverability reconcilers
+var GlobalReportedStatusStore = NewReportedStatusStore()
+
+func NewReportedStatusStore() *ReportedStatusStore {
+	return &ReportedStatusStore{
+		statuses: make(map[ResourceID]ReportedStatus),
+		kinds:    make(map[string]struct{}),
+	}
+}
+
+// Set stores the status reported for a policy and tells whether it changed
+func (s *ReportedStatusStore) Set(status ReportedStatus) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.kinds[status.Policy.Kind] = struct{}{}
+	if existing, ok := s.statuses[status.Policy]; ok && equality.Semantic.DeepEqual(existing, status) {
+		return false
+	}
+	s.statuses[status.Policy] = status
+	return true
+}
+
+// Delete removes the status reported for a policy and tells whether there was one
+func (s *ReportedStatusStore) Delete(policy ResourceID) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, existed := s.statuses[policy]
+	delete(s.statuses, policy)
+	return existed
+}
+
+// Get returns the status reported for the policy, if any
+func (s *ReportedStatusStore) Get(policy ResourceID) (ReportedStatus, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	status, ok := s.statuses[policy]
+	return status, ok
+}
+
+// ForTargets returns the statuses reported for the policies targeting any of the objects, sorted by policy
+func (s *ReportedStatusStore) ForTargets(targets ...machinery.Targetable) []ReportedStatus {
+	locators := lo.Map(targets, func(t machinery.Targetable, _ int) string { return t.GetLocator() })
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var result []ReportedStatus
+	for _, status := range s.statuses {
+		if lo.Some(status.TargetRefLocators, locators) {
+			result = append(result, status)
+		}
+	}
+	slices.SortFunc(result, func(a, b ReportedStatus) int {
+		return cmp.Or(cmp.Compare(a.Policy.Kind, b.Policy.Kind), cmp.Compare(a.Policy.Namespace, b.Policy.Namespace), cmp.Compare(a.Policy.Name, b.Policy.Name))
+	})
+	return result
+}
+
+// Kinds returns the kinds of all the policies that ever reported their status, sorted
+func (s *ReportedStatusStore) Kinds() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	kinds := lo.Keys(s.kinds)
+	slices.Sort(kinds)
+	return kinds
+}
+
+// ownedPolicyConditions adds to the conditions of a policy the problems of the Kuadrant policies it controls:
+// the policy is not enforced as long as any of them is not
+func ownedPolicyConditions(conditions []metav1.Condition, owner ResourceID, topology *machinery.Topology) []metav1.Condition {
+	conditions = slices.Clone(conditions)
+	for _, policy := range topology.Policies().Items() {
+		if policy.GetNamespace() != owner.Namespace || !isControlledBy(policy, owner) {
+			continue
+		}
+		withStatus, ok := policy.(interface {
+			GetStatus() kuadrantgatewayapi.PolicyStatus
+		})
+		if !ok {
+			continue
+		}
+		kind := policy.GroupVersionKind().Kind
+		for _, conditionType := range []string{string(gatewayapiv1alpha2.PolicyConditionAccepted), string(kuadrant.PolicyConditionEnforced)} {
+			condition := meta.FindStatusCondition(withStatus.GetStatus().GetConditions(), conditionType)
+			if condition == nil || condition.Status == metav1.ConditionTrue {
+				continue
+			}
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:    string(kuadrant.PolicyConditionEnforced),
+				Status:  metav1.ConditionFalse,
+				Reason:  condition.Reason,
+				Message: fmt.Sprintf("%s %s/%s: %s", kind, policy.GetNamespace(), policy.GetName(), condition.Message),
+			})
+			return conditions
+		}
+	}
+	return conditions
+}
+
+func isControlledBy(policy machinery.Policy, owner ResourceID) bool {
+	object, ok := policy.(metav1.Object)
+	if !ok {
+		return false
+	}
+	controllerRef := metav1.GetControllerOf(object)
+	return controllerRef != nil && controllerRef.Kind == owner.Kind && controllerRef.Name == owner.Name
+}
+
+// ReportedStatusesFor returns the statuses reported by the extensions for the policies targeting any of the objects
+func ReportedStatusesFor(targets ...machinery.Targetable) []ReportedStatus {
+	return GlobalReportedStatusStore.ForTargets(targets...)
+}
+
+// ReportedPolicyKinds returns the kinds of the extension policies that ever reported their status
+func ReportedPolicyKinds() []string {
+	return GlobalReportedStatusStore.Kinds()
+}
@@ -0,0 +1,140 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+func TestReportedStatusStore(t *testing.T) {
+	store := NewReportedStatusStore()
+	gateway := &machinery.Gateway{Gateway: BuildGateway()}
+	otherGateway := &machinery.Gateway{Gateway: BuildGateway(func(gw *gwapiv1.Gateway) { gw.Name = "other" })}
+
+	status := ReportedStatus{
+		Policy:            ResourceID{Kind: "PlanPolicy", Namespace: "my-namespace", Name: "plan"},
+		TargetRefLocators: []string{gateway.GetLocator()},
+		Conditions:        []metav1.Condition{{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"}},
+	}
+	assert.Assert(t, store.Set(status))
+	assert.Assert(t, !store.Set(status), "reporting the same status again is not a change")
+
+	assert.DeepEqual(t, store.ForTargets(gateway), []ReportedStatus{status})
+	assert.Equal(t, len(store.ForTargets(otherGateway)), 0)
+
+	assert.Assert(t, store.Delete(status.Policy))
+	assert.Assert(t, !store.Delete(status.Policy))
+	assert.Equal(t, len(store.ForTargets(gateway)), 0)
+	assert.DeepEqual(t, store.Kinds(), []string{"PlanPolicy"})
+}
+
+func TestReportStatus(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	service.reportedStatus = NewReportedStatusStore()
+	var notifications []string
+	service.changeNotifier = func(reason string) error {
+		notifications = append(notifications, reason)
+		return nil
+	}
+
+	request := &extpb.ReportStatusRequest{
+		Policy: &extpb.Policy{
+			Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "my-namespace", Name: "plan"},
+			TargetRefs: []*extpb.TargetRef{{Group: gwapiv1.GroupName, Kind: "Gateway", Name: "my-gateway", Namespace: "my-namespace"}},
+		},
+		Conditions: []*extpb.Condition{{Type: "Enforced", ConditionStatus: "True", Reason: "Enforced", Message: "PlanPolicy has been successfully enforced"}},
+	}
+
+	ctx := contextWithExtensionName(context.Background(), "plan-policy")
+	response, err := service.ReportStatus(ctx, request)
+	assert.NilError(t, err)
+	assert.Equal(t, len(response.GetConditions()), 1)
+	assert.Equal(t, response.GetConditions()[0].GetConditionStatus(), "True")
+	assert.Equal(t, len(notifications), 1)
+
+	gateway := &machinery.Gateway{Gateway: BuildGateway()}
+	reported := service.reportedStatus.ForTargets(gateway)
+	assert.Equal(t, len(reported), 1)
+	assert.Equal(t, reported[0].Conditions[0].Message, "PlanPolicy has been successfully enforced")
+
+	_, err = service.ReportStatus(ctx, request)
+	assert.NilError(t, err)
+	assert.Equal(t, len(notifications), 1, "reporting the same status again does not trigger a reconciliation")
+
+	service.ClearExtensionData("plan-policy")
+	assert.Equal(t, len(service.reportedStatus.ForTargets(gateway)), 0)
+
+	_, err = service.ReportStatus(ctx, &extpb.ReportStatusRequest{Policy: &extpb.Policy{Metadata: &extpb.Metadata{Kind: "PlanPolicy"}}})
+	assert.ErrorContains(t, err, "policy kind, namespace, and name must be specified")
+}
+
+func TestReportStatusWithOwnedPolicies(t *testing.T) {
+	service := newExtensionService(newNilGuardedPointer[StateAwareDAG](), logr.Discard()).(*extensionService)
+	service.reportedStatus = NewReportedStatusStore()
+
+	rateLimitPolicy := &kuadrantv1.RateLimitPolicy{
+		TypeMeta: metav1.TypeMeta{APIVersion: kuadrantv1.GroupVersion.String(), Kind: "RateLimitPolicy"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "plan",
+			Namespace: "my-namespace",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "extensions.kuadrant.io/v1alpha1", Kind: "PlanPolicy", Name: "plan", Controller: ptr.To(true)},
+			},
+		},
+		Spec: kuadrantv1.RateLimitPolicySpec{
+			TargetRef: gwapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gwapiv1alpha2.LocalPolicyTargetReference{Group: gwapiv1.GroupName, Kind: "Gateway", Name: "my-gateway"},
+			},
+		},
+		Status: kuadrantv1.RateLimitPolicyStatus{
+			Conditions: []metav1.Condition{
+				{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"},
+				{Type: "Enforced", Status: metav1.ConditionFalse, Reason: "Unknown", Message: "RateLimitPolicy waiting for the limits to be applied"},
+			},
+		},
+	}
+	topology, err := machinery.NewGatewayAPITopology(
+		machinery.WithGateways(BuildGateway()),
+		machinery.WithGatewayAPITopologyPolicies(rateLimitPolicy),
+	)
+	assert.NilError(t, err)
+	service.dag.set(StateAwareDAG{topology: topology, state: &sync.Map{}})
+
+	response, err := service.ReportStatus(context.Background(), &extpb.ReportStatusRequest{
+		Policy: &extpb.Policy{
+			Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "my-namespace", Name: "plan"},
+			TargetRefs: []*extpb.TargetRef{{Group: gwapiv1.GroupName, Kind: "Gateway", Name: "my-gateway", Namespace: "my-namespace"}},
+		},
+		Conditions: []*extpb.Condition{
+			{Type: "Accepted", ConditionStatus: "True", Reason: "Accepted"},
+			{Type: "Enforced", ConditionStatus: "True", Reason: "Enforced"},
+		},
+	})
+	assert.NilError(t, err)
+
+	conditions := extpb.ToConditions(response.GetConditions())
+	assert.Assert(t, meta.IsStatusConditionTrue(conditions, "Accepted"))
+	enforced := meta.FindStatusCondition(conditions, "Enforced")
+	assert.Assert(t, enforced != nil)
+	assert.Equal(t, enforced.Status, metav1.ConditionFalse)
+	assert.Equal(t, enforced.Reason, "Unknown")
+	assert.Equal(t, enforced.Message, "RateLimitPolicy my-namespace/plan: RateLimitPolicy waiting for the limits to be applied")
+
+	// the conditions surfaced on the targets are the reported ones
+	reported := service.reportedStatus.ForTargets(&machinery.Gateway{Gateway: BuildGateway()})
+	assert.Assert(t, meta.IsStatusConditionTrue(reported[0].Conditions, "Enforced"))
+}
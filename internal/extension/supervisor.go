@@ -0,0 +1,422 @@
+/*
+Copyright 2025 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extension
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+type ExtensionState string
+
+const (
+	ExtensionStateRunning    ExtensionState = "Running"
+	ExtensionStateRestarting ExtensionState = "Restarting"
+	ExtensionStateFailed     ExtensionState = "Failed"
+	ExtensionStateStopped    ExtensionState = "Stopped"
+	// ExtensionStateDisconnected is the state of a remote extension that stopped calling the operator
+	ExtensionStateDisconnected ExtensionState = "Disconnected"
+)
+
+// ExtensionHealth is the health of an extension
+type ExtensionHealth struct {
+	Name       string
+	Executable string
+	State      ExtensionState
+	// Restarts is the number of consecutive restarts of the extension, reset once it runs for a stable period
+	Restarts  int
+	LastError string
+	// LastPingLatency is the time the last ping of the extension took to reach the operator
+	LastPingLatency *time.Duration
+	// Mutators and Subscriptions are the numbers of mutators and subscriptions registered by the extension
+	Mutators      int
+	Subscriptions int
+	// Address is the network address a remote extension last called the operator from
+	Address string
+	// APIVersion and Incompatibilities are the capabilities the extension negotiated, if it did
+	APIVersion        string
+	Incompatibilities []string
+}
+
+// BackoffPolicy sets how dead extensions are restarted
+type BackoffPolicy struct {
+	// InitialInterval is the delay before the first restart, doubled on every subsequent restart
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between restarts
+	MaxInterval time.Duration
+	// MaxRestarts is the number of consecutive restarts after which the extension is given up on. An extension that
+	// runs for MaxInterval without exiting is considered stable, and its restarts are reset.
+	MaxRestarts int
+}
+
+var DefaultBackoffPolicy = BackoffPolicy{
+	InitialInterval: 1 * time.Second,
+	MaxInterval:     1 * time.Minute,
+	MaxRestarts:     10,
+}
+
+// Delay returns how long to wait before the restart that follows the given number of restarts
+func (b BackoffPolicy) Delay(restarts int) time.Duration {
+	delay := b.InitialInterval
+	for i := 0; i < restarts && delay < b.MaxInterval; i++ {
+		delay *= 2
+	}
+	return min(delay, b.MaxInterval)
+}
+
+const defaultSupervisorCheckInterval = 1 * time.Second
+
+// supervisor restarts the extensions that die, with exponential backoff, until they exceed the max restarts.
+// Remote extensions are not restarted: they are disconnected until they call the operator again.
+type supervisor struct {
+	backoff       BackoffPolicy
+	checkInterval time.Duration
+	// stablePeriod is how long an extension must run without exiting for its restarts to be reset
+	stablePeriod time.Duration
+	// onDeath is called every time an extension is found dead, before restarting it
+	onDeath func(name string)
+	// onChange is called every time the state of an extension changes
+	onChange func(reason string)
+	logger   logr.Logger
+
+	mu         sync.RWMutex
+	extensions []Extension
+	health     map[string]*ExtensionHealth
+	// stopCh is set while the supervisor runs
+	stopCh chan struct{}
+
+	wg sync.WaitGroup
+}
+
+func newSupervisor(extensions []Extension, backoff BackoffPolicy, logger logr.Logger) *supervisor {
+	health := make(map[string]*ExtensionHealth, len(extensions))
+	for _, extension := range extensions {
+		health[extension.Name()] = &ExtensionHealth{Name: extension.Name(), Executable: extension.Executable(), State: ExtensionStateStopped}
+	}
+	s := &supervisor{
+		extensions:    extensions,
+		backoff:       backoff,
+		checkInterval: defaultSupervisorCheckInterval,
+		stablePeriod:  backoff.MaxInterval,
+		onDeath:       func(string) {},
+		onChange:      func(string) {},
+		logger:        logger.WithName("supervisor"),
+		health:        health,
+	}
+	for _, extension := range extensions {
+		s.onSeen(extension)
+	}
+	return s
+}
+
+// onSeen reconnects a remote extension whenever it calls the operator
+func (s *supervisor) onSeen(extension Extension) {
+	if remote, ok := extension.(*RemoteExtension); ok {
+		name := remote.Name()
+		remote.setOnSeen(func() { s.reconnected(name) })
+	}
+}
+
+// add supervises an extension from now on. The extension is started right away if the supervisor runs, otherwise when
+// it is run.
+func (s *supervisor) add(extension Extension) {
+	name := extension.Name()
+	s.onSeen(extension)
+
+	s.mu.Lock()
+	s.extensions = append(s.extensions, extension)
+	s.health[name] = &ExtensionHealth{Name: name, Executable: extension.Executable(), State: ExtensionStateStopped}
+	stopCh := s.stopCh
+	if stopCh != nil {
+		s.wg.Add(1)
+	}
+	s.mu.Unlock()
+
+	if stopCh == nil {
+		return
+	}
+	s.started(name, extension.Start())
+	if s.state(name) != ExtensionStateRunning {
+		s.wg.Done()
+		return
+	}
+	go s.watch(extension, stopCh)
+}
+
+// remove stops supervising an extension, without stopping it
+func (s *supervisor) remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.extensions = slices.DeleteFunc(s.extensions, func(extension Extension) bool { return extension.Name() == name })
+	delete(s.health, name)
+	deleteExtensionMetrics(name)
+}
+
+// running tells whether the supervisor runs
+func (s *supervisor) running() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stopCh != nil
+}
+
+// supervises tells whether the extension is still supervised
+func (s *supervisor) supervises(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.health[name]
+	return ok
+}
+
+// started records the outcome of the initial start of an extension
+func (s *supervisor) started(name string, err error) {
+	if err != nil {
+		s.setState(name, ExtensionStateFailed, err)
+		return
+	}
+	s.setState(name, ExtensionStateRunning, nil)
+}
+
+// run watches the running extensions. Remote extensions added before are started first, as they only wait for the
+// extension to connect.
+func (s *supervisor) run() {
+	s.mu.Lock()
+	s.stopCh = make(chan struct{})
+	stopCh := s.stopCh
+	extensions := slices.Clone(s.extensions)
+	s.mu.Unlock()
+
+	for _, extension := range extensions {
+		if _, ok := extension.(*RemoteExtension); ok && s.state(extension.Name()) == ExtensionStateStopped {
+			s.started(extension.Name(), extension.Start())
+		}
+		if s.state(extension.Name()) != ExtensionStateRunning {
+			continue
+		}
+		s.wg.Add(1)
+		go s.watch(extension, stopCh)
+	}
+}
+
+func (s *supervisor) stop() {
+	s.mu.Lock()
+	stopCh := s.stopCh
+	s.stopCh = nil
+	s.mu.Unlock()
+
+	if stopCh == nil {
+		return
+	}
+	close(stopCh)
+	s.wg.Wait()
+}
+
+// stopped records that all extensions were stopped
+func (s *supervisor) stopped() {
+	s.mu.RLock()
+	extensions := slices.Clone(s.extensions)
+	s.mu.RUnlock()
+
+	for _, extension := range extensions {
+		s.setState(extension.Name(), ExtensionStateStopped, nil)
+	}
+}
+
+func (s *supervisor) watch(extension Extension, stopCh chan struct{}) {
+	defer s.wg.Done()
+
+	if _, ok := extension.(*RemoteExtension); ok {
+		s.watchRemote(extension, stopCh)
+		return
+	}
+
+	name := extension.Name()
+	logger := s.logger.WithValues("extension", name)
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	runningSince := time.Now()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		if extension.IsAlive() {
+			if time.Since(runningSince) >= s.stablePeriod && s.resetRestarts(name) {
+				logger.V(1).Info("extension is stable, reset restarts")
+			}
+			continue
+		}
+
+		exitErr := extension.ExitError()
+		if exitErr == nil {
+			exitErr = fmt.Errorf("extension exited")
+		}
+		logger.Info("extension is dead", "error", exitErr.Error())
+		s.onDeath(name)
+
+		for !extension.IsAlive() {
+			restarts := s.restarts(name)
+			if restarts >= s.backoff.MaxRestarts {
+				logger.Info("giving up on extension", "restarts", restarts)
+				s.setState(name, ExtensionStateFailed, exitErr)
+				s.onChange(fmt.Sprintf("extension %s failed", name))
+				return
+			}
+
+			s.setState(name, ExtensionStateRestarting, exitErr)
+			s.onChange(fmt.Sprintf("extension %s is restarting", name))
+
+			select {
+			case <-stopCh:
+				return
+			case <-time.After(s.backoff.Delay(restarts)):
+			}
+
+			s.incRestarts(name)
+			logger.Info("restarting extension", "restarts", restarts+1)
+			if err := extension.Start(); err != nil {
+				logger.Error(err, "failed to restart extension")
+				exitErr = err
+			}
+		}
+
+		runningSince = time.Now()
+		s.setState(name, ExtensionStateRunning, exitErr)
+		s.onChange(fmt.Sprintf("extension %s restarted", name))
+	}
+}
+
+// watchRemote disconnects a remote extension when it stops calling the operator. It is reconnected as soon as it calls
+// again, however long it was away.
+func (s *supervisor) watchRemote(extension Extension, stopCh chan struct{}) {
+	name := extension.Name()
+	logger := s.logger.WithValues("extension", name)
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		if !s.supervises(name) {
+			return
+		}
+
+		alive := extension.IsAlive()
+		switch state := s.state(name); {
+		case !alive && state == ExtensionStateRunning:
+			exitErr := extension.ExitError()
+			logger.Info("remote extension is disconnected", "error", exitErr.Error())
+			s.onDeath(name)
+			s.setState(name, ExtensionStateDisconnected, exitErr)
+			s.onChange(fmt.Sprintf("extension %s disconnected", name))
+		case alive && state == ExtensionStateDisconnected:
+			// called the operator between the liveness check and the disconnection
+			s.reconnected(name)
+		}
+	}
+}
+
+// reconnected moves a disconnected remote extension back to running
+func (s *supervisor) reconnected(name string) {
+	s.mu.Lock()
+	health, ok := s.health[name]
+	if !ok || health.State != ExtensionStateDisconnected {
+		s.mu.Unlock()
+		return
+	}
+	health.State = ExtensionStateRunning
+	setExtensionStateMetric(name, ExtensionStateRunning)
+	s.mu.Unlock()
+
+	s.logger.Info("remote extension is reconnected", "extension", name)
+	s.onChange(fmt.Sprintf("extension %s reconnected", name))
+}
+
+// Health returns the health of all the extensions, sorted by name
+func (s *supervisor) Health() []ExtensionHealth {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	health := make([]ExtensionHealth, 0, len(s.health))
+	for _, h := range s.health {
+		health = append(health, *h)
+	}
+	sort.Slice(health, func(i, j int) bool { return health[i].Name < health[j].Name })
+	return health
+}
+
+func (s *supervisor) state(name string) ExtensionState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	health, ok := s.health[name]
+	if !ok {
+		return ExtensionStateStopped
+	}
+	return health.State
+}
+
+func (s *supervisor) restarts(name string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.health[name].Restarts
+}
+
+func (s *supervisor) incRestarts(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.health[name].Restarts++
+	extensionRestarts.WithLabelValues(name).Inc()
+}
+
+// resetRestarts resets the restarts of an extension, and with them the backoff. It returns whether there were any.
+func (s *supervisor) resetRestarts(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.health[name].Restarts == 0 {
+		return false
+	}
+	s.health[name].Restarts = 0
+	return true
+}
+
+func (s *supervisor) setState(name string, state ExtensionState, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	health, ok := s.health[name]
+	if !ok {
+		return
+	}
+	health.State = state
+	if err != nil {
+		health.LastError = err.Error()
+	}
+	setExtensionStateMetric(name, state)
+}
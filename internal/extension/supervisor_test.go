@@ -0,0 +1,288 @@
+//go:build unit
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/assert"
+
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+type fakeExtension struct {
+	name string
+
+	mu        sync.Mutex
+	alive     bool
+	starts    int
+	failStart bool
+}
+
+func (f *fakeExtension) Name() string { return f.name }
+
+func (f *fakeExtension) Executable() string { return "/extensions/" + f.name + "/" + f.name }
+
+func (f *fakeExtension) Start() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.starts++
+	if f.failStart {
+		return errors.New("failed to start")
+	}
+	f.alive = true
+	return nil
+}
+
+func (f *fakeExtension) Stop() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.alive = false
+	return nil
+}
+
+func (f *fakeExtension) IsAlive() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.alive
+}
+
+func (f *fakeExtension) ExitError() error {
+	return errors.New("exit status 1")
+}
+
+func (f *fakeExtension) crash(failRestarts bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.alive = false
+	f.failStart = failRestarts
+}
+
+func (f *fakeExtension) startCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.starts
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func newTestSupervisor(extensions []Extension, maxRestarts int) *supervisor {
+	s := newSupervisor(extensions, BackoffPolicy{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     4 * time.Millisecond,
+		MaxRestarts:     maxRestarts,
+	}, logr.Discard())
+	s.checkInterval = time.Millisecond
+	s.stablePeriod = time.Hour
+	return s
+}
+
+func TestBackoffPolicyDelay(t *testing.T) {
+	backoff := BackoffPolicy{InitialInterval: time.Second, MaxInterval: 10 * time.Second, MaxRestarts: 5}
+
+	assert.Equal(t, backoff.Delay(0), time.Second)
+	assert.Equal(t, backoff.Delay(1), 2*time.Second)
+	assert.Equal(t, backoff.Delay(3), 8*time.Second)
+	assert.Equal(t, backoff.Delay(4), 10*time.Second)
+	assert.Equal(t, backoff.Delay(100), 10*time.Second)
+}
+
+func TestSupervisorRestartsDeadExtension(t *testing.T) {
+	extension := &fakeExtension{name: "test"}
+	s := newTestSupervisor([]Extension{extension}, 3)
+
+	var deaths []string
+	var deathsMu sync.Mutex
+	s.onDeath = func(name string) {
+		deathsMu.Lock()
+		defer deathsMu.Unlock()
+		deaths = append(deaths, name)
+	}
+
+	s.started(extension.Name(), extension.Start())
+	s.run()
+	defer s.stop()
+
+	extension.crash(false)
+	waitFor(t, func() bool { return extension.startCount() == 2 && s.state("test") == ExtensionStateRunning })
+
+	health := s.Health()
+	assert.Equal(t, len(health), 1)
+	assert.Equal(t, health[0].Name, "test")
+	assert.Equal(t, health[0].Restarts, 1)
+	assert.Equal(t, health[0].LastError, "exit status 1")
+
+	deathsMu.Lock()
+	defer deathsMu.Unlock()
+	assert.DeepEqual(t, deaths, []string{"test"})
+}
+
+func TestSupervisorGivesUpAfterMaxRestarts(t *testing.T) {
+	extension := &fakeExtension{name: "test"}
+	s := newTestSupervisor([]Extension{extension}, 3)
+
+	s.started(extension.Name(), extension.Start())
+	s.run()
+	defer s.stop()
+
+	extension.crash(true)
+	waitFor(t, func() bool { return s.state("test") == ExtensionStateFailed })
+
+	health := s.Health()[0]
+	assert.Equal(t, health.Restarts, 3)
+	assert.Equal(t, health.LastError, "failed to start")
+	assert.Equal(t, extension.startCount(), 4)
+}
+
+func TestSupervisorResetsRestartsOfStableExtension(t *testing.T) {
+	extension := &fakeExtension{name: "test"}
+	s := newTestSupervisor([]Extension{extension}, 3)
+	s.stablePeriod = 50 * time.Millisecond
+
+	s.started(extension.Name(), extension.Start())
+	s.run()
+	defer s.stop()
+
+	// crashing more often than the max restarts does not fail the extension, as long as it is stable in between
+	for i := 1; i <= 5; i++ {
+		extension.crash(false)
+		waitFor(t, func() bool { return extension.startCount() == i+1 && s.state("test") == ExtensionStateRunning })
+		assert.Equal(t, s.restarts("test"), 1)
+		waitFor(t, func() bool { return s.restarts("test") == 0 })
+	}
+
+	assert.Equal(t, s.state("test"), ExtensionStateRunning)
+}
+
+func TestSupervisorReconnectsRemoteExtension(t *testing.T) {
+	extension := newRemoteExtension("remote", logr.Discard())
+	extension.livenessTimeout = 20 * time.Millisecond
+	s := newTestSupervisor([]Extension{extension}, 1)
+
+	var deaths, changes int
+	var mu sync.Mutex
+	s.onDeath = func(string) {
+		mu.Lock()
+		defer mu.Unlock()
+		deaths++
+	}
+	s.onChange = func(string) {
+		mu.Lock()
+		defer mu.Unlock()
+		changes++
+	}
+
+	s.started(extension.Name(), extension.Start())
+	s.run()
+	defer s.stop()
+
+	// away for longer than the liveness timeout more often than the max restarts
+	for i := 0; i < 3; i++ {
+		waitFor(t, func() bool { return s.state("remote") == ExtensionStateDisconnected })
+		assert.Equal(t, s.Health()[0].LastError, "no call received from remote extension in 20ms")
+
+		extension.seen(nil)
+		assert.Equal(t, s.state("remote"), ExtensionStateRunning)
+	}
+	s.stop()
+
+	assert.Equal(t, s.restarts("remote"), 0)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, deaths, 3)
+	assert.Equal(t, changes, 6)
+}
+
+func TestSupervisorDoesNotWatchExtensionsThatFailedToStart(t *testing.T) {
+	extension := &fakeExtension{name: "test", failStart: true}
+	s := newTestSupervisor([]Extension{extension}, 3)
+
+	s.started(extension.Name(), extension.Start())
+	s.run()
+	time.Sleep(20 * time.Millisecond)
+	s.stop()
+	s.stopped()
+
+	assert.Equal(t, extension.startCount(), 1)
+	assert.Equal(t, s.state("test"), ExtensionStateStopped)
+	assert.Equal(t, s.Health()[0].LastError, "failed to start")
+}
+
+func TestClearExtensionData(t *testing.T) {
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+
+	register := func(extensionName, policyName string) {
+		ctx := contextWithExtensionName(context.Background(), extensionName)
+		_, err := service.RegisterMutator(ctx, &extpb.RegisterMutatorRequest{
+			Policy: &extpb.Policy{
+				Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: policyName},
+				TargetRefs: []*extpb.TargetRef{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "gw", Namespace: "default"}},
+			},
+			Domain:     extpb.Domain_DOMAIN_AUTH,
+			Binding:    "plan",
+			Expression: "'gold'",
+		})
+		assert.NilError(t, err)
+	}
+
+	register("plan-policy", "a")
+	register("plan-policy", "b")
+	register("other", "c")
+
+	clearedMutators, clearedSubscriptions := service.ClearExtensionData("plan-policy")
+	assert.Equal(t, clearedMutators, 2)
+	assert.Equal(t, clearedSubscriptions, 0)
+	assert.Equal(t, len(service.registeredData.GetAllForTargetRef("gateway.gateway.networking.k8s.io:default/gw", extpb.Domain_DOMAIN_AUTH)), 1)
+
+	clearedMutators, _ = service.ClearExtensionData("plan-policy")
+	assert.Equal(t, clearedMutators, 0)
+}
+
+func TestManagerHealth(t *testing.T) {
+	extension := &fakeExtension{name: "plan-policy"}
+	service := newExtensionService(nil, logr.Discard()).(*extensionService)
+	manager := Manager{
+		extensions: []Extension{extension},
+		service:    service,
+		supervisor: newManagerSupervisor([]Extension{extension}, service, logr.Discard()),
+	}
+
+	ctx := contextWithExtensionName(context.Background(), "plan-policy")
+	_, err := service.Ping(ctx, &extpb.PingRequest{Out: timestamppb.New(time.Now().Add(-10 * time.Millisecond))})
+	assert.NilError(t, err)
+	_, err = service.RegisterMutator(ctx, &extpb.RegisterMutatorRequest{
+		Policy: &extpb.Policy{
+			Metadata:   &extpb.Metadata{Kind: "PlanPolicy", Namespace: "default", Name: "a"},
+			TargetRefs: []*extpb.TargetRef{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "gw", Namespace: "default"}},
+		},
+		Domain:     extpb.Domain_DOMAIN_AUTH,
+		Binding:    "plan",
+		Expression: "'gold'",
+	})
+	assert.NilError(t, err)
+
+	health := manager.Health()
+	assert.Equal(t, len(health), 1)
+	assert.Equal(t, health[0].Name, "plan-policy")
+	assert.Equal(t, health[0].Executable, "/extensions/plan-policy/plan-policy")
+	assert.Equal(t, health[0].State, ExtensionStateStopped)
+	assert.Equal(t, health[0].Mutators, 1)
+	assert.Equal(t, health[0].Subscriptions, 0)
+	assert.Assert(t, health[0].LastPingLatency != nil)
+	assert.Assert(t, *health[0].LastPingLatency >= 10*time.Millisecond)
+}
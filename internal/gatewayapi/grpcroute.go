@@ -0,0 +1,78 @@
+package gatewayapi
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/samber/lo"
+	"k8s.io/utils/ptr"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HTTPRouteMatchFromGRPCRouteMatch returns the HTTP equivalent of a GRPCRouteMatch.
+// gRPC requests are sent to the path /<service>/<method>, so the service and method of the match translate into a
+// path match; header matches are kept as they are.
+//   - service and method (exact): exact path /<service>/<method>
+//   - service only (exact): path prefix /<service>/
+//   - method only (exact): regular expression ^/[^/]+/<method>$
+//   - regular expression: regular expression ^/(<service>)/(<method>)$, with any missing part matching any value
+//   - no method match: path prefix /
+func HTTPRouteMatchFromGRPCRouteMatch(match gatewayapiv1.GRPCRouteMatch) gatewayapiv1.HTTPRouteMatch {
+	httpRouteMatch := gatewayapiv1.HTTPRouteMatch{
+		Path: pathMatchFromGRPCMethodMatch(match.Method),
+	}
+
+	for _, headerMatch := range match.Headers {
+		matchType := gatewayapiv1.HeaderMatchExact
+		if ptr.Deref(headerMatch.Type, gatewayapiv1.GRPCHeaderMatchExact) == gatewayapiv1.GRPCHeaderMatchRegularExpression {
+			matchType = gatewayapiv1.HeaderMatchRegularExpression
+		}
+		httpRouteMatch.Headers = append(httpRouteMatch.Headers, gatewayapiv1.HTTPHeaderMatch{
+			Type:  ptr.To(matchType),
+			Name:  gatewayapiv1.HTTPHeaderName(headerMatch.Name),
+			Value: headerMatch.Value,
+		})
+	}
+
+	return httpRouteMatch
+}
+
+func pathMatchFromGRPCMethodMatch(methodMatch *gatewayapiv1.GRPCMethodMatch) *gatewayapiv1.HTTPPathMatch {
+	var service, method string
+	if methodMatch != nil {
+		service, method = ptr.Deref(methodMatch.Service, ""), ptr.Deref(methodMatch.Method, "")
+	}
+
+	if service == "" && method == "" {
+		return &gatewayapiv1.HTTPPathMatch{
+			Type:  ptr.To(gatewayapiv1.PathMatchPathPrefix),
+			Value: ptr.To("/"),
+		}
+	}
+
+	if ptr.Deref(methodMatch.Type, gatewayapiv1.GRPCMethodMatchExact) == gatewayapiv1.GRPCMethodMatchRegularExpression {
+		anyValue := "[^/]+"
+		return &gatewayapiv1.HTTPPathMatch{
+			Type:  ptr.To(gatewayapiv1.PathMatchRegularExpression),
+			Value: ptr.To(fmt.Sprintf("^/(%s)/(%s)$", lo.Ternary(service != "", service, anyValue), lo.Ternary(method != "", method, anyValue))),
+		}
+	}
+
+	switch {
+	case service != "" && method != "":
+		return &gatewayapiv1.HTTPPathMatch{
+			Type:  ptr.To(gatewayapiv1.PathMatchExact),
+			Value: ptr.To(fmt.Sprintf("/%s/%s", service, method)),
+		}
+	case service != "":
+		return &gatewayapiv1.HTTPPathMatch{
+			Type:  ptr.To(gatewayapiv1.PathMatchPathPrefix),
+			Value: ptr.To(fmt.Sprintf("/%s/", service)),
+		}
+	default:
+		return &gatewayapiv1.HTTPPathMatch{
+			Type:  ptr.To(gatewayapiv1.PathMatchRegularExpression),
+			Value: ptr.To(fmt.Sprintf("^/[^/]+/%s$", regexp.QuoteMeta(method))),
+		}
+	}
+}
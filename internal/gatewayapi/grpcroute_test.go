@@ -0,0 +1,85 @@
+//go:build unit
+
+package gatewayapi
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/utils/ptr"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestHTTPRouteMatchFromGRPCRouteMatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		match    gatewayapiv1.GRPCRouteMatch
+		expected gatewayapiv1.HTTPRouteMatch
+	}{
+		{
+			name:  "empty match",
+			match: gatewayapiv1.GRPCRouteMatch{},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchPathPrefix), Value: ptr.To("/")},
+			},
+		},
+		{
+			name: "service and method",
+			match: gatewayapiv1.GRPCRouteMatch{
+				Method: &gatewayapiv1.GRPCMethodMatch{Service: ptr.To("helloworld.Greeter"), Method: ptr.To("SayHello")},
+			},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchExact), Value: ptr.To("/helloworld.Greeter/SayHello")},
+			},
+		},
+		{
+			name: "service only",
+			match: gatewayapiv1.GRPCRouteMatch{
+				Method: &gatewayapiv1.GRPCMethodMatch{Service: ptr.To("helloworld.Greeter")},
+			},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchPathPrefix), Value: ptr.To("/helloworld.Greeter/")},
+			},
+		},
+		{
+			name: "method only",
+			match: gatewayapiv1.GRPCRouteMatch{
+				Method: &gatewayapiv1.GRPCMethodMatch{Method: ptr.To("SayHello")},
+			},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchRegularExpression), Value: ptr.To("^/[^/]+/SayHello$")},
+			},
+		},
+		{
+			name: "regular expression",
+			match: gatewayapiv1.GRPCRouteMatch{
+				Method: &gatewayapiv1.GRPCMethodMatch{Type: ptr.To(gatewayapiv1.GRPCMethodMatchRegularExpression), Service: ptr.To("helloworld.*")},
+			},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchRegularExpression), Value: ptr.To("^/(helloworld.*)/([^/]+)$")},
+			},
+		},
+		{
+			name: "headers",
+			match: gatewayapiv1.GRPCRouteMatch{
+				Headers: []gatewayapiv1.GRPCHeaderMatch{
+					{Name: "x-tenant", Value: "acme"},
+					{Type: ptr.To(gatewayapiv1.GRPCHeaderMatchRegularExpression), Name: "x-version", Value: "v[12]"},
+				},
+			},
+			expected: gatewayapiv1.HTTPRouteMatch{
+				Path: &gatewayapiv1.HTTPPathMatch{Type: ptr.To(gatewayapiv1.PathMatchPathPrefix), Value: ptr.To("/")},
+				Headers: []gatewayapiv1.HTTPHeaderMatch{
+					{Type: ptr.To(gatewayapiv1.HeaderMatchExact), Name: "x-tenant", Value: "acme"},
+					{Type: ptr.To(gatewayapiv1.HeaderMatchRegularExpression), Name: "x-version", Value: "v[12]"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(subT *testing.T) {
+			assert.DeepEqual(subT, HTTPRouteMatchFromGRPCRouteMatch(tc.match), tc.expected)
+		})
+	}
+}
@@ -11,26 +11,78 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kuadrant/kuadrant-operator/internal/utils"
 )
 
-func HostnamesFromListenerAndHTTPRoute(listener *gatewayapiv1.Listener, httpRoute *gatewayapiv1.HTTPRoute) []gatewayapiv1.Hostname {
+// HostnamesFromListenerAndRoute returns the hostnames of a HTTPRoute or GRPCRoute that are within the scope of a
+// listener, or the hostname of the listener if the route does not specify any
+func HostnamesFromListenerAndRoute(listener *gatewayapiv1.Listener, route machinery.Targetable) []gatewayapiv1.Hostname {
 	hostname := listener.Hostname
 	if hostname == nil {
 		hostname = ptr.To(gatewayapiv1.Hostname("*"))
 	}
 	hostnames := []gatewayapiv1.Hostname{*hostname}
-	if routeHostnames := httpRoute.Spec.Hostnames; len(routeHostnames) > 0 {
-		hostnames = lo.Filter(httpRoute.Spec.Hostnames, func(h gatewayapiv1.Hostname, _ int) bool {
+	if routeHostnames := RouteHostnames(route); len(routeHostnames) > 0 {
+		hostnames = lo.Filter(routeHostnames, func(h gatewayapiv1.Hostname, _ int) bool {
 			return utils.Name(h).SubsetOf(utils.Name(*hostname))
 		})
 	}
 	return hostnames
 }
 
+// RouteObject returns the Gateway API object wrapped by a targetable HTTPRoute or GRPCRoute
+func RouteObject(route machinery.Targetable) client.Object {
+	switch r := route.(type) {
+	case *machinery.HTTPRoute:
+		return r.HTTPRoute
+	case *machinery.GRPCRoute:
+		return r.GRPCRoute
+	}
+	return nil
+}
+
+// RouteHostnames returns the hostnames of a HTTPRoute or GRPCRoute
+func RouteHostnames(route machinery.Targetable) []gatewayapiv1.Hostname {
+	switch r := route.(type) {
+	case *machinery.HTTPRoute:
+		return r.Spec.Hostnames
+	case *machinery.GRPCRoute:
+		return r.Spec.Hostnames
+	}
+	return nil
+}
+
+// RouteStatus returns the status of a HTTPRoute or GRPCRoute
+func RouteStatus(route machinery.Targetable) *gatewayapiv1.RouteStatus {
+	switch r := route.(type) {
+	case *machinery.HTTPRoute:
+		return &r.Status.RouteStatus
+	case *machinery.GRPCRoute:
+		return &r.Status.RouteStatus
+	}
+	return nil
+}
+
+// RouteRuleMatches returns the matches of a HTTPRouteRule, or the HTTP equivalent of the matches of a GRPCRouteRule
+func RouteRuleMatches(routeRule machinery.Targetable) []gatewayapiv1.HTTPRouteMatch {
+	switch r := routeRule.(type) {
+	case *machinery.HTTPRouteRule:
+		return r.Matches
+	case *machinery.GRPCRouteRule:
+		if len(r.Matches) == 0 { // a grpc route rule without matches matches all requests
+			return []gatewayapiv1.HTTPRouteMatch{HTTPRouteMatchFromGRPCRouteMatch(gatewayapiv1.GRPCRouteMatch{})}
+		}
+		return lo.Map(r.Matches, func(match gatewayapiv1.GRPCRouteMatch, _ int) gatewayapiv1.HTTPRouteMatch {
+			return HTTPRouteMatchFromGRPCRouteMatch(match)
+		})
+	}
+	return nil
+}
+
 // IsHTTPRouteAccepted returns true if a given HTTPRoute has the Accepted status condition added by any of its
 // parentRefs; otherwise, it returns false
 func IsHTTPRouteAccepted(httpRoute *gatewayapiv1.HTTPRoute) bool {
@@ -59,13 +111,18 @@ func GetRouteAcceptedParentRefs(route *gatewayapiv1.HTTPRoute) []gatewayapiv1.Pa
 	})
 }
 
-func IsHTTPRouteReady(httpRoute *gatewayapiv1.HTTPRoute, gateway *gatewayapiv1.Gateway, controllerName gatewayapiv1.GatewayController) bool {
-	routeStatus, found := lo.Find(httpRoute.Status.Parents, func(s gatewayapiv1.RouteParentStatus) bool {
+// IsRouteReady returns true if a given HTTPRoute or GRPCRoute has been accepted by a gateway controller for a gateway
+func IsRouteReady(route machinery.Targetable, gateway *gatewayapiv1.Gateway, controllerName gatewayapiv1.GatewayController) bool {
+	status := RouteStatus(route)
+	if status == nil {
+		return false
+	}
+	routeStatus, found := lo.Find(status.Parents, func(s gatewayapiv1.RouteParentStatus) bool {
 		ref := s.ParentRef
 		return s.ControllerName == controllerName &&
 			ptr.Deref(ref.Group, gatewayapiv1.Group(gatewayapiv1.GroupName)) == gatewayapiv1.Group(gateway.GroupVersionKind().Group) &&
 			ptr.Deref(ref.Kind, gatewayapiv1.Kind(machinery.GatewayGroupKind.Kind)) == gatewayapiv1.Kind(gateway.GroupVersionKind().Kind) &&
-			ptr.Deref(ref.Namespace, gatewayapiv1.Namespace(httpRoute.GetNamespace())) == gatewayapiv1.Namespace(gateway.GetNamespace()) &&
+			ptr.Deref(ref.Namespace, gatewayapiv1.Namespace(route.GetNamespace())) == gatewayapiv1.Namespace(gateway.GetNamespace()) &&
 			ref.Name == gatewayapiv1.ObjectName(gateway.GetName())
 	})
 	if !found {
@@ -120,6 +177,10 @@ func IsGatewayAPIInstalled(restMapper meta.RESTMapper) (bool, error) {
 	return utils.IsCRDInstalled(restMapper, gatewayapiv1.GroupName, "HTTPRoute", gatewayapiv1.GroupVersion.Version)
 }
 
+func IsGRPCRouteInstalled(restMapper meta.RESTMapper) (bool, error) {
+	return utils.IsCRDInstalled(restMapper, gatewayapiv1.GroupName, "GRPCRoute", gatewayapiv1.GroupVersion.Version)
+}
+
 func IsCertManagerInstalled(restMapper meta.RESTMapper, logger logr.Logger) (bool, error) {
 	if ok, err := utils.IsCRDInstalled(restMapper, certmanager.GroupName, certmanv1.CertificateKind, certmanv1.SchemeGroupVersion.Version); !ok || err != nil {
 		logger.V(1).Error(err, "CertManager CRD was not installed", "group", certmanager.GroupName, "kind", certmanv1.CertificateKind, "version", certmanv1.SchemeGroupVersion.Version)
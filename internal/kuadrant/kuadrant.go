@@ -1,6 +1,8 @@
 package kuadrant
 
 import (
+	"fmt"
+
 	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
 )
 
@@ -17,3 +19,8 @@ type Policy interface {
 	kuadrantgatewayapi.Policy
 	Kind() string
 }
+
+// DedicatedServiceClusterName returns the name of the cluster of a service instance other than the one managed by kuadrant
+func DedicatedServiceClusterName(clusterName, host string, port int32) string {
+	return fmt.Sprintf("%s|%s|%d", clusterName, host, port)
+}
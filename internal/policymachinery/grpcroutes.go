@@ -0,0 +1,95 @@
+// TODO: Move to github.com/kuadrant/policy-machinery
+
+package policymachinery
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	core "k8s.io/api/core/v1"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// GRPCRouteTopologyBuilder builds topologies that include the GRPCRoutes and their rules as targetables.
+//
+// The topology builder of the policy machinery controller only supports HTTPRoutes, and it cannot be replaced. Instead,
+// the builder records the state of the world from the resource events passed to the reconcile function and, when the
+// state of the world includes GRPCRoutes, it builds the topology again with the Gateway API topology of the policy
+// machinery, which supports GRPCRoutes. The policies and the generic objects are taken from the topology built by the
+// controller, and the object links are applied to the recorded state of the world.
+type GRPCRouteTopologyBuilder struct {
+	sync.Mutex
+	objectLinks []controller.LinkFunc
+	objs        controller.Store
+}
+
+// NewGRPCRouteTopologyBuilder returns a GRPCRouteTopologyBuilder with the object links registered in the controller
+func NewGRPCRouteTopologyBuilder(objectLinks ...controller.LinkFunc) *GRPCRouteTopologyBuilder {
+	return &GRPCRouteTopologyBuilder{
+		objectLinks: objectLinks,
+		objs:        controller.Store{},
+	}
+}
+
+// Reconcile wraps a reconcile function so it receives a topology where the GRPCRoutes are targetables.
+// The topology built by the controller is passed unchanged if there are no GRPCRoutes.
+func (b *GRPCRouteTopologyBuilder) Reconcile(reconcile controller.ReconcileFunc) controller.ReconcileFunc {
+	return func(ctx context.Context, events []controller.ResourceEvent, topology *machinery.Topology, err error, state *sync.Map) error {
+		b.Lock()
+		b.record(events)
+		grpcRoutes := lo.Map(b.objs.FilterByGroupKind(machinery.GRPCRouteGroupKind), controller.ObjectAs[*gatewayapiv1.GRPCRoute])
+		if topology != nil && len(grpcRoutes) > 0 {
+			var buildErr error
+			topology, buildErr = b.build(topology, grpcRoutes)
+			if buildErr != nil {
+				err = buildErr
+			}
+		}
+		b.Unlock()
+
+		return reconcile(ctx, events, topology, err, state)
+	}
+}
+
+// record applies the resource events to the recorded state of the world
+func (b *GRPCRouteTopologyBuilder) record(events []controller.ResourceEvent) {
+	for _, event := range events {
+		switch event.EventType {
+		case controller.CreateEvent, controller.UpdateEvent:
+			b.objs[string(event.NewObject.GetUID())] = event.NewObject
+		case controller.DeleteEvent:
+			delete(b.objs, string(event.OldObject.GetUID()))
+		}
+	}
+}
+
+// build builds the topology of the recorded state of the world, including the given GRPCRoutes, along with the
+// policies and generic objects of the topology built by the controller
+func (b *GRPCRouteTopologyBuilder) build(topology *machinery.Topology, grpcRoutes []*gatewayapiv1.GRPCRoute) (*machinery.Topology, error) {
+	gatewayClasses := lo.Map(b.objs.FilterByGroupKind(machinery.GatewayClassGroupKind), controller.ObjectAs[*gatewayapiv1.GatewayClass])
+	gateways := lo.Map(b.objs.FilterByGroupKind(machinery.GatewayGroupKind), controller.ObjectAs[*gatewayapiv1.Gateway])
+	httpRoutes := lo.Map(b.objs.FilterByGroupKind(machinery.HTTPRouteGroupKind), controller.ObjectAs[*gatewayapiv1.HTTPRoute])
+	services := lo.Map(b.objs.FilterByGroupKind(machinery.ServiceGroupKind), controller.ObjectAs[*core.Service])
+
+	linkFuncs := lo.Map(b.objectLinks, func(f controller.LinkFunc, _ int) machinery.LinkFunc {
+		return f(b.objs)
+	})
+
+	return machinery.NewGatewayAPITopology(
+		machinery.WithGatewayClasses(gatewayClasses...),
+		machinery.WithGateways(gateways...),
+		machinery.WithHTTPRoutes(httpRoutes...),
+		machinery.WithGRPCRoutes(grpcRoutes...),
+		machinery.WithServices(services...),
+		machinery.ExpandGatewayListeners(),
+		machinery.ExpandHTTPRouteRules(),
+		machinery.ExpandGRPCRouteRules(),
+		machinery.ExpandServicePorts(),
+		machinery.WithGatewayAPITopologyLinks(linkFuncs...),
+		machinery.WithGatewayAPITopologyPolicies(topology.Policies().Items()...),
+		machinery.WithGatewayAPITopologyObjects(topology.Objects().Items()...),
+	)
+}
@@ -0,0 +1,142 @@
+//go:build unit
+
+package policymachinery
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"github.com/samber/lo"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func TestGRPCRouteTopologyBuilder(t *testing.T) {
+	configMapGroupKind := schema.GroupKind{Kind: "ConfigMap"}
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "my-namespace", UID: "config-map"},
+	}
+	grpcRoutePolicy := &machinery.TestPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "test/v1", Kind: "TestPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-grpc-route-policy", Namespace: "my-namespace"},
+		Spec: machinery.TestPolicySpec{
+			TargetRef: gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gatewayapiv1alpha2.LocalPolicyTargetReference{
+					Group: gatewayapiv1.GroupName,
+					Kind:  "GRPCRoute",
+					Name:  "my-grpc-route",
+				},
+			},
+		},
+	}
+
+	// the state of the world is recorded by uid
+	gatewayClass := machinery.BuildGatewayClass(func(o *gatewayapiv1.GatewayClass) { o.UID = "gateway-class" })
+	gateway := machinery.BuildGateway(func(o *gatewayapiv1.Gateway) { o.UID = "gateway" })
+	service := machinery.BuildService(func(o *corev1.Service) { o.UID = "service" })
+	grpcRoute := machinery.BuildGRPCRoute(func(o *gatewayapiv1.GRPCRoute) { o.UID = "grpc-route" })
+
+	// the object link is applied to the recorded state of the world
+	gatewayToConfigMap := func(objs controller.Store) machinery.LinkFunc {
+		gateways := lo.Map(objs.FilterByGroupKind(machinery.GatewayGroupKind), controller.ObjectAs[*gatewayapiv1.Gateway])
+		return machinery.LinkFunc{
+			From: machinery.GatewayGroupKind,
+			To:   configMapGroupKind,
+			Func: func(_ machinery.Object) []machinery.Object {
+				return lo.Map(gateways, func(g *gatewayapiv1.Gateway, _ int) machinery.Object {
+					return &machinery.Gateway{Gateway: g}
+				})
+			},
+		}
+	}
+
+	// topology built by the controller, without the grpc routes
+	controllerTopology := func() *machinery.Topology {
+		topology, err := machinery.NewGatewayAPITopology(
+			machinery.WithGatewayClasses(gatewayClass),
+			machinery.WithGateways(gateway),
+			machinery.WithServices(service),
+			machinery.ExpandGatewayListeners(),
+			machinery.ExpandHTTPRouteRules(),
+			machinery.ExpandServicePorts(),
+			machinery.WithGatewayAPITopologyPolicies(grpcRoutePolicy),
+			machinery.WithGatewayAPITopologyObjects(&controller.RuntimeObject{Object: configMap}),
+		)
+		assert.NilError(t, err)
+		return topology
+	}
+
+	var reconciled *machinery.Topology
+	reconcile := NewGRPCRouteTopologyBuilder(gatewayToConfigMap).Reconcile(func(_ context.Context, _ []controller.ResourceEvent, topology *machinery.Topology, err error, _ *sync.Map) error {
+		reconciled = topology
+		return err
+	})
+	created := func(objs ...controller.Object) []controller.ResourceEvent {
+		return lo.Map(objs, func(obj controller.Object, _ int) controller.ResourceEvent {
+			return controller.ResourceEvent{Kind: obj.GetObjectKind().GroupVersionKind().GroupKind(), EventType: controller.CreateEvent, NewObject: obj}
+		})
+	}
+
+	t.Run("no grpc routes", func(subT *testing.T) {
+		topology := controllerTopology()
+		assert.NilError(subT, reconcile(context.Background(), created(gatewayClass, gateway, service, configMap), topology, nil, &sync.Map{}))
+		assert.Assert(subT, reconciled == topology)
+	})
+
+	t.Run("grpc routes", func(subT *testing.T) {
+		topology := controllerTopology()
+		assert.NilError(subT, reconcile(context.Background(), created(grpcRoute), topology, nil, &sync.Map{}))
+		assert.Assert(subT, reconciled != topology)
+
+		targetables := reconciled.Targetables()
+		expandedGRPCRoute, found := lo.Find(targetables.Items(), func(t machinery.Targetable) bool {
+			return t.GroupVersionKind().GroupKind() == machinery.GRPCRouteGroupKind
+		})
+		assert.Assert(subT, found)
+		assert.Equal(subT, len(expandedGRPCRoute.Policies()), 1)
+		assert.Equal(subT, expandedGRPCRoute.Policies()[0].GetLocator(), grpcRoutePolicy.GetLocator())
+
+		// gateway class -> gateway -> listener -> grpc route -> grpc route rule
+		gatewayClassTargetable, found := lo.Find(targetables.Items(), func(t machinery.Targetable) bool {
+			return t.GroupVersionKind().GroupKind() == machinery.GatewayClassGroupKind
+		})
+		assert.Assert(subT, found)
+		routeRules := targetables.Children(expandedGRPCRoute)
+		assert.Equal(subT, len(routeRules), 1)
+		paths := targetables.Paths(gatewayClassTargetable, routeRules[0])
+		assert.Equal(subT, len(paths), 1)
+		_, _, _, route, routeRule, err := ObjectsInRequestPath(paths[0])
+		assert.NilError(subT, err)
+		assert.Equal(subT, route.GetLocator(), expandedGRPCRoute.GetLocator())
+		assert.Equal(subT, routeRule.GetLocator(), routeRules[0].GetLocator())
+
+		// grpc route rule -> service
+		assert.Assert(subT, lo.ContainsBy(targetables.Children(routeRules[0]), func(t machinery.Targetable) bool {
+			return t.GroupVersionKind().GroupKind() == machinery.ServiceGroupKind
+		}))
+
+		// the objects of the controller topology are linked
+		configMapObject, found := lo.Find(reconciled.Objects().Items(), func(o machinery.Object) bool {
+			return o.GroupVersionKind().GroupKind() == configMapGroupKind
+		})
+		assert.Assert(subT, found)
+		assert.Assert(subT, lo.ContainsBy(reconciled.All().Parents(configMapObject), func(o machinery.Object) bool {
+			return o.GroupVersionKind().GroupKind() == machinery.GatewayGroupKind
+		}))
+	})
+
+	t.Run("grpc routes deleted", func(subT *testing.T) {
+		topology := controllerTopology()
+		deleted := controller.ResourceEvent{Kind: machinery.GRPCRouteGroupKind, EventType: controller.DeleteEvent, OldObject: grpcRoute}
+		assert.NilError(subT, reconcile(context.Background(), []controller.ResourceEvent{deleted}, topology, nil, &sync.Map{}))
+		assert.Assert(subT, reconciled == topology)
+	})
+}
@@ -28,8 +28,9 @@ func (e ErrInvalidPath) Error() string {
 }
 
 // ObjectsInRequestPath returns the objects in a data plane path converted to their respective types
+// The route and route rule are either a HTTPRoute and a HTTPRouteRule or a GRPCRoute and a GRPCRouteRule.
 // The last returned value is an error that indicates if the path is valid if present.
-func ObjectsInRequestPath(path []machinery.Targetable) (*machinery.GatewayClass, *machinery.Gateway, *machinery.Listener, *machinery.HTTPRoute, *machinery.HTTPRouteRule, error) {
+func ObjectsInRequestPath(path []machinery.Targetable) (*machinery.GatewayClass, *machinery.Gateway, *machinery.Listener, machinery.Targetable, machinery.Targetable, error) {
 	if len(path) == 0 {
 		return nil, nil, nil, nil, nil, NewErrInvalidPath("empty path")
 	}
@@ -55,15 +56,23 @@ func ObjectsInRequestPath(path []machinery.Targetable) (*machinery.GatewayClass,
 		return gatewayClass, gateway, listener, nil, nil, NewErrInvalidPath("listener does not belong to the gateway")
 	}
 
-	httpRoute, ok := path[3].(*machinery.HTTPRoute)
-	if !ok {
-		return gatewayClass, gateway, listener, nil, nil, NewErrInvalidPath("index 3 is not a HTTPRoute")
+	route := path[3]
+	var routeKind string
+	var parentRefs []gatewayapiv1.ParentReference
+	var routeHostnames []gatewayapiv1.Hostname
+	switch r := route.(type) {
+	case *machinery.HTTPRoute:
+		routeKind, parentRefs, routeHostnames = "http", r.Spec.ParentRefs, r.Spec.Hostnames
+	case *machinery.GRPCRoute:
+		routeKind, parentRefs, routeHostnames = "grpc", r.Spec.ParentRefs, r.Spec.Hostnames
+	default:
+		return gatewayClass, gateway, listener, nil, nil, NewErrInvalidPath("index 3 is not a HTTPRoute or GRPCRoute")
 	}
-	if !lo.ContainsBy(httpRoute.Spec.ParentRefs, func(ref gatewayapiv1.ParentReference) bool {
+	if !lo.ContainsBy(parentRefs, func(ref gatewayapiv1.ParentReference) bool {
 		gateway := listener.Gateway
 		defaultGroup := gatewayapiv1.Group(gatewayapiv1.GroupName)
 		defaultKind := gatewayapiv1.Kind(machinery.GatewayGroupKind.Kind)
-		defaultNamespace := gatewayapiv1.Namespace(httpRoute.GetNamespace())
+		defaultNamespace := gatewayapiv1.Namespace(route.GetNamespace())
 		if ptr.Deref(ref.Group, defaultGroup) != gatewayapiv1.Group(gateway.GroupVersionKind().Group) || ptr.Deref(ref.Kind, defaultKind) != gatewayapiv1.Kind(gateway.GroupVersionKind().Kind) || ptr.Deref(ref.Namespace, defaultNamespace) != gatewayapiv1.Namespace(gateway.GetNamespace()) || ref.Name != gatewayapiv1.ObjectName(gateway.GetName()) {
 			return false
 		}
@@ -74,8 +83,8 @@ func ObjectsInRequestPath(path []machinery.Targetable) (*machinery.GatewayClass,
 		if listener.Hostname != nil {
 			hostnameSupersets = []gatewayapiv1.Hostname{*(listener.Hostname)}
 		}
-		if len(httpRoute.Spec.Hostnames) > 0 {
-			return lo.SomeBy(httpRoute.Spec.Hostnames, func(routeHostname gatewayapiv1.Hostname) bool {
+		if len(routeHostnames) > 0 {
+			return lo.SomeBy(routeHostnames, func(routeHostname gatewayapiv1.Hostname) bool {
 				return lo.SomeBy(hostnameSupersets, func(hostnameSuperset gatewayapiv1.Hostname) bool {
 					return utils.Name(routeHostname).SubsetOf(utils.Name(hostnameSuperset))
 				})
@@ -83,18 +92,28 @@ func ObjectsInRequestPath(path []machinery.Targetable) (*machinery.GatewayClass,
 		}
 		return true
 	}) {
-		return gatewayClass, gateway, listener, httpRoute, nil, NewErrInvalidPath("http route does not belong to the listener")
+		return gatewayClass, gateway, listener, route, nil, NewErrInvalidPath(fmt.Sprintf("%s route does not belong to the listener", routeKind))
 	}
 
-	httpRouteRule, ok := path[4].(*machinery.HTTPRouteRule)
-	if !ok {
-		return gatewayClass, gateway, listener, httpRoute, nil, NewErrInvalidPath("index 4 is not a HTTPRouteRule")
+	routeRule := path[4]
+	var ruleRoute machinery.Object
+	switch r := routeRule.(type) {
+	case *machinery.HTTPRouteRule:
+		if r.HTTPRoute != nil {
+			ruleRoute = r.HTTPRoute
+		}
+	case *machinery.GRPCRouteRule:
+		if r.GRPCRoute != nil {
+			ruleRoute = r.GRPCRoute
+		}
+	default:
+		return gatewayClass, gateway, listener, route, nil, NewErrInvalidPath("index 4 is not a HTTPRouteRule or GRPCRouteRule")
 	}
-	if httpRouteRule.HTTPRoute == nil || httpRouteRule.HTTPRoute.GetNamespace() != httpRoute.GetNamespace() || httpRouteRule.HTTPRoute.GetName() != httpRoute.GetName() {
-		return gatewayClass, gateway, listener, httpRoute, httpRouteRule, NewErrInvalidPath("http route rule does not belong to the http route")
+	if ruleRoute == nil || ruleRoute.GetLocator() != route.GetLocator() {
+		return gatewayClass, gateway, listener, route, routeRule, NewErrInvalidPath(fmt.Sprintf("%[1]s route rule does not belong to the %[1]s route", routeKind))
 	}
 
-	return gatewayClass, gateway, listener, httpRoute, httpRouteRule, nil
+	return gatewayClass, gateway, listener, route, routeRule, nil
 }
 
 // NamespacedNameFromLocator returns a k8s namespaced name from a Policy Machinery object locator
@@ -109,3 +128,12 @@ func NamespacedNameFromLocator(locator string) (k8stypes.NamespacedName, error)
 	}
 	return k8stypes.NamespacedName{Namespace: namespacedName[0], Name: namespacedName[1]}, nil
 }
+
+// IsRouteRule returns true if the object is a HTTPRouteRule or a GRPCRouteRule
+func IsRouteRule(o machinery.Object) bool {
+	switch o.(type) {
+	case *machinery.HTTPRouteRule, *machinery.GRPCRouteRule:
+		return true
+	}
+	return false
+}
@@ -209,14 +209,68 @@ func TestObjectsInRequestPath(t *testing.T) {
 		r.Spec.Hostnames = []gatewayapiv1.Hostname{"other.org"}
 	})
 
+	grpcRoute := func(parent *machinery.Gateway, mutate ...func(*machinery.GRPCRoute)) *machinery.GRPCRoute {
+		o := &machinery.GRPCRoute{
+			GRPCRoute: &gatewayapiv1.GRPCRoute{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gatewayapiv1.SchemeGroupVersion.String(),
+					Kind:       machinery.GRPCRouteGroupKind.Kind,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "example",
+					Namespace: "default",
+				},
+				Spec: gatewayapiv1.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+						ParentRefs: []gatewayapiv1.ParentReference{
+							{
+								Name:      gatewayapiv1.ObjectName(parent.GetName()),
+								Namespace: ptr.To(gatewayapiv1.Namespace(parent.GetNamespace())),
+							},
+						},
+					},
+					Hostnames: []gatewayapiv1.Hostname{"*.example.com"},
+					Rules: []gatewayapiv1.GRPCRouteRule{
+						{
+							Matches: []gatewayapiv1.GRPCRouteMatch{
+								{
+									Method: &gatewayapiv1.GRPCMethodMatch{Service: ptr.To("foo.Foo")},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		for _, m := range mutate {
+			m(o)
+		}
+		return o
+	}
+
+	grpcRouteRule := func(r *machinery.GRPCRoute) *machinery.GRPCRouteRule {
+		return &machinery.GRPCRouteRule{
+			Name:          "rule-1",
+			GRPCRoute:     r,
+			GRPCRouteRule: &r.Spec.Rules[0],
+		}
+	}
+
+	gr := grpcRoute(g)
+	grr := grpcRouteRule(gr)
+	otherGRPCRoute := grpcRoute(otherGateway, func(r *machinery.GRPCRoute) {
+		r.ObjectMeta.Name = "other"
+	})
+	otherGRPCRouteRule := grpcRouteRule(otherGRPCRoute)
+
 	testCase := []struct {
 		name                  string
 		path                  []machinery.Targetable
 		expectedGatewayClass  *machinery.GatewayClass
 		expectedGateway       *machinery.Gateway
 		expectedListener      *machinery.Listener
-		expectedHTTPRoute     *machinery.HTTPRoute
-		expectedHTTPRouteRule *machinery.HTTPRouteRule
+		expectedHTTPRoute     machinery.Targetable
+		expectedHTTPRouteRule machinery.Targetable
 		expectedError         error
 	}{
 		{
@@ -246,6 +300,15 @@ func TestObjectsInRequestPath(t *testing.T) {
 			expectedHTTPRoute:     routeWithSectionName,
 			expectedHTTPRouteRule: routeRuleWithSectionName,
 		},
+		{
+			name:                  "valid path with grpc route",
+			path:                  []machinery.Targetable{gc, g, l, gr, grr},
+			expectedGatewayClass:  gc,
+			expectedGateway:       g,
+			expectedListener:      l,
+			expectedHTTPRoute:     gr,
+			expectedHTTPRouteRule: grr,
+		},
 		{
 			name:                 "gateway does not belong to the gateway class",
 			path:                 []machinery.Targetable{gc, otherGateway, l, r, rr},
@@ -316,6 +379,35 @@ func TestObjectsInRequestPath(t *testing.T) {
 			expectedHTTPRoute:     r,
 			expectedHTTPRouteRule: otherRouteRule,
 		},
+		{
+			name:                 "grpc route does not belong to the listener",
+			path:                 []machinery.Targetable{gc, g, l, otherGRPCRoute, otherGRPCRouteRule},
+			expectedError:        NewErrInvalidPath("grpc route does not belong to the listener"),
+			expectedGatewayClass: gc,
+			expectedGateway:      g,
+			expectedListener:     l,
+			expectedHTTPRoute:    otherGRPCRoute,
+		},
+		{
+			name:                  "grpc route rule does not belong to the grpc route",
+			path:                  []machinery.Targetable{gc, g, l, gr, otherGRPCRouteRule},
+			expectedError:         NewErrInvalidPath("grpc route rule does not belong to the grpc route"),
+			expectedGatewayClass:  gc,
+			expectedGateway:       g,
+			expectedListener:      l,
+			expectedHTTPRoute:     gr,
+			expectedHTTPRouteRule: otherGRPCRouteRule,
+		},
+		{
+			name:                  "http route rule in grpc route path",
+			path:                  []machinery.Targetable{gc, g, l, gr, rr},
+			expectedError:         NewErrInvalidPath("grpc route rule does not belong to the grpc route"),
+			expectedGatewayClass:  gc,
+			expectedGateway:       g,
+			expectedListener:      l,
+			expectedHTTPRoute:     gr,
+			expectedHTTPRouteRule: rr,
+		},
 		{
 			name:          "invalid gateway class",
 			path:          []machinery.Targetable{rr, g, l, r, rr},
@@ -337,7 +429,7 @@ func TestObjectsInRequestPath(t *testing.T) {
 		{
 			name:                 "invalid http route",
 			path:                 []machinery.Targetable{gc, g, l, rr, rr},
-			expectedError:        NewErrInvalidPath("index 3 is not a HTTPRoute"),
+			expectedError:        NewErrInvalidPath("index 3 is not a HTTPRoute or GRPCRoute"),
 			expectedGatewayClass: gc,
 			expectedGateway:      g,
 			expectedListener:     l,
@@ -345,7 +437,7 @@ func TestObjectsInRequestPath(t *testing.T) {
 		{
 			name:                 "invalid http route rule",
 			path:                 []machinery.Targetable{gc, g, l, r, gc},
-			expectedError:        NewErrInvalidPath("index 4 is not a HTTPRouteRule"),
+			expectedError:        NewErrInvalidPath("index 4 is not a HTTPRouteRule or GRPCRouteRule"),
 			expectedGatewayClass: gc,
 			expectedGateway:      g,
 			expectedListener:     l,
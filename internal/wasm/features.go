@@ -0,0 +1,69 @@
+package wasm
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/samber/lo"
+	"k8s.io/utils/env"
+)
+
+// ShimFeature is a feature of the wasm-shim that is not supported by all of its versions
+type ShimFeature string
+
+const (
+	// ShimFeatureActionMode is the mode of the actions, to record the decision of a service without enforcing it
+	ShimFeatureActionMode ShimFeature = "action-mode"
+	// ShimFeatureEventStreamResponseBody is the event-stream format of the response body of the actions, to read the
+	// response body expressions from streamed responses
+	ShimFeatureEventStreamResponseBody ShimFeature = "event-stream-response-body"
+	// ShimFeatureResponseData is the data of the config evaluated once the upstream has responded
+	ShimFeatureResponseData ShimFeature = "response-data"
+)
+
+// ShimImageURL is the URL of the wasm-shim image deployed to the gateways
+var ShimImageURL = env.GetString("RELATED_IMAGE_WASMSHIM", "oci://quay.io/kuadrant/wasm-shim:latest")
+
+// shimFeatureVersions are the first releases of the wasm-shim that support each feature.
+// Features without a release that supports them are only enabled by the WASM_SHIM_FEATURES env var.
+var shimFeatureVersions = map[ShimFeature]*semver.Version{}
+
+// ShimFeatureSupported tells whether the wasm-shim of an image supports a feature. The version of the wasm-shim is
+// read from the tag of the image. The features supported by images tagged with other than a semantic version,
+// e.g. latest, must be listed in the WASM_SHIM_FEATURES env var, separated by commas.
+func ShimFeatureSupported(imageURL string, feature ShimFeature) bool {
+	if lo.Contains(enabledShimFeatures(), feature) {
+		return true
+	}
+	minVersion, ok := shimFeatureVersions[feature]
+	if !ok {
+		return false
+	}
+	version := shimVersion(imageURL)
+	return version != nil && !version.LessThan(minVersion)
+}
+
+func enabledShimFeatures() []ShimFeature {
+	features := strings.Split(env.GetString("WASM_SHIM_FEATURES", ""), ",")
+	return lo.FilterMap(features, func(feature string, _ int) (ShimFeature, bool) {
+		feature = strings.TrimSpace(feature)
+		return ShimFeature(feature), feature != ""
+	})
+}
+
+// shimVersion returns the semantic version in the tag of a wasm-shim image, if any
+func shimVersion(imageURL string) *semver.Version {
+	if strings.Contains(imageURL, "@") { // image referenced by digest
+		return nil
+	}
+	name := imageURL[strings.LastIndex(imageURL, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	if i < 0 {
+		return nil
+	}
+	version, err := semver.NewVersion(name[i+1:])
+	if err != nil {
+		return nil
+	}
+	return version
+}
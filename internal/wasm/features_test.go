@@ -0,0 +1,65 @@
+//go:build unit
+
+package wasm
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"gotest.tools/assert"
+)
+
+func TestShimFeatureSupported(t *testing.T) {
+	const feature ShimFeature = "test-feature"
+	shimFeatureVersions[feature] = semver.MustParse("0.12.0")
+	defer delete(shimFeatureVersions, feature)
+
+	testCases := []struct {
+		name     string
+		imageURL string
+		features string
+		expected bool
+	}{
+		{
+			name:     "version that supports the feature",
+			imageURL: "oci://quay.io/kuadrant/wasm-shim:v0.12.0",
+			expected: true,
+		},
+		{
+			name:     "later version",
+			imageURL: "oci://registry.example.com:5000/kuadrant/wasm-shim:v1.0.1",
+			expected: true,
+		},
+		{
+			name:     "earlier version",
+			imageURL: "oci://quay.io/kuadrant/wasm-shim:v0.11.0",
+			expected: false,
+		},
+		{
+			name:     "tag without version",
+			imageURL: "oci://quay.io/kuadrant/wasm-shim:latest",
+			expected: false,
+		},
+		{
+			name:     "digest",
+			imageURL: "oci://quay.io/kuadrant/wasm-shim@sha256:bc3a9d4f50bd4b33cc3d3e3b8d4f0f9f7ae01a06b8ef5cf8c4c3e4b1a1b3d2c1",
+			expected: false,
+		},
+		{
+			name:     "enabled by the env var",
+			imageURL: "oci://quay.io/kuadrant/wasm-shim:latest",
+			features: "other-feature, test-feature",
+			expected: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(subT *testing.T) {
+			subT.Setenv("WASM_SHIM_FEATURES", tc.features)
+			assert.Equal(subT, ShimFeatureSupported(tc.imageURL, feature), tc.expected)
+		})
+	}
+
+	t.Run("feature without release", func(subT *testing.T) {
+		assert.Assert(subT, !ShimFeatureSupported("oci://quay.io/kuadrant/wasm-shim:v99.0.0", ShimFeatureActionMode))
+	})
+}
@@ -12,9 +12,11 @@ import (
 )
 
 type Config struct {
-	RequestData map[string]string  `json:"requestData,omitempty"`
-	Services    map[string]Service `json:"services"`
-	ActionSets  []ActionSet        `json:"actionSets"`
+	RequestData map[string]string `json:"requestData,omitempty"`
+	// ResponseData is evaluated once the upstream has responded, so it can refer to the response attributes
+	ResponseData map[string]string  `json:"responseData,omitempty"`
+	Services     map[string]Service `json:"services"`
+	ActionSets   []ActionSet        `json:"actionSets"`
 }
 
 func (c *Config) ToStruct() (*_struct.Struct, error) {
@@ -40,7 +42,7 @@ func (c *Config) ToJSON() (*apiextensionsv1.JSON, error) {
 }
 
 func (c *Config) EqualTo(other *Config) bool {
-	if len(c.RequestData) != len(other.RequestData) || len(c.Services) != len(other.Services) || len(c.ActionSets) != len(other.ActionSets) {
+	if len(c.RequestData) != len(other.RequestData) || len(c.ResponseData) != len(other.ResponseData) || len(c.Services) != len(other.Services) || len(c.ActionSets) != len(other.ActionSets) {
 		return false
 	}
 
@@ -50,6 +52,12 @@ func (c *Config) EqualTo(other *Config) bool {
 		}
 	}
 
+	for key, data := range c.ResponseData {
+		if otherData, ok := other.ResponseData[key]; !ok || data != otherData {
+			return false
+		}
+	}
+
 	for key, service := range c.Services {
 		if otherService, ok := other.Services[key]; !ok || !service.EqualTo(otherService) {
 			return false
@@ -153,12 +161,55 @@ func (r *RouteRuleConditions) EqualTo(other RouteRuleConditions) bool {
 	return true
 }
 
+// +kubebuilder:validation:Enum:=json;event-stream
+type ResponseBodyFormat string
+
+const (
+	// ResponseBodyFormatJSON reads the response body as a single JSON document
+	ResponseBodyFormatJSON ResponseBodyFormat = "json"
+	// ResponseBodyFormatEventStream reads `text/event-stream` response bodies as a stream of server-sent events,
+	// evaluating the response body expressions against the last event carrying a JSON payload.
+	// Responses of any other content type are read as a single JSON document.
+	ResponseBodyFormatEventStream ResponseBodyFormat = "event-stream"
+)
+
+// +kubebuilder:validation:Enum:=enforce;shadow
+type ActionMode string
+
+const (
+	// ActionModeEnforce denies the request when the service says so
+	ActionModeEnforce ActionMode = "enforce"
+	// ActionModeShadow calls the service and records its decision (logs and metrics), but never denies the request
+	ActionModeShadow ActionMode = "shadow"
+)
+
+// ServiceOverrides sets the failure mode and the timeout of the service called by an action, instead of the defaults
+type ServiceOverrides struct {
+	// Service is the name of the default service whose settings are overridden
+	Service     string
+	FailureMode FailureModeType
+	Timeout     *string
+}
+
 type Action struct {
 	ServiceName string `json:"service"`
 	Scope       string `json:"scope"`
 
 	Predicates []string `json:"predicates,omitempty"`
 
+	// Mode sets whether the decision of the service is enforced.
+	// Defaults to enforce
+	// +optional
+	Mode ActionMode `json:"mode,omitempty"`
+
+	// ResponseBodyFormat sets how the response body is read by the expressions of the action.
+	// Defaults to json
+	// +optional
+	ResponseBodyFormat ResponseBodyFormat `json:"responseBodyFormat,omitempty"`
+
+	// ServiceOverrides holds the settings of the service called by the action, when other than the defaults (internal use)
+	ServiceOverrides *ServiceOverrides `json:"-"`
+
 	// ConditionalData data contains the predicates and data that will be sent to the service
 	// +optional
 	ConditionalData []ConditionalData `json:"conditionalData,omitempty"`
@@ -214,9 +265,34 @@ func (c *ConditionalData) EqualTo(other ConditionalData) bool {
 	return true
 }
 
+// WithServiceOverrides points the action to a variant of its service with the given failure mode and timeout.
+// Unset settings keep the defaults of the service. Actions with the same overrides share the same service.
+func (a Action) WithServiceOverrides(failureMode FailureModeType, timeout *string) Action {
+	if failureMode == "" && timeout == nil {
+		return a
+	}
+	a.ServiceOverrides = &ServiceOverrides{
+		Service:     a.ServiceName,
+		FailureMode: failureMode,
+		Timeout:     timeout,
+	}
+	a.ServiceName = ServiceNameWithOverrides(a.ServiceName, failureMode, timeout)
+	return a
+}
+
+// BaseServiceName returns the name of the default service called by the action, regardless of any overrides
+func (a *Action) BaseServiceName() string {
+	if a.ServiceOverrides != nil {
+		return a.ServiceOverrides.Service
+	}
+	return a.ServiceName
+}
+
 func (a *Action) EqualTo(other Action) bool {
 	if a.Scope != other.Scope ||
 		a.ServiceName != other.ServiceName ||
+		a.Mode != other.Mode ||
+		a.ResponseBodyFormat != other.ResponseBodyFormat ||
 		len(a.ConditionalData) != len(other.ConditionalData) {
 		return false
 	}
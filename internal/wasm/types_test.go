@@ -199,6 +199,19 @@ func TestValidAction(t *testing.T) {
 			yaml: `
 service: ratelimit-service
 scope: some-scope
+`,
+		},
+		{
+			name: "event stream response body",
+			expectedAction: &Action{
+				ServiceName:        "ratelimit-report",
+				Scope:              "some-scope",
+				ResponseBodyFormat: ResponseBodyFormatEventStream,
+			},
+			yaml: `
+service: ratelimit-report
+scope: some-scope
+responseBodyFormat: event-stream
 `,
 		},
 	}
@@ -28,7 +28,11 @@ const (
 	RateLimitServiceName       = "ratelimit-service"
 	RateLimitCheckServiceName  = "ratelimit-check-service"
 	RateLimitReportServiceName = "ratelimit-report-service"
+	RateLimitShadowServiceName = "ratelimit-shadow-service"
 	AuthServiceName            = "auth-service"
+
+	// HitsAddendKey is the data key holding the amount by which the counters of a rate limit are incremented
+	HitsAddendKey = "ratelimit.hits_addend"
 )
 
 func AuthServiceTimeout() string {
@@ -82,46 +86,109 @@ func ExtensionName(gatewayName string) string {
 	return fmt.Sprintf("kuadrant-%s", gatewayName)
 }
 
-func BuildConfigForActionSet(actionSets []ActionSet, logger *logr.Logger) Config {
+// ServiceNameWithOverrides returns the name of the variant of a service with the given failure mode and timeout
+func ServiceNameWithOverrides(name string, failureMode FailureModeType, timeout *string) string {
+	parts := []string{name}
+	if failureMode != "" {
+		parts = append(parts, string(failureMode))
+	}
+	if timeout != nil {
+		parts = append(parts, *timeout)
+	}
+	return strings.Join(parts, "-")
+}
+
+// ServiceClusters are the names of the clusters of the services called by the wasm module of a gateway
+type ServiceClusters struct {
+	Auth      string
+	RateLimit string
+}
+
+// DefaultServiceClusters returns the clusters of the services managed by kuadrant
+func DefaultServiceClusters() ServiceClusters {
+	return ServiceClusters{
+		Auth:      kuadrant.KuadrantAuthClusterName,
+		RateLimit: kuadrant.KuadrantRateLimitClusterName,
+	}
+}
+
+func BuildConfigForActionSet(actionSets []ActionSet, clusters ServiceClusters, logger *logr.Logger) Config {
+	services := defaultServices(clusters, logger)
+
+	// variants of the default services with the failure mode and timeout overridden by the policies
+	for _, actionSet := range actionSets {
+		for _, action := range actionSet.Actions {
+			overrides := action.ServiceOverrides
+			if overrides == nil {
+				continue
+			}
+			if _, exists := services[action.ServiceName]; exists {
+				continue
+			}
+			service, ok := services[overrides.Service]
+			if !ok { // should never happen
+				continue
+			}
+			if overrides.FailureMode != "" {
+				service.FailureMode = overrides.FailureMode
+			}
+			if overrides.Timeout != nil {
+				service.Timeout = overrides.Timeout
+			}
+			services[action.ServiceName] = service
+		}
+	}
+
 	return Config{
-		Services: map[string]Service{
-			AuthServiceName: {
-				Type:        AuthServiceType,
-				Endpoint:    kuadrant.KuadrantAuthClusterName,
-				FailureMode: AuthServiceFailureMode(logger),
-				Timeout:     ptr.To(AuthServiceTimeout()),
-			},
-			RateLimitServiceName: {
-				Type:        RateLimitServiceType,
-				Endpoint:    kuadrant.KuadrantRateLimitClusterName,
-				FailureMode: RatelimitServiceFailureMode(logger),
-				Timeout:     ptr.To(RatelimitServiceTimeout()),
-			},
-			RateLimitCheckServiceName: {
-				Type:        RateLimitCheckServiceType,
-				Endpoint:    kuadrant.KuadrantRateLimitClusterName,
-				FailureMode: RatelimitCheckServiceFailureMode(logger),
-				Timeout:     ptr.To(RatelimitCheckServiceTimeout()),
-			},
-			RateLimitReportServiceName: {
-				Type:        RateLimitReportServiceType,
-				Endpoint:    kuadrant.KuadrantRateLimitClusterName,
-				FailureMode: RatelimitReportServiceFailureMode(logger),
-				Timeout:     ptr.To(RatelimitReportServiceTimeout()),
-			},
-		},
+		Services:   services,
 		ActionSets: actionSets,
 	}
 }
 
+func defaultServices(clusters ServiceClusters, logger *logr.Logger) map[string]Service {
+	return map[string]Service{
+		AuthServiceName: {
+			Type:        AuthServiceType,
+			Endpoint:    clusters.Auth,
+			FailureMode: AuthServiceFailureMode(logger),
+			Timeout:     ptr.To(AuthServiceTimeout()),
+		},
+		RateLimitServiceName: {
+			Type:        RateLimitServiceType,
+			Endpoint:    clusters.RateLimit,
+			FailureMode: RatelimitServiceFailureMode(logger),
+			Timeout:     ptr.To(RatelimitServiceTimeout()),
+		},
+		// reports the hits of the limits in shadow mode, which are counted but never checked, with the settings of the ratelimit service
+		RateLimitShadowServiceName: {
+			Type:        RateLimitReportServiceType,
+			Endpoint:    clusters.RateLimit,
+			FailureMode: RatelimitServiceFailureMode(logger),
+			Timeout:     ptr.To(RatelimitServiceTimeout()),
+		},
+		RateLimitCheckServiceName: {
+			Type:        RateLimitCheckServiceType,
+			Endpoint:    clusters.RateLimit,
+			FailureMode: RatelimitCheckServiceFailureMode(logger),
+			Timeout:     ptr.To(RatelimitCheckServiceTimeout()),
+		},
+		RateLimitReportServiceName: {
+			Type:        RateLimitReportServiceType,
+			Endpoint:    clusters.RateLimit,
+			FailureMode: RatelimitReportServiceFailureMode(logger),
+			Timeout:     ptr.To(RatelimitReportServiceTimeout()),
+		},
+	}
+}
+
 func BuildActionSetsForPath(pathID string, path []machinery.Targetable, actions []Action) ([]kuadrantgatewayapi.HTTPRouteMatchConfig, error) {
-	_, _, listener, httpRoute, httpRouteRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(path)
+	_, _, listener, route, routeRule, err := kuadrantpolicymachinery.ObjectsInRequestPath(path)
 	if err != nil {
 		return nil, err
 	}
 
-	return lo.FlatMap(kuadrantgatewayapi.HostnamesFromListenerAndHTTPRoute(listener.Listener, httpRoute.HTTPRoute), func(hostname gatewayapiv1.Hostname, _ int) []kuadrantgatewayapi.HTTPRouteMatchConfig {
-		return lo.Map(httpRouteRule.Matches, func(httpRouteMatch gatewayapiv1.HTTPRouteMatch, j int) kuadrantgatewayapi.HTTPRouteMatchConfig {
+	return lo.FlatMap(kuadrantgatewayapi.HostnamesFromListenerAndRoute(listener.Listener, route), func(hostname gatewayapiv1.Hostname, _ int) []kuadrantgatewayapi.HTTPRouteMatchConfig {
+		return lo.Map(kuadrantgatewayapi.RouteRuleMatches(routeRule), func(httpRouteMatch gatewayapiv1.HTTPRouteMatch, j int) kuadrantgatewayapi.HTTPRouteMatchConfig {
 			actionSet := ActionSet{
 				Name:    ActionSetNameForPath(pathID, j, string(hostname)),
 				Actions: actions,
@@ -136,9 +203,9 @@ func BuildActionSetsForPath(pathID string, path []machinery.Targetable, actions
 			return kuadrantgatewayapi.HTTPRouteMatchConfig{
 				Hostname:          string(hostname),
 				HTTPRouteMatch:    httpRouteMatch,
-				CreationTimestamp: httpRoute.GetCreationTimestamp(),
-				Namespace:         httpRoute.GetNamespace(),
-				Name:              httpRoute.GetName(),
+				CreationTimestamp: kuadrantgatewayapi.RouteObject(route).GetCreationTimestamp(),
+				Namespace:         route.GetNamespace(),
+				Name:              route.GetName(),
 				Config:            actionSet,
 			}
 		})
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"gotest.tools/assert"
 	"k8s.io/utils/ptr"
 
@@ -14,6 +15,9 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/yaml"
+
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
+	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
 )
 
 var (
@@ -22,6 +26,9 @@ var (
 			"metrics.labels.user":  "auth.identity.user",
 			"metrics.labels.group": "auth.identity.group",
 		},
+		ResponseData: map[string]string{
+			"metrics.labels.status": "response.code",
+		},
 		Services: map[string]Service{
 			"auth-service": {
 				Type:        "auth",
@@ -138,11 +145,13 @@ var (
 			},
 		},
 	}
-	testBasicConfigJSON = `{"requestData":{"metrics.labels.group":"auth.identity.group","metrics.labels.user":"auth.identity.user"},"services":{"auth-service":{"type":"auth","endpoint":"kuadrant-auth-service","failureMode":"deny","timeout":"200ms"},"ratelimit-service":{"type":"ratelimit","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"},"ratelimit-check-service":{"type":"ratelimit-check","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"},"ratelimit-report-service":{"type":"ratelimit-report","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"}},"actionSets":[{"name":"5755da0b3c275ba6b8f553890eb32b04768a703b60ab9a5d7f4e0948e23ef0ab","routeRuleConditions":{"hostnames":["other.example.com"],"predicates":["request.url_path.startsWith('/')"]},"actions":[{"service":"ratelimit-service","scope":"default/other","conditionalData":[{"predicates":["source.address != \"127.0.0.1\""],"data":[{"static":{"key":"limit.global__f63bec56","value":"1"}}]}]}]},{"name":"21cb3adc608c09a360d62a03fd1afd7cc6f8720999a51d7916927fff26a34ef8","routeRuleConditions":{"hostnames":["*"],"predicates":["request.method == 'GET'","request.url_path.startsWith('/')"]},"actions":[{"service":"auth-service","scope":"e2db39952dd3bc72e152330a2eb15abbd9675c7ac6b54a1a292f07f25f09f138"},{"service":"ratelimit-service","scope":"default/toystore","conditionalData":[{"data":[{"static":{"key":"limit.specific__69ea4d2d","value":"1"}}]}]},{"service":"ratelimit-service","scope":"default/toystore","conditionalData":[{"predicates":["source.address != \"127.0.0.1\""],"data":[{"static":{"key":"limit.global__f63bec56","value":"1"}}]}]}]}]}`
+	testBasicConfigJSON = `{"requestData":{"metrics.labels.group":"auth.identity.group","metrics.labels.user":"auth.identity.user"},"responseData":{"metrics.labels.status":"response.code"},"services":{"auth-service":{"type":"auth","endpoint":"kuadrant-auth-service","failureMode":"deny","timeout":"200ms"},"ratelimit-service":{"type":"ratelimit","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"},"ratelimit-check-service":{"type":"ratelimit-check","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"},"ratelimit-report-service":{"type":"ratelimit-report","endpoint":"kuadrant-ratelimit-service","failureMode":"allow","timeout":"100ms"}},"actionSets":[{"name":"5755da0b3c275ba6b8f553890eb32b04768a703b60ab9a5d7f4e0948e23ef0ab","routeRuleConditions":{"hostnames":["other.example.com"],"predicates":["request.url_path.startsWith('/')"]},"actions":[{"service":"ratelimit-service","scope":"default/other","conditionalData":[{"predicates":["source.address != \"127.0.0.1\""],"data":[{"static":{"key":"limit.global__f63bec56","value":"1"}}]}]}]},{"name":"21cb3adc608c09a360d62a03fd1afd7cc6f8720999a51d7916927fff26a34ef8","routeRuleConditions":{"hostnames":["*"],"predicates":["request.method == 'GET'","request.url_path.startsWith('/')"]},"actions":[{"service":"auth-service","scope":"e2db39952dd3bc72e152330a2eb15abbd9675c7ac6b54a1a292f07f25f09f138"},{"service":"ratelimit-service","scope":"default/toystore","conditionalData":[{"data":[{"static":{"key":"limit.specific__69ea4d2d","value":"1"}}]}]},{"service":"ratelimit-service","scope":"default/toystore","conditionalData":[{"predicates":["source.address != \"127.0.0.1\""],"data":[{"static":{"key":"limit.global__f63bec56","value":"1"}}]}]}]}]}`
 	testBasicConfigYAML = `
 requestData:
   metrics.labels.user: auth.identity.user
   metrics.labels.group: auth.identity.group
+responseData:
+  metrics.labels.status: response.code
 services:
   auth-service:
     type: auth
@@ -336,3 +345,73 @@ func TestPredicatesFromHTTPRouteMatch(t *testing.T) {
 	assert.Equal(t, predicates[4], "'kua' in queryMap(request.query) ? queryMap(request.query)['kua'] == 'drant' : false")
 	assert.Equal(t, len(predicates), 5)
 }
+
+func TestPredicatesFromGRPCRouteMatch(t *testing.T) {
+	predicates := PredicatesFromHTTPRouteMatch(kuadrantgatewayapi.HTTPRouteMatchFromGRPCRouteMatch(gatewayapiv1.GRPCRouteMatch{
+		Method: &gatewayapiv1.GRPCMethodMatch{
+			Service: ptr.To("helloworld.Greeter"),
+			Method:  ptr.To("SayHello"),
+		},
+		Headers: []gatewayapiv1.GRPCHeaderMatch{
+			{
+				Name:  "X-Auth",
+				Value: "kuadrant",
+			},
+		},
+	}))
+
+	assert.Equal(t, predicates[0], "request.url_path == '/helloworld.Greeter/SayHello'")
+	assert.Equal(t, predicates[1], "request.headers.exists(h, h.lowerAscii() == 'x-auth' && request.headers[h] == 'kuadrant')")
+	assert.Equal(t, len(predicates), 2)
+}
+
+func TestBuildConfigForActionSetWithServiceOverrides(t *testing.T) {
+	logger := logr.Discard()
+
+	enforced := Action{ServiceName: RateLimitServiceName, Scope: "default/payments"}.WithServiceOverrides(FailureModeDeny, ptr.To("500ms"))
+	timeoutOnly := Action{ServiceName: AuthServiceName, Scope: "docs"}.WithServiceOverrides("", ptr.To("1s"))
+	noOverrides := Action{ServiceName: RateLimitServiceName, Scope: "default/docs"}.WithServiceOverrides("", nil)
+
+	assert.Equal(t, enforced.ServiceName, "ratelimit-service-deny-500ms")
+	assert.Equal(t, timeoutOnly.ServiceName, "auth-service-1s")
+	assert.Equal(t, noOverrides.ServiceName, RateLimitServiceName)
+	assert.Assert(t, noOverrides.ServiceOverrides == nil)
+
+	config := BuildConfigForActionSet([]ActionSet{{Name: "a", Actions: []Action{enforced, timeoutOnly, noOverrides}}}, DefaultServiceClusters(), &logger)
+
+	assert.Equal(t, len(config.Services), 7)
+
+	rateLimitService := config.Services[RateLimitServiceName]
+	assert.DeepEqual(t, config.Services["ratelimit-service-deny-500ms"], Service{
+		Type:        RateLimitServiceType,
+		Endpoint:    rateLimitService.Endpoint,
+		FailureMode: FailureModeDeny,
+		Timeout:     ptr.To("500ms"),
+	})
+
+	authService := config.Services[AuthServiceName]
+	assert.DeepEqual(t, config.Services["auth-service-1s"], Service{
+		Type:        AuthServiceType,
+		Endpoint:    authService.Endpoint,
+		FailureMode: authService.FailureMode,
+		Timeout:     ptr.To("1s"),
+	})
+}
+
+func TestBuildConfigForActionSetWithServiceClusters(t *testing.T) {
+	logger := logr.Discard()
+
+	action := Action{ServiceName: RateLimitServiceName, Scope: "default/payments"}.WithServiceOverrides(FailureModeDeny, nil)
+	clusters := ServiceClusters{
+		Auth:      kuadrant.KuadrantAuthClusterName,
+		RateLimit: "limitador-tenant-a",
+	}
+
+	config := BuildConfigForActionSet([]ActionSet{{Name: "a", Actions: []Action{action}}}, clusters, &logger)
+
+	assert.Equal(t, config.Services[AuthServiceName].Endpoint, kuadrant.KuadrantAuthClusterName)
+	assert.Equal(t, config.Services[RateLimitServiceName].Endpoint, "limitador-tenant-a")
+	assert.Equal(t, config.Services[RateLimitCheckServiceName].Endpoint, "limitador-tenant-a")
+	assert.Equal(t, config.Services[RateLimitReportServiceName].Endpoint, "limitador-tenant-a")
+	assert.Equal(t, config.Services["ratelimit-service-deny"].Endpoint, "limitador-tenant-a")
+}
@@ -1,35 +1,47 @@
 package kuadrant
 
 import (
-	"math"
-
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
 	"github.com/kuadrant/policy-machinery/machinery"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	v1 "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
+// LatestVersion is the latest version of the library, available through the `__KUADRANT_VERSION` constant
+const LatestVersion uint32 = 1
+
+// CelExt returns the latest version of the library
 func CelExt(dag DAG) cel.EnvOption {
+	return CelExtVersion(dag, LatestVersion)
+}
+
+// CelExtVersion returns a given version of the library
+func CelExtVersion(dag DAG, version uint32) cel.EnvOption {
 	l := &kuadrantLib{
 		dag:     dag,
-		version: math.MaxUint32,
-		dev:     true,
+		version: version,
 	}
 	return cel.Lib(l)
 }
 
 type DAG interface {
 	FindGatewaysFor([]*v1.TargetRef) ([]*v1.Gateway, error)
+	FindListenersFor([]*v1.TargetRef) ([]*v1.GatewayListener, error)
+	FindHTTPRoutesFor([]*v1.TargetRef) ([]*v1.HTTPRoute, error)
 	FindPoliciesFor([]*v1.TargetRef, machinery.Policy) ([]*v1.Policy, error)
+	FindEffectivePoliciesFor([]*v1.TargetRef, machinery.Policy) ([]*v1.EffectivePolicy, error)
+	Kuadrant() (*v1.Kuadrant, error)
 }
 
 type kuadrantLib struct {
 	dag     DAG
 	version uint32
-	dev     bool
 }
 
 func (l kuadrantLib) CompileOptions() []cel.EnvOption {
@@ -37,84 +49,11 @@ func (l kuadrantLib) CompileOptions() []cel.EnvOption {
 
 	constVersion := "0"
 
-	// eventually add functions for v1 here:
-	if l.version >= 1 {
-		constVersion = "1"
-	}
-
-	// only dev adds anything for now really
 	registry := getRegistryWithTypes()
 
-	if l.dev {
-		opts = append(opts,
-			cel.Function("findGateways",
-				cel.MemberOverload("gateways_for_policy",
-					[]*cel.Type{
-						cel.ObjectType("kuadrant.v1.Policy"),
-					}, cel.ListType(cel.ObjectType("kuadrant.v1.Gateway")),
-					cel.UnaryBinding(func(arg ref.Val) ref.Val {
-						policy, err := refToProto[*v1.Policy](arg)
-						if err != nil {
-							return types.NewErr("pbError: %w", err)
-						}
-						gws, err := l.dag.FindGatewaysFor(policy.TargetRefs)
-						if err != nil {
-							return types.NewErr("cel-kuadrant(gateways_for_policy): %w", err)
-						}
-						list := make([]ref.Val, 0, len(gws))
-						for _, gw := range gws {
-							gateway :=
-								registry.NativeToValue(gw)
-							list = append(list, gateway)
-						}
-						return registry.NativeToValue(list)
-					})),
-				cel.MemberOverload("gateways_for_target",
-					[]*cel.Type{
-						cel.ObjectType("kuadrant.v1.TargetRef"),
-					}, cel.ListType(cel.ObjectType("kuadrant.v1.Gateway")),
-					cel.UnaryBinding(func(arg ref.Val) ref.Val {
-						target, err := refToProto[*v1.TargetRef](arg)
-						if err != nil {
-							return types.NewErr("pbError: %w", err)
-						}
-						gws, err := l.dag.FindGatewaysFor([]*v1.TargetRef{target})
-						if err != nil {
-							return types.NewErr("cel-kuadrant(gateways_for_target): %w", err)
-						}
-						list := make([]ref.Val, 0, len(gws))
-						for _, gw := range gws {
-							gateway :=
-								registry.NativeToValue(gw)
-							list = append(list, gateway)
-						}
-						return registry.NativeToValue(list)
-					})),
-			),
-			cel.Function("findAuthPolicies",
-				cel.MemberOverload("authpolicies_for_policy",
-					[]*cel.Type{
-						cel.ObjectType("kuadrant.v1.Policy"),
-					}, cel.ListType(cel.ObjectType("kuadrant.v1.Policy")),
-					cel.UnaryBinding(func(arg ref.Val) ref.Val {
-						policy, err := refToProto[*v1.Policy](arg)
-						if err != nil {
-							return types.NewErr("pbError: %w", err)
-						}
-						policies, err := l.dag.FindPoliciesFor(policy.TargetRefs, &kuadrantv1.AuthPolicy{})
-						if err != nil {
-							return types.NewErr("cel-kuadrant(authpolicies_for_policy): %w", err)
-						}
-						list := make([]ref.Val, 0, len(policies))
-						for _, pol := range policies {
-							outPolicy := registry.NativeToValue(pol)
-							list = append(list, outPolicy)
-						}
-						return registry.NativeToValue(list)
-					})),
-			),
-		)
-		constVersion = constVersion + "_dev"
+	if l.version >= 1 {
+		constVersion = "1"
+		opts = append(opts, l.v1Functions(registry)...)
 	}
 
 	opts = append(opts,
@@ -128,6 +67,102 @@ func (l kuadrantLib) CompileOptions() []cel.EnvOption {
 	return opts
 }
 
+func (l kuadrantLib) v1Functions(registry *types.Registry) []cel.EnvOption {
+	policyType := cel.ObjectType("kuadrant.v1.Policy")
+	targetRefType := cel.ObjectType("kuadrant.v1.TargetRef")
+
+	return []cel.EnvOption{
+		cel.Function("findGateways",
+			memberOverload("gateways_for_policy", policyType, cel.ListType(cel.ObjectType("kuadrant.v1.Gateway")), policyTargetRefs, l.dag.FindGatewaysFor, registry),
+			memberOverload("gateways_for_target", targetRefType, cel.ListType(cel.ObjectType("kuadrant.v1.Gateway")), targetRefAsList, l.dag.FindGatewaysFor, registry),
+		),
+		cel.Function("findListeners",
+			memberOverload("listeners_for_policy", policyType, cel.ListType(cel.ObjectType("kuadrant.v1.GatewayListener")), policyTargetRefs, l.dag.FindListenersFor, registry),
+			memberOverload("listeners_for_target", targetRefType, cel.ListType(cel.ObjectType("kuadrant.v1.GatewayListener")), targetRefAsList, l.dag.FindListenersFor, registry),
+		),
+		cel.Function("findHTTPRoutes",
+			memberOverload("httproutes_for_policy", policyType, cel.ListType(cel.ObjectType("kuadrant.v1.HTTPRoute")), policyTargetRefs, l.dag.FindHTTPRoutesFor, registry),
+			memberOverload("httproutes_for_target", targetRefType, cel.ListType(cel.ObjectType("kuadrant.v1.HTTPRoute")), targetRefAsList, l.dag.FindHTTPRoutesFor, registry),
+		),
+		l.findPoliciesFunction("findAuthPolicies", "authpolicies_for_policy", &kuadrantv1.AuthPolicy{}, registry),
+		l.findPoliciesFunction("findRateLimitPolicies", "ratelimitpolicies_for_policy", &kuadrantv1.RateLimitPolicy{}, registry),
+		l.findPoliciesFunction("findTokenRateLimitPolicies", "tokenratelimitpolicies_for_policy", &kuadrantv1alpha1.TokenRateLimitPolicy{}, registry),
+		l.findPoliciesFunction("findDNSPolicies", "dnspolicies_for_policy", &kuadrantv1.DNSPolicy{}, registry),
+		l.findPoliciesFunction("findTLSPolicies", "tlspolicies_for_policy", &kuadrantv1.TLSPolicy{}, registry),
+		l.findEffectivePoliciesFunction("findEffectiveAuthPolicies", "effective_authpolicies_for_policy", &kuadrantv1.AuthPolicy{}, registry),
+		l.findEffectivePoliciesFunction("findEffectiveRateLimitPolicies", "effective_ratelimitpolicies_for_policy", &kuadrantv1.RateLimitPolicy{}, registry),
+		l.findEffectivePoliciesFunction("findEffectiveTokenRateLimitPolicies", "effective_tokenratelimitpolicies_for_policy", &kuadrantv1alpha1.TokenRateLimitPolicy{}, registry),
+		cel.Function("kuadrant",
+			cel.Overload("kuadrant",
+				[]*cel.Type{}, cel.ObjectType("kuadrant.v1.Kuadrant"),
+				cel.FunctionBinding(func(_ ...ref.Val) ref.Val {
+					kuadrant, err := l.dag.Kuadrant()
+					if err != nil {
+						return types.NewErr("cel-kuadrant(kuadrant): %w", err)
+					}
+					return registry.NativeToValue(kuadrant)
+				})),
+		),
+	}
+}
+
+// findPoliciesFunction declares a function listing the policies of a kind that attach to the targets of a policy
+func (l kuadrantLib) findPoliciesFunction(name, overloadID string, policyKind machinery.Policy, registry *types.Registry) cel.EnvOption {
+	find := func(targetRefs []*v1.TargetRef) ([]*v1.Policy, error) {
+		return l.dag.FindPoliciesFor(targetRefs, policyKind)
+	}
+	return cel.Function(name,
+		memberOverload(overloadID, cel.ObjectType("kuadrant.v1.Policy"), cel.ListType(cel.ObjectType("kuadrant.v1.Policy")), policyTargetRefs, find, registry),
+	)
+}
+
+// findEffectivePoliciesFunction declares a function listing the effective policies of a kind for the paths through the targets of a policy
+func (l kuadrantLib) findEffectivePoliciesFunction(name, overloadID string, policyKind machinery.Policy, registry *types.Registry) cel.EnvOption {
+	find := func(targetRefs []*v1.TargetRef) ([]*v1.EffectivePolicy, error) {
+		return l.dag.FindEffectivePoliciesFor(targetRefs, policyKind)
+	}
+	return cel.Function(name,
+		memberOverload(overloadID, cel.ObjectType("kuadrant.v1.Policy"), cel.ListType(cel.ObjectType("kuadrant.v1.EffectivePolicy")), policyTargetRefs, find, registry),
+	)
+}
+
+// memberOverload declares a member overload that looks up a list of objects in the DAG for the target references of its receiver
+func memberOverload[T proto.Message](overloadID string, receiverType, resultType *cel.Type, targetRefs func(ref.Val) ([]*v1.TargetRef, error), find func([]*v1.TargetRef) ([]T, error), registry *types.Registry) cel.FunctionOpt {
+	return cel.MemberOverload(overloadID,
+		[]*cel.Type{receiverType}, resultType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			refs, err := targetRefs(arg)
+			if err != nil {
+				return types.NewErr("pbError: %w", err)
+			}
+			objects, err := find(refs)
+			if err != nil {
+				return types.NewErr("cel-kuadrant(%s): %w", overloadID, err)
+			}
+			list := make([]ref.Val, 0, len(objects))
+			for _, object := range objects {
+				list = append(list, registry.NativeToValue(object))
+			}
+			return registry.NativeToValue(list)
+		}))
+}
+
+func policyTargetRefs(arg ref.Val) ([]*v1.TargetRef, error) {
+	policy, err := refToProto[*v1.Policy](arg)
+	if err != nil {
+		return nil, err
+	}
+	return policy.TargetRefs, nil
+}
+
+func targetRefAsList(arg ref.Val) ([]*v1.TargetRef, error) {
+	target, err := refToProto[*v1.TargetRef](arg)
+	if err != nil {
+		return nil, err
+	}
+	return []*v1.TargetRef{target}, nil
+}
+
 func (l kuadrantLib) ProgramOptions() []cel.ProgramOption {
 	return []cel.ProgramOption{}
 }
@@ -138,27 +173,18 @@ func (kuadrantLib) LibraryName() string {
 }
 
 func getRegistryWithTypes() *types.Registry {
-	registry, _ := types.NewRegistry(
-		// common.proto
-		&v1.Metadata{},
-		&v1.TargetRef{},
-		&v1.Condition{},
-		&v1.ConditionStatus{},
-
-		// gateway_api.proto
-		&v1.Gateway{},
-		&v1.GatewaySpec{},
-		&v1.Listener{},
-		&v1.GatewayAddresses{},
-		&v1.GatewayStatus{},
-		&v1.ListenerStatus{},
-		&v1.GatewayClass{},
-		&v1.GatewayClassSpec{},
-		&v1.GatewayClassStatus{},
-
-		// policy.proto
-		&v1.Policy{},
-		&v1.PolicyStatus{},
-	)
+	registry, _ := types.NewRegistry()
+	// registering a file registers all of its messages
+	for _, file := range []protoreflect.FileDescriptor{
+		v1.File_v1_common_proto,
+		v1.File_v1_policy_proto,
+		v1.File_v1_topology_proto,
+		// generated from the Go types of the APIs
+		v1.File_v1_k8s_api_proto,
+		v1.File_v1_gateway_api_proto,
+		v1.File_v1_kuadrant_api_proto,
+	} {
+		_ = registry.RegisterDescriptor(file)
+	}
 	return registry
 }
@@ -7,7 +7,12 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/kuadrant/policy-machinery/machinery"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
 
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
 	v1 "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
 )
 
@@ -15,7 +20,7 @@ var tests = []struct {
 	expr string
 	err  string
 }{
-	{expr: `__KUADRANT_VERSION == "1_dev"`},
+	{expr: `__KUADRANT_VERSION == "1"`},
 	{expr: `self.findGateways().size() == 1`},
 	{expr: `self.findGateways()[0].metadata.name == "kuadrant-gw"`},
 	{expr: `self.findGateways()[0].spec.listeners.size() == 1`},
@@ -28,6 +33,29 @@ var tests = []struct {
 	{expr: `self.findAuthPolicies()[0].targetRefs[0].group == "gateway.networking.k8s.io"`},
 	{expr: `self.findAuthPolicies()[0].targetRefs[0].name == "kuadrant-gw"`},
 	{expr: `self.findAuthPolicies()[0].targetRefs[0].namespace == "some-ns"`},
+	{expr: `self.findRateLimitPolicies()[0].metadata.kind == "RateLimitPolicy"`},
+	{expr: `self.findRateLimitPolicies()[0].spec.limits["global"].rates[0].limit == 10`},
+	{expr: `self.findRateLimitPolicies()[0].spec.limits.map(k, k) == ["global"]`},
+	{expr: `self.findTokenRateLimitPolicies()[0].metadata.kind == "TokenRateLimitPolicy"`},
+	{expr: `self.findDNSPolicies()[0].metadata.kind == "DNSPolicy"`},
+	{expr: `self.findTLSPolicies()[0].metadata.kind == "TLSPolicy"`},
+	{expr: `self.findListeners().size() == 1`},
+	{expr: `self.findListeners()[0].gateway.name == "kuadrant-gw"`},
+	{expr: `self.findListeners()[0].listener.port == 443`},
+	{expr: `has(self.findListeners()[0].listener.tls) && !has(self.findListeners()[0].listener.allowedRoutes)`},
+	{expr: `self.targetRefs[0].findListeners()[0].listener.name == "https"`},
+	{expr: `self.findHTTPRoutes()[0].spec.hostnames == ["api.kuadrant.io"]`},
+	{expr: `self.findHTTPRoutes()[0].spec.rules[0].matches[0].path.value == "/v1"`},
+	{expr: `self.findHTTPRoutes()[0].spec.parentRefs[0].name == "kuadrant-gw"`},
+	{expr: `self.targetRefs[0].findHTTPRoutes()[0].metadata.name == "api"`},
+	{expr: `self.findEffectiveRateLimitPolicies().size() == 1`},
+	{expr: `self.findEffectiveRateLimitPolicies()[0].path[1].kind == "Gateway"`},
+	{expr: `self.findEffectiveRateLimitPolicies()[0].rules["global"].spec.rates[0].limit == 10.0`},
+	{expr: `self.findEffectiveRateLimitPolicies()[0].rules["global"].source == "kuadrant.io/v1, Kind=RateLimitPolicy:some-ns/rlp"`},
+	{expr: `self.findEffectiveAuthPolicies().size() == 0`},
+	{expr: `kuadrant().spec.observability.enable`},
+	{expr: `kuadrant().spec.mtls.authorino && !kuadrant().spec.mtls.limitador`},
+	{expr: `kuadrant().spec.gatewayServices[0].limitador.port == 8081`},
 }
 
 func TestKuadrantExt(t *testing.T) {
@@ -75,6 +103,19 @@ func TestKuadrantExt(t *testing.T) {
 	}
 }
 
+func TestKuadrantExtVersion0(t *testing.T) {
+	env, err := cel.NewEnv(CelExtVersion(&TestDAG{}, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, iss := env.Compile(`__KUADRANT_VERSION == "0"`); iss.Err() != nil {
+		t.Fatal(iss.Err())
+	}
+	if _, iss := env.Compile(`self.findGateways()`); iss.Err() == nil {
+		t.Fatal("expected findGateways to be undeclared in version 0")
+	}
+}
+
 func testKuadrantEnv(t *testing.T, opts ...cel.EnvOption) *cel.Env {
 	t.Helper()
 	baseOpts := []cel.EnvOption{
@@ -102,7 +143,51 @@ func (d *TestDAG) FindGatewaysFor(targets []*v1.TargetRef) ([]*v1.Gateway, error
 				Spec: &v1.GatewaySpec{
 					Listeners: []*v1.Listener{
 						{
-							Hostname: "kuadrant.io",
+							Hostname: ptr.To("kuadrant.io"),
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (d *TestDAG) FindListenersFor(targets []*v1.TargetRef) ([]*v1.GatewayListener, error) {
+	if targets[0].Name == "foo" && targets[0].Group == "bar" && targets[0].Kind == "baz" {
+		return []*v1.GatewayListener{
+			{
+				Gateway: &v1.Metadata{
+					Name:      "kuadrant-gw",
+					Namespace: "some-ns",
+				},
+				Listener: &v1.Listener{
+					Name:     "https",
+					Hostname: ptr.To("kuadrant.io"),
+					Protocol: "HTTPS",
+					Port:     443,
+					Tls:      &v1.GatewayTLSConfig{},
+				},
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (d *TestDAG) FindHTTPRoutesFor(targets []*v1.TargetRef) ([]*v1.HTTPRoute, error) {
+	if targets[0].Name == "foo" && targets[0].Group == "bar" && targets[0].Kind == "baz" {
+		return []*v1.HTTPRoute{
+			{
+				Metadata: &v1.Metadata{
+					Name:      "api",
+					Namespace: "some-ns",
+				},
+				Spec: &v1.HTTPRouteSpec{
+					ParentRefs: []*v1.ParentReference{{Name: "kuadrant-gw", Namespace: ptr.To("some-ns")}},
+					Hostnames:  []string{"api.kuadrant.io"},
+					Rules: []*v1.HTTPRouteRule{
+						{
+							Matches: []*v1.HTTPRouteMatch{{Path: &v1.HTTPPathMatch{Type: ptr.To("PathPrefix"), Value: ptr.To("/v1")}}},
 						},
 					},
 				},
@@ -112,11 +197,62 @@ func (d *TestDAG) FindGatewaysFor(targets []*v1.TargetRef) ([]*v1.Gateway, error
 	return nil, nil
 }
 
-func (d *TestDAG) FindPoliciesFor(targets []*v1.TargetRef, _ machinery.Policy) ([]*v1.Policy, error) {
+func (d *TestDAG) FindEffectivePoliciesFor(_ []*v1.TargetRef, policyType machinery.Policy) ([]*v1.EffectivePolicy, error) {
+	if _, ok := policyType.(*kuadrantv1.RateLimitPolicy); !ok {
+		return nil, nil
+	}
+	spec, err := structpb.NewValue(map[string]any{
+		"rates": []any{map[string]any{"limit": 10, "window": "1m"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*v1.EffectivePolicy{
+		{
+			Kind: "RateLimitPolicy",
+			Path: []*v1.TargetRef{
+				{Group: "gateway.networking.k8s.io", Kind: "GatewayClass", Name: "istio"},
+				{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "kuadrant-gw", Namespace: "some-ns"},
+			},
+			Rules: map[string]*v1.MergeableRule{
+				"global": {Spec: spec, Source: "kuadrant.io/v1, Kind=RateLimitPolicy:some-ns/rlp"},
+			},
+		},
+	}, nil
+}
+
+func (d *TestDAG) Kuadrant() (*v1.Kuadrant, error) {
+	return &v1.Kuadrant{
+		Metadata: &v1.Metadata{Name: "kuadrant", Namespace: "kuadrant-system"},
+		Spec: &v1.KuadrantSpec{
+			Observability: &v1.Observability{Enable: true},
+			Mtls:          &v1.MTLS{Enable: true, Authorino: ptr.To(true)},
+			GatewayServices: []*v1.GatewayServices{
+				{
+					Gateways:  []*v1.GatewayReference{{Name: "kuadrant-gw", Namespace: "some-ns"}},
+					Limitador: &v1.ServiceEndpoint{Host: "limitador.dedicated.svc", Port: 8081},
+				},
+			},
+		},
+	}, nil
+}
+
+func (d *TestDAG) FindPoliciesFor(targets []*v1.TargetRef, policyType machinery.Policy) ([]*v1.Policy, error) {
 	if targets[0].Name == "foo" && targets[0].Group == "bar" && targets[0].Kind == "baz" {
+		var spec *anypb.Any
+		if _, ok := policyType.(*kuadrantv1.RateLimitPolicy); ok {
+			var err error
+			spec, err = anypb.New(&v1.RateLimitPolicySpec{
+				Limits: map[string]*v1.Limit{"global": {Rates: []*v1.Rate{{Limit: 10, Window: "1m"}}}},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
 		return []*v1.Policy{
 			{
 				Metadata: &v1.Metadata{
+					Kind:      policyKind(policyType),
 					Name:      "kuadrant-auth",
 					Namespace: "some-ns",
 				},
@@ -128,8 +264,24 @@ func (d *TestDAG) FindPoliciesFor(targets []*v1.TargetRef, _ machinery.Policy) (
 						Namespace: "some-ns",
 					},
 				},
+				Spec: spec,
 			},
 		}, nil
 	}
 	return nil, nil
 }
+
+func policyKind(policyType machinery.Policy) string {
+	switch policyType.(type) {
+	case *kuadrantv1.RateLimitPolicy:
+		return "RateLimitPolicy"
+	case *kuadrantv1alpha1.TokenRateLimitPolicy:
+		return "TokenRateLimitPolicy"
+	case *kuadrantv1.DNSPolicy:
+		return "DNSPolicy"
+	case *kuadrantv1.TLSPolicy:
+		return "TLSPolicy"
+	default:
+		return "AuthPolicy"
+	}
+}
@@ -1,14 +1,17 @@
 package controller
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 
 	ctrlruntimehandler "sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
@@ -36,6 +39,10 @@ type Builder struct {
 	forType    client.Object
 	watchTypes []client.Object
 	ownTypes   []client.Object
+	address    string
+	tlsConfig  *tls.Config
+	features   []string
+	celVersion uint32
 }
 
 func NewBuilder(name string) (*Builder, logr.Logger) {
@@ -82,6 +89,30 @@ func (b *Builder) Owns(obj client.Object) *Builder {
 	return b
 }
 
+// WithRemote connects the extension to kuadrant-operator at the address over tcp with mutual tls, instead of the unix
+// socket it is given when run by kuadrant-operator. The common name of the client certificate must be the name of the
+// extension, registered in the spec.extensions.remote of the kuadrant CR.
+// If not set, the KUADRANT_ADDRESS environment variable is used, with the certificates in KUADRANT_TLS_DIR.
+func (b *Builder) WithRemote(address string, tlsConfig *tls.Config) *Builder {
+	b.address = address
+	b.tlsConfig = tlsConfig
+	return b
+}
+
+// WithFeatures declares the features of kuadrant-operator the extension requires, e.g. extpb.FeatureReportStatus, on top
+// of the ones the SDK requires itself. The ones kuadrant-operator does not support are logged when the extension starts,
+// and reported in the status of the Kuadrant CR.
+func (b *Builder) WithFeatures(features ...string) *Builder {
+	b.features = append(b.features, features...)
+	return b
+}
+
+// WithCELVersion declares the minimum version of the kuadrant CEL library the expressions of the extension require
+func (b *Builder) WithCELVersion(version uint32) *Builder {
+	b.celVersion = version
+	return b
+}
+
 func (b *Builder) Build() (*ExtensionController, error) {
 	if b.name == "" {
 		return nil, fmt.Errorf("controller name must be set")
@@ -96,13 +127,7 @@ func (b *Builder) Build() (*ExtensionController, error) {
 		return nil, fmt.Errorf("for type must be set")
 	}
 
-	// todo(adam-cattermole): we could rework this to be either unix socket path or host etc and configure appropriately
-	if len(os.Args) < 2 {
-		return nil, errors.New("missing socket path argument")
-	}
-	socketPath := os.Args[1]
-
-	extClient, err := newExtensionClient(socketPath)
+	extClient, err := b.extensionClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create extension client: %w", err)
 	}
@@ -151,6 +176,8 @@ func (b *Builder) Build() (*ExtensionController, error) {
 		ForType:      b.forType,
 		Reconcile:    b.reconcile,
 		WatchSources: watchSources,
+		Features:     lo.Uniq(append(slices.Clone(sdkFeatures), b.features...)),
+		CELVersion:   b.celVersion,
 	}
 
 	return &ExtensionController{
@@ -162,3 +189,26 @@ func (b *Builder) Build() (*ExtensionController, error) {
 		BaseReconciler:  basereconciler.NewBaseReconciler(mgr.GetClient(), mgr.GetScheme(), mgr.GetAPIReader()),
 	}, nil
 }
+
+// extensionClient connects to kuadrant-operator remotely if configured to, otherwise through the unix socket passed as
+// argument by kuadrant-operator
+func (b *Builder) extensionClient() (*extensionClient, error) {
+	address, tlsConfig := b.address, b.tlsConfig
+	if address == "" {
+		address = env.GetString("KUADRANT_ADDRESS", "")
+	}
+	if address != "" {
+		if tlsConfig == nil {
+			var err error
+			if tlsConfig, err = LoadClientTLSConfig(env.GetString("KUADRANT_TLS_DIR", "/etc/kuadrant/tls")); err != nil {
+				return nil, err
+			}
+		}
+		return newRemoteExtensionClient(address, tlsConfig)
+	}
+
+	if len(os.Args) < 2 {
+		return nil, errors.New("missing socket path argument")
+	}
+	return newExtensionClient(os.Args[1])
+}
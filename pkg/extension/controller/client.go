@@ -2,12 +2,18 @@ package controller
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -24,11 +30,24 @@ func newExtensionClient(socketPath string) (*extensionClient, error) {
 		return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
 	}
 
-	conn, err := grpc.NewClient(
+	return dialExtensionClient(
 		"unix://"+socketPath,
 		grpc.WithContextDialer(dialer),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
+}
+
+// newRemoteExtensionClient connects to kuadrant-operator over tcp, authenticating with the client certificate of the
+// tls config
+func newRemoteExtensionClient(address string, tlsConfig *tls.Config) (*extensionClient, error) {
+	if tlsConfig == nil || (len(tlsConfig.Certificates) == 0 && tlsConfig.GetClientCertificate == nil) {
+		return nil, errors.New("remote extensions require a client certificate")
+	}
+	return dialExtensionClient(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+}
+
+func dialExtensionClient(target string, opts ...grpc.DialOption) (*extensionClient, error) {
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -39,13 +58,86 @@ func newExtensionClient(socketPath string) (*extensionClient, error) {
 	}, nil
 }
 
-//lint:ignore U1000
+// LoadClientTLSConfig returns the tls config a remote extension connects to kuadrant-operator with, from the tls.crt,
+// tls.key and ca.crt files in the directory. The common name of the client certificate must be the name of the
+// extension, and the ca is the one that signed the certificate of kuadrant-operator.
+// The files are read again on every handshake, so that renewed certificates are used without restarting the extension.
+func LoadClientTLSConfig(dir string) (*tls.Config, error) {
+	if _, err := loadClientCertificate(dir); err != nil {
+		return nil, err
+	}
+	if _, err := loadServerCA(dir); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return loadClientCertificate(dir)
+		},
+		// the certificate of kuadrant-operator is verified by VerifyConnection instead, against the ca read on every
+		// handshake, as RootCAs cannot be reloaded
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return verifyServerCertificate(dir, state)
+		},
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+func loadClientCertificate(dir string) (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	return &cert, nil
+}
+
+func loadServerCA(dir string) (*x509.CertPool, error) {
+	ca, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to parse server ca")
+	}
+	return pool, nil
+}
+
+// verifyServerCertificate verifies the certificate of kuadrant-operator as the tls package would with RootCAs
+func verifyServerCertificate(dir string, state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no server certificate")
+	}
+	pool, err := loadServerCA(dir)
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         pool,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
 func (ec *extensionClient) ping(ctx context.Context) (*extpb.PongResponse, error) {
 	return ec.client.Ping(ctx, &extpb.PingRequest{
 		Out: timestamppb.New(time.Now()),
 	})
 }
 
+func (ec *extensionClient) getCapabilities(ctx context.Context, celVersion uint32, features []string) (*extpb.GetCapabilitiesResponse, error) {
+	return ec.client.GetCapabilities(ctx, &extpb.GetCapabilitiesRequest{
+		ApiVersion: extpb.APIVersion,
+		CelVersion: celVersion,
+		Features:   features,
+	})
+}
+
 func (ec *extensionClient) subscribe(ctx context.Context, policyKind string, callback func(response *extpb.SubscribeResponse)) error {
 	stream, err := ec.client.Subscribe(ctx, &extpb.SubscribeRequest{
 		PolicyKind: policyKind,
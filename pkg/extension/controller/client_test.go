@@ -0,0 +1,140 @@
+//go:build unit
+
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NilError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// writeClientCerts writes the client certificate issued by the ca, along with the ca, to the directory
+func (ca *testCA) writeClientCerts(t *testing.T, dir string) {
+	t.Helper()
+	cert := ca.issue(t, "plan-policy", x509.ExtKeyUsageClientAuth)
+	key, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	assert.NilError(t, err)
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "tls.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "tls.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}), 0o600))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "ca.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+}
+
+func TestLoadClientTLSConfigReloadsCertificates(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadClientTLSConfig(dir)
+	assert.ErrorContains(t, err, "failed to load client certificate")
+
+	// handshake connects to a server with a certificate issued by the ca, which requires a client certificate issued by
+	// the same ca, and returns the common name of the client certificate the server received
+	handshake := func(tlsConfig *tls.Config, ca *testCA) (string, error) {
+		ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{ca.issue(t, "kuadrant-operator", x509.ExtKeyUsageServerAuth)},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    ca.pool,
+			MinVersion:   tls.VersionTLS12,
+		})
+		assert.NilError(t, err)
+		defer ln.Close()
+
+		commonName := make(chan string, 1)
+		go func() {
+			conn, err := ln.Accept()
+			if err != nil {
+				commonName <- ""
+				return
+			}
+			defer conn.Close()
+			serverConn := conn.(*tls.Conn)
+			if serverConn.Handshake() != nil || len(serverConn.ConnectionState().PeerCertificates) == 0 {
+				commonName <- ""
+				return
+			}
+			commonName <- serverConn.ConnectionState().PeerCertificates[0].Subject.CommonName
+		}()
+
+		_, port, _ := net.SplitHostPort(ln.Addr().String())
+		conn, err := tls.Dial("tcp", net.JoinHostPort("localhost", port), tlsConfig)
+		if err != nil {
+			return "", err
+		}
+		defer conn.Close()
+		return <-commonName, nil
+	}
+
+	first := newTestCA(t)
+	first.writeClientCerts(t, dir)
+	tlsConfig, err := LoadClientTLSConfig(dir)
+	assert.NilError(t, err)
+
+	commonName, err := handshake(tlsConfig, first)
+	assert.NilError(t, err)
+	assert.Equal(t, commonName, "plan-policy")
+
+	second := newTestCA(t)
+	_, err = handshake(tlsConfig, second)
+	assert.ErrorContains(t, err, "certificate signed by unknown authority", "servers of another ca are rejected")
+
+	second.writeClientCerts(t, dir)
+	commonName, err = handshake(tlsConfig, second)
+	assert.NilError(t, err, "renewed certificates are used on the next handshake")
+	assert.Equal(t, commonName, "plan-policy")
+}
@@ -3,14 +3,20 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/cel-go/cel"
 	celtypes "github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -29,14 +35,23 @@ import (
 
 const (
 	ExtensionFinalizer = "kuadrant.io/extensions"
+
+	// pingInterval is how often the extension pings kuadrant-operator, which reports the latency in the kuadrant cr status
+	pingInterval = 30 * time.Second
 )
 
+// sdkFeatures are the features of kuadrant-operator every extension built with the SDK requires
+var sdkFeatures = []string{extpb.FeatureSubscribe, extpb.FeatureClearPolicy}
+
 type ExtensionConfig struct {
 	Name         string
 	PolicyKind   string
 	ForType      client.Object
 	Reconcile    exttypes.ReconcileFn
 	WatchSources []ctrlruntimesrc.Source
+	// Features and CELVersion are the requirements the extension negotiates with kuadrant-operator
+	Features   []string
+	CELVersion uint32
 }
 
 type ExtensionController struct {
@@ -50,6 +65,19 @@ type ExtensionController struct {
 	*basereconciler.BaseReconciler // TODO(didierofrivia): Next iteration, use policy machinery
 }
 
+// NewKuadrantCtx returns the KuadrantCtx of an extension connected to kuadrant-operator through the connection, which
+// reconciles objects with the client. It is meant to test extensions against an in-process kuadrant-operator.
+func NewKuadrantCtx(conn *grpc.ClientConn, c client.Client, scheme *runtime.Scheme, logger logr.Logger) *ExtensionController {
+	return &ExtensionController{
+		logger: logger,
+		extensionClient: &extensionClient{
+			conn:   conn,
+			client: extpb.NewExtensionServiceClient(conn),
+		},
+		BaseReconciler: basereconciler.NewBaseReconciler(c, scheme, c),
+	}
+}
+
 func (ec *ExtensionController) Start(ctx context.Context) error {
 	stopCh := make(chan struct{})
 	// todo(adam-cattermole): how big do we make the reconcile event channel?
@@ -73,7 +101,9 @@ func (ec *ExtensionController) Start(ctx context.Context) error {
 			}
 		}
 
+		ec.negotiateCapabilities(ctx)
 		go ec.Subscribe(ctx, reconcileChan)
+		go wait.UntilWithContext(ctx, ec.ping, pingInterval)
 		err = ec.manager.Start(ctx)
 		if err != nil {
 			return fmt.Errorf("error starting manager: %w", err)
@@ -92,6 +122,32 @@ func (ec *ExtensionController) Start(ctx context.Context) error {
 	return nil
 }
 
+// negotiateCapabilities logs the requirements of the extension kuadrant-operator does not support, which then fail at
+// first use. kuadrant-operator reports them in the status of the Kuadrant CR too.
+func (ec *ExtensionController) negotiateCapabilities(ctx context.Context) {
+	response, err := ec.extensionClient.getCapabilities(ctx, ec.config.CELVersion, ec.config.Features)
+	if status.Code(err) == codes.Unimplemented {
+		ec.logger.Info("kuadrant-operator does not support capability negotiation, it might not support all the features the extension requires", "features", ec.config.Features)
+		return
+	}
+	if err != nil {
+		ec.logger.Error(err, "failed to negotiate capabilities with kuadrant-operator")
+		return
+	}
+	if incompatibilities := response.GetIncompatibilities(); len(incompatibilities) > 0 {
+		ec.logger.Error(fmt.Errorf("%s", strings.Join(incompatibilities, "; ")), "kuadrant-operator does not support the requirements of the extension",
+			"apiVersions", response.GetApiVersions(), "celVersion", response.GetCelVersion(), "features", response.GetFeatures())
+		return
+	}
+	ec.logger.V(1).Info("negotiated capabilities with kuadrant-operator", "apiVersion", extpb.APIVersion, "features", ec.config.Features)
+}
+
+func (ec *ExtensionController) ping(ctx context.Context) {
+	if _, err := ec.extensionClient.ping(ctx); err != nil {
+		ec.logger.V(1).Info("failed to ping kuadrant-operator", "error", err.Error())
+	}
+}
+
 func (ec *ExtensionController) Subscribe(ctx context.Context, reconcileChan chan ctrlruntimeevent.GenericEvent) {
 	err := ec.extensionClient.subscribe(ctx, ec.config.PolicyKind, func(response *extpb.SubscribeResponse) {
 		ec.logger.Info("received response", "response", response)
@@ -249,6 +305,17 @@ func (ec *ExtensionController) AddDataTo(ctx context.Context, policy exttypes.Po
 	return err
 }
 
+func (ec *ExtensionController) ReportStatus(ctx context.Context, policy exttypes.Policy, conditions []metav1.Condition) ([]metav1.Condition, error) {
+	resp, err := ec.extensionClient.client.ReportStatus(ctx, &extpb.ReportStatusRequest{
+		Policy:     convertPolicyToProtobuf(policy),
+		Conditions: extpb.ConvertConditions(conditions),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reporting status: %w", err)
+	}
+	return extpb.ToConditions(resp.GetConditions()), nil
+}
+
 func (ec *ExtensionController) ReconcileObject(ctx context.Context, obj client.Object, desired client.Object, mutateFn exttypes.MutateFn) (client.Object, error) {
 	obj, err := ec.ReconcileResource(ctx, obj, desired, basereconciler.MutateFn(mutateFn)) // TODO(didierofrivia): Next iteration, use policy machinery
 	if err != nil {
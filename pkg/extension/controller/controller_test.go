@@ -18,6 +18,7 @@ import (
 	"gotest.tools/assert"
 	"gotest.tools/assert/cmp"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -31,6 +32,7 @@ type mockKuadrantCtx struct {
 	resolveFn       func(ctx context.Context, policy exttypes.Policy, expression string, subscribe bool) (ref.Val, error)
 	resolvePolicyFn func(ctx context.Context, policy exttypes.Policy, expression string, subscribe bool) (exttypes.Policy, error)
 	addDataToFn     func(ctx context.Context, policy exttypes.Policy, domain exttypes.Domain, binding string, expression string) error
+	reportStatusFn  func(ctx context.Context, policy exttypes.Policy, conditions []metav1.Condition) ([]metav1.Condition, error)
 }
 
 type mockPolicy struct {
@@ -95,6 +97,10 @@ func (m *mockKuadrantCtx) ReconcileObject(ctx context.Context, obj, desired clie
 	return nil, nil
 }
 
+func (m *mockKuadrantCtx) ReportStatus(ctx context.Context, policy exttypes.Policy, conditions []metav1.Condition) ([]metav1.Condition, error) {
+	return m.reportStatusFn(ctx, policy, conditions)
+}
+
 func TestGenericResolveSuccess(t *testing.T) {
 	mockCtx := &mockKuadrantCtx{
 		resolveFn: func(ctx context.Context, policy exttypes.Policy, expression string, subscribe bool) (ref.Val, error) {
@@ -180,6 +186,33 @@ func TestResolvePolicy(t *testing.T) {
 	assert.Equal(t, string(*targetRefs[0].SectionName), "http")
 }
 
+func TestReportStatus(t *testing.T) {
+	mockCtx := &mockKuadrantCtx{
+		reportStatusFn: func(ctx context.Context, policy exttypes.Policy, conditions []metav1.Condition) ([]metav1.Condition, error) {
+			reported := append([]metav1.Condition{}, conditions...)
+			reported[1] = metav1.Condition{Type: "Enforced", Status: metav1.ConditionFalse, Reason: "Unknown", Message: "RateLimitPolicy ns/test: not enforced"}
+			return reported, nil
+		},
+	}
+
+	conditions := []metav1.Condition{
+		{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted", ObservedGeneration: 2},
+		{Type: "Enforced", Status: metav1.ConditionTrue, Reason: "Enforced", ObservedGeneration: 2},
+	}
+	err := ReportStatus(context.Background(), mockCtx, &mockPolicy{name: "test", namespace: "ns"}, &conditions)
+	assert.NilError(t, err)
+	assert.Equal(t, len(conditions), 2)
+	assert.Equal(t, conditions[1].Status, metav1.ConditionFalse)
+	assert.Equal(t, conditions[1].Message, "RateLimitPolicy ns/test: not enforced")
+	assert.Equal(t, conditions[1].ObservedGeneration, int64(2))
+
+	mockCtx.reportStatusFn = func(ctx context.Context, policy exttypes.Policy, conditions []metav1.Condition) ([]metav1.Condition, error) {
+		return nil, errors.New("unavailable")
+	}
+	err = ReportStatus(context.Background(), mockCtx, &mockPolicy{name: "test", namespace: "ns"}, &conditions)
+	assert.ErrorContains(t, err, "unavailable")
+}
+
 func TestPolicyAdapterMethods(t *testing.T) {
 	pbPolicy := &extpb.Policy{
 		Metadata: &extpb.Metadata{
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kuadrantgatewayapi "github.com/kuadrant/kuadrant-operator/internal/gatewayapi"
+	"github.com/kuadrant/kuadrant-operator/internal/kuadrant"
+	exttypes "github.com/kuadrant/kuadrant-operator/pkg/extension/types"
+)
+
+// KuadrantPolicy is a Kuadrant policy created by an extension, e.g. a RateLimitPolicy or an AuthPolicy
+type KuadrantPolicy interface {
+	GetName() string
+	Kind() string
+	GetStatus() kuadrantgatewayapi.PolicyStatus
+}
+
+// ErrorConditions returns the conditions of a policy whose spec failed to reconcile: not accepted, nor enforced
+func ErrorConditions(policy exttypes.StatusPolicy, specErr error) []metav1.Condition {
+	// Copy initial conditions. Otherwise, status will always be updated
+	conditions := slices.Clone(policy.GetPolicyStatus().GetConditions())
+	meta.SetStatusCondition(&conditions, *AcceptedCondition(policy, specErr))
+	meta.RemoveStatusCondition(&conditions, string(kuadrant.PolicyConditionEnforced))
+	return conditions
+}
+
+// EnforcedConditions returns the conditions of an accepted policy, enforced unless there is an error enforcing it
+func EnforcedConditions(policy exttypes.StatusPolicy, enforcedErr error) []metav1.Condition {
+	// Copy initial conditions. Otherwise, status will always be updated
+	conditions := slices.Clone(policy.GetPolicyStatus().GetConditions())
+	meta.SetStatusCondition(&conditions, *AcceptedCondition(policy, nil))
+	meta.SetStatusCondition(&conditions, *EnforcedCondition(policy, enforcedErr, true))
+	return conditions
+}
+
+// PoliciesEnforced returns an error for the first of the Kuadrant policies that is not enforced
+func PoliciesEnforced(policies ...KuadrantPolicy) error {
+	for _, policy := range policies {
+		if !meta.IsStatusConditionTrue(policy.GetStatus().GetConditions(), string(kuadrant.PolicyConditionEnforced)) {
+			return fmt.Errorf("%s %s is not enforced", policy.Kind(), policy.GetName())
+		}
+	}
+	return nil
+}
+
+// ReconcileStatus patches the status of the policy with the conditions, observed for the current generation of the
+// policy. The status is not patched when already up-to-date.
+func ReconcileStatus(ctx context.Context, c client.Client, policy exttypes.StatusPolicy, conditions []metav1.Condition, logger logr.Logger) (reconcile.Result, error) {
+	status := policy.GetPolicyStatus()
+	desired := &conditionsStatus{observedGeneration: policy.GetGeneration(), conditions: conditions}
+	if exttypes.StatusEquals(status, desired, logger) {
+		// Steady state
+		logger.V(1).Info("Status was not updated")
+		return reconcile.Result{}, nil
+	}
+
+	logger.V(1).Info("Updating Status", "sequence no:", fmt.Sprintf("sequence No: %v->%v", status.GetObservedGeneration(), desired.observedGeneration))
+
+	// Merge patches are not rejected by outdated resource versions
+	patch := client.MergeFrom(policy.DeepCopyObject().(client.Object))
+	status.SetObservedGeneration(desired.observedGeneration)
+	status.SetConditions(conditions)
+	if err := c.Status().Patch(ctx, policy, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+type conditionsStatus struct {
+	observedGeneration int64
+	conditions         []metav1.Condition
+}
+
+func (s *conditionsStatus) GetObservedGeneration() int64 { return s.observedGeneration }
+
+func (s *conditionsStatus) SetObservedGeneration(generation int64) { s.observedGeneration = generation }
+
+func (s *conditionsStatus) GetConditions() []metav1.Condition { return s.conditions }
+
+func (s *conditionsStatus) SetConditions(conditions []metav1.Condition) { s.conditions = conditions }
@@ -0,0 +1,94 @@
+//go:build unit
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	"github.com/kuadrant/kuadrant-operator/cmd/extensions/telemetry-policy/api/v1alpha1"
+	extcontroller "github.com/kuadrant/kuadrant-operator/pkg/extension/controller"
+)
+
+func testTelemetryPolicy() *v1alpha1.TelemetryPolicy {
+	return &v1alpha1.TelemetryPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "TelemetryPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "telemetry", Namespace: "default", Generation: 2},
+	}
+}
+
+func TestPolicyConditions(t *testing.T) {
+	policy := testTelemetryPolicy()
+
+	conditions := extcontroller.EnforcedConditions(policy, nil)
+	assert.Assert(t, meta.IsStatusConditionTrue(conditions, "Accepted"))
+	assert.Assert(t, meta.IsStatusConditionTrue(conditions, "Enforced"))
+	assert.Equal(t, len(policy.Status.Conditions), 0, "the conditions of the policy are not modified")
+
+	policy.Status.Conditions = conditions
+	conditions = extcontroller.ErrorConditions(policy, errors.New("invalid spec"))
+	accepted := meta.FindStatusCondition(conditions, "Accepted")
+	assert.Equal(t, accepted.Status, metav1.ConditionFalse)
+	assert.Equal(t, accepted.Message, "TelemetryPolicy has encountered some issues: invalid spec")
+	assert.Assert(t, meta.FindStatusCondition(conditions, "Enforced") == nil)
+}
+
+func TestPoliciesEnforced(t *testing.T) {
+	enforced := &kuadrantv1.RateLimitPolicy{ObjectMeta: metav1.ObjectMeta{Name: "enforced"}}
+	enforced.Status.Conditions = []metav1.Condition{{Type: "Enforced", Status: metav1.ConditionTrue}}
+	pending := &kuadrantv1.AuthPolicy{ObjectMeta: metav1.ObjectMeta{Name: "pending"}}
+	pending.Status.Conditions = []metav1.Condition{{Type: "Enforced", Status: metav1.ConditionFalse}}
+
+	assert.NilError(t, extcontroller.PoliciesEnforced(enforced))
+	assert.Error(t, extcontroller.PoliciesEnforced(enforced, pending), "AuthPolicy pending is not enforced")
+	assert.Error(t, extcontroller.PoliciesEnforced(&kuadrantv1.RateLimitPolicy{ObjectMeta: metav1.ObjectMeta{Name: "new"}}), "RateLimitPolicy new is not enforced")
+}
+
+func TestReconcileStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, v1alpha1.AddToScheme(scheme))
+	policy := testTelemetryPolicy()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).WithStatusSubresource(policy).Build()
+	ctx := context.Background()
+
+	current := &v1alpha1.TelemetryPolicy{}
+	assert.NilError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), current))
+	result, err := extcontroller.ReconcileStatus(ctx, c, current, extcontroller.EnforcedConditions(current, nil), logr.Discard())
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Requeue)
+
+	updated := &v1alpha1.TelemetryPolicy{}
+	assert.NilError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), updated))
+	assert.Equal(t, updated.Status.ObservedGeneration, int64(2))
+	assert.Assert(t, meta.IsStatusConditionTrue(updated.Status.Conditions, "Enforced"))
+
+	// the policy read before the update is outdated, which does not prevent patching its status
+	stale := policy.DeepCopy()
+	result, err = extcontroller.ReconcileStatus(ctx, c, stale, extcontroller.ErrorConditions(stale, errors.New("invalid spec")), logr.Discard())
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Requeue)
+	assert.NilError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), updated))
+	assert.Assert(t, meta.IsStatusConditionFalse(updated.Status.Conditions, "Accepted"))
+	assert.Assert(t, meta.FindStatusCondition(updated.Status.Conditions, "Enforced") == nil)
+
+	// up-to-date statuses are not updated
+	result, err = extcontroller.ReconcileStatus(ctx, c, updated, extcontroller.EnforcedConditions(updated, nil), logr.Discard())
+	assert.NilError(t, err)
+	assert.NilError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), updated))
+	resourceVersion := updated.ResourceVersion
+	result, err = extcontroller.ReconcileStatus(ctx, c, updated, extcontroller.EnforcedConditions(updated, nil), logr.Discard())
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Requeue)
+	assert.NilError(t, c.Get(ctx, client.ObjectKeyFromObject(policy), updated))
+	assert.Equal(t, updated.ResourceVersion, resourceVersion)
+}
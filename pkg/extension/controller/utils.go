@@ -8,6 +8,7 @@ import (
 	"slices"
 	"sort"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -23,6 +24,8 @@ func convertDomainToProtobuf(domain exttypes.Domain) extpb.Domain {
 		return extpb.Domain_DOMAIN_AUTH
 	case exttypes.DomainRequest:
 		return extpb.Domain_DOMAIN_REQUEST
+	case exttypes.DomainResponse:
+		return extpb.Domain_DOMAIN_RESPONSE
 	default:
 		return extpb.Domain_DOMAIN_UNSPECIFIED
 	}
@@ -74,6 +77,22 @@ func Resolve[T any](ctx context.Context, kuadrantCtx exttypes.KuadrantCtx, polic
 	return result, nil
 }
 
+// ReportStatus publishes the conditions of a policy to Kuadrant, which surfaces them on the gateways and routes the
+// policy targets, and updates the conditions with the problems of the Kuadrant policies the policy owns
+func ReportStatus(ctx context.Context, kuadrantCtx exttypes.KuadrantCtx, policy exttypes.Policy, conditions *[]metav1.Condition) error {
+	reported, err := kuadrantCtx.ReportStatus(ctx, policy, *conditions)
+	if err != nil {
+		return err
+	}
+	for _, condition := range reported {
+		if existing := meta.FindStatusCondition(*conditions, condition.Type); existing != nil && condition.ObservedGeneration == 0 {
+			condition.ObservedGeneration = existing.ObservedGeneration
+		}
+		meta.SetStatusCondition(conditions, condition)
+	}
+	return nil
+}
+
 func AcceptedCondition(p exttypes.Policy, err error) *metav1.Condition {
 	policyKind := p.GetObjectKind().GroupVersionKind().Kind
 	cond := &metav1.Condition{
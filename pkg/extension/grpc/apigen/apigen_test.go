@@ -0,0 +1,110 @@
+//go:build unit
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+)
+
+const generatedDir = "../v1"
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	files, err := generate(generatedDir)
+	if err != nil {
+		t.Fatalf("generate() failed: %v", err)
+	}
+	for name, content := range files {
+		current, err := os.ReadFile(filepath.Join(generatedDir, name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		if !bytes.Equal(current, content) {
+			t.Errorf("%s is out of date with the API types, run pkg/extension/grpc/generate_proto.sh v1", name)
+		}
+	}
+}
+
+func TestCompiledMessagesMatchTheAPITypes(t *testing.T) {
+	previous, err := readPreviousMessages(generatedDir)
+	if err != nil {
+		t.Fatalf("readPreviousMessages() failed: %v", err)
+	}
+	m, err := buildModel(previous)
+	if err != nil {
+		t.Fatalf("buildModel() failed: %v", err)
+	}
+	for _, msg := range m.messages {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName("kuadrant.v1." + msg.name))
+		if err != nil {
+			t.Errorf("message %s is not compiled, run pkg/extension/grpc/generate_proto.sh v1", msg.name)
+			continue
+		}
+		desc := d.(protoreflect.MessageDescriptor)
+		if desc.Fields().Len() != len(msg.fields) {
+			t.Errorf("message %s: expected %d fields, got %d", msg.name, len(msg.fields), desc.Fields().Len())
+		}
+		for _, f := range msg.fields {
+			fd := desc.Fields().ByName(protoreflect.Name(f.name))
+			if fd == nil {
+				t.Errorf("message %s: field %s is not compiled", msg.name, f.name)
+				continue
+			}
+			if int(fd.Number()) != f.number {
+				t.Errorf("message %s: field %s: expected number %d, got %d", msg.name, f.name, f.number, fd.Number())
+			}
+			if repeated := fd.IsList(); repeated != (f.label == "repeated") {
+				t.Errorf("message %s: field %s: expected repeated to be %t, got %t", msg.name, f.name, f.label == "repeated", repeated)
+			}
+			if isMap := strings.HasPrefix(f.protoType, "map<"); fd.IsMap() != isMap {
+				t.Errorf("message %s: field %s: expected map to be %t, got %t", msg.name, f.name, isMap, fd.IsMap())
+			}
+			if optional := fd.HasOptionalKeyword(); optional != (f.label == "optional") {
+				t.Errorf("message %s: field %s: expected optional to be %t, got %t", msg.name, f.name, f.label == "optional", optional)
+			}
+			if fd.Message() != nil && !fd.IsMap() && string(fd.Message().Name()) != f.protoType[strings.LastIndex(f.protoType, ".")+1:] {
+				t.Errorf("message %s: field %s: expected type %s, got %s", msg.name, f.name, f.protoType, fd.Message().FullName())
+			}
+		}
+	}
+}
+
+func TestTimesRoundTrip(t *testing.T) {
+	notAfter := time.Date(2026, 10, 18, 12, 30, 45, 0, time.UTC)
+	policy := &kuadrantv1.TLSPolicy{
+		Status: kuadrantv1.TLSPolicyStatus{
+			Certificates: []kuadrantv1.CertificateStatus{
+				{Name: "cert", NotAfter: &metav1.Time{Time: notAfter}},
+			},
+		},
+	}
+
+	data, err := proto.Marshal(extpb.ConvertTLSPolicy(policy))
+	if err != nil {
+		t.Fatalf("proto.Marshal() failed: %v", err)
+	}
+	out := &extpb.TLSPolicy{}
+	if err := proto.Unmarshal(data, out); err != nil {
+		t.Fatalf("proto.Unmarshal() failed: %v", err)
+	}
+
+	cert := out.GetStatus().GetCertificates()[0]
+	if got := cert.GetNotAfter().AsTime(); !got.Equal(notAfter) {
+		t.Errorf("notAfter: expected %s, got %s", notAfter, got)
+	}
+	if cert.GetRenewalTime() != nil {
+		t.Errorf("renewalTime: expected unset, got %s", cert.GetRenewalTime().AsTime())
+	}
+}
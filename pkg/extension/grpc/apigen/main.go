@@ -0,0 +1,129 @@
+// apigen generates the protobuf messages that mirror the Kuadrant and Gateway API objects exposed to extensions,
+// along with the Go functions that convert the API objects into those messages.
+//
+// The messages are derived from the Go types by reflection. The numbers of the fields are read back from the
+// previously generated files, so that regenerating never renumbers an existing field.
+//
+// Usage:
+//
+//	go run ./pkg/extension/grpc/apigen -out pkg/extension/grpc/v1
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	certmanmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+// roots are the API objects exposed to extensions; every type they refer to gets a message too
+var roots = []root{
+	{object: &gatewayapiv1.GatewayClass{}, groupKind: schema.GroupKind{Group: gatewayapiv1.GroupName, Kind: "GatewayClass"}},
+	{object: &gatewayapiv1.Gateway{}, groupKind: schema.GroupKind{Group: gatewayapiv1.GroupName, Kind: "Gateway"}},
+	{object: &gatewayapiv1.HTTPRoute{}, groupKind: schema.GroupKind{Group: gatewayapiv1.GroupName, Kind: "HTTPRoute"}},
+	{object: &gatewayapiv1.GRPCRoute{}, groupKind: schema.GroupKind{Group: gatewayapiv1.GroupName, Kind: "GRPCRoute"}},
+	{object: &kuadrantv1beta1.Kuadrant{}, groupKind: kuadrantv1beta1.KuadrantGroupKind},
+	{object: &kuadrantv1.AuthPolicy{}, groupKind: kuadrantv1.AuthPolicyGroupKind},
+	{object: &kuadrantv1.RateLimitPolicy{}, groupKind: kuadrantv1.RateLimitPolicyGroupKind},
+	{object: &kuadrantv1.DNSPolicy{}, groupKind: kuadrantv1.DNSPolicyGroupKind},
+	{object: &kuadrantv1.TLSPolicy{}, groupKind: kuadrantv1.TLSPolicyGroupKind},
+	{object: &kuadrantv1alpha1.TokenRateLimitPolicy{}, groupKind: kuadrantv1alpha1.TokenRateLimitPolicyGroupKind},
+}
+
+// protoFiles are the generated files, in dependency order; a message goes to the first file matching its package
+var protoFiles = []protoFile{
+	{name: "k8s_api.proto", packages: []string{"k8s.io/"}},
+	{name: "gateway_api.proto", packages: []string{"sigs.k8s.io/gateway-api/"}},
+	{name: "kuadrant_api.proto", packages: []string{""}},
+}
+
+// renames resolve the conflicts between types of the same name from different packages
+var renames = map[reflect.Type]string{
+	reflect.TypeOf(corev1.LocalObjectReference{}):   "CoreLocalObjectReference",
+	reflect.TypeOf(certmanmetav1.ObjectReference{}): "CertManagerObjectReference",
+}
+
+// wellKnownTypes are mapped to hand-written messages or to scalars, and converted by hand-written functions.
+// Lists only appear here as the values of maps, which proto does not allow to be repeated.
+var wellKnownTypes = map[reflect.Type]wellKnownType{
+	reflect.TypeOf(metav1.Condition{}):     {protoType: "Condition", importPath: "v1/common.proto", convert: "convertCondition"},
+	reflect.TypeOf([]metav1.Condition{}):   {protoType: "ConditionList", importPath: "v1/common.proto", convert: "convertConditionList"},
+	reflect.TypeOf(metav1.Duration{}):      {protoType: "google.protobuf.Duration", importPath: "google/protobuf/duration.proto", convert: "convertDuration"},
+	reflect.TypeOf(metav1.Time{}):          {protoType: "google.protobuf.Timestamp", importPath: "google/protobuf/timestamp.proto", convert: "convertTime"},
+	reflect.TypeOf(runtime.RawExtension{}): {protoType: "google.protobuf.Value", importPath: "google/protobuf/struct.proto", convert: "convertRawExtension"},
+	reflect.TypeOf(resource.Quantity{}):    {protoType: "string", convert: "convertQuantity"},
+	reflect.TypeOf(intstr.IntOrString{}):   {protoType: "string", convert: "convertIntOrString"},
+	reflect.TypeOf(metav1.ObjectMeta{}):    {protoType: "Metadata", importPath: "v1/common.proto", convert: "convertObjectMeta", objectMeta: true},
+	reflect.TypeOf(metav1.TypeMeta{}):      {skip: true},
+}
+
+// packageAliases are the names under which the converters import the packages of the API types
+var packageAliases = map[string]string{
+	"sigs.k8s.io/gateway-api/apis/v1":                              "gatewayapiv1",
+	"sigs.k8s.io/gateway-api/apis/v1alpha2":                        "gatewayapiv1alpha2",
+	"github.com/kuadrant/kuadrant-operator/api/v1":                 "kuadrantv1",
+	"github.com/kuadrant/kuadrant-operator/api/v1alpha1":           "kuadrantv1alpha1",
+	"github.com/kuadrant/kuadrant-operator/api/v1beta1":            "kuadrantv1beta1",
+	"github.com/kuadrant/authorino/api/v1beta3":                    "authorinov1beta3",
+	"github.com/kuadrant/authorino-operator/api/v1beta1":           "authorinooperatorv1beta1",
+	"github.com/kuadrant/dns-operator/api/v1alpha1":                "kuadrantdnsv1alpha1",
+	"github.com/kuadrant/limitador-operator/api/v1alpha1":          "limitadorv1alpha1",
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1": "certmanv1",
+	"github.com/cert-manager/cert-manager/pkg/apis/meta/v1":        "certmanmetav1",
+	"k8s.io/api/core/v1":                                           "corev1",
+	"k8s.io/apimachinery/pkg/apis/meta/v1":                         "metav1",
+}
+
+func main() {
+	out := flag.String("out", ".", "directory of the generated files, also holding the previously generated ones")
+	flag.Parse()
+
+	files, err := generate(*out)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "apigen: %v\n", err)
+		os.Exit(1)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), content, 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "apigen: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the contents of the generated files, by name
+func generate(dir string) (map[string][]byte, error) {
+	previous, err := readPreviousMessages(dir)
+	if err != nil {
+		return nil, err
+	}
+	m, err := buildModel(previous)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte, len(protoFiles)+1)
+	for _, file := range protoFiles {
+		files[file.name] = m.renderProto(file.name)
+	}
+	converters, err := m.renderConverters()
+	if err != nil {
+		return nil, err
+	}
+	files[convertersFile] = converters
+	return files, nil
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type root struct {
+	object    any
+	groupKind schema.GroupKind
+}
+
+type protoFile struct {
+	name string
+	// packages are the prefixes of the Go packages whose types go to the file
+	packages []string
+}
+
+type wellKnownType struct {
+	protoType  string
+	importPath string
+	convert    string
+	// skip drops the fields of the type altogether
+	skip bool
+	// objectMeta converts the field along with the group and kind of the root object
+	objectMeta bool
+}
+
+type model struct {
+	messages []*message
+	byType   map[reflect.Type]*message
+	byName   map[string]*message
+	previous map[string]*previousMessage
+}
+
+type message struct {
+	name     string
+	goType   reflect.Type
+	file     string
+	root     *root
+	fields   []*field
+	reserved []int
+}
+
+type field struct {
+	name       string
+	goName     string
+	label      string
+	protoType  string
+	ref        *message
+	importPath string
+	// convert is the Go expression that converts the field of the `in` object
+	convert string
+	// guard is the condition for the field to be converted, when inlined from an embedded pointer
+	guard  string
+	number int
+}
+
+// typeKey identifies the type of the field on the wire; changing it requires a new number
+func (f *field) typeKey() string {
+	if f.label == "repeated" {
+		return "repeated " + f.protoType
+	}
+	return f.protoType
+}
+
+var (
+	validFieldName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+	// reservedGoNames are the names protoc-gen-go renames to avoid conflicts with the methods of the messages
+	reservedGoNames = []string{"Reset", "String", "ProtoMessage", "ProtoReflect", "Descriptor"}
+)
+
+// scalars are the proto types of the Go kinds, with the Go type protoc-gen-go generates for them
+var scalars = map[reflect.Kind]struct{ protoType, goType string }{
+	reflect.String:  {"string", "string"},
+	reflect.Bool:    {"bool", "bool"},
+	reflect.Int32:   {"int32", "int32"},
+	reflect.Int64:   {"int64", "int64"},
+	reflect.Int:     {"int64", "int64"},
+	reflect.Float64: {"double", "float64"},
+}
+
+func buildModel(previous map[string]*previousMessage) (*model, error) {
+	m := &model{
+		byType:   make(map[reflect.Type]*message),
+		byName:   make(map[string]*message),
+		previous: previous,
+	}
+	for i := range roots {
+		if _, err := m.addMessage(reflect.TypeOf(roots[i].object).Elem(), &roots[i]); err != nil {
+			return nil, err
+		}
+	}
+	// the list of messages grows while the fields are collected
+	for i := 0; i < len(m.messages); i++ {
+		msg := m.messages[i]
+		if err := m.collectFields(msg, msg.goType, "in.", ""); err != nil {
+			return nil, fmt.Errorf("%s: %w", qualifiedName(msg.goType), err)
+		}
+	}
+	for _, msg := range m.messages {
+		m.number(msg)
+	}
+	return m, nil
+}
+
+func (m *model) addMessage(t reflect.Type, r *root) (*message, error) {
+	if msg, ok := m.byType[t]; ok {
+		return msg, nil
+	}
+	name, ok := renames[t]
+	if !ok {
+		name = t.Name()
+	}
+	if other, ok := m.byName[name]; ok {
+		return nil, fmt.Errorf("message %s is generated for both %s and %s, add a rename", name, qualifiedName(other.goType), qualifiedName(t))
+	}
+	if _, ok := packageAliases[t.PkgPath()]; !ok {
+		return nil, fmt.Errorf("no alias for the package of %s", qualifiedName(t))
+	}
+	msg := &message{name: name, goType: t, file: fileFor(t.PkgPath()), root: r}
+	m.messages = append(m.messages, msg)
+	m.byType[t] = msg
+	m.byName[name] = msg
+	return msg, nil
+}
+
+// collectFields adds the fields of a Go struct to a message, following the rules of encoding/json for the inlined ones
+func (m *model) collectFields(msg *message, t reflect.Type, prefix, guard string) error {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag := structField.Tag.Get("json")
+		if tag == "-" || (!structField.IsExported() && !structField.Anonymous) {
+			continue
+		}
+		if wellKnown, ok := wellKnownTypes[structField.Type]; ok && wellKnown.skip {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if structField.Anonymous && name == "" {
+			inlined, inlinedGuard := structField.Type, guard
+			if inlined.Kind() == reflect.Ptr {
+				inlined = inlined.Elem()
+				inlinedGuard = strings.TrimPrefix(guard+" && "+prefix+structField.Name+" != nil", " && ")
+			}
+			if inlined.Kind() != reflect.Struct {
+				return fmt.Errorf("unsupported inlined field %s", structField.Name)
+			}
+			if err := m.collectFields(msg, inlined, prefix+structField.Name+".", inlinedGuard); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			name = structField.Name
+		}
+		f, err := m.newField(msg, name, prefix+structField.Name, structField.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", structField.Name, err)
+		}
+		f.guard = guard
+		for _, other := range msg.fields {
+			if other.name == f.name || other.goName == f.goName {
+				return fmt.Errorf("field %s conflicts with field %s", f.name, other.name)
+			}
+		}
+		msg.fields = append(msg.fields, f)
+	}
+	return nil
+}
+
+func (m *model) newField(msg *message, name, expr string, t reflect.Type) (*field, error) {
+	// json names such as `redis-cached` are not valid proto names
+	name = strings.ReplaceAll(name, "-", "_")
+	if !validFieldName.MatchString(name) {
+		return nil, fmt.Errorf("invalid field name %q", name)
+	}
+	f := &field{name: name, goName: goCamelCase(name)}
+	if slices.Contains(reservedGoNames, f.goName) {
+		return nil, fmt.Errorf("field name %q conflicts with the methods of the messages", name)
+	}
+
+	if wellKnown, ok := wellKnownTypes[t]; ok && wellKnown.objectMeta {
+		if msg.root == nil {
+			return nil, fmt.Errorf("object metadata outside of a root object")
+		}
+		f.protoType, f.importPath = wellKnown.protoType, wellKnown.importPath
+		f.convert = fmt.Sprintf("%s(%q, %q, &%s)", wellKnown.convert, msg.root.groupKind.Group, msg.root.groupKind.Kind, expr)
+		return f, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		f.label = "repeated"
+		elem := t.Elem()
+		if elem.Kind() == reflect.Ptr && elem.Elem().Kind() == reflect.Struct {
+			convert, err := m.setStructType(f, elem.Elem())
+			if err != nil {
+				return nil, err
+			}
+			f.convert = fmt.Sprintf("convertPtrList(%s, %s)", expr, convert)
+			return f, nil
+		}
+		if elem.Kind() == reflect.Struct {
+			convert, err := m.setStructType(f, elem)
+			if err != nil {
+				return nil, err
+			}
+			f.convert = fmt.Sprintf("convertList(%s, %s)", expr, convert)
+			return f, nil
+		}
+		scalar, ok := scalars[elem.Kind()]
+		if !ok || scalar.protoType == "bool" || scalar.protoType == "double" {
+			return nil, fmt.Errorf("unsupported list of %s", elem)
+		}
+		f.protoType = scalar.protoType
+		f.convert = fmt.Sprintf("%sList(%s)", scalar.protoType, expr)
+
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key %s", t.Key())
+		}
+		elem := t.Elem()
+		if _, ok := wellKnownTypes[elem]; ok || elem.Kind() == reflect.Struct {
+			convert, err := m.setStructType(f, elem)
+			if err != nil {
+				return nil, err
+			}
+			f.protoType = fmt.Sprintf("map<string, %s>", f.protoType)
+			f.convert = fmt.Sprintf("convertMap(%s, %s)", expr, convert)
+			return f, nil
+		}
+		if elem.Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map value %s", elem)
+		}
+		f.protoType = "map<string, string>"
+		f.convert = fmt.Sprintf("stringMap(%s)", expr)
+
+	case reflect.Ptr:
+		elem := t.Elem()
+		if _, ok := wellKnownTypes[elem]; ok || elem.Kind() == reflect.Struct {
+			convert, err := m.setStructType(f, elem)
+			if err != nil {
+				return nil, err
+			}
+			f.convert = fmt.Sprintf("%s(%s)", convert, expr)
+			return f, nil
+		}
+		scalar, ok := scalars[elem.Kind()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		f.label = "optional"
+		f.protoType = scalar.protoType
+		f.convert = fmt.Sprintf("optional%s(%s)", strings.ToUpper(scalar.goType[:1])+scalar.goType[1:], expr)
+
+	case reflect.Struct:
+		convert, err := m.setStructType(f, t)
+		if err != nil {
+			return nil, err
+		}
+		f.convert = fmt.Sprintf("%s(&%s)", convert, expr)
+
+	default:
+		scalar, ok := scalars[t.Kind()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		f.protoType = scalar.protoType
+		f.convert = expr
+		if t.PkgPath() != "" || t.Name() != scalar.goType {
+			f.convert = fmt.Sprintf("%s(%s)", scalar.goType, expr)
+		}
+	}
+	return f, nil
+}
+
+// setStructType sets the type of a field holding a Go struct and returns the name of the function converting it
+func (m *model) setStructType(f *field, t reflect.Type) (string, error) {
+	if wellKnown, ok := wellKnownTypes[t]; ok {
+		if wellKnown.convert == "" || wellKnown.objectMeta {
+			return "", fmt.Errorf("unsupported use of %s", qualifiedName(t))
+		}
+		f.protoType, f.importPath = wellKnown.protoType, wellKnown.importPath
+		return wellKnown.convert, nil
+	}
+	msg, err := m.addMessage(t, nil)
+	if err != nil {
+		return "", err
+	}
+	f.protoType, f.ref = msg.name, msg
+	return msg.convertFunc(), nil
+}
+
+// number assigns the numbers of the fields, keeping those of the previously generated message
+// and reserving the ones of the fields that were removed or changed type
+func (m *model) number(msg *message) {
+	used := make(map[int]bool)
+	next := 1
+	if previous, ok := m.previous[msg.name]; ok {
+		for _, f := range msg.fields {
+			if p, ok := previous.fields[f.name]; ok && p.typeKey == f.typeKey() {
+				f.number = p.number
+				used[p.number] = true
+			}
+		}
+		msg.reserved = slices.Clone(previous.reserved)
+		for _, p := range previous.fields {
+			if !used[p.number] {
+				msg.reserved = append(msg.reserved, p.number)
+			}
+			next = max(next, p.number+1)
+		}
+		for _, n := range previous.reserved {
+			next = max(next, n+1)
+		}
+		slices.Sort(msg.reserved)
+	}
+	for _, f := range msg.fields {
+		if f.number == 0 {
+			f.number = next
+			next++
+		}
+	}
+}
+
+func (msg *message) convertFunc() string {
+	if msg.root != nil {
+		return "Convert" + msg.name
+	}
+	return "convert" + msg.name
+}
+
+func fileFor(pkgPath string) string {
+	for _, file := range protoFiles {
+		for _, prefix := range file.packages {
+			if strings.HasPrefix(pkgPath, prefix) {
+				return file.name
+			}
+		}
+	}
+	return protoFiles[len(protoFiles)-1].name
+}
+
+func qualifiedName(t reflect.Type) string {
+	return t.PkgPath() + "." + t.Name()
+}
+
+// goCamelCase is the name protoc-gen-go gives to the Go field of a proto field
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case '0' <= c && c <= '9':
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	header         = "// Code generated by apigen. DO NOT EDIT.\n"
+	convertersFile = "zz_generated.converters.go"
+)
+
+type previousMessage struct {
+	fields   map[string]previousField
+	reserved []int
+}
+
+type previousField struct {
+	number  int
+	typeKey string
+}
+
+var (
+	messageLine  = regexp.MustCompile(`^message (\w+) \{`)
+	fieldLine    = regexp.MustCompile(`^\s+(optional |repeated )?(map<[\w.]+, [\w.]+>|[\w.]+) (\w+) = (\d+);`)
+	reservedLine = regexp.MustCompile(`^\s+reserved ([\d, ]+);`)
+)
+
+// readPreviousMessages reads the numbers of the fields of the messages in the previously generated files
+func readPreviousMessages(dir string) (map[string]*previousMessage, error) {
+	messages := make(map[string]*previousMessage)
+	for _, file := range protoFiles {
+		content, err := os.ReadFile(filepath.Join(dir, file.name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var current *previousMessage
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case messageLine.MatchString(line):
+				current = &previousMessage{fields: make(map[string]previousField)}
+				messages[messageLine.FindStringSubmatch(line)[1]] = current
+			case current == nil:
+			case fieldLine.MatchString(line):
+				match := fieldLine.FindStringSubmatch(line)
+				number, _ := strconv.Atoi(match[4])
+				typeKey := match[2]
+				if match[1] == "repeated " {
+					typeKey = "repeated " + typeKey
+				}
+				current.fields[match[3]] = previousField{number: number, typeKey: typeKey}
+			case reservedLine.MatchString(line):
+				for _, n := range strings.Split(reservedLine.FindStringSubmatch(line)[1], ",") {
+					number, err := strconv.Atoi(strings.TrimSpace(n))
+					if err != nil {
+						return nil, fmt.Errorf("%s: invalid reserved number %q", file.name, n)
+					}
+					current.reserved = append(current.reserved, number)
+				}
+			case line == "}":
+				current = nil
+			}
+		}
+	}
+	return messages, nil
+}
+
+func (m *model) renderProto(file string) []byte {
+	messages := lo.Filter(m.messages, func(msg *message, _ int) bool { return msg.file == file })
+	slices.SortFunc(messages, func(a, b *message) int { return strings.Compare(a.name, b.name) })
+
+	var imports []string
+	for _, msg := range messages {
+		for _, f := range msg.fields {
+			if f.importPath != "" {
+				imports = append(imports, f.importPath)
+			}
+			if f.ref != nil && f.ref.file != file {
+				imports = append(imports, "v1/"+f.ref.file)
+			}
+		}
+	}
+	imports = lo.Uniq(imports)
+	slices.Sort(imports)
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("\nsyntax = \"proto3\";\n\noption go_package = \"/v1\";\n\npackage kuadrant.v1;\n")
+	if len(imports) > 0 {
+		b.WriteString("\n")
+	}
+	for _, i := range imports {
+		fmt.Fprintf(&b, "import %q;\n", i)
+	}
+	for _, msg := range messages {
+		fmt.Fprintf(&b, "\n// %s is generated from %s\n", msg.name, qualifiedName(msg.goType))
+		fmt.Fprintf(&b, "message %s {\n", msg.name)
+		for _, f := range msg.fields {
+			label := ""
+			if f.label != "" {
+				label = f.label + " "
+			}
+			fmt.Fprintf(&b, "  %s%s %s = %d;\n", label, f.protoType, f.name, f.number)
+		}
+		if len(msg.reserved) > 0 {
+			fmt.Fprintf(&b, "  reserved %s;\n", strings.Join(lo.Map(msg.reserved, func(n int, _ int) string { return strconv.Itoa(n) }), ", "))
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+func (m *model) renderConverters() ([]byte, error) {
+	messages := slices.Clone(m.messages)
+	slices.SortFunc(messages, func(a, b *message) int { return strings.Compare(a.name, b.name) })
+
+	imports := lo.Uniq(lo.Map(messages, func(msg *message, _ int) string { return msg.goType.PkgPath() }))
+	slices.Sort(imports)
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("\npackage v1\n\nimport (\n")
+	for _, i := range imports {
+		fmt.Fprintf(&b, "\t%s %q\n", packageAliases[i], i)
+	}
+	b.WriteString(")\n")
+	for _, msg := range messages {
+		goType := packageAliases[msg.goType.PkgPath()] + "." + msg.goType.Name()
+		if msg.root != nil {
+			fmt.Fprintf(&b, "\n// %s converts a %s into its protobuf representation\n", msg.convertFunc(), goType)
+		} else {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "func %s(in *%s) *%s {\n", msg.convertFunc(), goType, msg.name)
+		b.WriteString("\tif in == nil {\n\t\treturn nil\n\t}\n")
+		guarded := lo.GroupBy(lo.Filter(msg.fields, func(f *field, _ int) bool { return f.guard != "" }), func(f *field) string { return f.guard })
+		if len(guarded) == 0 {
+			b.WriteString("\treturn ")
+		} else {
+			b.WriteString("\tout := ")
+		}
+		fmt.Fprintf(&b, "&%s{\n", msg.name)
+		for _, f := range msg.fields {
+			if f.guard == "" {
+				fmt.Fprintf(&b, "\t\t%s: %s,\n", f.goName, f.convert)
+			}
+		}
+		b.WriteString("\t}\n")
+		if len(guarded) > 0 {
+			guards := lo.Keys(guarded)
+			slices.Sort(guards)
+			for _, guard := range guards {
+				fmt.Fprintf(&b, "\tif %s {\n", guard)
+				for _, f := range guarded[guard] {
+					fmt.Fprintf(&b, "\t\tout.%s = %s\n", f.goName, f.convert)
+				}
+				b.WriteString("\t}\n")
+			}
+			b.WriteString("\treturn out\n")
+		}
+		b.WriteString("}\n")
+	}
+	return format.Source(b.Bytes())
+}
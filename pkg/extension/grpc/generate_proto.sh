@@ -15,7 +15,16 @@ then
 fi
 
 SCRIPT_DIR=$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )
+
+# the messages mirroring the API objects, and their converters, are generated from the Go types
+if [ "$1" == "v1" ]; then
+  (cd $SCRIPT_DIR && go run ./apigen -out $1) || exit 1
+fi
+
 protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/common.proto
 protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR --go-grpc_out=$SCRIPT_DIR $SCRIPT_DIR/$1/kuadrant.proto
+protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/k8s_api.proto
 protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/gateway_api.proto
 protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/policy.proto
+protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/kuadrant_api.proto
+protoc -I=$SCRIPT_DIR -I=$SCRIPT_DIR/$1 --go_out=$SCRIPT_DIR $SCRIPT_DIR/$1/topology.proto
@@ -0,0 +1,16 @@
+package v1
+
+// APIVersion is the version of the extension API of this package
+const APIVersion = "v1"
+
+// Features of kuadrant-operator an extension may require, negotiated with GetCapabilities
+const (
+	FeatureResolve         = "Resolve"
+	FeatureSubscribe       = "Subscribe"
+	FeatureRegisterMutator = "RegisterMutator"
+	FeatureClearPolicy     = "ClearPolicy"
+	FeatureReportStatus    = "ReportStatus"
+	FeatureDomainAuth      = "DomainAuth"
+	FeatureDomainRequest   = "DomainRequest"
+	FeatureDomainResponse  = "DomainResponse"
+)
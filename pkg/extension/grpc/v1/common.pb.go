@@ -28,6 +28,9 @@ type Metadata struct {
 	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Generation    int64                  `protobuf:"varint,7,opt,name=generation,proto3" json:"generation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +93,27 @@ func (x *Metadata) GetNamespace() string {
 	return ""
 }
 
+func (x *Metadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Metadata) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Metadata) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
 type TargetRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
@@ -286,16 +310,72 @@ func (x *ConditionStatus) GetStatus() string {
 	return ""
 }
 
+// ConditionList holds the conditions of a map entry, as proto does not allow the values of maps to be repeated
+type ConditionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conditions    []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConditionList) Reset() {
+	*x = ConditionList{}
+	mi := &file_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConditionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionList) ProtoMessage() {}
+
+func (x *ConditionList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionList.ProtoReflect.Descriptor instead.
+func (*ConditionList) Descriptor() ([]byte, []int) {
+	return file_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConditionList) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 var File_v1_common_proto protoreflect.FileDescriptor
 
 const file_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x0fv1/common.proto\x12\vkuadrant.v1\"f\n" +
+	"\x0fv1/common.proto\x12\vkuadrant.v1\"\x86\x03\n" +
 	"\bMetadata\x12\x14\n" +
 	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
 	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
-	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"\x89\x01\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x129\n" +
+	"\x06labels\x18\x05 \x03(\v2!.kuadrant.v1.Metadata.LabelsEntryR\x06labels\x12H\n" +
+	"\vannotations\x18\x06 \x03(\v2&.kuadrant.v1.Metadata.AnnotationsEntryR\vannotations\x12\x1e\n" +
+	"\n" +
+	"generation\x18\a \x01(\x03R\n" +
+	"generation\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
 	"\tTargetRef\x12\x14\n" +
 	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
 	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
@@ -309,7 +389,11 @@ const file_v1_common_proto_rawDesc = "" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
 	"\amessage\x18\x05 \x01(\tR\amessage\")\n" +
 	"\x0fConditionStatus\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06statusB\x05Z\x03/v1b\x06proto3"
+	"\x06status\x18\x01 \x01(\tR\x06status\"G\n" +
+	"\rConditionList\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditionsB\x05Z\x03/v1b\x06proto3"
 
 var (
 	file_v1_common_proto_rawDescOnce sync.Once
@@ -323,19 +407,25 @@ func file_v1_common_proto_rawDescGZIP() []byte {
 	return file_v1_common_proto_rawDescData
 }
 
-var file_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_v1_common_proto_goTypes = []any{
 	(*Metadata)(nil),        // 0: kuadrant.v1.Metadata
 	(*TargetRef)(nil),       // 1: kuadrant.v1.TargetRef
 	(*Condition)(nil),       // 2: kuadrant.v1.Condition
 	(*ConditionStatus)(nil), // 3: kuadrant.v1.ConditionStatus
+	(*ConditionList)(nil),   // 4: kuadrant.v1.ConditionList
+	nil,                     // 5: kuadrant.v1.Metadata.LabelsEntry
+	nil,                     // 6: kuadrant.v1.Metadata.AnnotationsEntry
 }
 var file_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5, // 0: kuadrant.v1.Metadata.labels:type_name -> kuadrant.v1.Metadata.LabelsEntry
+	6, // 1: kuadrant.v1.Metadata.annotations:type_name -> kuadrant.v1.Metadata.AnnotationsEntry
+	2, // 2: kuadrant.v1.ConditionList.conditions:type_name -> kuadrant.v1.Condition
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_v1_common_proto_init() }
@@ -349,7 +439,7 @@ func file_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_common_proto_rawDesc), len(file_v1_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
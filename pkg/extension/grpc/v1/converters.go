@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"maps"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ConvertConditions converts status conditions into their protobuf representation
+func ConvertConditions(in []metav1.Condition) []*Condition {
+	return convertList(in, convertCondition)
+}
+
+// ToConditions converts the protobuf representation of status conditions back into conditions, without transition time
+func ToConditions(in []*Condition) []metav1.Condition {
+	if in == nil {
+		return nil
+	}
+	out := make([]metav1.Condition, len(in))
+	for i, c := range in {
+		out[i] = metav1.Condition{
+			Type:               c.GetType(),
+			Status:             metav1.ConditionStatus(c.GetConditionStatus()),
+			ObservedGeneration: c.GetObservedGeneration(),
+			Reason:             c.GetReason(),
+			Message:            c.GetMessage(),
+		}
+	}
+	return out
+}
+
+// The functions below are used by the converters generated by apigen, see zz_generated.converters.go
+
+func convertObjectMeta(group, kind string, in *metav1.ObjectMeta) *Metadata {
+	return &Metadata{
+		Group:       group,
+		Kind:        kind,
+		Name:        in.Name,
+		Namespace:   in.Namespace,
+		Labels:      maps.Clone(in.Labels),
+		Annotations: maps.Clone(in.Annotations),
+		Generation:  in.Generation,
+	}
+}
+
+func convertCondition(in *metav1.Condition) *Condition {
+	return &Condition{
+		Type:               in.Type,
+		ConditionStatus:    string(in.Status),
+		ObservedGeneration: in.ObservedGeneration,
+		Reason:             in.Reason,
+		Message:            in.Message,
+	}
+}
+
+func convertConditionList(in *[]metav1.Condition) *ConditionList {
+	return &ConditionList{Conditions: convertList(*in, convertCondition)}
+}
+
+func convertDuration(in *metav1.Duration) *durationpb.Duration {
+	if in == nil {
+		return nil
+	}
+	return durationpb.New(in.Duration)
+}
+
+// convertTime converts a timestamp, or null if the timestamp is not set
+func convertTime(in *metav1.Time) *timestamppb.Timestamp {
+	if in == nil || in.IsZero() {
+		return nil
+	}
+	return timestamppb.New(in.Time)
+}
+
+// convertRawExtension converts an embedded object into a JSON value, or null if the object cannot be represented as such
+func convertRawExtension(in *runtime.RawExtension) *structpb.Value {
+	if in == nil {
+		return nil
+	}
+	value := &structpb.Value{}
+	raw, err := in.MarshalJSON()
+	if err != nil || value.UnmarshalJSON(raw) != nil {
+		return structpb.NewNullValue()
+	}
+	return value
+}
+
+func convertQuantity(in *resource.Quantity) string {
+	if in == nil {
+		return ""
+	}
+	return in.String()
+}
+
+func convertIntOrString(in *intstr.IntOrString) string {
+	if in == nil {
+		return ""
+	}
+	return in.String()
+}
+
+func convertList[T, U any](in []T, convert func(*T) U) []U {
+	if in == nil {
+		return nil
+	}
+	out := make([]U, len(in))
+	for i := range in {
+		out[i] = convert(&in[i])
+	}
+	return out
+}
+
+func convertPtrList[T, U any](in []*T, convert func(*T) U) []U {
+	if in == nil {
+		return nil
+	}
+	out := make([]U, len(in))
+	for i := range in {
+		out[i] = convert(in[i])
+	}
+	return out
+}
+
+func convertMap[K ~string, T, U any](in map[K]T, convert func(*T) U) map[string]U {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]U, len(in))
+	for k, v := range in {
+		out[string(k)] = convert(&v)
+	}
+	return out
+}
+
+func stringMap[K, V ~string](in map[K]V) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[string(k)] = string(v)
+	}
+	return out
+}
+
+func stringList[T ~string](in []T) []string {
+	if in == nil {
+		return nil
+	}
+	out := make([]string, len(in))
+	for i, v := range in {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func int64List[T ~int | ~int32 | ~int64](in []T) []int64 {
+	if in == nil {
+		return nil
+	}
+	out := make([]int64, len(in))
+	for i, v := range in {
+		out[i] = int64(v)
+	}
+	return out
+}
+
+func optionalString[T ~string](in *T) *string {
+	return optional[T, string](in, func(v T) string { return string(v) })
+}
+
+func optionalBool[T ~bool](in *T) *bool {
+	return optional[T, bool](in, func(v T) bool { return bool(v) })
+}
+
+func optionalInt32[T ~int32](in *T) *int32 {
+	return optional[T, int32](in, func(v T) int32 { return int32(v) })
+}
+
+func optionalInt64[T ~int | ~int32 | ~int64](in *T) *int64 {
+	return optional[T, int64](in, func(v T) int64 { return int64(v) })
+}
+
+func optional[T, U any](in *T, convert func(T) U) *U {
+	if in == nil {
+		return nil
+	}
+	out := convert(*in)
+	return &out
+}
@@ -1,3 +1,5 @@
+// Code generated by apigen. DO NOT EDIT.
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.8
@@ -21,29 +23,29 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Gateway struct {
+// AllowedRoutes is generated from sigs.k8s.io/gateway-api/apis/v1.AllowedRoutes
+type AllowedRoutes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *GatewaySpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *GatewayStatus         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Namespaces    *RouteNamespaces       `protobuf:"bytes,1,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Kinds         []*RouteGroupKind      `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Gateway) Reset() {
-	*x = Gateway{}
+func (x *AllowedRoutes) Reset() {
+	*x = AllowedRoutes{}
 	mi := &file_v1_gateway_api_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Gateway) String() string {
+func (x *AllowedRoutes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gateway) ProtoMessage() {}
+func (*AllowedRoutes) ProtoMessage() {}
 
-func (x *Gateway) ProtoReflect() protoreflect.Message {
+func (x *AllowedRoutes) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -55,55 +57,51 @@ func (x *Gateway) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gateway.ProtoReflect.Descriptor instead.
-func (*Gateway) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllowedRoutes.ProtoReflect.Descriptor instead.
+func (*AllowedRoutes) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Gateway) GetMetadata() *Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *Gateway) GetSpec() *GatewaySpec {
+func (x *AllowedRoutes) GetNamespaces() *RouteNamespaces {
 	if x != nil {
-		return x.Spec
+		return x.Namespaces
 	}
 	return nil
 }
 
-func (x *Gateway) GetStatus() *GatewayStatus {
+func (x *AllowedRoutes) GetKinds() []*RouteGroupKind {
 	if x != nil {
-		return x.Status
+		return x.Kinds
 	}
 	return nil
 }
 
-type GatewaySpec struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GatewayClassName string                 `protobuf:"bytes,1,opt,name=gatewayClassName,proto3" json:"gatewayClassName,omitempty"`
-	Listeners        []*Listener            `protobuf:"bytes,2,rep,name=listeners,proto3" json:"listeners,omitempty"`
-	Addresses        []*GatewayAddresses    `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// BackendObjectReference is generated from sigs.k8s.io/gateway-api/apis/v1.BackendObjectReference
+type BackendObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Port          *int32                 `protobuf:"varint,5,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewaySpec) Reset() {
-	*x = GatewaySpec{}
+func (x *BackendObjectReference) Reset() {
+	*x = BackendObjectReference{}
 	mi := &file_v1_gateway_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewaySpec) String() string {
+func (x *BackendObjectReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewaySpec) ProtoMessage() {}
+func (*BackendObjectReference) ProtoMessage() {}
 
-func (x *GatewaySpec) ProtoReflect() protoreflect.Message {
+func (x *BackendObjectReference) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -115,55 +113,68 @@ func (x *GatewaySpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewaySpec.ProtoReflect.Descriptor instead.
-func (*GatewaySpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendObjectReference.ProtoReflect.Descriptor instead.
+func (*BackendObjectReference) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GatewaySpec) GetGatewayClassName() string {
-	if x != nil {
-		return x.GatewayClassName
+func (x *BackendObjectReference) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
 	}
 	return ""
 }
 
-func (x *GatewaySpec) GetListeners() []*Listener {
-	if x != nil {
-		return x.Listeners
+func (x *BackendObjectReference) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewaySpec) GetAddresses() []*GatewayAddresses {
+func (x *BackendObjectReference) GetName() string {
 	if x != nil {
-		return x.Addresses
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Listener struct {
+func (x *BackendObjectReference) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *BackendObjectReference) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+// CookieConfig is generated from sigs.k8s.io/gateway-api/apis/v1.CookieConfig
+type CookieConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	LifetimeType  *string                `protobuf:"bytes,1,opt,name=lifetimeType,proto3,oneof" json:"lifetimeType,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Listener) Reset() {
-	*x = Listener{}
+func (x *CookieConfig) Reset() {
+	*x = CookieConfig{}
 	mi := &file_v1_gateway_api_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Listener) String() string {
+func (x *CookieConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listener) ProtoMessage() {}
+func (*CookieConfig) ProtoMessage() {}
 
-func (x *Listener) ProtoReflect() protoreflect.Message {
+func (x *CookieConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,54 +186,41 @@ func (x *Listener) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
-func (*Listener) Descriptor() ([]byte, []int) {
+// Deprecated: Use CookieConfig.ProtoReflect.Descriptor instead.
+func (*CookieConfig) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Listener) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Listener) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *Listener) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
+func (x *CookieConfig) GetLifetimeType() string {
+	if x != nil && x.LifetimeType != nil {
+		return *x.LifetimeType
 	}
 	return ""
 }
 
-type GatewayAddresses struct {
+// Fraction is generated from sigs.k8s.io/gateway-api/apis/v1.Fraction
+type Fraction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressType   string                 `protobuf:"bytes,1,opt,name=addressType,proto3" json:"addressType,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Numerator     int32                  `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator   *int32                 `protobuf:"varint,2,opt,name=denominator,proto3,oneof" json:"denominator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayAddresses) Reset() {
-	*x = GatewayAddresses{}
+func (x *Fraction) Reset() {
+	*x = Fraction{}
 	mi := &file_v1_gateway_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayAddresses) String() string {
+func (x *Fraction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayAddresses) ProtoMessage() {}
+func (*Fraction) ProtoMessage() {}
 
-func (x *GatewayAddresses) ProtoReflect() protoreflect.Message {
+func (x *Fraction) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -234,48 +232,47 @@ func (x *GatewayAddresses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayAddresses.ProtoReflect.Descriptor instead.
-func (*GatewayAddresses) Descriptor() ([]byte, []int) {
+// Deprecated: Use Fraction.ProtoReflect.Descriptor instead.
+func (*Fraction) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GatewayAddresses) GetAddressType() string {
+func (x *Fraction) GetNumerator() int32 {
 	if x != nil {
-		return x.AddressType
+		return x.Numerator
 	}
-	return ""
+	return 0
 }
 
-func (x *GatewayAddresses) GetValue() string {
-	if x != nil {
-		return x.Value
+func (x *Fraction) GetDenominator() int32 {
+	if x != nil && x.Denominator != nil {
+		return *x.Denominator
 	}
-	return ""
+	return 0
 }
 
-type GatewayStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []*GatewayAddresses    `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Conditions    []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
-	Listeners     []*ListenerStatus      `protobuf:"bytes,3,rep,name=listeners,proto3" json:"listeners,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// FrontendTLSValidation is generated from sigs.k8s.io/gateway-api/apis/v1.FrontendTLSValidation
+type FrontendTLSValidation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CaCertificateRefs []*ObjectReference     `protobuf:"bytes,1,rep,name=caCertificateRefs,proto3" json:"caCertificateRefs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GatewayStatus) Reset() {
-	*x = GatewayStatus{}
+func (x *FrontendTLSValidation) Reset() {
+	*x = FrontendTLSValidation{}
 	mi := &file_v1_gateway_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayStatus) String() string {
+func (x *FrontendTLSValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayStatus) ProtoMessage() {}
+func (*FrontendTLSValidation) ProtoMessage() {}
 
-func (x *GatewayStatus) ProtoReflect() protoreflect.Message {
+func (x *FrontendTLSValidation) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -287,55 +284,46 @@ func (x *GatewayStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayStatus.ProtoReflect.Descriptor instead.
-func (*GatewayStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendTLSValidation.ProtoReflect.Descriptor instead.
+func (*FrontendTLSValidation) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GatewayStatus) GetAddresses() []*GatewayAddresses {
-	if x != nil {
-		return x.Addresses
-	}
-	return nil
-}
-
-func (x *GatewayStatus) GetConditions() []*Condition {
-	if x != nil {
-		return x.Conditions
-	}
-	return nil
-}
-
-func (x *GatewayStatus) GetListeners() []*ListenerStatus {
+func (x *FrontendTLSValidation) GetCaCertificateRefs() []*ObjectReference {
 	if x != nil {
-		return x.Listeners
+		return x.CaCertificateRefs
 	}
 	return nil
 }
 
-type ListenerStatus struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AttachedRoutes int32                  `protobuf:"varint,2,opt,name=attachedRoutes,proto3" json:"attachedRoutes,omitempty"`
-	Conditions     []*Condition           `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GRPCBackendRef is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCBackendRef
+type GRPCBackendRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Port          *int32                 `protobuf:"varint,5,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	Weight        *int32                 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Filters       []*GRPCRouteFilter     `protobuf:"bytes,7,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenerStatus) Reset() {
-	*x = ListenerStatus{}
+func (x *GRPCBackendRef) Reset() {
+	*x = GRPCBackendRef{}
 	mi := &file_v1_gateway_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenerStatus) String() string {
+func (x *GRPCBackendRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenerStatus) ProtoMessage() {}
+func (*GRPCBackendRef) ProtoMessage() {}
 
-func (x *ListenerStatus) ProtoReflect() protoreflect.Message {
+func (x *GRPCBackendRef) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,55 +335,84 @@ func (x *ListenerStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenerStatus.ProtoReflect.Descriptor instead.
-func (*ListenerStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GRPCBackendRef.ProtoReflect.Descriptor instead.
+func (*GRPCBackendRef) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListenerStatus) GetName() string {
+func (x *GRPCBackendRef) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *GRPCBackendRef) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *GRPCBackendRef) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListenerStatus) GetAttachedRoutes() int32 {
-	if x != nil {
-		return x.AttachedRoutes
+func (x *GRPCBackendRef) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GRPCBackendRef) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
 	}
 	return 0
 }
 
-func (x *ListenerStatus) GetConditions() []*Condition {
+func (x *GRPCBackendRef) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *GRPCBackendRef) GetFilters() []*GRPCRouteFilter {
 	if x != nil {
-		return x.Conditions
+		return x.Filters
 	}
 	return nil
 }
 
-type GatewayClass struct {
+// GRPCHeaderMatch is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCHeaderMatch
+type GRPCHeaderMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec          *GatewayClassSpec      `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
-	Status        *GatewayClassStatus    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayClass) Reset() {
-	*x = GatewayClass{}
+func (x *GRPCHeaderMatch) Reset() {
+	*x = GRPCHeaderMatch{}
 	mi := &file_v1_gateway_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayClass) String() string {
+func (x *GRPCHeaderMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayClass) ProtoMessage() {}
+func (*GRPCHeaderMatch) ProtoMessage() {}
 
-func (x *GatewayClass) ProtoReflect() protoreflect.Message {
+func (x *GRPCHeaderMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,53 +424,56 @@ func (x *GatewayClass) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayClass.ProtoReflect.Descriptor instead.
-func (*GatewayClass) Descriptor() ([]byte, []int) {
+// Deprecated: Use GRPCHeaderMatch.ProtoReflect.Descriptor instead.
+func (*GRPCHeaderMatch) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GatewayClass) GetMetadata() *Metadata {
-	if x != nil {
-		return x.Metadata
+func (x *GRPCHeaderMatch) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewayClass) GetSpec() *GatewayClassSpec {
+func (x *GRPCHeaderMatch) GetName() string {
 	if x != nil {
-		return x.Spec
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewayClass) GetStatus() *GatewayClassStatus {
+func (x *GRPCHeaderMatch) GetValue() string {
 	if x != nil {
-		return x.Status
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type GatewayClassSpec struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ControllerName string                 `protobuf:"bytes,1,opt,name=controllerName,proto3" json:"controllerName,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GRPCMethodMatch is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCMethodMatch
+type GRPCMethodMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Service       *string                `protobuf:"bytes,2,opt,name=service,proto3,oneof" json:"service,omitempty"`
+	Method        *string                `protobuf:"bytes,3,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayClassSpec) Reset() {
-	*x = GatewayClassSpec{}
+func (x *GRPCMethodMatch) Reset() {
+	*x = GRPCMethodMatch{}
 	mi := &file_v1_gateway_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayClassSpec) String() string {
+func (x *GRPCMethodMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayClassSpec) ProtoMessage() {}
+func (*GRPCMethodMatch) ProtoMessage() {}
 
-func (x *GatewayClassSpec) ProtoReflect() protoreflect.Message {
+func (x *GRPCMethodMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,39 +485,56 @@ func (x *GatewayClassSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayClassSpec.ProtoReflect.Descriptor instead.
-func (*GatewayClassSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use GRPCMethodMatch.ProtoReflect.Descriptor instead.
+func (*GRPCMethodMatch) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GatewayClassSpec) GetControllerName() string {
-	if x != nil {
-		return x.ControllerName
+func (x *GRPCMethodMatch) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-type GatewayClassStatus struct {
+func (x *GRPCMethodMatch) GetService() string {
+	if x != nil && x.Service != nil {
+		return *x.Service
+	}
+	return ""
+}
+
+func (x *GRPCMethodMatch) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+// GRPCRoute is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRoute
+type GRPCRoute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conditions    []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *GRPCRouteSpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *GRPCRouteStatus       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayClassStatus) Reset() {
-	*x = GatewayClassStatus{}
+func (x *GRPCRoute) Reset() {
+	*x = GRPCRoute{}
 	mi := &file_v1_gateway_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayClassStatus) String() string {
+func (x *GRPCRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayClassStatus) ProtoMessage() {}
+func (*GRPCRoute) ProtoMessage() {}
 
-func (x *GatewayClassStatus) ProtoReflect() protoreflect.Message {
+func (x *GRPCRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_gateway_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,60 +546,3450 @@ func (x *GatewayClassStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayClassStatus.ProtoReflect.Descriptor instead.
-func (*GatewayClassStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GRPCRoute.ProtoReflect.Descriptor instead.
+func (*GRPCRoute) Descriptor() ([]byte, []int) {
 	return file_v1_gateway_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GatewayClassStatus) GetConditions() []*Condition {
+func (x *GRPCRoute) GetMetadata() *Metadata {
 	if x != nil {
-		return x.Conditions
+		return x.Metadata
 	}
 	return nil
 }
 
-var File_v1_gateway_api_proto protoreflect.FileDescriptor
+func (x *GRPCRoute) GetSpec() *GRPCRouteSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
 
-const file_v1_gateway_api_proto_rawDesc = "" +
-	"\n" +
-	"\x14v1/gateway_api.proto\x12\vkuadrant.v1\x1a\x0fv1/common.proto\"\x9e\x01\n" +
-	"\aGateway\x121\n" +
-	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12,\n" +
-	"\x04spec\x18\x02 \x01(\v2\x18.kuadrant.v1.GatewaySpecR\x04spec\x122\n" +
-	"\x06status\x18\x03 \x01(\v2\x1a.kuadrant.v1.GatewayStatusR\x06status\"\xab\x01\n" +
-	"\vGatewaySpec\x12*\n" +
-	"\x10gatewayClassName\x18\x01 \x01(\tR\x10gatewayClassName\x123\n" +
-	"\tlisteners\x18\x02 \x03(\v2\x15.kuadrant.v1.ListenerR\tlisteners\x12;\n" +
-	"\taddresses\x18\x03 \x03(\v2\x1d.kuadrant.v1.GatewayAddressesR\taddresses\"V\n" +
-	"\bListener\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x1a\n" +
-	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"J\n" +
-	"\x10GatewayAddresses\x12 \n" +
-	"\vaddressType\x18\x01 \x01(\tR\vaddressType\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\xbf\x01\n" +
-	"\rGatewayStatus\x12;\n" +
-	"\taddresses\x18\x01 \x03(\v2\x1d.kuadrant.v1.GatewayAddressesR\taddresses\x126\n" +
-	"\n" +
-	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
-	"conditions\x129\n" +
-	"\tlisteners\x18\x03 \x03(\v2\x1b.kuadrant.v1.ListenerStatusR\tlisteners\"\x84\x01\n" +
-	"\x0eListenerStatus\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
-	"\x0eattachedRoutes\x18\x02 \x01(\x05R\x0eattachedRoutes\x126\n" +
-	"\n" +
-	"conditions\x18\x03 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
-	"conditions\"\xad\x01\n" +
-	"\fGatewayClass\x121\n" +
-	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x121\n" +
-	"\x04spec\x18\x02 \x01(\v2\x1d.kuadrant.v1.GatewayClassSpecR\x04spec\x127\n" +
-	"\x06status\x18\x03 \x01(\v2\x1f.kuadrant.v1.GatewayClassStatusR\x06status\":\n" +
-	"\x10GatewayClassSpec\x12&\n" +
-	"\x0econtrollerName\x18\x01 \x01(\tR\x0econtrollerName\"L\n" +
-	"\x12GatewayClassStatus\x126\n" +
-	"\n" +
-	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
-	"conditionsB\x05Z\x03/v1b\x06proto3"
+func (x *GRPCRoute) GetStatus() *GRPCRouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// GRPCRouteFilter is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRouteFilter
+type GRPCRouteFilter struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	Type                   string                   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	RequestHeaderModifier  *HTTPHeaderFilter        `protobuf:"bytes,2,opt,name=requestHeaderModifier,proto3" json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilter        `protobuf:"bytes,3,opt,name=responseHeaderModifier,proto3" json:"responseHeaderModifier,omitempty"`
+	RequestMirror          *HTTPRequestMirrorFilter `protobuf:"bytes,4,opt,name=requestMirror,proto3" json:"requestMirror,omitempty"`
+	ExtensionRef           *LocalObjectReference    `protobuf:"bytes,5,opt,name=extensionRef,proto3" json:"extensionRef,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GRPCRouteFilter) Reset() {
+	*x = GRPCRouteFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCRouteFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCRouteFilter) ProtoMessage() {}
+
+func (x *GRPCRouteFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCRouteFilter.ProtoReflect.Descriptor instead.
+func (*GRPCRouteFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GRPCRouteFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GRPCRouteFilter) GetRequestHeaderModifier() *HTTPHeaderFilter {
+	if x != nil {
+		return x.RequestHeaderModifier
+	}
+	return nil
+}
+
+func (x *GRPCRouteFilter) GetResponseHeaderModifier() *HTTPHeaderFilter {
+	if x != nil {
+		return x.ResponseHeaderModifier
+	}
+	return nil
+}
+
+func (x *GRPCRouteFilter) GetRequestMirror() *HTTPRequestMirrorFilter {
+	if x != nil {
+		return x.RequestMirror
+	}
+	return nil
+}
+
+func (x *GRPCRouteFilter) GetExtensionRef() *LocalObjectReference {
+	if x != nil {
+		return x.ExtensionRef
+	}
+	return nil
+}
+
+// GRPCRouteMatch is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRouteMatch
+type GRPCRouteMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        *GRPCMethodMatch       `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Headers       []*GRPCHeaderMatch     `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRPCRouteMatch) Reset() {
+	*x = GRPCRouteMatch{}
+	mi := &file_v1_gateway_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCRouteMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCRouteMatch) ProtoMessage() {}
+
+func (x *GRPCRouteMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCRouteMatch.ProtoReflect.Descriptor instead.
+func (*GRPCRouteMatch) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GRPCRouteMatch) GetMethod() *GRPCMethodMatch {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
+
+func (x *GRPCRouteMatch) GetHeaders() []*GRPCHeaderMatch {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// GRPCRouteRule is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRouteRule
+type GRPCRouteRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Matches            []*GRPCRouteMatch      `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	Filters            []*GRPCRouteFilter     `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	BackendRefs        []*GRPCBackendRef      `protobuf:"bytes,4,rep,name=backendRefs,proto3" json:"backendRefs,omitempty"`
+	SessionPersistence *SessionPersistence    `protobuf:"bytes,5,opt,name=sessionPersistence,proto3" json:"sessionPersistence,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GRPCRouteRule) Reset() {
+	*x = GRPCRouteRule{}
+	mi := &file_v1_gateway_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCRouteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCRouteRule) ProtoMessage() {}
+
+func (x *GRPCRouteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCRouteRule.ProtoReflect.Descriptor instead.
+func (*GRPCRouteRule) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GRPCRouteRule) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GRPCRouteRule) GetMatches() []*GRPCRouteMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GRPCRouteRule) GetFilters() []*GRPCRouteFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GRPCRouteRule) GetBackendRefs() []*GRPCBackendRef {
+	if x != nil {
+		return x.BackendRefs
+	}
+	return nil
+}
+
+func (x *GRPCRouteRule) GetSessionPersistence() *SessionPersistence {
+	if x != nil {
+		return x.SessionPersistence
+	}
+	return nil
+}
+
+// GRPCRouteSpec is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRouteSpec
+type GRPCRouteSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentRefs    []*ParentReference     `protobuf:"bytes,1,rep,name=parentRefs,proto3" json:"parentRefs,omitempty"`
+	Hostnames     []string               `protobuf:"bytes,2,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	Rules         []*GRPCRouteRule       `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRPCRouteSpec) Reset() {
+	*x = GRPCRouteSpec{}
+	mi := &file_v1_gateway_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCRouteSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCRouteSpec) ProtoMessage() {}
+
+func (x *GRPCRouteSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCRouteSpec.ProtoReflect.Descriptor instead.
+func (*GRPCRouteSpec) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GRPCRouteSpec) GetParentRefs() []*ParentReference {
+	if x != nil {
+		return x.ParentRefs
+	}
+	return nil
+}
+
+func (x *GRPCRouteSpec) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *GRPCRouteSpec) GetRules() []*GRPCRouteRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// GRPCRouteStatus is generated from sigs.k8s.io/gateway-api/apis/v1.GRPCRouteStatus
+type GRPCRouteStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parents       []*RouteParentStatus   `protobuf:"bytes,1,rep,name=parents,proto3" json:"parents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRPCRouteStatus) Reset() {
+	*x = GRPCRouteStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCRouteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCRouteStatus) ProtoMessage() {}
+
+func (x *GRPCRouteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCRouteStatus.ProtoReflect.Descriptor instead.
+func (*GRPCRouteStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GRPCRouteStatus) GetParents() []*RouteParentStatus {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+// Gateway is generated from sigs.k8s.io/gateway-api/apis/v1.Gateway
+type Gateway struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *GatewaySpec           `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *GatewayStatus         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gateway) Reset() {
+	*x = Gateway{}
+	mi := &file_v1_gateway_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gateway) ProtoMessage() {}
+
+func (x *Gateway) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gateway.ProtoReflect.Descriptor instead.
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Gateway) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Gateway) GetSpec() *GatewaySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Gateway) GetStatus() *GatewayStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// GatewayAddress is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayAddress
+type GatewayAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayAddress) Reset() {
+	*x = GatewayAddress{}
+	mi := &file_v1_gateway_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayAddress) ProtoMessage() {}
+
+func (x *GatewayAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayAddress.ProtoReflect.Descriptor instead.
+func (*GatewayAddress) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GatewayAddress) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GatewayAddress) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// GatewayBackendTLS is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayBackendTLS
+type GatewayBackendTLS struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ClientCertificateRef *SecretObjectReference `protobuf:"bytes,1,opt,name=clientCertificateRef,proto3" json:"clientCertificateRef,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GatewayBackendTLS) Reset() {
+	*x = GatewayBackendTLS{}
+	mi := &file_v1_gateway_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayBackendTLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayBackendTLS) ProtoMessage() {}
+
+func (x *GatewayBackendTLS) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayBackendTLS.ProtoReflect.Descriptor instead.
+func (*GatewayBackendTLS) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GatewayBackendTLS) GetClientCertificateRef() *SecretObjectReference {
+	if x != nil {
+		return x.ClientCertificateRef
+	}
+	return nil
+}
+
+// GatewayClass is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayClass
+type GatewayClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *GatewayClassSpec      `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *GatewayClassStatus    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayClass) Reset() {
+	*x = GatewayClass{}
+	mi := &file_v1_gateway_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClass) ProtoMessage() {}
+
+func (x *GatewayClass) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClass.ProtoReflect.Descriptor instead.
+func (*GatewayClass) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GatewayClass) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GatewayClass) GetSpec() *GatewayClassSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *GatewayClass) GetStatus() *GatewayClassStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// GatewayClassSpec is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayClassSpec
+type GatewayClassSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ControllerName string                 `protobuf:"bytes,1,opt,name=controllerName,proto3" json:"controllerName,omitempty"`
+	ParametersRef  *ParametersReference   `protobuf:"bytes,2,opt,name=parametersRef,proto3" json:"parametersRef,omitempty"`
+	Description    *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GatewayClassSpec) Reset() {
+	*x = GatewayClassSpec{}
+	mi := &file_v1_gateway_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayClassSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClassSpec) ProtoMessage() {}
+
+func (x *GatewayClassSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClassSpec.ProtoReflect.Descriptor instead.
+func (*GatewayClassSpec) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GatewayClassSpec) GetControllerName() string {
+	if x != nil {
+		return x.ControllerName
+	}
+	return ""
+}
+
+func (x *GatewayClassSpec) GetParametersRef() *ParametersReference {
+	if x != nil {
+		return x.ParametersRef
+	}
+	return nil
+}
+
+func (x *GatewayClassSpec) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// GatewayClassStatus is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayClassStatus
+type GatewayClassStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Conditions        []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	SupportedFeatures []*SupportedFeature    `protobuf:"bytes,2,rep,name=supportedFeatures,proto3" json:"supportedFeatures,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GatewayClassStatus) Reset() {
+	*x = GatewayClassStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayClassStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClassStatus) ProtoMessage() {}
+
+func (x *GatewayClassStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClassStatus.ProtoReflect.Descriptor instead.
+func (*GatewayClassStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GatewayClassStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *GatewayClassStatus) GetSupportedFeatures() []*SupportedFeature {
+	if x != nil {
+		return x.SupportedFeatures
+	}
+	return nil
+}
+
+// GatewayInfrastructure is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayInfrastructure
+type GatewayInfrastructure struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Labels        map[string]string         `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string         `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ParametersRef *LocalParametersReference `protobuf:"bytes,3,opt,name=parametersRef,proto3" json:"parametersRef,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayInfrastructure) Reset() {
+	*x = GatewayInfrastructure{}
+	mi := &file_v1_gateway_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayInfrastructure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayInfrastructure) ProtoMessage() {}
+
+func (x *GatewayInfrastructure) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayInfrastructure.ProtoReflect.Descriptor instead.
+func (*GatewayInfrastructure) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GatewayInfrastructure) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GatewayInfrastructure) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *GatewayInfrastructure) GetParametersRef() *LocalParametersReference {
+	if x != nil {
+		return x.ParametersRef
+	}
+	return nil
+}
+
+// GatewaySpec is generated from sigs.k8s.io/gateway-api/apis/v1.GatewaySpec
+type GatewaySpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GatewayClassName string                 `protobuf:"bytes,1,opt,name=gatewayClassName,proto3" json:"gatewayClassName,omitempty"`
+	Listeners        []*Listener            `protobuf:"bytes,2,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	Addresses        []*GatewayAddress      `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Infrastructure   *GatewayInfrastructure `protobuf:"bytes,5,opt,name=infrastructure,proto3" json:"infrastructure,omitempty"`
+	BackendTLS       *GatewayBackendTLS     `protobuf:"bytes,6,opt,name=backendTLS,proto3" json:"backendTLS,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GatewaySpec) Reset() {
+	*x = GatewaySpec{}
+	mi := &file_v1_gateway_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewaySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaySpec) ProtoMessage() {}
+
+func (x *GatewaySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaySpec.ProtoReflect.Descriptor instead.
+func (*GatewaySpec) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GatewaySpec) GetGatewayClassName() string {
+	if x != nil {
+		return x.GatewayClassName
+	}
+	return ""
+}
+
+func (x *GatewaySpec) GetListeners() []*Listener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *GatewaySpec) GetAddresses() []*GatewayAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GatewaySpec) GetInfrastructure() *GatewayInfrastructure {
+	if x != nil {
+		return x.Infrastructure
+	}
+	return nil
+}
+
+func (x *GatewaySpec) GetBackendTLS() *GatewayBackendTLS {
+	if x != nil {
+		return x.BackendTLS
+	}
+	return nil
+}
+
+// GatewayStatus is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayStatus
+type GatewayStatus struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Addresses     []*GatewayStatusAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Conditions    []*Condition            `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Listeners     []*ListenerStatus       `protobuf:"bytes,3,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayStatus) Reset() {
+	*x = GatewayStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayStatus) ProtoMessage() {}
+
+func (x *GatewayStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayStatus.ProtoReflect.Descriptor instead.
+func (*GatewayStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GatewayStatus) GetAddresses() []*GatewayStatusAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GatewayStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *GatewayStatus) GetListeners() []*ListenerStatus {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+// GatewayStatusAddress is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayStatusAddress
+type GatewayStatusAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayStatusAddress) Reset() {
+	*x = GatewayStatusAddress{}
+	mi := &file_v1_gateway_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayStatusAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayStatusAddress) ProtoMessage() {}
+
+func (x *GatewayStatusAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayStatusAddress.ProtoReflect.Descriptor instead.
+func (*GatewayStatusAddress) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GatewayStatusAddress) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GatewayStatusAddress) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// GatewayTLSConfig is generated from sigs.k8s.io/gateway-api/apis/v1.GatewayTLSConfig
+type GatewayTLSConfig struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Mode               *string                  `protobuf:"bytes,1,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	CertificateRefs    []*SecretObjectReference `protobuf:"bytes,2,rep,name=certificateRefs,proto3" json:"certificateRefs,omitempty"`
+	FrontendValidation *FrontendTLSValidation   `protobuf:"bytes,3,opt,name=frontendValidation,proto3" json:"frontendValidation,omitempty"`
+	Options            map[string]string        `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GatewayTLSConfig) Reset() {
+	*x = GatewayTLSConfig{}
+	mi := &file_v1_gateway_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayTLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayTLSConfig) ProtoMessage() {}
+
+func (x *GatewayTLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayTLSConfig.ProtoReflect.Descriptor instead.
+func (*GatewayTLSConfig) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GatewayTLSConfig) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *GatewayTLSConfig) GetCertificateRefs() []*SecretObjectReference {
+	if x != nil {
+		return x.CertificateRefs
+	}
+	return nil
+}
+
+func (x *GatewayTLSConfig) GetFrontendValidation() *FrontendTLSValidation {
+	if x != nil {
+		return x.FrontendValidation
+	}
+	return nil
+}
+
+func (x *GatewayTLSConfig) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// HTTPBackendRef is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPBackendRef
+type HTTPBackendRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Port          *int32                 `protobuf:"varint,5,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	Weight        *int32                 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Filters       []*HTTPRouteFilter     `protobuf:"bytes,7,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPBackendRef) Reset() {
+	*x = HTTPBackendRef{}
+	mi := &file_v1_gateway_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPBackendRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPBackendRef) ProtoMessage() {}
+
+func (x *HTTPBackendRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPBackendRef.ProtoReflect.Descriptor instead.
+func (*HTTPBackendRef) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HTTPBackendRef) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *HTTPBackendRef) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *HTTPBackendRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPBackendRef) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *HTTPBackendRef) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *HTTPBackendRef) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *HTTPBackendRef) GetFilters() []*HTTPRouteFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// HTTPHeader is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPHeader
+type HTTPHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPHeader) Reset() {
+	*x = HTTPHeader{}
+	mi := &file_v1_gateway_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPHeader) ProtoMessage() {}
+
+func (x *HTTPHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPHeader.ProtoReflect.Descriptor instead.
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HTTPHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPHeader) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// HTTPHeaderFilter is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderFilter
+type HTTPHeaderFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Set           []*HTTPHeader          `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Add           []*HTTPHeader          `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPHeaderFilter) Reset() {
+	*x = HTTPHeaderFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPHeaderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPHeaderFilter) ProtoMessage() {}
+
+func (x *HTTPHeaderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPHeaderFilter.ProtoReflect.Descriptor instead.
+func (*HTTPHeaderFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HTTPHeaderFilter) GetSet() []*HTTPHeader {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *HTTPHeaderFilter) GetAdd() []*HTTPHeader {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *HTTPHeaderFilter) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+// HTTPHeaderMatch is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPHeaderMatch
+type HTTPHeaderMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPHeaderMatch) Reset() {
+	*x = HTTPHeaderMatch{}
+	mi := &file_v1_gateway_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPHeaderMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPHeaderMatch) ProtoMessage() {}
+
+func (x *HTTPHeaderMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPHeaderMatch.ProtoReflect.Descriptor instead.
+func (*HTTPHeaderMatch) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HTTPHeaderMatch) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *HTTPHeaderMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPHeaderMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// HTTPPathMatch is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPPathMatch
+type HTTPPathMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Value         *string                `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPPathMatch) Reset() {
+	*x = HTTPPathMatch{}
+	mi := &file_v1_gateway_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPPathMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPPathMatch) ProtoMessage() {}
+
+func (x *HTTPPathMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPPathMatch.ProtoReflect.Descriptor instead.
+func (*HTTPPathMatch) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HTTPPathMatch) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *HTTPPathMatch) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+// HTTPPathModifier is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPPathModifier
+type HTTPPathModifier struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ReplaceFullPath    *string                `protobuf:"bytes,2,opt,name=replaceFullPath,proto3,oneof" json:"replaceFullPath,omitempty"`
+	ReplacePrefixMatch *string                `protobuf:"bytes,3,opt,name=replacePrefixMatch,proto3,oneof" json:"replacePrefixMatch,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HTTPPathModifier) Reset() {
+	*x = HTTPPathModifier{}
+	mi := &file_v1_gateway_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPPathModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPPathModifier) ProtoMessage() {}
+
+func (x *HTTPPathModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPPathModifier.ProtoReflect.Descriptor instead.
+func (*HTTPPathModifier) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HTTPPathModifier) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HTTPPathModifier) GetReplaceFullPath() string {
+	if x != nil && x.ReplaceFullPath != nil {
+		return *x.ReplaceFullPath
+	}
+	return ""
+}
+
+func (x *HTTPPathModifier) GetReplacePrefixMatch() string {
+	if x != nil && x.ReplacePrefixMatch != nil {
+		return *x.ReplacePrefixMatch
+	}
+	return ""
+}
+
+// HTTPQueryParamMatch is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPQueryParamMatch
+type HTTPQueryParamMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPQueryParamMatch) Reset() {
+	*x = HTTPQueryParamMatch{}
+	mi := &file_v1_gateway_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPQueryParamMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPQueryParamMatch) ProtoMessage() {}
+
+func (x *HTTPQueryParamMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPQueryParamMatch.ProtoReflect.Descriptor instead.
+func (*HTTPQueryParamMatch) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HTTPQueryParamMatch) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *HTTPQueryParamMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPQueryParamMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// HTTPRequestMirrorFilter is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRequestMirrorFilter
+type HTTPRequestMirrorFilter struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	BackendRef    *BackendObjectReference `protobuf:"bytes,1,opt,name=backendRef,proto3" json:"backendRef,omitempty"`
+	Percent       *int32                  `protobuf:"varint,2,opt,name=percent,proto3,oneof" json:"percent,omitempty"`
+	Fraction      *Fraction               `protobuf:"bytes,3,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRequestMirrorFilter) Reset() {
+	*x = HTTPRequestMirrorFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRequestMirrorFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRequestMirrorFilter) ProtoMessage() {}
+
+func (x *HTTPRequestMirrorFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRequestMirrorFilter.ProtoReflect.Descriptor instead.
+func (*HTTPRequestMirrorFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HTTPRequestMirrorFilter) GetBackendRef() *BackendObjectReference {
+	if x != nil {
+		return x.BackendRef
+	}
+	return nil
+}
+
+func (x *HTTPRequestMirrorFilter) GetPercent() int32 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *HTTPRequestMirrorFilter) GetFraction() *Fraction {
+	if x != nil {
+		return x.Fraction
+	}
+	return nil
+}
+
+// HTTPRequestRedirectFilter is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRequestRedirectFilter
+type HTTPRequestRedirectFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scheme        *string                `protobuf:"bytes,1,opt,name=scheme,proto3,oneof" json:"scheme,omitempty"`
+	Hostname      *string                `protobuf:"bytes,2,opt,name=hostname,proto3,oneof" json:"hostname,omitempty"`
+	Path          *HTTPPathModifier      `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Port          *int32                 `protobuf:"varint,4,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	StatusCode    *int64                 `protobuf:"varint,5,opt,name=statusCode,proto3,oneof" json:"statusCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRequestRedirectFilter) Reset() {
+	*x = HTTPRequestRedirectFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRequestRedirectFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRequestRedirectFilter) ProtoMessage() {}
+
+func (x *HTTPRequestRedirectFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRequestRedirectFilter.ProtoReflect.Descriptor instead.
+func (*HTTPRequestRedirectFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HTTPRequestRedirectFilter) GetScheme() string {
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
+	}
+	return ""
+}
+
+func (x *HTTPRequestRedirectFilter) GetHostname() string {
+	if x != nil && x.Hostname != nil {
+		return *x.Hostname
+	}
+	return ""
+}
+
+func (x *HTTPRequestRedirectFilter) GetPath() *HTTPPathModifier {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *HTTPRequestRedirectFilter) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+func (x *HTTPRequestRedirectFilter) GetStatusCode() int64 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+// HTTPRoute is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRoute
+type HTTPRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *HTTPRouteSpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *HTTPRouteStatus       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRoute) Reset() {
+	*x = HTTPRoute{}
+	mi := &file_v1_gateway_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRoute) ProtoMessage() {}
+
+func (x *HTTPRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRoute.ProtoReflect.Descriptor instead.
+func (*HTTPRoute) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HTTPRoute) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *HTTPRoute) GetSpec() *HTTPRouteSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *HTTPRoute) GetStatus() *HTTPRouteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// HTTPRouteFilter is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteFilter
+type HTTPRouteFilter struct {
+	state                  protoimpl.MessageState     `protogen:"open.v1"`
+	Type                   string                     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	RequestHeaderModifier  *HTTPHeaderFilter          `protobuf:"bytes,2,opt,name=requestHeaderModifier,proto3" json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilter          `protobuf:"bytes,3,opt,name=responseHeaderModifier,proto3" json:"responseHeaderModifier,omitempty"`
+	RequestMirror          *HTTPRequestMirrorFilter   `protobuf:"bytes,4,opt,name=requestMirror,proto3" json:"requestMirror,omitempty"`
+	RequestRedirect        *HTTPRequestRedirectFilter `protobuf:"bytes,5,opt,name=requestRedirect,proto3" json:"requestRedirect,omitempty"`
+	UrlRewrite             *HTTPURLRewriteFilter      `protobuf:"bytes,6,opt,name=urlRewrite,proto3" json:"urlRewrite,omitempty"`
+	ExtensionRef           *LocalObjectReference      `protobuf:"bytes,7,opt,name=extensionRef,proto3" json:"extensionRef,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *HTTPRouteFilter) Reset() {
+	*x = HTTPRouteFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteFilter) ProtoMessage() {}
+
+func (x *HTTPRouteFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteFilter.ProtoReflect.Descriptor instead.
+func (*HTTPRouteFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HTTPRouteFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HTTPRouteFilter) GetRequestHeaderModifier() *HTTPHeaderFilter {
+	if x != nil {
+		return x.RequestHeaderModifier
+	}
+	return nil
+}
+
+func (x *HTTPRouteFilter) GetResponseHeaderModifier() *HTTPHeaderFilter {
+	if x != nil {
+		return x.ResponseHeaderModifier
+	}
+	return nil
+}
+
+func (x *HTTPRouteFilter) GetRequestMirror() *HTTPRequestMirrorFilter {
+	if x != nil {
+		return x.RequestMirror
+	}
+	return nil
+}
+
+func (x *HTTPRouteFilter) GetRequestRedirect() *HTTPRequestRedirectFilter {
+	if x != nil {
+		return x.RequestRedirect
+	}
+	return nil
+}
+
+func (x *HTTPRouteFilter) GetUrlRewrite() *HTTPURLRewriteFilter {
+	if x != nil {
+		return x.UrlRewrite
+	}
+	return nil
+}
+
+func (x *HTTPRouteFilter) GetExtensionRef() *LocalObjectReference {
+	if x != nil {
+		return x.ExtensionRef
+	}
+	return nil
+}
+
+// HTTPRouteMatch is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteMatch
+type HTTPRouteMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          *HTTPPathMatch         `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Headers       []*HTTPHeaderMatch     `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	QueryParams   []*HTTPQueryParamMatch `protobuf:"bytes,3,rep,name=queryParams,proto3" json:"queryParams,omitempty"`
+	Method        *string                `protobuf:"bytes,4,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRouteMatch) Reset() {
+	*x = HTTPRouteMatch{}
+	mi := &file_v1_gateway_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteMatch) ProtoMessage() {}
+
+func (x *HTTPRouteMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteMatch.ProtoReflect.Descriptor instead.
+func (*HTTPRouteMatch) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HTTPRouteMatch) GetPath() *HTTPPathMatch {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *HTTPRouteMatch) GetHeaders() []*HTTPHeaderMatch {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPRouteMatch) GetQueryParams() []*HTTPQueryParamMatch {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *HTTPRouteMatch) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+// HTTPRouteRetry is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteRetry
+type HTTPRouteRetry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []int64                `protobuf:"varint,1,rep,packed,name=codes,proto3" json:"codes,omitempty"`
+	Attempts      *int64                 `protobuf:"varint,2,opt,name=attempts,proto3,oneof" json:"attempts,omitempty"`
+	Backoff       *string                `protobuf:"bytes,3,opt,name=backoff,proto3,oneof" json:"backoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRouteRetry) Reset() {
+	*x = HTTPRouteRetry{}
+	mi := &file_v1_gateway_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteRetry) ProtoMessage() {}
+
+func (x *HTTPRouteRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteRetry.ProtoReflect.Descriptor instead.
+func (*HTTPRouteRetry) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HTTPRouteRetry) GetCodes() []int64 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *HTTPRouteRetry) GetAttempts() int64 {
+	if x != nil && x.Attempts != nil {
+		return *x.Attempts
+	}
+	return 0
+}
+
+func (x *HTTPRouteRetry) GetBackoff() string {
+	if x != nil && x.Backoff != nil {
+		return *x.Backoff
+	}
+	return ""
+}
+
+// HTTPRouteRule is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteRule
+type HTTPRouteRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Matches            []*HTTPRouteMatch      `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	Filters            []*HTTPRouteFilter     `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	BackendRefs        []*HTTPBackendRef      `protobuf:"bytes,4,rep,name=backendRefs,proto3" json:"backendRefs,omitempty"`
+	Timeouts           *HTTPRouteTimeouts     `protobuf:"bytes,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Retry              *HTTPRouteRetry        `protobuf:"bytes,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	SessionPersistence *SessionPersistence    `protobuf:"bytes,7,opt,name=sessionPersistence,proto3" json:"sessionPersistence,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HTTPRouteRule) Reset() {
+	*x = HTTPRouteRule{}
+	mi := &file_v1_gateway_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteRule) ProtoMessage() {}
+
+func (x *HTTPRouteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteRule.ProtoReflect.Descriptor instead.
+func (*HTTPRouteRule) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HTTPRouteRule) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *HTTPRouteRule) GetMatches() []*HTTPRouteMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *HTTPRouteRule) GetFilters() []*HTTPRouteFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *HTTPRouteRule) GetBackendRefs() []*HTTPBackendRef {
+	if x != nil {
+		return x.BackendRefs
+	}
+	return nil
+}
+
+func (x *HTTPRouteRule) GetTimeouts() *HTTPRouteTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *HTTPRouteRule) GetRetry() *HTTPRouteRetry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *HTTPRouteRule) GetSessionPersistence() *SessionPersistence {
+	if x != nil {
+		return x.SessionPersistence
+	}
+	return nil
+}
+
+// HTTPRouteSpec is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteSpec
+type HTTPRouteSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentRefs    []*ParentReference     `protobuf:"bytes,1,rep,name=parentRefs,proto3" json:"parentRefs,omitempty"`
+	Hostnames     []string               `protobuf:"bytes,2,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	Rules         []*HTTPRouteRule       `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRouteSpec) Reset() {
+	*x = HTTPRouteSpec{}
+	mi := &file_v1_gateway_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteSpec) ProtoMessage() {}
+
+func (x *HTTPRouteSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteSpec.ProtoReflect.Descriptor instead.
+func (*HTTPRouteSpec) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HTTPRouteSpec) GetParentRefs() []*ParentReference {
+	if x != nil {
+		return x.ParentRefs
+	}
+	return nil
+}
+
+func (x *HTTPRouteSpec) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *HTTPRouteSpec) GetRules() []*HTTPRouteRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// HTTPRouteStatus is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteStatus
+type HTTPRouteStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parents       []*RouteParentStatus   `protobuf:"bytes,1,rep,name=parents,proto3" json:"parents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPRouteStatus) Reset() {
+	*x = HTTPRouteStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteStatus) ProtoMessage() {}
+
+func (x *HTTPRouteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteStatus.ProtoReflect.Descriptor instead.
+func (*HTTPRouteStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HTTPRouteStatus) GetParents() []*RouteParentStatus {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+// HTTPRouteTimeouts is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPRouteTimeouts
+type HTTPRouteTimeouts struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Request        *string                `protobuf:"bytes,1,opt,name=request,proto3,oneof" json:"request,omitempty"`
+	BackendRequest *string                `protobuf:"bytes,2,opt,name=backendRequest,proto3,oneof" json:"backendRequest,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HTTPRouteTimeouts) Reset() {
+	*x = HTTPRouteTimeouts{}
+	mi := &file_v1_gateway_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPRouteTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRouteTimeouts) ProtoMessage() {}
+
+func (x *HTTPRouteTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRouteTimeouts.ProtoReflect.Descriptor instead.
+func (*HTTPRouteTimeouts) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HTTPRouteTimeouts) GetRequest() string {
+	if x != nil && x.Request != nil {
+		return *x.Request
+	}
+	return ""
+}
+
+func (x *HTTPRouteTimeouts) GetBackendRequest() string {
+	if x != nil && x.BackendRequest != nil {
+		return *x.BackendRequest
+	}
+	return ""
+}
+
+// HTTPURLRewriteFilter is generated from sigs.k8s.io/gateway-api/apis/v1.HTTPURLRewriteFilter
+type HTTPURLRewriteFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      *string                `protobuf:"bytes,1,opt,name=hostname,proto3,oneof" json:"hostname,omitempty"`
+	Path          *HTTPPathModifier      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPURLRewriteFilter) Reset() {
+	*x = HTTPURLRewriteFilter{}
+	mi := &file_v1_gateway_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPURLRewriteFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPURLRewriteFilter) ProtoMessage() {}
+
+func (x *HTTPURLRewriteFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPURLRewriteFilter.ProtoReflect.Descriptor instead.
+func (*HTTPURLRewriteFilter) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HTTPURLRewriteFilter) GetHostname() string {
+	if x != nil && x.Hostname != nil {
+		return *x.Hostname
+	}
+	return ""
+}
+
+func (x *HTTPURLRewriteFilter) GetPath() *HTTPPathModifier {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// Listener is generated from sigs.k8s.io/gateway-api/apis/v1.Listener
+type Listener struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hostname      *string                `protobuf:"bytes,2,opt,name=hostname,proto3,oneof" json:"hostname,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Tls           *GatewayTLSConfig      `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	AllowedRoutes *AllowedRoutes         `protobuf:"bytes,6,opt,name=allowedRoutes,proto3" json:"allowedRoutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Listener) Reset() {
+	*x = Listener{}
+	mi := &file_v1_gateway_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Listener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listener) ProtoMessage() {}
+
+func (x *Listener) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
+func (*Listener) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Listener) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Listener) GetHostname() string {
+	if x != nil && x.Hostname != nil {
+		return *x.Hostname
+	}
+	return ""
+}
+
+func (x *Listener) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Listener) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Listener) GetTls() *GatewayTLSConfig {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *Listener) GetAllowedRoutes() *AllowedRoutes {
+	if x != nil {
+		return x.AllowedRoutes
+	}
+	return nil
+}
+
+// ListenerStatus is generated from sigs.k8s.io/gateway-api/apis/v1.ListenerStatus
+type ListenerStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SupportedKinds []*RouteGroupKind      `protobuf:"bytes,4,rep,name=supportedKinds,proto3" json:"supportedKinds,omitempty"`
+	AttachedRoutes int32                  `protobuf:"varint,2,opt,name=attachedRoutes,proto3" json:"attachedRoutes,omitempty"`
+	Conditions     []*Condition           `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListenerStatus) Reset() {
+	*x = ListenerStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerStatus) ProtoMessage() {}
+
+func (x *ListenerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerStatus.ProtoReflect.Descriptor instead.
+func (*ListenerStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListenerStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListenerStatus) GetSupportedKinds() []*RouteGroupKind {
+	if x != nil {
+		return x.SupportedKinds
+	}
+	return nil
+}
+
+func (x *ListenerStatus) GetAttachedRoutes() int32 {
+	if x != nil {
+		return x.AttachedRoutes
+	}
+	return 0
+}
+
+func (x *ListenerStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// LocalObjectReference is generated from sigs.k8s.io/gateway-api/apis/v1.LocalObjectReference
+type LocalObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalObjectReference) Reset() {
+	*x = LocalObjectReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalObjectReference) ProtoMessage() {}
+
+func (x *LocalObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalObjectReference.ProtoReflect.Descriptor instead.
+func (*LocalObjectReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LocalObjectReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *LocalObjectReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LocalObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// LocalParametersReference is generated from sigs.k8s.io/gateway-api/apis/v1.LocalParametersReference
+type LocalParametersReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalParametersReference) Reset() {
+	*x = LocalParametersReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalParametersReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalParametersReference) ProtoMessage() {}
+
+func (x *LocalParametersReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalParametersReference.ProtoReflect.Descriptor instead.
+func (*LocalParametersReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LocalParametersReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *LocalParametersReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LocalParametersReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// LocalPolicyTargetReferenceWithSectionName is generated from sigs.k8s.io/gateway-api/apis/v1alpha2.LocalPolicyTargetReferenceWithSectionName
+type LocalPolicyTargetReferenceWithSectionName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SectionName   *string                `protobuf:"bytes,4,opt,name=sectionName,proto3,oneof" json:"sectionName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) Reset() {
+	*x = LocalPolicyTargetReferenceWithSectionName{}
+	mi := &file_v1_gateway_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalPolicyTargetReferenceWithSectionName) ProtoMessage() {}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalPolicyTargetReferenceWithSectionName.ProtoReflect.Descriptor instead.
+func (*LocalPolicyTargetReferenceWithSectionName) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocalPolicyTargetReferenceWithSectionName) GetSectionName() string {
+	if x != nil && x.SectionName != nil {
+		return *x.SectionName
+	}
+	return ""
+}
+
+// ObjectReference is generated from sigs.k8s.io/gateway-api/apis/v1.ObjectReference
+type ObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectReference) Reset() {
+	*x = ObjectReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectReference) ProtoMessage() {}
+
+func (x *ObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectReference.ProtoReflect.Descriptor instead.
+func (*ObjectReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ObjectReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ObjectReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectReference) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// ParametersReference is generated from sigs.k8s.io/gateway-api/apis/v1.ParametersReference
+type ParametersReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParametersReference) Reset() {
+	*x = ParametersReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParametersReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParametersReference) ProtoMessage() {}
+
+func (x *ParametersReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParametersReference.ProtoReflect.Descriptor instead.
+func (*ParametersReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ParametersReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ParametersReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ParametersReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParametersReference) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// ParentReference is generated from sigs.k8s.io/gateway-api/apis/v1.ParentReference
+type ParentReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Namespace     *string                `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	SectionName   *string                `protobuf:"bytes,5,opt,name=sectionName,proto3,oneof" json:"sectionName,omitempty"`
+	Port          *int32                 `protobuf:"varint,6,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParentReference) Reset() {
+	*x = ParentReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParentReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentReference) ProtoMessage() {}
+
+func (x *ParentReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentReference.ProtoReflect.Descriptor instead.
+func (*ParentReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ParentReference) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *ParentReference) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *ParentReference) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *ParentReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParentReference) GetSectionName() string {
+	if x != nil && x.SectionName != nil {
+		return *x.SectionName
+	}
+	return ""
+}
+
+func (x *ParentReference) GetPort() int32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+// RouteGroupKind is generated from sigs.k8s.io/gateway-api/apis/v1.RouteGroupKind
+type RouteGroupKind struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteGroupKind) Reset() {
+	*x = RouteGroupKind{}
+	mi := &file_v1_gateway_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteGroupKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteGroupKind) ProtoMessage() {}
+
+func (x *RouteGroupKind) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteGroupKind.ProtoReflect.Descriptor instead.
+func (*RouteGroupKind) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RouteGroupKind) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *RouteGroupKind) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// RouteNamespaces is generated from sigs.k8s.io/gateway-api/apis/v1.RouteNamespaces
+type RouteNamespaces struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *string                `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	Selector      *LabelSelector         `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteNamespaces) Reset() {
+	*x = RouteNamespaces{}
+	mi := &file_v1_gateway_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteNamespaces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteNamespaces) ProtoMessage() {}
+
+func (x *RouteNamespaces) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteNamespaces.ProtoReflect.Descriptor instead.
+func (*RouteNamespaces) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RouteNamespaces) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *RouteNamespaces) GetSelector() *LabelSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// RouteParentStatus is generated from sigs.k8s.io/gateway-api/apis/v1.RouteParentStatus
+type RouteParentStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParentRef      *ParentReference       `protobuf:"bytes,1,opt,name=parentRef,proto3" json:"parentRef,omitempty"`
+	ControllerName string                 `protobuf:"bytes,2,opt,name=controllerName,proto3" json:"controllerName,omitempty"`
+	Conditions     []*Condition           `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RouteParentStatus) Reset() {
+	*x = RouteParentStatus{}
+	mi := &file_v1_gateway_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteParentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteParentStatus) ProtoMessage() {}
+
+func (x *RouteParentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteParentStatus.ProtoReflect.Descriptor instead.
+func (*RouteParentStatus) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RouteParentStatus) GetParentRef() *ParentReference {
+	if x != nil {
+		return x.ParentRef
+	}
+	return nil
+}
+
+func (x *RouteParentStatus) GetControllerName() string {
+	if x != nil {
+		return x.ControllerName
+	}
+	return ""
+}
+
+func (x *RouteParentStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// SecretObjectReference is generated from sigs.k8s.io/gateway-api/apis/v1.SecretObjectReference
+type SecretObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *string                `protobuf:"bytes,1,opt,name=group,proto3,oneof" json:"group,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *string                `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretObjectReference) Reset() {
+	*x = SecretObjectReference{}
+	mi := &file_v1_gateway_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretObjectReference) ProtoMessage() {}
+
+func (x *SecretObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretObjectReference.ProtoReflect.Descriptor instead.
+func (*SecretObjectReference) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SecretObjectReference) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *SecretObjectReference) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *SecretObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretObjectReference) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// SessionPersistence is generated from sigs.k8s.io/gateway-api/apis/v1.SessionPersistence
+type SessionPersistence struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionName     *string                `protobuf:"bytes,1,opt,name=sessionName,proto3,oneof" json:"sessionName,omitempty"`
+	AbsoluteTimeout *string                `protobuf:"bytes,2,opt,name=absoluteTimeout,proto3,oneof" json:"absoluteTimeout,omitempty"`
+	IdleTimeout     *string                `protobuf:"bytes,3,opt,name=idleTimeout,proto3,oneof" json:"idleTimeout,omitempty"`
+	Type            *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	CookieConfig    *CookieConfig          `protobuf:"bytes,5,opt,name=cookieConfig,proto3" json:"cookieConfig,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SessionPersistence) Reset() {
+	*x = SessionPersistence{}
+	mi := &file_v1_gateway_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionPersistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionPersistence) ProtoMessage() {}
+
+func (x *SessionPersistence) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionPersistence.ProtoReflect.Descriptor instead.
+func (*SessionPersistence) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SessionPersistence) GetSessionName() string {
+	if x != nil && x.SessionName != nil {
+		return *x.SessionName
+	}
+	return ""
+}
+
+func (x *SessionPersistence) GetAbsoluteTimeout() string {
+	if x != nil && x.AbsoluteTimeout != nil {
+		return *x.AbsoluteTimeout
+	}
+	return ""
+}
+
+func (x *SessionPersistence) GetIdleTimeout() string {
+	if x != nil && x.IdleTimeout != nil {
+		return *x.IdleTimeout
+	}
+	return ""
+}
+
+func (x *SessionPersistence) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *SessionPersistence) GetCookieConfig() *CookieConfig {
+	if x != nil {
+		return x.CookieConfig
+	}
+	return nil
+}
+
+// SupportedFeature is generated from sigs.k8s.io/gateway-api/apis/v1.SupportedFeature
+type SupportedFeature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportedFeature) Reset() {
+	*x = SupportedFeature{}
+	mi := &file_v1_gateway_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportedFeature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportedFeature) ProtoMessage() {}
+
+func (x *SupportedFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gateway_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportedFeature.ProtoReflect.Descriptor instead.
+func (*SupportedFeature) Descriptor() ([]byte, []int) {
+	return file_v1_gateway_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SupportedFeature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_v1_gateway_api_proto protoreflect.FileDescriptor
+
+const file_v1_gateway_api_proto_rawDesc = "" +
+	"\n" +
+	"\x14v1/gateway_api.proto\x12\vkuadrant.v1\x1a\x0fv1/common.proto\x1a\x10v1/k8s_api.proto\"\x80\x01\n" +
+	"\rAllowedRoutes\x12<\n" +
+	"\n" +
+	"namespaces\x18\x01 \x01(\v2\x1c.kuadrant.v1.RouteNamespacesR\n" +
+	"namespaces\x121\n" +
+	"\x05kinds\x18\x02 \x03(\v2\x1b.kuadrant.v1.RouteGroupKindR\x05kinds\"\xc6\x01\n" +
+	"\x16BackendObjectReference\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x12\x17\n" +
+	"\x04port\x18\x05 \x01(\x05H\x03R\x04port\x88\x01\x01B\b\n" +
+	"\x06_groupB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_namespaceB\a\n" +
+	"\x05_port\"H\n" +
+	"\fCookieConfig\x12'\n" +
+	"\flifetimeType\x18\x01 \x01(\tH\x00R\flifetimeType\x88\x01\x01B\x0f\n" +
+	"\r_lifetimeType\"_\n" +
+	"\bFraction\x12\x1c\n" +
+	"\tnumerator\x18\x01 \x01(\x05R\tnumerator\x12%\n" +
+	"\vdenominator\x18\x02 \x01(\x05H\x00R\vdenominator\x88\x01\x01B\x0e\n" +
+	"\f_denominator\"c\n" +
+	"\x15FrontendTLSValidation\x12J\n" +
+	"\x11caCertificateRefs\x18\x01 \x03(\v2\x1c.kuadrant.v1.ObjectReferenceR\x11caCertificateRefs\"\x9e\x02\n" +
+	"\x0eGRPCBackendRef\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x12\x17\n" +
+	"\x04port\x18\x05 \x01(\x05H\x03R\x04port\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x06 \x01(\x05H\x04R\x06weight\x88\x01\x01\x126\n" +
+	"\afilters\x18\a \x03(\v2\x1c.kuadrant.v1.GRPCRouteFilterR\afiltersB\b\n" +
+	"\x06_groupB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_namespaceB\a\n" +
+	"\x05_portB\t\n" +
+	"\a_weight\"]\n" +
+	"\x0fGRPCHeaderMatch\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05valueB\a\n" +
+	"\x05_type\"\x86\x01\n" +
+	"\x0fGRPCMethodMatch\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x1d\n" +
+	"\aservice\x18\x02 \x01(\tH\x01R\aservice\x88\x01\x01\x12\x1b\n" +
+	"\x06method\x18\x03 \x01(\tH\x02R\x06method\x88\x01\x01B\a\n" +
+	"\x05_typeB\n" +
+	"\n" +
+	"\b_serviceB\t\n" +
+	"\a_method\"\xa4\x01\n" +
+	"\tGRPCRoute\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.kuadrant.v1.GRPCRouteSpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.kuadrant.v1.GRPCRouteStatusR\x06status\"\xe4\x02\n" +
+	"\x0fGRPCRouteFilter\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12S\n" +
+	"\x15requestHeaderModifier\x18\x02 \x01(\v2\x1d.kuadrant.v1.HTTPHeaderFilterR\x15requestHeaderModifier\x12U\n" +
+	"\x16responseHeaderModifier\x18\x03 \x01(\v2\x1d.kuadrant.v1.HTTPHeaderFilterR\x16responseHeaderModifier\x12J\n" +
+	"\rrequestMirror\x18\x04 \x01(\v2$.kuadrant.v1.HTTPRequestMirrorFilterR\rrequestMirror\x12E\n" +
+	"\fextensionRef\x18\x05 \x01(\v2!.kuadrant.v1.LocalObjectReferenceR\fextensionRef\"~\n" +
+	"\x0eGRPCRouteMatch\x124\n" +
+	"\x06method\x18\x01 \x01(\v2\x1c.kuadrant.v1.GRPCMethodMatchR\x06method\x126\n" +
+	"\aheaders\x18\x02 \x03(\v2\x1c.kuadrant.v1.GRPCHeaderMatchR\aheaders\"\xb0\x02\n" +
+	"\rGRPCRouteRule\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x125\n" +
+	"\amatches\x18\x02 \x03(\v2\x1b.kuadrant.v1.GRPCRouteMatchR\amatches\x126\n" +
+	"\afilters\x18\x03 \x03(\v2\x1c.kuadrant.v1.GRPCRouteFilterR\afilters\x12=\n" +
+	"\vbackendRefs\x18\x04 \x03(\v2\x1b.kuadrant.v1.GRPCBackendRefR\vbackendRefs\x12O\n" +
+	"\x12sessionPersistence\x18\x05 \x01(\v2\x1f.kuadrant.v1.SessionPersistenceR\x12sessionPersistenceB\a\n" +
+	"\x05_name\"\x9d\x01\n" +
+	"\rGRPCRouteSpec\x12<\n" +
+	"\n" +
+	"parentRefs\x18\x01 \x03(\v2\x1c.kuadrant.v1.ParentReferenceR\n" +
+	"parentRefs\x12\x1c\n" +
+	"\thostnames\x18\x02 \x03(\tR\thostnames\x120\n" +
+	"\x05rules\x18\x03 \x03(\v2\x1a.kuadrant.v1.GRPCRouteRuleR\x05rules\"K\n" +
+	"\x0fGRPCRouteStatus\x128\n" +
+	"\aparents\x18\x01 \x03(\v2\x1e.kuadrant.v1.RouteParentStatusR\aparents\"\x9e\x01\n" +
+	"\aGateway\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12,\n" +
+	"\x04spec\x18\x02 \x01(\v2\x18.kuadrant.v1.GatewaySpecR\x04spec\x122\n" +
+	"\x06status\x18\x03 \x01(\v2\x1a.kuadrant.v1.GatewayStatusR\x06status\"H\n" +
+	"\x0eGatewayAddress\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05valueB\a\n" +
+	"\x05_type\"k\n" +
+	"\x11GatewayBackendTLS\x12V\n" +
+	"\x14clientCertificateRef\x18\x01 \x01(\v2\".kuadrant.v1.SecretObjectReferenceR\x14clientCertificateRef\"\xad\x01\n" +
+	"\fGatewayClass\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x121\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1d.kuadrant.v1.GatewayClassSpecR\x04spec\x127\n" +
+	"\x06status\x18\x03 \x01(\v2\x1f.kuadrant.v1.GatewayClassStatusR\x06status\"\xb9\x01\n" +
+	"\x10GatewayClassSpec\x12&\n" +
+	"\x0econtrollerName\x18\x01 \x01(\tR\x0econtrollerName\x12F\n" +
+	"\rparametersRef\x18\x02 \x01(\v2 .kuadrant.v1.ParametersReferenceR\rparametersRef\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"\x99\x01\n" +
+	"\x12GatewayClassStatus\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12K\n" +
+	"\x11supportedFeatures\x18\x02 \x03(\v2\x1d.kuadrant.v1.SupportedFeatureR\x11supportedFeatures\"\xfe\x02\n" +
+	"\x15GatewayInfrastructure\x12F\n" +
+	"\x06labels\x18\x01 \x03(\v2..kuadrant.v1.GatewayInfrastructure.LabelsEntryR\x06labels\x12U\n" +
+	"\vannotations\x18\x02 \x03(\v23.kuadrant.v1.GatewayInfrastructure.AnnotationsEntryR\vannotations\x12K\n" +
+	"\rparametersRef\x18\x03 \x01(\v2%.kuadrant.v1.LocalParametersReferenceR\rparametersRef\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbb\x02\n" +
+	"\vGatewaySpec\x12*\n" +
+	"\x10gatewayClassName\x18\x01 \x01(\tR\x10gatewayClassName\x123\n" +
+	"\tlisteners\x18\x02 \x03(\v2\x15.kuadrant.v1.ListenerR\tlisteners\x129\n" +
+	"\taddresses\x18\x04 \x03(\v2\x1b.kuadrant.v1.GatewayAddressR\taddresses\x12J\n" +
+	"\x0einfrastructure\x18\x05 \x01(\v2\".kuadrant.v1.GatewayInfrastructureR\x0einfrastructure\x12>\n" +
+	"\n" +
+	"backendTLS\x18\x06 \x01(\v2\x1e.kuadrant.v1.GatewayBackendTLSR\n" +
+	"backendTLSJ\x04\b\x03\x10\x04\"\xc9\x01\n" +
+	"\rGatewayStatus\x12?\n" +
+	"\taddresses\x18\x04 \x03(\v2!.kuadrant.v1.GatewayStatusAddressR\taddresses\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x129\n" +
+	"\tlisteners\x18\x03 \x03(\v2\x1b.kuadrant.v1.ListenerStatusR\tlistenersJ\x04\b\x01\x10\x02\"N\n" +
+	"\x14GatewayStatusAddress\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05valueB\a\n" +
+	"\x05_type\"\xd8\x02\n" +
+	"\x10GatewayTLSConfig\x12\x17\n" +
+	"\x04mode\x18\x01 \x01(\tH\x00R\x04mode\x88\x01\x01\x12L\n" +
+	"\x0fcertificateRefs\x18\x02 \x03(\v2\".kuadrant.v1.SecretObjectReferenceR\x0fcertificateRefs\x12R\n" +
+	"\x12frontendValidation\x18\x03 \x01(\v2\".kuadrant.v1.FrontendTLSValidationR\x12frontendValidation\x12D\n" +
+	"\aoptions\x18\x04 \x03(\v2*.kuadrant.v1.GatewayTLSConfig.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_mode\"\x9e\x02\n" +
+	"\x0eHTTPBackendRef\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x12\x17\n" +
+	"\x04port\x18\x05 \x01(\x05H\x03R\x04port\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x06 \x01(\x05H\x04R\x06weight\x88\x01\x01\x126\n" +
+	"\afilters\x18\a \x03(\v2\x1c.kuadrant.v1.HTTPRouteFilterR\afiltersB\b\n" +
+	"\x06_groupB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_namespaceB\a\n" +
+	"\x05_portB\t\n" +
+	"\a_weight\"6\n" +
+	"\n" +
+	"HTTPHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x80\x01\n" +
+	"\x10HTTPHeaderFilter\x12)\n" +
+	"\x03set\x18\x01 \x03(\v2\x17.kuadrant.v1.HTTPHeaderR\x03set\x12)\n" +
+	"\x03add\x18\x02 \x03(\v2\x17.kuadrant.v1.HTTPHeaderR\x03add\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\"]\n" +
+	"\x0fHTTPHeaderMatch\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05valueB\a\n" +
+	"\x05_type\"V\n" +
+	"\rHTTPPathMatch\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\tH\x01R\x05value\x88\x01\x01B\a\n" +
+	"\x05_typeB\b\n" +
+	"\x06_value\"\xb5\x01\n" +
+	"\x10HTTPPathModifier\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12-\n" +
+	"\x0freplaceFullPath\x18\x02 \x01(\tH\x00R\x0freplaceFullPath\x88\x01\x01\x123\n" +
+	"\x12replacePrefixMatch\x18\x03 \x01(\tH\x01R\x12replacePrefixMatch\x88\x01\x01B\x12\n" +
+	"\x10_replaceFullPathB\x15\n" +
+	"\x13_replacePrefixMatch\"a\n" +
+	"\x13HTTPQueryParamMatch\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05valueB\a\n" +
+	"\x05_type\"\xbc\x01\n" +
+	"\x17HTTPRequestMirrorFilter\x12C\n" +
+	"\n" +
+	"backendRef\x18\x01 \x01(\v2#.kuadrant.v1.BackendObjectReferenceR\n" +
+	"backendRef\x12\x1d\n" +
+	"\apercent\x18\x02 \x01(\x05H\x00R\apercent\x88\x01\x01\x121\n" +
+	"\bfraction\x18\x03 \x01(\v2\x15.kuadrant.v1.FractionR\bfractionB\n" +
+	"\n" +
+	"\b_percent\"\xfa\x01\n" +
+	"\x19HTTPRequestRedirectFilter\x12\x1b\n" +
+	"\x06scheme\x18\x01 \x01(\tH\x00R\x06scheme\x88\x01\x01\x12\x1f\n" +
+	"\bhostname\x18\x02 \x01(\tH\x01R\bhostname\x88\x01\x01\x121\n" +
+	"\x04path\x18\x03 \x01(\v2\x1d.kuadrant.v1.HTTPPathModifierR\x04path\x12\x17\n" +
+	"\x04port\x18\x04 \x01(\x05H\x02R\x04port\x88\x01\x01\x12#\n" +
+	"\n" +
+	"statusCode\x18\x05 \x01(\x03H\x03R\n" +
+	"statusCode\x88\x01\x01B\t\n" +
+	"\a_schemeB\v\n" +
+	"\t_hostnameB\a\n" +
+	"\x05_portB\r\n" +
+	"\v_statusCode\"\xa4\x01\n" +
+	"\tHTTPRoute\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.kuadrant.v1.HTTPRouteSpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.kuadrant.v1.HTTPRouteStatusR\x06status\"\xf9\x03\n" +
+	"\x0fHTTPRouteFilter\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12S\n" +
+	"\x15requestHeaderModifier\x18\x02 \x01(\v2\x1d.kuadrant.v1.HTTPHeaderFilterR\x15requestHeaderModifier\x12U\n" +
+	"\x16responseHeaderModifier\x18\x03 \x01(\v2\x1d.kuadrant.v1.HTTPHeaderFilterR\x16responseHeaderModifier\x12J\n" +
+	"\rrequestMirror\x18\x04 \x01(\v2$.kuadrant.v1.HTTPRequestMirrorFilterR\rrequestMirror\x12P\n" +
+	"\x0frequestRedirect\x18\x05 \x01(\v2&.kuadrant.v1.HTTPRequestRedirectFilterR\x0frequestRedirect\x12A\n" +
+	"\n" +
+	"urlRewrite\x18\x06 \x01(\v2!.kuadrant.v1.HTTPURLRewriteFilterR\n" +
+	"urlRewrite\x12E\n" +
+	"\fextensionRef\x18\a \x01(\v2!.kuadrant.v1.LocalObjectReferenceR\fextensionRef\"\xe4\x01\n" +
+	"\x0eHTTPRouteMatch\x12.\n" +
+	"\x04path\x18\x01 \x01(\v2\x1a.kuadrant.v1.HTTPPathMatchR\x04path\x126\n" +
+	"\aheaders\x18\x02 \x03(\v2\x1c.kuadrant.v1.HTTPHeaderMatchR\aheaders\x12B\n" +
+	"\vqueryParams\x18\x03 \x03(\v2 .kuadrant.v1.HTTPQueryParamMatchR\vqueryParams\x12\x1b\n" +
+	"\x06method\x18\x04 \x01(\tH\x00R\x06method\x88\x01\x01B\t\n" +
+	"\a_method\"\x7f\n" +
+	"\x0eHTTPRouteRetry\x12\x14\n" +
+	"\x05codes\x18\x01 \x03(\x03R\x05codes\x12\x1f\n" +
+	"\battempts\x18\x02 \x01(\x03H\x00R\battempts\x88\x01\x01\x12\x1d\n" +
+	"\abackoff\x18\x03 \x01(\tH\x01R\abackoff\x88\x01\x01B\v\n" +
+	"\t_attemptsB\n" +
+	"\n" +
+	"\b_backoff\"\x9f\x03\n" +
+	"\rHTTPRouteRule\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x125\n" +
+	"\amatches\x18\x02 \x03(\v2\x1b.kuadrant.v1.HTTPRouteMatchR\amatches\x126\n" +
+	"\afilters\x18\x03 \x03(\v2\x1c.kuadrant.v1.HTTPRouteFilterR\afilters\x12=\n" +
+	"\vbackendRefs\x18\x04 \x03(\v2\x1b.kuadrant.v1.HTTPBackendRefR\vbackendRefs\x12:\n" +
+	"\btimeouts\x18\x05 \x01(\v2\x1e.kuadrant.v1.HTTPRouteTimeoutsR\btimeouts\x121\n" +
+	"\x05retry\x18\x06 \x01(\v2\x1b.kuadrant.v1.HTTPRouteRetryR\x05retry\x12O\n" +
+	"\x12sessionPersistence\x18\a \x01(\v2\x1f.kuadrant.v1.SessionPersistenceR\x12sessionPersistenceB\a\n" +
+	"\x05_name\"\x9d\x01\n" +
+	"\rHTTPRouteSpec\x12<\n" +
+	"\n" +
+	"parentRefs\x18\x01 \x03(\v2\x1c.kuadrant.v1.ParentReferenceR\n" +
+	"parentRefs\x12\x1c\n" +
+	"\thostnames\x18\x02 \x03(\tR\thostnames\x120\n" +
+	"\x05rules\x18\x03 \x03(\v2\x1a.kuadrant.v1.HTTPRouteRuleR\x05rules\"K\n" +
+	"\x0fHTTPRouteStatus\x128\n" +
+	"\aparents\x18\x01 \x03(\v2\x1e.kuadrant.v1.RouteParentStatusR\aparents\"~\n" +
+	"\x11HTTPRouteTimeouts\x12\x1d\n" +
+	"\arequest\x18\x01 \x01(\tH\x00R\arequest\x88\x01\x01\x12+\n" +
+	"\x0ebackendRequest\x18\x02 \x01(\tH\x01R\x0ebackendRequest\x88\x01\x01B\n" +
+	"\n" +
+	"\b_requestB\x11\n" +
+	"\x0f_backendRequest\"w\n" +
+	"\x14HTTPURLRewriteFilter\x12\x1f\n" +
+	"\bhostname\x18\x01 \x01(\tH\x00R\bhostname\x88\x01\x01\x121\n" +
+	"\x04path\x18\x02 \x01(\v2\x1d.kuadrant.v1.HTTPPathModifierR\x04pathB\v\n" +
+	"\t_hostname\"\xef\x01\n" +
+	"\bListener\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\bhostname\x18\x02 \x01(\tH\x00R\bhostname\x88\x01\x01\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12/\n" +
+	"\x03tls\x18\x05 \x01(\v2\x1d.kuadrant.v1.GatewayTLSConfigR\x03tls\x12@\n" +
+	"\rallowedRoutes\x18\x06 \x01(\v2\x1a.kuadrant.v1.AllowedRoutesR\rallowedRoutesB\v\n" +
+	"\t_hostname\"\xc9\x01\n" +
+	"\x0eListenerStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\x0esupportedKinds\x18\x04 \x03(\v2\x1b.kuadrant.v1.RouteGroupKindR\x0esupportedKinds\x12&\n" +
+	"\x0eattachedRoutes\x18\x02 \x01(\x05R\x0eattachedRoutes\x126\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"T\n" +
+	"\x14LocalObjectReference\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"X\n" +
+	"\x18LocalParametersReference\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xa0\x01\n" +
+	")LocalPolicyTargetReferenceWithSectionName\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vsectionName\x18\x04 \x01(\tH\x00R\vsectionName\x88\x01\x01B\x0e\n" +
+	"\f_sectionName\"\x80\x01\n" +
+	"\x0fObjectReference\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespace\"\x84\x01\n" +
+	"\x13ParametersReference\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespace\"\xf6\x01\n" +
+	"\x0fParentReference\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x02R\tnamespace\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12%\n" +
+	"\vsectionName\x18\x05 \x01(\tH\x03R\vsectionName\x88\x01\x01\x12\x17\n" +
+	"\x04port\x18\x06 \x01(\x05H\x04R\x04port\x88\x01\x01B\b\n" +
+	"\x06_groupB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_namespaceB\x0e\n" +
+	"\f_sectionNameB\a\n" +
+	"\x05_port\"I\n" +
+	"\x0eRouteGroupKind\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kindB\b\n" +
+	"\x06_group\"k\n" +
+	"\x0fRouteNamespaces\x12\x17\n" +
+	"\x04from\x18\x01 \x01(\tH\x00R\x04from\x88\x01\x01\x126\n" +
+	"\bselector\x18\x02 \x01(\v2\x1a.kuadrant.v1.LabelSelectorR\bselectorB\a\n" +
+	"\x05_from\"\xaf\x01\n" +
+	"\x11RouteParentStatus\x12:\n" +
+	"\tparentRef\x18\x01 \x01(\v2\x1c.kuadrant.v1.ParentReferenceR\tparentRef\x12&\n" +
+	"\x0econtrollerName\x18\x02 \x01(\tR\x0econtrollerName\x126\n" +
+	"\n" +
+	"conditions\x18\x03 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"\xa3\x01\n" +
+	"\x15SecretObjectReference\x12\x19\n" +
+	"\x05group\x18\x01 \x01(\tH\x00R\x05group\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01B\b\n" +
+	"\x06_groupB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_namespace\"\xa6\x02\n" +
+	"\x12SessionPersistence\x12%\n" +
+	"\vsessionName\x18\x01 \x01(\tH\x00R\vsessionName\x88\x01\x01\x12-\n" +
+	"\x0fabsoluteTimeout\x18\x02 \x01(\tH\x01R\x0fabsoluteTimeout\x88\x01\x01\x12%\n" +
+	"\vidleTimeout\x18\x03 \x01(\tH\x02R\vidleTimeout\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tH\x03R\x04type\x88\x01\x01\x12=\n" +
+	"\fcookieConfig\x18\x05 \x01(\v2\x19.kuadrant.v1.CookieConfigR\fcookieConfigB\x0e\n" +
+	"\f_sessionNameB\x12\n" +
+	"\x10_absoluteTimeoutB\x0e\n" +
+	"\f_idleTimeoutB\a\n" +
+	"\x05_type\"&\n" +
+	"\x10SupportedFeature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04nameB\x05Z\x03/v1b\x06proto3"
 
 var (
 	file_v1_gateway_api_proto_rawDescOnce sync.Once
@@ -576,39 +4003,157 @@ func file_v1_gateway_api_proto_rawDescGZIP() []byte {
 	return file_v1_gateway_api_proto_rawDescData
 }
 
-var file_v1_gateway_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_gateway_api_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_v1_gateway_api_proto_goTypes = []any{
-	(*Gateway)(nil),            // 0: kuadrant.v1.Gateway
-	(*GatewaySpec)(nil),        // 1: kuadrant.v1.GatewaySpec
-	(*Listener)(nil),           // 2: kuadrant.v1.Listener
-	(*GatewayAddresses)(nil),   // 3: kuadrant.v1.GatewayAddresses
-	(*GatewayStatus)(nil),      // 4: kuadrant.v1.GatewayStatus
-	(*ListenerStatus)(nil),     // 5: kuadrant.v1.ListenerStatus
-	(*GatewayClass)(nil),       // 6: kuadrant.v1.GatewayClass
-	(*GatewayClassSpec)(nil),   // 7: kuadrant.v1.GatewayClassSpec
-	(*GatewayClassStatus)(nil), // 8: kuadrant.v1.GatewayClassStatus
-	(*Metadata)(nil),           // 9: kuadrant.v1.Metadata
-	(*Condition)(nil),          // 10: kuadrant.v1.Condition
+	(*AllowedRoutes)(nil),                             // 0: kuadrant.v1.AllowedRoutes
+	(*BackendObjectReference)(nil),                    // 1: kuadrant.v1.BackendObjectReference
+	(*CookieConfig)(nil),                              // 2: kuadrant.v1.CookieConfig
+	(*Fraction)(nil),                                  // 3: kuadrant.v1.Fraction
+	(*FrontendTLSValidation)(nil),                     // 4: kuadrant.v1.FrontendTLSValidation
+	(*GRPCBackendRef)(nil),                            // 5: kuadrant.v1.GRPCBackendRef
+	(*GRPCHeaderMatch)(nil),                           // 6: kuadrant.v1.GRPCHeaderMatch
+	(*GRPCMethodMatch)(nil),                           // 7: kuadrant.v1.GRPCMethodMatch
+	(*GRPCRoute)(nil),                                 // 8: kuadrant.v1.GRPCRoute
+	(*GRPCRouteFilter)(nil),                           // 9: kuadrant.v1.GRPCRouteFilter
+	(*GRPCRouteMatch)(nil),                            // 10: kuadrant.v1.GRPCRouteMatch
+	(*GRPCRouteRule)(nil),                             // 11: kuadrant.v1.GRPCRouteRule
+	(*GRPCRouteSpec)(nil),                             // 12: kuadrant.v1.GRPCRouteSpec
+	(*GRPCRouteStatus)(nil),                           // 13: kuadrant.v1.GRPCRouteStatus
+	(*Gateway)(nil),                                   // 14: kuadrant.v1.Gateway
+	(*GatewayAddress)(nil),                            // 15: kuadrant.v1.GatewayAddress
+	(*GatewayBackendTLS)(nil),                         // 16: kuadrant.v1.GatewayBackendTLS
+	(*GatewayClass)(nil),                              // 17: kuadrant.v1.GatewayClass
+	(*GatewayClassSpec)(nil),                          // 18: kuadrant.v1.GatewayClassSpec
+	(*GatewayClassStatus)(nil),                        // 19: kuadrant.v1.GatewayClassStatus
+	(*GatewayInfrastructure)(nil),                     // 20: kuadrant.v1.GatewayInfrastructure
+	(*GatewaySpec)(nil),                               // 21: kuadrant.v1.GatewaySpec
+	(*GatewayStatus)(nil),                             // 22: kuadrant.v1.GatewayStatus
+	(*GatewayStatusAddress)(nil),                      // 23: kuadrant.v1.GatewayStatusAddress
+	(*GatewayTLSConfig)(nil),                          // 24: kuadrant.v1.GatewayTLSConfig
+	(*HTTPBackendRef)(nil),                            // 25: kuadrant.v1.HTTPBackendRef
+	(*HTTPHeader)(nil),                                // 26: kuadrant.v1.HTTPHeader
+	(*HTTPHeaderFilter)(nil),                          // 27: kuadrant.v1.HTTPHeaderFilter
+	(*HTTPHeaderMatch)(nil),                           // 28: kuadrant.v1.HTTPHeaderMatch
+	(*HTTPPathMatch)(nil),                             // 29: kuadrant.v1.HTTPPathMatch
+	(*HTTPPathModifier)(nil),                          // 30: kuadrant.v1.HTTPPathModifier
+	(*HTTPQueryParamMatch)(nil),                       // 31: kuadrant.v1.HTTPQueryParamMatch
+	(*HTTPRequestMirrorFilter)(nil),                   // 32: kuadrant.v1.HTTPRequestMirrorFilter
+	(*HTTPRequestRedirectFilter)(nil),                 // 33: kuadrant.v1.HTTPRequestRedirectFilter
+	(*HTTPRoute)(nil),                                 // 34: kuadrant.v1.HTTPRoute
+	(*HTTPRouteFilter)(nil),                           // 35: kuadrant.v1.HTTPRouteFilter
+	(*HTTPRouteMatch)(nil),                            // 36: kuadrant.v1.HTTPRouteMatch
+	(*HTTPRouteRetry)(nil),                            // 37: kuadrant.v1.HTTPRouteRetry
+	(*HTTPRouteRule)(nil),                             // 38: kuadrant.v1.HTTPRouteRule
+	(*HTTPRouteSpec)(nil),                             // 39: kuadrant.v1.HTTPRouteSpec
+	(*HTTPRouteStatus)(nil),                           // 40: kuadrant.v1.HTTPRouteStatus
+	(*HTTPRouteTimeouts)(nil),                         // 41: kuadrant.v1.HTTPRouteTimeouts
+	(*HTTPURLRewriteFilter)(nil),                      // 42: kuadrant.v1.HTTPURLRewriteFilter
+	(*Listener)(nil),                                  // 43: kuadrant.v1.Listener
+	(*ListenerStatus)(nil),                            // 44: kuadrant.v1.ListenerStatus
+	(*LocalObjectReference)(nil),                      // 45: kuadrant.v1.LocalObjectReference
+	(*LocalParametersReference)(nil),                  // 46: kuadrant.v1.LocalParametersReference
+	(*LocalPolicyTargetReferenceWithSectionName)(nil), // 47: kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	(*ObjectReference)(nil),                           // 48: kuadrant.v1.ObjectReference
+	(*ParametersReference)(nil),                       // 49: kuadrant.v1.ParametersReference
+	(*ParentReference)(nil),                           // 50: kuadrant.v1.ParentReference
+	(*RouteGroupKind)(nil),                            // 51: kuadrant.v1.RouteGroupKind
+	(*RouteNamespaces)(nil),                           // 52: kuadrant.v1.RouteNamespaces
+	(*RouteParentStatus)(nil),                         // 53: kuadrant.v1.RouteParentStatus
+	(*SecretObjectReference)(nil),                     // 54: kuadrant.v1.SecretObjectReference
+	(*SessionPersistence)(nil),                        // 55: kuadrant.v1.SessionPersistence
+	(*SupportedFeature)(nil),                          // 56: kuadrant.v1.SupportedFeature
+	nil,                                               // 57: kuadrant.v1.GatewayInfrastructure.LabelsEntry
+	nil,                                               // 58: kuadrant.v1.GatewayInfrastructure.AnnotationsEntry
+	nil,                                               // 59: kuadrant.v1.GatewayTLSConfig.OptionsEntry
+	(*Metadata)(nil),                                  // 60: kuadrant.v1.Metadata
+	(*Condition)(nil),                                 // 61: kuadrant.v1.Condition
+	(*LabelSelector)(nil),                             // 62: kuadrant.v1.LabelSelector
 }
 var file_v1_gateway_api_proto_depIdxs = []int32{
-	9,  // 0: kuadrant.v1.Gateway.metadata:type_name -> kuadrant.v1.Metadata
-	1,  // 1: kuadrant.v1.Gateway.spec:type_name -> kuadrant.v1.GatewaySpec
-	4,  // 2: kuadrant.v1.Gateway.status:type_name -> kuadrant.v1.GatewayStatus
-	2,  // 3: kuadrant.v1.GatewaySpec.listeners:type_name -> kuadrant.v1.Listener
-	3,  // 4: kuadrant.v1.GatewaySpec.addresses:type_name -> kuadrant.v1.GatewayAddresses
-	3,  // 5: kuadrant.v1.GatewayStatus.addresses:type_name -> kuadrant.v1.GatewayAddresses
-	10, // 6: kuadrant.v1.GatewayStatus.conditions:type_name -> kuadrant.v1.Condition
-	5,  // 7: kuadrant.v1.GatewayStatus.listeners:type_name -> kuadrant.v1.ListenerStatus
-	10, // 8: kuadrant.v1.ListenerStatus.conditions:type_name -> kuadrant.v1.Condition
-	9,  // 9: kuadrant.v1.GatewayClass.metadata:type_name -> kuadrant.v1.Metadata
-	7,  // 10: kuadrant.v1.GatewayClass.spec:type_name -> kuadrant.v1.GatewayClassSpec
-	8,  // 11: kuadrant.v1.GatewayClass.status:type_name -> kuadrant.v1.GatewayClassStatus
-	10, // 12: kuadrant.v1.GatewayClassStatus.conditions:type_name -> kuadrant.v1.Condition
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	52, // 0: kuadrant.v1.AllowedRoutes.namespaces:type_name -> kuadrant.v1.RouteNamespaces
+	51, // 1: kuadrant.v1.AllowedRoutes.kinds:type_name -> kuadrant.v1.RouteGroupKind
+	48, // 2: kuadrant.v1.FrontendTLSValidation.caCertificateRefs:type_name -> kuadrant.v1.ObjectReference
+	9,  // 3: kuadrant.v1.GRPCBackendRef.filters:type_name -> kuadrant.v1.GRPCRouteFilter
+	60, // 4: kuadrant.v1.GRPCRoute.metadata:type_name -> kuadrant.v1.Metadata
+	12, // 5: kuadrant.v1.GRPCRoute.spec:type_name -> kuadrant.v1.GRPCRouteSpec
+	13, // 6: kuadrant.v1.GRPCRoute.status:type_name -> kuadrant.v1.GRPCRouteStatus
+	27, // 7: kuadrant.v1.GRPCRouteFilter.requestHeaderModifier:type_name -> kuadrant.v1.HTTPHeaderFilter
+	27, // 8: kuadrant.v1.GRPCRouteFilter.responseHeaderModifier:type_name -> kuadrant.v1.HTTPHeaderFilter
+	32, // 9: kuadrant.v1.GRPCRouteFilter.requestMirror:type_name -> kuadrant.v1.HTTPRequestMirrorFilter
+	45, // 10: kuadrant.v1.GRPCRouteFilter.extensionRef:type_name -> kuadrant.v1.LocalObjectReference
+	7,  // 11: kuadrant.v1.GRPCRouteMatch.method:type_name -> kuadrant.v1.GRPCMethodMatch
+	6,  // 12: kuadrant.v1.GRPCRouteMatch.headers:type_name -> kuadrant.v1.GRPCHeaderMatch
+	10, // 13: kuadrant.v1.GRPCRouteRule.matches:type_name -> kuadrant.v1.GRPCRouteMatch
+	9,  // 14: kuadrant.v1.GRPCRouteRule.filters:type_name -> kuadrant.v1.GRPCRouteFilter
+	5,  // 15: kuadrant.v1.GRPCRouteRule.backendRefs:type_name -> kuadrant.v1.GRPCBackendRef
+	55, // 16: kuadrant.v1.GRPCRouteRule.sessionPersistence:type_name -> kuadrant.v1.SessionPersistence
+	50, // 17: kuadrant.v1.GRPCRouteSpec.parentRefs:type_name -> kuadrant.v1.ParentReference
+	11, // 18: kuadrant.v1.GRPCRouteSpec.rules:type_name -> kuadrant.v1.GRPCRouteRule
+	53, // 19: kuadrant.v1.GRPCRouteStatus.parents:type_name -> kuadrant.v1.RouteParentStatus
+	60, // 20: kuadrant.v1.Gateway.metadata:type_name -> kuadrant.v1.Metadata
+	21, // 21: kuadrant.v1.Gateway.spec:type_name -> kuadrant.v1.GatewaySpec
+	22, // 22: kuadrant.v1.Gateway.status:type_name -> kuadrant.v1.GatewayStatus
+	54, // 23: kuadrant.v1.GatewayBackendTLS.clientCertificateRef:type_name -> kuadrant.v1.SecretObjectReference
+	60, // 24: kuadrant.v1.GatewayClass.metadata:type_name -> kuadrant.v1.Metadata
+	18, // 25: kuadrant.v1.GatewayClass.spec:type_name -> kuadrant.v1.GatewayClassSpec
+	19, // 26: kuadrant.v1.GatewayClass.status:type_name -> kuadrant.v1.GatewayClassStatus
+	49, // 27: kuadrant.v1.GatewayClassSpec.parametersRef:type_name -> kuadrant.v1.ParametersReference
+	61, // 28: kuadrant.v1.GatewayClassStatus.conditions:type_name -> kuadrant.v1.Condition
+	56, // 29: kuadrant.v1.GatewayClassStatus.supportedFeatures:type_name -> kuadrant.v1.SupportedFeature
+	57, // 30: kuadrant.v1.GatewayInfrastructure.labels:type_name -> kuadrant.v1.GatewayInfrastructure.LabelsEntry
+	58, // 31: kuadrant.v1.GatewayInfrastructure.annotations:type_name -> kuadrant.v1.GatewayInfrastructure.AnnotationsEntry
+	46, // 32: kuadrant.v1.GatewayInfrastructure.parametersRef:type_name -> kuadrant.v1.LocalParametersReference
+	43, // 33: kuadrant.v1.GatewaySpec.listeners:type_name -> kuadrant.v1.Listener
+	15, // 34: kuadrant.v1.GatewaySpec.addresses:type_name -> kuadrant.v1.GatewayAddress
+	20, // 35: kuadrant.v1.GatewaySpec.infrastructure:type_name -> kuadrant.v1.GatewayInfrastructure
+	16, // 36: kuadrant.v1.GatewaySpec.backendTLS:type_name -> kuadrant.v1.GatewayBackendTLS
+	23, // 37: kuadrant.v1.GatewayStatus.addresses:type_name -> kuadrant.v1.GatewayStatusAddress
+	61, // 38: kuadrant.v1.GatewayStatus.conditions:type_name -> kuadrant.v1.Condition
+	44, // 39: kuadrant.v1.GatewayStatus.listeners:type_name -> kuadrant.v1.ListenerStatus
+	54, // 40: kuadrant.v1.GatewayTLSConfig.certificateRefs:type_name -> kuadrant.v1.SecretObjectReference
+	4,  // 41: kuadrant.v1.GatewayTLSConfig.frontendValidation:type_name -> kuadrant.v1.FrontendTLSValidation
+	59, // 42: kuadrant.v1.GatewayTLSConfig.options:type_name -> kuadrant.v1.GatewayTLSConfig.OptionsEntry
+	35, // 43: kuadrant.v1.HTTPBackendRef.filters:type_name -> kuadrant.v1.HTTPRouteFilter
+	26, // 44: kuadrant.v1.HTTPHeaderFilter.set:type_name -> kuadrant.v1.HTTPHeader
+	26, // 45: kuadrant.v1.HTTPHeaderFilter.add:type_name -> kuadrant.v1.HTTPHeader
+	1,  // 46: kuadrant.v1.HTTPRequestMirrorFilter.backendRef:type_name -> kuadrant.v1.BackendObjectReference
+	3,  // 47: kuadrant.v1.HTTPRequestMirrorFilter.fraction:type_name -> kuadrant.v1.Fraction
+	30, // 48: kuadrant.v1.HTTPRequestRedirectFilter.path:type_name -> kuadrant.v1.HTTPPathModifier
+	60, // 49: kuadrant.v1.HTTPRoute.metadata:type_name -> kuadrant.v1.Metadata
+	39, // 50: kuadrant.v1.HTTPRoute.spec:type_name -> kuadrant.v1.HTTPRouteSpec
+	40, // 51: kuadrant.v1.HTTPRoute.status:type_name -> kuadrant.v1.HTTPRouteStatus
+	27, // 52: kuadrant.v1.HTTPRouteFilter.requestHeaderModifier:type_name -> kuadrant.v1.HTTPHeaderFilter
+	27, // 53: kuadrant.v1.HTTPRouteFilter.responseHeaderModifier:type_name -> kuadrant.v1.HTTPHeaderFilter
+	32, // 54: kuadrant.v1.HTTPRouteFilter.requestMirror:type_name -> kuadrant.v1.HTTPRequestMirrorFilter
+	33, // 55: kuadrant.v1.HTTPRouteFilter.requestRedirect:type_name -> kuadrant.v1.HTTPRequestRedirectFilter
+	42, // 56: kuadrant.v1.HTTPRouteFilter.urlRewrite:type_name -> kuadrant.v1.HTTPURLRewriteFilter
+	45, // 57: kuadrant.v1.HTTPRouteFilter.extensionRef:type_name -> kuadrant.v1.LocalObjectReference
+	29, // 58: kuadrant.v1.HTTPRouteMatch.path:type_name -> kuadrant.v1.HTTPPathMatch
+	28, // 59: kuadrant.v1.HTTPRouteMatch.headers:type_name -> kuadrant.v1.HTTPHeaderMatch
+	31, // 60: kuadrant.v1.HTTPRouteMatch.queryParams:type_name -> kuadrant.v1.HTTPQueryParamMatch
+	36, // 61: kuadrant.v1.HTTPRouteRule.matches:type_name -> kuadrant.v1.HTTPRouteMatch
+	35, // 62: kuadrant.v1.HTTPRouteRule.filters:type_name -> kuadrant.v1.HTTPRouteFilter
+	25, // 63: kuadrant.v1.HTTPRouteRule.backendRefs:type_name -> kuadrant.v1.HTTPBackendRef
+	41, // 64: kuadrant.v1.HTTPRouteRule.timeouts:type_name -> kuadrant.v1.HTTPRouteTimeouts
+	37, // 65: kuadrant.v1.HTTPRouteRule.retry:type_name -> kuadrant.v1.HTTPRouteRetry
+	55, // 66: kuadrant.v1.HTTPRouteRule.sessionPersistence:type_name -> kuadrant.v1.SessionPersistence
+	50, // 67: kuadrant.v1.HTTPRouteSpec.parentRefs:type_name -> kuadrant.v1.ParentReference
+	38, // 68: kuadrant.v1.HTTPRouteSpec.rules:type_name -> kuadrant.v1.HTTPRouteRule
+	53, // 69: kuadrant.v1.HTTPRouteStatus.parents:type_name -> kuadrant.v1.RouteParentStatus
+	30, // 70: kuadrant.v1.HTTPURLRewriteFilter.path:type_name -> kuadrant.v1.HTTPPathModifier
+	24, // 71: kuadrant.v1.Listener.tls:type_name -> kuadrant.v1.GatewayTLSConfig
+	0,  // 72: kuadrant.v1.Listener.allowedRoutes:type_name -> kuadrant.v1.AllowedRoutes
+	51, // 73: kuadrant.v1.ListenerStatus.supportedKinds:type_name -> kuadrant.v1.RouteGroupKind
+	61, // 74: kuadrant.v1.ListenerStatus.conditions:type_name -> kuadrant.v1.Condition
+	62, // 75: kuadrant.v1.RouteNamespaces.selector:type_name -> kuadrant.v1.LabelSelector
+	50, // 76: kuadrant.v1.RouteParentStatus.parentRef:type_name -> kuadrant.v1.ParentReference
+	61, // 77: kuadrant.v1.RouteParentStatus.conditions:type_name -> kuadrant.v1.Condition
+	2,  // 78: kuadrant.v1.SessionPersistence.cookieConfig:type_name -> kuadrant.v1.CookieConfig
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_v1_gateway_api_proto_init() }
@@ -617,13 +4162,46 @@ func file_v1_gateway_api_proto_init() {
 		return
 	}
 	file_v1_common_proto_init()
+	file_v1_k8s_api_proto_init()
+	file_v1_gateway_api_proto_msgTypes[1].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[2].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[3].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[5].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[6].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[7].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[11].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[15].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[18].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[23].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[24].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[25].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[28].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[29].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[30].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[31].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[32].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[33].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[36].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[37].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[38].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[41].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[42].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[43].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[47].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[48].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[49].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[50].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[51].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[52].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[54].OneofWrappers = []any{}
+	file_v1_gateway_api_proto_msgTypes[55].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_gateway_api_proto_rawDesc), len(file_v1_gateway_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,388 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: v1/k8s_api.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CoreLocalObjectReference is generated from k8s.io/api/core/v1.LocalObjectReference
+type CoreLocalObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoreLocalObjectReference) Reset() {
+	*x = CoreLocalObjectReference{}
+	mi := &file_v1_k8s_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoreLocalObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoreLocalObjectReference) ProtoMessage() {}
+
+func (x *CoreLocalObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_k8s_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoreLocalObjectReference.ProtoReflect.Descriptor instead.
+func (*CoreLocalObjectReference) Descriptor() ([]byte, []int) {
+	return file_v1_k8s_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CoreLocalObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// LabelSelector is generated from k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector
+type LabelSelector struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	MatchLabels      map[string]string           `protobuf:"bytes,1,rep,name=matchLabels,proto3" json:"matchLabels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MatchExpressions []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=matchExpressions,proto3" json:"matchExpressions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LabelSelector) Reset() {
+	*x = LabelSelector{}
+	mi := &file_v1_k8s_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelector) ProtoMessage() {}
+
+func (x *LabelSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_k8s_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelector.ProtoReflect.Descriptor instead.
+func (*LabelSelector) Descriptor() ([]byte, []int) {
+	return file_v1_k8s_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LabelSelector) GetMatchLabels() map[string]string {
+	if x != nil {
+		return x.MatchLabels
+	}
+	return nil
+}
+
+func (x *LabelSelector) GetMatchExpressions() []*LabelSelectorRequirement {
+	if x != nil {
+		return x.MatchExpressions
+	}
+	return nil
+}
+
+// LabelSelectorRequirement is generated from k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement
+type LabelSelectorRequirement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelSelectorRequirement) Reset() {
+	*x = LabelSelectorRequirement{}
+	mi := &file_v1_k8s_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelSelectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelectorRequirement) ProtoMessage() {}
+
+func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_k8s_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_v1_k8s_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LabelSelectorRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// ResourceClaim is generated from k8s.io/api/core/v1.ResourceClaim
+type ResourceClaim struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceClaim) Reset() {
+	*x = ResourceClaim{}
+	mi := &file_v1_k8s_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceClaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceClaim) ProtoMessage() {}
+
+func (x *ResourceClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_k8s_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceClaim.ProtoReflect.Descriptor instead.
+func (*ResourceClaim) Descriptor() ([]byte, []int) {
+	return file_v1_k8s_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceClaim) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceClaim) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+// ResourceRequirements is generated from k8s.io/api/core/v1.ResourceRequirements
+type ResourceRequirements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        map[string]string      `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Requests      map[string]string      `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Claims        []*ResourceClaim       `protobuf:"bytes,3,rep,name=claims,proto3" json:"claims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRequirements) Reset() {
+	*x = ResourceRequirements{}
+	mi := &file_v1_k8s_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRequirements) ProtoMessage() {}
+
+func (x *ResourceRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_k8s_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRequirements.ProtoReflect.Descriptor instead.
+func (*ResourceRequirements) Descriptor() ([]byte, []int) {
+	return file_v1_k8s_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResourceRequirements) GetLimits() map[string]string {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ResourceRequirements) GetRequests() map[string]string {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ResourceRequirements) GetClaims() []*ResourceClaim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+var File_v1_k8s_api_proto protoreflect.FileDescriptor
+
+const file_v1_k8s_api_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/k8s_api.proto\x12\vkuadrant.v1\".\n" +
+	"\x18CoreLocalObjectReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xf1\x01\n" +
+	"\rLabelSelector\x12M\n" +
+	"\vmatchLabels\x18\x01 \x03(\v2+.kuadrant.v1.LabelSelector.MatchLabelsEntryR\vmatchLabels\x12Q\n" +
+	"\x10matchExpressions\x18\x02 \x03(\v2%.kuadrant.v1.LabelSelectorRequirementR\x10matchExpressions\x1a>\n" +
+	"\x10MatchLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\x18LabelSelectorRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"=\n" +
+	"\rResourceClaim\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\"\xd6\x02\n" +
+	"\x14ResourceRequirements\x12E\n" +
+	"\x06limits\x18\x01 \x03(\v2-.kuadrant.v1.ResourceRequirements.LimitsEntryR\x06limits\x12K\n" +
+	"\brequests\x18\x02 \x03(\v2/.kuadrant.v1.ResourceRequirements.RequestsEntryR\brequests\x122\n" +
+	"\x06claims\x18\x03 \x03(\v2\x1a.kuadrant.v1.ResourceClaimR\x06claims\x1a9\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rRequestsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05Z\x03/v1b\x06proto3"
+
+var (
+	file_v1_k8s_api_proto_rawDescOnce sync.Once
+	file_v1_k8s_api_proto_rawDescData []byte
+)
+
+func file_v1_k8s_api_proto_rawDescGZIP() []byte {
+	file_v1_k8s_api_proto_rawDescOnce.Do(func() {
+		file_v1_k8s_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_k8s_api_proto_rawDesc), len(file_v1_k8s_api_proto_rawDesc)))
+	})
+	return file_v1_k8s_api_proto_rawDescData
+}
+
+var file_v1_k8s_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_k8s_api_proto_goTypes = []any{
+	(*CoreLocalObjectReference)(nil), // 0: kuadrant.v1.CoreLocalObjectReference
+	(*LabelSelector)(nil),            // 1: kuadrant.v1.LabelSelector
+	(*LabelSelectorRequirement)(nil), // 2: kuadrant.v1.LabelSelectorRequirement
+	(*ResourceClaim)(nil),            // 3: kuadrant.v1.ResourceClaim
+	(*ResourceRequirements)(nil),     // 4: kuadrant.v1.ResourceRequirements
+	nil,                              // 5: kuadrant.v1.LabelSelector.MatchLabelsEntry
+	nil,                              // 6: kuadrant.v1.ResourceRequirements.LimitsEntry
+	nil,                              // 7: kuadrant.v1.ResourceRequirements.RequestsEntry
+}
+var file_v1_k8s_api_proto_depIdxs = []int32{
+	5, // 0: kuadrant.v1.LabelSelector.matchLabels:type_name -> kuadrant.v1.LabelSelector.MatchLabelsEntry
+	2, // 1: kuadrant.v1.LabelSelector.matchExpressions:type_name -> kuadrant.v1.LabelSelectorRequirement
+	6, // 2: kuadrant.v1.ResourceRequirements.limits:type_name -> kuadrant.v1.ResourceRequirements.LimitsEntry
+	7, // 3: kuadrant.v1.ResourceRequirements.requests:type_name -> kuadrant.v1.ResourceRequirements.RequestsEntry
+	3, // 4: kuadrant.v1.ResourceRequirements.claims:type_name -> kuadrant.v1.ResourceClaim
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_k8s_api_proto_init() }
+func file_v1_k8s_api_proto_init() {
+	if File_v1_k8s_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_k8s_api_proto_rawDesc), len(file_v1_k8s_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_k8s_api_proto_goTypes,
+		DependencyIndexes: file_v1_k8s_api_proto_depIdxs,
+		MessageInfos:      file_v1_k8s_api_proto_msgTypes,
+	}.Build()
+	File_v1_k8s_api_proto = out.File
+	file_v1_k8s_api_proto_goTypes = nil
+	file_v1_k8s_api_proto_depIdxs = nil
+}
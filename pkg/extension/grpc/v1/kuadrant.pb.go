@@ -31,6 +31,7 @@ const (
 	Domain_DOMAIN_UNSPECIFIED Domain = 0
 	Domain_DOMAIN_AUTH        Domain = 1
 	Domain_DOMAIN_REQUEST     Domain = 2
+	Domain_DOMAIN_RESPONSE    Domain = 3
 )
 
 // Enum value maps for Domain.
@@ -39,11 +40,13 @@ var (
 		0: "DOMAIN_UNSPECIFIED",
 		1: "DOMAIN_AUTH",
 		2: "DOMAIN_REQUEST",
+		3: "DOMAIN_RESPONSE",
 	}
 	Domain_value = map[string]int32{
 		"DOMAIN_UNSPECIFIED": 0,
 		"DOMAIN_AUTH":        1,
 		"DOMAIN_REQUEST":     2,
+		"DOMAIN_RESPONSE":    3,
 	}
 )
 
@@ -574,6 +577,238 @@ func (x *ClearPolicyResponse) GetClearedMutators() int32 {
 	return 0
 }
 
+type ReportStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Conditions    []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportStatusRequest) Reset() {
+	*x = ReportStatusRequest{}
+	mi := &file_v1_kuadrant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportStatusRequest) ProtoMessage() {}
+
+func (x *ReportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReportStatusRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *ReportStatusRequest) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// The conditions of the policy, along with the ones of the Kuadrant policies it owns
+type ReportStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conditions    []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportStatusResponse) Reset() {
+	*x = ReportStatusResponse{}
+	mi := &file_v1_kuadrant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportStatusResponse) ProtoMessage() {}
+
+func (x *ReportStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReportStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReportStatusResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// The version of the API, of the CEL library and the features the extension requires
+type GetCapabilitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version of the API the extension is built against, e.g. v1
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// minimum version of the kuadrant CEL library the expressions of the extension require
+	CelVersion uint32 `protobuf:"varint,2,opt,name=cel_version,json=celVersion,proto3" json:"cel_version,omitempty"`
+	// features of kuadrant-operator the extension requires, e.g. ReportStatus
+	Features      []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_v1_kuadrant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCapabilitiesRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesRequest) GetCelVersion() uint32 {
+	if x != nil {
+		return x.CelVersion
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesRequest) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// The versions of the API, of the CEL library and the features kuadrant-operator supports
+type GetCapabilitiesResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersions []string               `protobuf:"bytes,1,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"`
+	// latest version of the kuadrant CEL library
+	CelVersion uint32   `protobuf:"varint,2,opt,name=cel_version,json=celVersion,proto3" json:"cel_version,omitempty"`
+	Features   []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	// requirements of the extension kuadrant-operator does not support, empty when compatible
+	Incompatibilities []string `protobuf:"bytes,4,rep,name=incompatibilities,proto3" json:"incompatibilities,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_v1_kuadrant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCapabilitiesResponse) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetCelVersion() uint32 {
+	if x != nil {
+		return x.CelVersion
+	}
+	return 0
+}
+
+func (x *GetCapabilitiesResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetIncompatibilities() []string {
+	if x != nil {
+		return x.Incompatibilities
+	}
+	return nil
+}
+
 var File_v1_kuadrant_proto protoreflect.FileDescriptor
 
 const file_v1_kuadrant_proto_rawDesc = "" +
@@ -611,17 +846,41 @@ const file_v1_kuadrant_proto_rawDesc = "" +
 	"\x06policy\x18\x01 \x01(\v2\x13.kuadrant.v1.PolicyR\x06policy\"u\n" +
 	"\x13ClearPolicyResponse\x123\n" +
 	"\x15cleared_subscriptions\x18\x01 \x01(\x05R\x14clearedSubscriptions\x12)\n" +
-	"\x10cleared_mutators\x18\x02 \x01(\x05R\x0fclearedMutators*E\n" +
+	"\x10cleared_mutators\x18\x02 \x01(\x05R\x0fclearedMutators\"z\n" +
+	"\x13ReportStatusRequest\x12+\n" +
+	"\x06policy\x18\x01 \x01(\v2\x13.kuadrant.v1.PolicyR\x06policy\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"N\n" +
+	"\x14ReportStatusResponse\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"v\n" +
+	"\x16GetCapabilitiesRequest\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x1f\n" +
+	"\vcel_version\x18\x02 \x01(\rR\n" +
+	"celVersion\x12\x1a\n" +
+	"\bfeatures\x18\x03 \x03(\tR\bfeatures\"\xa7\x01\n" +
+	"\x17GetCapabilitiesResponse\x12!\n" +
+	"\fapi_versions\x18\x01 \x03(\tR\vapiVersions\x12\x1f\n" +
+	"\vcel_version\x18\x02 \x01(\rR\n" +
+	"celVersion\x12\x1a\n" +
+	"\bfeatures\x18\x03 \x03(\tR\bfeatures\x12,\n" +
+	"\x11incompatibilities\x18\x04 \x03(\tR\x11incompatibilities*Z\n" +
 	"\x06Domain\x12\x16\n" +
 	"\x12DOMAIN_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vDOMAIN_AUTH\x10\x01\x12\x12\n" +
-	"\x0eDOMAIN_REQUEST\x10\x022\x8f\x03\n" +
+	"\x0eDOMAIN_REQUEST\x10\x02\x12\x13\n" +
+	"\x0fDOMAIN_RESPONSE\x10\x032\xc6\x04\n" +
 	"\x10ExtensionService\x12=\n" +
 	"\x04Ping\x12\x18.kuadrant.v1.PingRequest\x1a\x19.kuadrant.v1.PongResponse\"\x00\x12N\n" +
 	"\tSubscribe\x12\x1d.kuadrant.v1.SubscribeRequest\x1a\x1e.kuadrant.v1.SubscribeResponse\"\x000\x01\x12F\n" +
 	"\aResolve\x12\x1b.kuadrant.v1.ResolveRequest\x1a\x1c.kuadrant.v1.ResolveResponse\"\x00\x12P\n" +
 	"\x0fRegisterMutator\x12#.kuadrant.v1.RegisterMutatorRequest\x1a\x16.google.protobuf.Empty\"\x00\x12R\n" +
-	"\vClearPolicy\x12\x1f.kuadrant.v1.ClearPolicyRequest\x1a .kuadrant.v1.ClearPolicyResponse\"\x00B\x05Z\x03/v1b\x06proto3"
+	"\vClearPolicy\x12\x1f.kuadrant.v1.ClearPolicyRequest\x1a .kuadrant.v1.ClearPolicyResponse\"\x00\x12U\n" +
+	"\fReportStatus\x12 .kuadrant.v1.ReportStatusRequest\x1a!.kuadrant.v1.ReportStatusResponse\"\x00\x12^\n" +
+	"\x0fGetCapabilities\x12#.kuadrant.v1.GetCapabilitiesRequest\x1a$.kuadrant.v1.GetCapabilitiesResponse\"\x00B\x05Z\x03/v1b\x06proto3"
 
 var (
 	file_v1_kuadrant_proto_rawDescOnce sync.Once
@@ -636,52 +895,64 @@ func file_v1_kuadrant_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_kuadrant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_kuadrant_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_v1_kuadrant_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_v1_kuadrant_proto_goTypes = []any{
-	(Domain)(0),                    // 0: kuadrant.v1.Domain
-	(*PingRequest)(nil),            // 1: kuadrant.v1.PingRequest
-	(*PongResponse)(nil),           // 2: kuadrant.v1.PongResponse
-	(*ResolveRequest)(nil),         // 3: kuadrant.v1.ResolveRequest
-	(*ResolveResponse)(nil),        // 4: kuadrant.v1.ResolveResponse
-	(*SubscribeResponse)(nil),      // 5: kuadrant.v1.SubscribeResponse
-	(*SubscribeRequest)(nil),       // 6: kuadrant.v1.SubscribeRequest
-	(*Event)(nil),                  // 7: kuadrant.v1.Event
-	(*RegisterMutatorRequest)(nil), // 8: kuadrant.v1.RegisterMutatorRequest
-	(*ClearPolicyRequest)(nil),     // 9: kuadrant.v1.ClearPolicyRequest
-	(*ClearPolicyResponse)(nil),    // 10: kuadrant.v1.ClearPolicyResponse
-	(*timestamp.Timestamp)(nil),    // 11: google.protobuf.Timestamp
-	(*Policy)(nil),                 // 12: kuadrant.v1.Policy
-	(*v1alpha1.Value)(nil),         // 13: google.api.expr.v1alpha1.Value
-	(*status.Status)(nil),          // 14: google.rpc.Status
-	(*Metadata)(nil),               // 15: kuadrant.v1.Metadata
-	(*empty.Empty)(nil),            // 16: google.protobuf.Empty
+	(Domain)(0),                     // 0: kuadrant.v1.Domain
+	(*PingRequest)(nil),             // 1: kuadrant.v1.PingRequest
+	(*PongResponse)(nil),            // 2: kuadrant.v1.PongResponse
+	(*ResolveRequest)(nil),          // 3: kuadrant.v1.ResolveRequest
+	(*ResolveResponse)(nil),         // 4: kuadrant.v1.ResolveResponse
+	(*SubscribeResponse)(nil),       // 5: kuadrant.v1.SubscribeResponse
+	(*SubscribeRequest)(nil),        // 6: kuadrant.v1.SubscribeRequest
+	(*Event)(nil),                   // 7: kuadrant.v1.Event
+	(*RegisterMutatorRequest)(nil),  // 8: kuadrant.v1.RegisterMutatorRequest
+	(*ClearPolicyRequest)(nil),      // 9: kuadrant.v1.ClearPolicyRequest
+	(*ClearPolicyResponse)(nil),     // 10: kuadrant.v1.ClearPolicyResponse
+	(*ReportStatusRequest)(nil),     // 11: kuadrant.v1.ReportStatusRequest
+	(*ReportStatusResponse)(nil),    // 12: kuadrant.v1.ReportStatusResponse
+	(*GetCapabilitiesRequest)(nil),  // 13: kuadrant.v1.GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil), // 14: kuadrant.v1.GetCapabilitiesResponse
+	(*timestamp.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(*Policy)(nil),                  // 16: kuadrant.v1.Policy
+	(*v1alpha1.Value)(nil),          // 17: google.api.expr.v1alpha1.Value
+	(*status.Status)(nil),           // 18: google.rpc.Status
+	(*Metadata)(nil),                // 19: kuadrant.v1.Metadata
+	(*Condition)(nil),               // 20: kuadrant.v1.Condition
+	(*empty.Empty)(nil),             // 21: google.protobuf.Empty
 }
 var file_v1_kuadrant_proto_depIdxs = []int32{
-	11, // 0: kuadrant.v1.PingRequest.out:type_name -> google.protobuf.Timestamp
-	11, // 1: kuadrant.v1.PongResponse.in:type_name -> google.protobuf.Timestamp
-	12, // 2: kuadrant.v1.ResolveRequest.policy:type_name -> kuadrant.v1.Policy
-	13, // 3: kuadrant.v1.ResolveResponse.cel_result:type_name -> google.api.expr.v1alpha1.Value
+	15, // 0: kuadrant.v1.PingRequest.out:type_name -> google.protobuf.Timestamp
+	15, // 1: kuadrant.v1.PongResponse.in:type_name -> google.protobuf.Timestamp
+	16, // 2: kuadrant.v1.ResolveRequest.policy:type_name -> kuadrant.v1.Policy
+	17, // 3: kuadrant.v1.ResolveResponse.cel_result:type_name -> google.api.expr.v1alpha1.Value
 	7,  // 4: kuadrant.v1.SubscribeResponse.event:type_name -> kuadrant.v1.Event
-	14, // 5: kuadrant.v1.SubscribeResponse.error:type_name -> google.rpc.Status
-	15, // 6: kuadrant.v1.Event.metadata:type_name -> kuadrant.v1.Metadata
-	12, // 7: kuadrant.v1.RegisterMutatorRequest.policy:type_name -> kuadrant.v1.Policy
+	18, // 5: kuadrant.v1.SubscribeResponse.error:type_name -> google.rpc.Status
+	19, // 6: kuadrant.v1.Event.metadata:type_name -> kuadrant.v1.Metadata
+	16, // 7: kuadrant.v1.RegisterMutatorRequest.policy:type_name -> kuadrant.v1.Policy
 	0,  // 8: kuadrant.v1.RegisterMutatorRequest.domain:type_name -> kuadrant.v1.Domain
-	12, // 9: kuadrant.v1.ClearPolicyRequest.policy:type_name -> kuadrant.v1.Policy
-	1,  // 10: kuadrant.v1.ExtensionService.Ping:input_type -> kuadrant.v1.PingRequest
-	6,  // 11: kuadrant.v1.ExtensionService.Subscribe:input_type -> kuadrant.v1.SubscribeRequest
-	3,  // 12: kuadrant.v1.ExtensionService.Resolve:input_type -> kuadrant.v1.ResolveRequest
-	8,  // 13: kuadrant.v1.ExtensionService.RegisterMutator:input_type -> kuadrant.v1.RegisterMutatorRequest
-	9,  // 14: kuadrant.v1.ExtensionService.ClearPolicy:input_type -> kuadrant.v1.ClearPolicyRequest
-	2,  // 15: kuadrant.v1.ExtensionService.Ping:output_type -> kuadrant.v1.PongResponse
-	5,  // 16: kuadrant.v1.ExtensionService.Subscribe:output_type -> kuadrant.v1.SubscribeResponse
-	4,  // 17: kuadrant.v1.ExtensionService.Resolve:output_type -> kuadrant.v1.ResolveResponse
-	16, // 18: kuadrant.v1.ExtensionService.RegisterMutator:output_type -> google.protobuf.Empty
-	10, // 19: kuadrant.v1.ExtensionService.ClearPolicy:output_type -> kuadrant.v1.ClearPolicyResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 9: kuadrant.v1.ClearPolicyRequest.policy:type_name -> kuadrant.v1.Policy
+	16, // 10: kuadrant.v1.ReportStatusRequest.policy:type_name -> kuadrant.v1.Policy
+	20, // 11: kuadrant.v1.ReportStatusRequest.conditions:type_name -> kuadrant.v1.Condition
+	20, // 12: kuadrant.v1.ReportStatusResponse.conditions:type_name -> kuadrant.v1.Condition
+	1,  // 13: kuadrant.v1.ExtensionService.Ping:input_type -> kuadrant.v1.PingRequest
+	6,  // 14: kuadrant.v1.ExtensionService.Subscribe:input_type -> kuadrant.v1.SubscribeRequest
+	3,  // 15: kuadrant.v1.ExtensionService.Resolve:input_type -> kuadrant.v1.ResolveRequest
+	8,  // 16: kuadrant.v1.ExtensionService.RegisterMutator:input_type -> kuadrant.v1.RegisterMutatorRequest
+	9,  // 17: kuadrant.v1.ExtensionService.ClearPolicy:input_type -> kuadrant.v1.ClearPolicyRequest
+	11, // 18: kuadrant.v1.ExtensionService.ReportStatus:input_type -> kuadrant.v1.ReportStatusRequest
+	13, // 19: kuadrant.v1.ExtensionService.GetCapabilities:input_type -> kuadrant.v1.GetCapabilitiesRequest
+	2,  // 20: kuadrant.v1.ExtensionService.Ping:output_type -> kuadrant.v1.PongResponse
+	5,  // 21: kuadrant.v1.ExtensionService.Subscribe:output_type -> kuadrant.v1.SubscribeResponse
+	4,  // 22: kuadrant.v1.ExtensionService.Resolve:output_type -> kuadrant.v1.ResolveResponse
+	21, // 23: kuadrant.v1.ExtensionService.RegisterMutator:output_type -> google.protobuf.Empty
+	10, // 24: kuadrant.v1.ExtensionService.ClearPolicy:output_type -> kuadrant.v1.ClearPolicyResponse
+	12, // 25: kuadrant.v1.ExtensionService.ReportStatus:output_type -> kuadrant.v1.ReportStatusResponse
+	14, // 26: kuadrant.v1.ExtensionService.GetCapabilities:output_type -> kuadrant.v1.GetCapabilitiesResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_v1_kuadrant_proto_init() }
@@ -697,7 +968,7 @@ func file_v1_kuadrant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_kuadrant_proto_rawDesc), len(file_v1_kuadrant_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,8127 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: v1/kuadrant_api.proto
+
+package v1
+
+import (
+	duration "github.com/golang/protobuf/ptypes/duration"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdditionalHeadersRef is generated from github.com/kuadrant/dns-operator/api/v1alpha1.AdditionalHeadersRef
+type AdditionalHeadersRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalHeadersRef) Reset() {
+	*x = AdditionalHeadersRef{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalHeadersRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalHeadersRef) ProtoMessage() {}
+
+func (x *AdditionalHeadersRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalHeadersRef.ProtoReflect.Descriptor instead.
+func (*AdditionalHeadersRef) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdditionalHeadersRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AnonymousAccessSpec is generated from github.com/kuadrant/authorino/api/v1beta3.AnonymousAccessSpec
+type AnonymousAccessSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymousAccessSpec) Reset() {
+	*x = AnonymousAccessSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymousAccessSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymousAccessSpec) ProtoMessage() {}
+
+func (x *AnonymousAccessSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymousAccessSpec.ProtoReflect.Descriptor instead.
+func (*AnonymousAccessSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{1}
+}
+
+// ApiKeyAuthenticationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.ApiKeyAuthenticationSpec
+type ApiKeyAuthenticationSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *LabelSelector         `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	AllNamespaces bool                   `protobuf:"varint,2,opt,name=allNamespaces,proto3" json:"allNamespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyAuthenticationSpec) Reset() {
+	*x = ApiKeyAuthenticationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyAuthenticationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyAuthenticationSpec) ProtoMessage() {}
+
+func (x *ApiKeyAuthenticationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyAuthenticationSpec.ProtoReflect.Descriptor instead.
+func (*ApiKeyAuthenticationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApiKeyAuthenticationSpec) GetSelector() *LabelSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ApiKeyAuthenticationSpec) GetAllNamespaces() bool {
+	if x != nil {
+		return x.AllNamespaces
+	}
+	return false
+}
+
+// AuthPolicy is generated from github.com/kuadrant/kuadrant-operator/api/v1.AuthPolicy
+type AuthPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *AuthPolicySpec        `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *AuthPolicyStatus      `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthPolicy) Reset() {
+	*x = AuthPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicy) ProtoMessage() {}
+
+func (x *AuthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicy.ProtoReflect.Descriptor instead.
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthPolicy) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuthPolicy) GetSpec() *AuthPolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *AuthPolicy) GetStatus() *AuthPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// AuthPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.AuthPolicySpec
+type AuthPolicySpec struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	TargetRef     *LocalPolicyTargetReferenceWithSectionName `protobuf:"bytes,1,opt,name=targetRef,proto3" json:"targetRef,omitempty"`
+	Defaults      *MergeableAuthPolicySpec                   `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Overrides     *MergeableAuthPolicySpec                   `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	Patterns      map[string]*MergeablePatternExpressions    `protobuf:"bytes,4,rep,name=patterns,proto3" json:"patterns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	When          []*Predicate                               `protobuf:"bytes,5,rep,name=when,proto3" json:"when,omitempty"`
+	Rules         *AuthSchemeSpec                            `protobuf:"bytes,6,opt,name=rules,proto3" json:"rules,omitempty"`
+	Mode          string                                     `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	FailureMode   string                                     `protobuf:"bytes,8,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                                    `protobuf:"bytes,9,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthPolicySpec) Reset() {
+	*x = AuthPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicySpec) ProtoMessage() {}
+
+func (x *AuthPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicySpec.ProtoReflect.Descriptor instead.
+func (*AuthPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthPolicySpec) GetTargetRef() *LocalPolicyTargetReferenceWithSectionName {
+	if x != nil {
+		return x.TargetRef
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetDefaults() *MergeableAuthPolicySpec {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetOverrides() *MergeableAuthPolicySpec {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetPatterns() map[string]*MergeablePatternExpressions {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetRules() *AuthSchemeSpec {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *AuthPolicySpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *AuthPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *AuthPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// AuthPolicyStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1.AuthPolicyStatus
+type AuthPolicyStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ObservedGeneration int64                  `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions         []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AuthPolicyStatus) Reset() {
+	*x = AuthPolicyStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicyStatus) ProtoMessage() {}
+
+func (x *AuthPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicyStatus.ProtoReflect.Descriptor instead.
+func (*AuthPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *AuthPolicyStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// AuthSchemeSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.AuthSchemeSpec
+type AuthSchemeSpec struct {
+	state          protoimpl.MessageState                  `protogen:"open.v1"`
+	Authentication map[string]*MergeableAuthenticationSpec `protobuf:"bytes,1,rep,name=authentication,proto3" json:"authentication,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata       map[string]*MergeableMetadataSpec       `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Authorization  map[string]*MergeableAuthorizationSpec  `protobuf:"bytes,3,rep,name=authorization,proto3" json:"authorization,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Response       *MergeableResponseSpec                  `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	Callbacks      map[string]*MergeableCallbackSpec       `protobuf:"bytes,5,rep,name=callbacks,proto3" json:"callbacks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuthSchemeSpec) Reset() {
+	*x = AuthSchemeSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthSchemeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthSchemeSpec) ProtoMessage() {}
+
+func (x *AuthSchemeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthSchemeSpec.ProtoReflect.Descriptor instead.
+func (*AuthSchemeSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuthSchemeSpec) GetAuthentication() map[string]*MergeableAuthenticationSpec {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *AuthSchemeSpec) GetMetadata() map[string]*MergeableMetadataSpec {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuthSchemeSpec) GetAuthorization() map[string]*MergeableAuthorizationSpec {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
+func (x *AuthSchemeSpec) GetResponse() *MergeableResponseSpec {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AuthSchemeSpec) GetCallbacks() map[string]*MergeableCallbackSpec {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+// AuthorinoOIDCServer is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.AuthorinoOIDCServer
+type AuthorinoOIDCServer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tls           *Tls                   `protobuf:"bytes,1,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorinoOIDCServer) Reset() {
+	*x = AuthorinoOIDCServer{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorinoOIDCServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorinoOIDCServer) ProtoMessage() {}
+
+func (x *AuthorinoOIDCServer) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorinoOIDCServer.ProtoReflect.Descriptor instead.
+func (*AuthorinoOIDCServer) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuthorinoOIDCServer) GetTls() *Tls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// AuthorinoSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.AuthorinoSpec
+type AuthorinoSpec struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Replicas           *int32                 `protobuf:"varint,1,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	LogLevel           string                 `protobuf:"bytes,2,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	LogMode            string                 `protobuf:"bytes,3,opt,name=logMode,proto3" json:"logMode,omitempty"`
+	EvaluatorCacheSize *int64                 `protobuf:"varint,4,opt,name=evaluatorCacheSize,proto3,oneof" json:"evaluatorCacheSize,omitempty"`
+	OidcServer         *AuthorinoOIDCServer   `protobuf:"bytes,5,opt,name=oidcServer,proto3" json:"oidcServer,omitempty"`
+	Tracing            *Tracing               `protobuf:"bytes,6,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AuthorinoSpec) Reset() {
+	*x = AuthorinoSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorinoSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorinoSpec) ProtoMessage() {}
+
+func (x *AuthorinoSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorinoSpec.ProtoReflect.Descriptor instead.
+func (*AuthorinoSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuthorinoSpec) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *AuthorinoSpec) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *AuthorinoSpec) GetLogMode() string {
+	if x != nil {
+		return x.LogMode
+	}
+	return ""
+}
+
+func (x *AuthorinoSpec) GetEvaluatorCacheSize() int64 {
+	if x != nil && x.EvaluatorCacheSize != nil {
+		return *x.EvaluatorCacheSize
+	}
+	return 0
+}
+
+func (x *AuthorinoSpec) GetOidcServer() *AuthorinoOIDCServer {
+	if x != nil {
+		return x.OidcServer
+	}
+	return nil
+}
+
+func (x *AuthorinoSpec) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+// AuthorinoStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.AuthorinoStatus
+type AuthorinoStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Replicas           int32                  `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	LogLevel           string                 `protobuf:"bytes,2,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	EvaluatorCacheSize *int64                 `protobuf:"varint,3,opt,name=evaluatorCacheSize,proto3,oneof" json:"evaluatorCacheSize,omitempty"`
+	OidcServerTLS      *bool                  `protobuf:"varint,4,opt,name=oidcServerTLS,proto3,oneof" json:"oidcServerTLS,omitempty"`
+	TracingEndpoint    string                 `protobuf:"bytes,5,opt,name=tracingEndpoint,proto3" json:"tracingEndpoint,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AuthorinoStatus) Reset() {
+	*x = AuthorinoStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorinoStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorinoStatus) ProtoMessage() {}
+
+func (x *AuthorinoStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorinoStatus.ProtoReflect.Descriptor instead.
+func (*AuthorinoStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthorinoStatus) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *AuthorinoStatus) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *AuthorinoStatus) GetEvaluatorCacheSize() int64 {
+	if x != nil && x.EvaluatorCacheSize != nil {
+		return *x.EvaluatorCacheSize
+	}
+	return 0
+}
+
+func (x *AuthorinoStatus) GetOidcServerTLS() bool {
+	if x != nil && x.OidcServerTLS != nil {
+		return *x.OidcServerTLS
+	}
+	return false
+}
+
+func (x *AuthorinoStatus) GetTracingEndpoint() string {
+	if x != nil {
+		return x.TracingEndpoint
+	}
+	return ""
+}
+
+// CertManagerObjectReference is generated from github.com/cert-manager/cert-manager/pkg/apis/meta/v1.ObjectReference
+type CertManagerObjectReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertManagerObjectReference) Reset() {
+	*x = CertManagerObjectReference{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertManagerObjectReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertManagerObjectReference) ProtoMessage() {}
+
+func (x *CertManagerObjectReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertManagerObjectReference.ProtoReflect.Descriptor instead.
+func (*CertManagerObjectReference) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CertManagerObjectReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CertManagerObjectReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CertManagerObjectReference) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// CertificatePrivateKey is generated from github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1.CertificatePrivateKey
+type CertificatePrivateKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RotationPolicy string                 `protobuf:"bytes,1,opt,name=rotationPolicy,proto3" json:"rotationPolicy,omitempty"`
+	Encoding       string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Algorithm      string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Size           int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CertificatePrivateKey) Reset() {
+	*x = CertificatePrivateKey{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificatePrivateKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificatePrivateKey) ProtoMessage() {}
+
+func (x *CertificatePrivateKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificatePrivateKey.ProtoReflect.Descriptor instead.
+func (*CertificatePrivateKey) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CertificatePrivateKey) GetRotationPolicy() string {
+	if x != nil {
+		return x.RotationPolicy
+	}
+	return ""
+}
+
+func (x *CertificatePrivateKey) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *CertificatePrivateKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *CertificatePrivateKey) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// CertificateStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1.CertificateStatus
+type CertificateStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Gateway           string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Listener          string                 `protobuf:"bytes,4,opt,name=listener,proto3" json:"listener,omitempty"`
+	NotAfter          *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	RenewalTime       *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=renewalTime,proto3" json:"renewalTime,omitempty"`
+	LastFailureTime   *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=lastFailureTime,proto3" json:"lastFailureTime,omitempty"`
+	LastFailureReason string                 `protobuf:"bytes,8,opt,name=lastFailureReason,proto3" json:"lastFailureReason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CertificateStatus) Reset() {
+	*x = CertificateStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateStatus) ProtoMessage() {}
+
+func (x *CertificateStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateStatus.ProtoReflect.Descriptor instead.
+func (*CertificateStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CertificateStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CertificateStatus) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CertificateStatus) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *CertificateStatus) GetListener() string {
+	if x != nil {
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *CertificateStatus) GetNotAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *CertificateStatus) GetRenewalTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.RenewalTime
+	}
+	return nil
+}
+
+func (x *CertificateStatus) GetLastFailureTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFailureTime
+	}
+	return nil
+}
+
+func (x *CertificateStatus) GetLastFailureReason() string {
+	if x != nil {
+		return x.LastFailureReason
+	}
+	return ""
+}
+
+// Counter is generated from github.com/kuadrant/kuadrant-operator/api/v1.Counter
+type Counter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counter) Reset() {
+	*x = Counter{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counter) ProtoMessage() {}
+
+func (x *Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
+func (*Counter) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Counter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// Credentials is generated from github.com/kuadrant/authorino/api/v1beta3.Credentials
+type Credentials struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationHeader *Prefixed              `protobuf:"bytes,1,opt,name=authorizationHeader,proto3" json:"authorizationHeader,omitempty"`
+	CustomHeader        *CustomHeader          `protobuf:"bytes,2,opt,name=customHeader,proto3" json:"customHeader,omitempty"`
+	QueryString         *Named                 `protobuf:"bytes,3,opt,name=queryString,proto3" json:"queryString,omitempty"`
+	Cookie              *Named                 `protobuf:"bytes,4,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Credentials) Reset() {
+	*x = Credentials{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credentials) ProtoMessage() {}
+
+func (x *Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credentials.ProtoReflect.Descriptor instead.
+func (*Credentials) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Credentials) GetAuthorizationHeader() *Prefixed {
+	if x != nil {
+		return x.AuthorizationHeader
+	}
+	return nil
+}
+
+func (x *Credentials) GetCustomHeader() *CustomHeader {
+	if x != nil {
+		return x.CustomHeader
+	}
+	return nil
+}
+
+func (x *Credentials) GetQueryString() *Named {
+	if x != nil {
+		return x.QueryString
+	}
+	return nil
+}
+
+func (x *Credentials) GetCookie() *Named {
+	if x != nil {
+		return x.Cookie
+	}
+	return nil
+}
+
+// CustomHeader is generated from github.com/kuadrant/authorino/api/v1beta3.CustomHeader
+type CustomHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomHeader) Reset() {
+	*x = CustomHeader{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomHeader) ProtoMessage() {}
+
+func (x *CustomHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomHeader.ProtoReflect.Descriptor instead.
+func (*CustomHeader) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CustomHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DNSPolicy is generated from github.com/kuadrant/kuadrant-operator/api/v1.DNSPolicy
+type DNSPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *DNSPolicySpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *DNSPolicyStatus       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DNSPolicy) Reset() {
+	*x = DNSPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DNSPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSPolicy) ProtoMessage() {}
+
+func (x *DNSPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSPolicy.ProtoReflect.Descriptor instead.
+func (*DNSPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DNSPolicy) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DNSPolicy) GetSpec() *DNSPolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *DNSPolicy) GetStatus() *DNSPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// DNSPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.DNSPolicySpec
+type DNSPolicySpec struct {
+	state            protoimpl.MessageState                     `protogen:"open.v1"`
+	TargetRef        *LocalPolicyTargetReferenceWithSectionName `protobuf:"bytes,1,opt,name=targetRef,proto3" json:"targetRef,omitempty"`
+	HealthCheck      *HealthCheckSpec                           `protobuf:"bytes,2,opt,name=healthCheck,proto3" json:"healthCheck,omitempty"`
+	LoadBalancing    *LoadBalancingSpec                         `protobuf:"bytes,3,opt,name=loadBalancing,proto3" json:"loadBalancing,omitempty"`
+	ProviderRefs     []*ProviderRef                             `protobuf:"bytes,4,rep,name=providerRefs,proto3" json:"providerRefs,omitempty"`
+	ExcludeAddresses []string                                   `protobuf:"bytes,5,rep,name=excludeAddresses,proto3" json:"excludeAddresses,omitempty"`
+	RouteHostnames   bool                                       `protobuf:"varint,7,opt,name=routeHostnames,proto3" json:"routeHostnames,omitempty"`
+	Delegate         bool                                       `protobuf:"varint,6,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DNSPolicySpec) Reset() {
+	*x = DNSPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DNSPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSPolicySpec) ProtoMessage() {}
+
+func (x *DNSPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSPolicySpec.ProtoReflect.Descriptor instead.
+func (*DNSPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DNSPolicySpec) GetTargetRef() *LocalPolicyTargetReferenceWithSectionName {
+	if x != nil {
+		return x.TargetRef
+	}
+	return nil
+}
+
+func (x *DNSPolicySpec) GetHealthCheck() *HealthCheckSpec {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *DNSPolicySpec) GetLoadBalancing() *LoadBalancingSpec {
+	if x != nil {
+		return x.LoadBalancing
+	}
+	return nil
+}
+
+func (x *DNSPolicySpec) GetProviderRefs() []*ProviderRef {
+	if x != nil {
+		return x.ProviderRefs
+	}
+	return nil
+}
+
+func (x *DNSPolicySpec) GetExcludeAddresses() []string {
+	if x != nil {
+		return x.ExcludeAddresses
+	}
+	return nil
+}
+
+func (x *DNSPolicySpec) GetRouteHostnames() bool {
+	if x != nil {
+		return x.RouteHostnames
+	}
+	return false
+}
+
+func (x *DNSPolicySpec) GetDelegate() bool {
+	if x != nil {
+		return x.Delegate
+	}
+	return false
+}
+
+// DNSPolicyStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1.DNSPolicyStatus
+type DNSPolicyStatus struct {
+	state                    protoimpl.MessageState      `protogen:"open.v1"`
+	Conditions               []*Condition                `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	ObservedGeneration       int64                       `protobuf:"varint,2,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	HealthCheck              *HealthCheckStatus          `protobuf:"bytes,3,opt,name=healthCheck,proto3" json:"healthCheck,omitempty"`
+	RecordConditions         map[string]*ConditionList   `protobuf:"bytes,4,rep,name=recordConditions,proto3" json:"recordConditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ProviderRecordConditions []*ProviderRecordConditions `protobuf:"bytes,6,rep,name=providerRecordConditions,proto3" json:"providerRecordConditions,omitempty"`
+	TotalRecords             int32                       `protobuf:"varint,5,opt,name=totalRecords,proto3" json:"totalRecords,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DNSPolicyStatus) Reset() {
+	*x = DNSPolicyStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DNSPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSPolicyStatus) ProtoMessage() {}
+
+func (x *DNSPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSPolicyStatus.ProtoReflect.Descriptor instead.
+func (*DNSPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DNSPolicyStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DNSPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *DNSPolicyStatus) GetHealthCheck() *HealthCheckStatus {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *DNSPolicyStatus) GetRecordConditions() map[string]*ConditionList {
+	if x != nil {
+		return x.RecordConditions
+	}
+	return nil
+}
+
+func (x *DNSPolicyStatus) GetProviderRecordConditions() []*ProviderRecordConditions {
+	if x != nil {
+		return x.ProviderRecordConditions
+	}
+	return nil
+}
+
+func (x *DNSPolicyStatus) GetTotalRecords() int32 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+// DiskSpec is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.DiskSpec
+type DiskSpec struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PersistentVolumeClaim *PVCGenericSpec        `protobuf:"bytes,1,opt,name=persistentVolumeClaim,proto3" json:"persistentVolumeClaim,omitempty"`
+	Optimize              *string                `protobuf:"bytes,2,opt,name=optimize,proto3,oneof" json:"optimize,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DiskSpec) Reset() {
+	*x = DiskSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskSpec) ProtoMessage() {}
+
+func (x *DiskSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskSpec.ProtoReflect.Descriptor instead.
+func (*DiskSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiskSpec) GetPersistentVolumeClaim() *PVCGenericSpec {
+	if x != nil {
+		return x.PersistentVolumeClaim
+	}
+	return nil
+}
+
+func (x *DiskSpec) GetOptimize() string {
+	if x != nil && x.Optimize != nil {
+		return *x.Optimize
+	}
+	return ""
+}
+
+// EvaluatorCaching is generated from github.com/kuadrant/authorino/api/v1beta3.EvaluatorCaching
+type EvaluatorCaching struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ValueOrSelector       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ttl           int64                  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluatorCaching) Reset() {
+	*x = EvaluatorCaching{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluatorCaching) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatorCaching) ProtoMessage() {}
+
+func (x *EvaluatorCaching) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatorCaching.ProtoReflect.Descriptor instead.
+func (*EvaluatorCaching) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EvaluatorCaching) GetKey() *ValueOrSelector {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *EvaluatorCaching) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// ExtensionStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.ExtensionStatus
+type ExtensionStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Executable        string                 `protobuf:"bytes,2,opt,name=executable,proto3" json:"executable,omitempty"`
+	State             string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Restarts          int32                  `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastError         string                 `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastPingLatency   *duration.Duration     `protobuf:"bytes,6,opt,name=lastPingLatency,proto3" json:"lastPingLatency,omitempty"`
+	Mutators          int32                  `protobuf:"varint,7,opt,name=mutators,proto3" json:"mutators,omitempty"`
+	Subscriptions     int32                  `protobuf:"varint,8,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Address           string                 `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	ApiVersion        string                 `protobuf:"bytes,10,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Incompatibilities []string               `protobuf:"bytes,11,rep,name=incompatibilities,proto3" json:"incompatibilities,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExtensionStatus) Reset() {
+	*x = ExtensionStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionStatus) ProtoMessage() {}
+
+func (x *ExtensionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionStatus.ProtoReflect.Descriptor instead.
+func (*ExtensionStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExtensionStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ExtensionStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetLastPingLatency() *duration.Duration {
+	if x != nil {
+		return x.LastPingLatency
+	}
+	return nil
+}
+
+func (x *ExtensionStatus) GetMutators() int32 {
+	if x != nil {
+		return x.Mutators
+	}
+	return 0
+}
+
+func (x *ExtensionStatus) GetSubscriptions() int32 {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return 0
+}
+
+func (x *ExtensionStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ExtensionStatus) GetIncompatibilities() []string {
+	if x != nil {
+		return x.Incompatibilities
+	}
+	return nil
+}
+
+// ExtensionsSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.ExtensionsSpec
+type ExtensionsSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remote        []*RemoteExtension     `protobuf:"bytes,1,rep,name=remote,proto3" json:"remote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionsSpec) Reset() {
+	*x = ExtensionsSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionsSpec) ProtoMessage() {}
+
+func (x *ExtensionsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionsSpec.ProtoReflect.Descriptor instead.
+func (*ExtensionsSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExtensionsSpec) GetRemote() []*RemoteExtension {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+// ExternalOpaPolicy is generated from github.com/kuadrant/authorino/api/v1beta3.ExternalOpaPolicy
+type ExternalOpaPolicy struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Url             string                      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	UrlExpression   string                      `protobuf:"bytes,2,opt,name=urlExpression,proto3" json:"urlExpression,omitempty"`
+	Method          *string                     `protobuf:"bytes,3,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	Body            *ValueOrSelector            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	BodyParameters  map[string]*ValueOrSelector `protobuf:"bytes,5,rep,name=bodyParameters,proto3" json:"bodyParameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ContentType     string                      `protobuf:"bytes,6,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Headers         map[string]*ValueOrSelector `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SharedSecretRef *SecretKeyReference         `protobuf:"bytes,8,opt,name=sharedSecretRef,proto3" json:"sharedSecretRef,omitempty"`
+	Oauth2          *OAuth2ClientAuthentication `protobuf:"bytes,9,opt,name=oauth2,proto3" json:"oauth2,omitempty"`
+	Credentials     *Credentials                `protobuf:"bytes,10,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Ttl             int64                       `protobuf:"varint,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExternalOpaPolicy) Reset() {
+	*x = ExternalOpaPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalOpaPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalOpaPolicy) ProtoMessage() {}
+
+func (x *ExternalOpaPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalOpaPolicy.ProtoReflect.Descriptor instead.
+func (*ExternalOpaPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExternalOpaPolicy) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExternalOpaPolicy) GetUrlExpression() string {
+	if x != nil {
+		return x.UrlExpression
+	}
+	return ""
+}
+
+func (x *ExternalOpaPolicy) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *ExternalOpaPolicy) GetBody() *ValueOrSelector {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetBodyParameters() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.BodyParameters
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExternalOpaPolicy) GetHeaders() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetSharedSecretRef() *SecretKeyReference {
+	if x != nil {
+		return x.SharedSecretRef
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetOauth2() *OAuth2ClientAuthentication {
+	if x != nil {
+		return x.Oauth2
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetCredentials() *Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ExternalOpaPolicy) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// GatewayReference is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.GatewayReference
+type GatewayReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayReference) Reset() {
+	*x = GatewayReference{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayReference) ProtoMessage() {}
+
+func (x *GatewayReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayReference.ProtoReflect.Descriptor instead.
+func (*GatewayReference) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GatewayReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GatewayReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// GatewayServices is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.GatewayServices
+type GatewayServices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateways      []*GatewayReference    `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	Limitador     *ServiceEndpoint       `protobuf:"bytes,2,opt,name=limitador,proto3" json:"limitador,omitempty"`
+	Authorino     *ServiceEndpoint       `protobuf:"bytes,3,opt,name=authorino,proto3" json:"authorino,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayServices) Reset() {
+	*x = GatewayServices{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayServices) ProtoMessage() {}
+
+func (x *GatewayServices) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayServices.ProtoReflect.Descriptor instead.
+func (*GatewayServices) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GatewayServices) GetGateways() []*GatewayReference {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *GatewayServices) GetLimitador() *ServiceEndpoint {
+	if x != nil {
+		return x.Limitador
+	}
+	return nil
+}
+
+func (x *GatewayServices) GetAuthorino() *ServiceEndpoint {
+	if x != nil {
+		return x.Authorino
+	}
+	return nil
+}
+
+// HealthCheckSpec is generated from github.com/kuadrant/dns-operator/api/v1alpha1.HealthCheckSpec
+type HealthCheckSpec struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Port                 int64                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Path                 string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Protocol             string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Interval             *duration.Duration     `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	AdditionalHeadersRef *AdditionalHeadersRef  `protobuf:"bytes,5,opt,name=additionalHeadersRef,proto3" json:"additionalHeadersRef,omitempty"`
+	FailureThreshold     int64                  `protobuf:"varint,6,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *HealthCheckSpec) Reset() {
+	*x = HealthCheckSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckSpec) ProtoMessage() {}
+
+func (x *HealthCheckSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckSpec.ProtoReflect.Descriptor instead.
+func (*HealthCheckSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HealthCheckSpec) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HealthCheckSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HealthCheckSpec) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *HealthCheckSpec) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *HealthCheckSpec) GetAdditionalHeadersRef() *AdditionalHeadersRef {
+	if x != nil {
+		return x.AdditionalHeadersRef
+	}
+	return nil
+}
+
+func (x *HealthCheckSpec) GetFailureThreshold() int64 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+// HealthCheckStatus is generated from github.com/kuadrant/dns-operator/api/v1alpha1.HealthCheckStatus
+type HealthCheckStatus struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Conditions    []*Condition              `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Probes        []*HealthCheckStatusProbe `protobuf:"bytes,2,rep,name=probes,proto3" json:"probes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckStatus) Reset() {
+	*x = HealthCheckStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckStatus) ProtoMessage() {}
+
+func (x *HealthCheckStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckStatus.ProtoReflect.Descriptor instead.
+func (*HealthCheckStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HealthCheckStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *HealthCheckStatus) GetProbes() []*HealthCheckStatusProbe {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+// HealthCheckStatusProbe is generated from github.com/kuadrant/dns-operator/api/v1alpha1.HealthCheckStatusProbe
+type HealthCheckStatusProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Synced        bool                   `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	Conditions    []*Condition           `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckStatusProbe) Reset() {
+	*x = HealthCheckStatusProbe{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckStatusProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckStatusProbe) ProtoMessage() {}
+
+func (x *HealthCheckStatusProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckStatusProbe.ProtoReflect.Descriptor instead.
+func (*HealthCheckStatusProbe) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HealthCheckStatusProbe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HealthCheckStatusProbe) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *HealthCheckStatusProbe) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HealthCheckStatusProbe) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *HealthCheckStatusProbe) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// HttpEndpointSpec is generated from github.com/kuadrant/authorino/api/v1beta3.HttpEndpointSpec
+type HttpEndpointSpec struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Url             string                      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	UrlExpression   string                      `protobuf:"bytes,2,opt,name=urlExpression,proto3" json:"urlExpression,omitempty"`
+	Method          *string                     `protobuf:"bytes,3,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	Body            *ValueOrSelector            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	BodyParameters  map[string]*ValueOrSelector `protobuf:"bytes,5,rep,name=bodyParameters,proto3" json:"bodyParameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ContentType     string                      `protobuf:"bytes,6,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Headers         map[string]*ValueOrSelector `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SharedSecretRef *SecretKeyReference         `protobuf:"bytes,8,opt,name=sharedSecretRef,proto3" json:"sharedSecretRef,omitempty"`
+	Oauth2          *OAuth2ClientAuthentication `protobuf:"bytes,9,opt,name=oauth2,proto3" json:"oauth2,omitempty"`
+	Credentials     *Credentials                `protobuf:"bytes,10,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HttpEndpointSpec) Reset() {
+	*x = HttpEndpointSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpEndpointSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpEndpointSpec) ProtoMessage() {}
+
+func (x *HttpEndpointSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpEndpointSpec.ProtoReflect.Descriptor instead.
+func (*HttpEndpointSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HttpEndpointSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpEndpointSpec) GetUrlExpression() string {
+	if x != nil {
+		return x.UrlExpression
+	}
+	return ""
+}
+
+func (x *HttpEndpointSpec) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *HttpEndpointSpec) GetBody() *ValueOrSelector {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HttpEndpointSpec) GetBodyParameters() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.BodyParameters
+	}
+	return nil
+}
+
+func (x *HttpEndpointSpec) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *HttpEndpointSpec) GetHeaders() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpEndpointSpec) GetSharedSecretRef() *SecretKeyReference {
+	if x != nil {
+		return x.SharedSecretRef
+	}
+	return nil
+}
+
+func (x *HttpEndpointSpec) GetOauth2() *OAuth2ClientAuthentication {
+	if x != nil {
+		return x.Oauth2
+	}
+	return nil
+}
+
+func (x *HttpEndpointSpec) GetCredentials() *Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// JsonAuthResponseSpec is generated from github.com/kuadrant/authorino/api/v1beta3.JsonAuthResponseSpec
+type JsonAuthResponseSpec struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Properties    map[string]*ValueOrSelector `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonAuthResponseSpec) Reset() {
+	*x = JsonAuthResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonAuthResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonAuthResponseSpec) ProtoMessage() {}
+
+func (x *JsonAuthResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonAuthResponseSpec.ProtoReflect.Descriptor instead.
+func (*JsonAuthResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *JsonAuthResponseSpec) GetProperties() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// JwtAuthenticationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.JwtAuthenticationSpec
+type JwtAuthenticationSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JwksUrl       string                 `protobuf:"bytes,1,opt,name=jwksUrl,proto3" json:"jwksUrl,omitempty"`
+	IssuerUrl     string                 `protobuf:"bytes,2,opt,name=issuerUrl,proto3" json:"issuerUrl,omitempty"`
+	Ttl           int64                  `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JwtAuthenticationSpec) Reset() {
+	*x = JwtAuthenticationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwtAuthenticationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtAuthenticationSpec) ProtoMessage() {}
+
+func (x *JwtAuthenticationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtAuthenticationSpec.ProtoReflect.Descriptor instead.
+func (*JwtAuthenticationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *JwtAuthenticationSpec) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *JwtAuthenticationSpec) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *JwtAuthenticationSpec) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// Kuadrant is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.Kuadrant
+type Kuadrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *KuadrantSpec          `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *KuadrantStatus        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Kuadrant) Reset() {
+	*x = Kuadrant{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kuadrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kuadrant) ProtoMessage() {}
+
+func (x *Kuadrant) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kuadrant.ProtoReflect.Descriptor instead.
+func (*Kuadrant) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Kuadrant) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Kuadrant) GetSpec() *KuadrantSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Kuadrant) GetStatus() *KuadrantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// KuadrantSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.KuadrantSpec
+type KuadrantSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Observability   *Observability         `protobuf:"bytes,1,opt,name=observability,proto3" json:"observability,omitempty"`
+	Mtls            *MTLS                  `protobuf:"bytes,2,opt,name=mtls,proto3" json:"mtls,omitempty"`
+	Limitador       *LimitadorSpec         `protobuf:"bytes,4,opt,name=limitador,proto3" json:"limitador,omitempty"`
+	Authorino       *AuthorinoSpec         `protobuf:"bytes,5,opt,name=authorino,proto3" json:"authorino,omitempty"`
+	GatewayServices []*GatewayServices     `protobuf:"bytes,3,rep,name=gatewayServices,proto3" json:"gatewayServices,omitempty"`
+	Extensions      *ExtensionsSpec        `protobuf:"bytes,6,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KuadrantSpec) Reset() {
+	*x = KuadrantSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KuadrantSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KuadrantSpec) ProtoMessage() {}
+
+func (x *KuadrantSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KuadrantSpec.ProtoReflect.Descriptor instead.
+func (*KuadrantSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *KuadrantSpec) GetObservability() *Observability {
+	if x != nil {
+		return x.Observability
+	}
+	return nil
+}
+
+func (x *KuadrantSpec) GetMtls() *MTLS {
+	if x != nil {
+		return x.Mtls
+	}
+	return nil
+}
+
+func (x *KuadrantSpec) GetLimitador() *LimitadorSpec {
+	if x != nil {
+		return x.Limitador
+	}
+	return nil
+}
+
+func (x *KuadrantSpec) GetAuthorino() *AuthorinoSpec {
+	if x != nil {
+		return x.Authorino
+	}
+	return nil
+}
+
+func (x *KuadrantSpec) GetGatewayServices() []*GatewayServices {
+	if x != nil {
+		return x.GatewayServices
+	}
+	return nil
+}
+
+func (x *KuadrantSpec) GetExtensions() *ExtensionsSpec {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// KuadrantStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.KuadrantStatus
+type KuadrantStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ObservedGeneration int64                  `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions         []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	MtlsAuthorino      *bool                  `protobuf:"varint,3,opt,name=mtlsAuthorino,proto3,oneof" json:"mtlsAuthorino,omitempty"`
+	MtlsLimitador      *bool                  `protobuf:"varint,4,opt,name=mtlsLimitador,proto3,oneof" json:"mtlsLimitador,omitempty"`
+	Limitador          *LimitadorStatus       `protobuf:"bytes,5,opt,name=limitador,proto3" json:"limitador,omitempty"`
+	Authorino          *AuthorinoStatus       `protobuf:"bytes,6,opt,name=authorino,proto3" json:"authorino,omitempty"`
+	Extensions         []*ExtensionStatus     `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *KuadrantStatus) Reset() {
+	*x = KuadrantStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KuadrantStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KuadrantStatus) ProtoMessage() {}
+
+func (x *KuadrantStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KuadrantStatus.ProtoReflect.Descriptor instead.
+func (*KuadrantStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *KuadrantStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *KuadrantStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *KuadrantStatus) GetMtlsAuthorino() bool {
+	if x != nil && x.MtlsAuthorino != nil {
+		return *x.MtlsAuthorino
+	}
+	return false
+}
+
+func (x *KuadrantStatus) GetMtlsLimitador() bool {
+	if x != nil && x.MtlsLimitador != nil {
+		return *x.MtlsLimitador
+	}
+	return false
+}
+
+func (x *KuadrantStatus) GetLimitador() *LimitadorStatus {
+	if x != nil {
+		return x.Limitador
+	}
+	return nil
+}
+
+func (x *KuadrantStatus) GetAuthorino() *AuthorinoStatus {
+	if x != nil {
+		return x.Authorino
+	}
+	return nil
+}
+
+func (x *KuadrantStatus) GetExtensions() []*ExtensionStatus {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// KubernetesSubjectAccessReviewAuthorizationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.KubernetesSubjectAccessReviewAuthorizationSpec
+type KubernetesSubjectAccessReviewAuthorizationSpec struct {
+	state               protoimpl.MessageState                               `protogen:"open.v1"`
+	User                *ValueOrSelector                                     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Groups              []string                                             `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	AuthorizationGroups *ValueOrSelector                                     `protobuf:"bytes,3,opt,name=authorizationGroups,proto3" json:"authorizationGroups,omitempty"`
+	ResourceAttributes  *KubernetesSubjectAccessReviewResourceAttributesSpec `protobuf:"bytes,4,opt,name=resourceAttributes,proto3" json:"resourceAttributes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) Reset() {
+	*x = KubernetesSubjectAccessReviewAuthorizationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesSubjectAccessReviewAuthorizationSpec) ProtoMessage() {}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesSubjectAccessReviewAuthorizationSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesSubjectAccessReviewAuthorizationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) GetUser() *ValueOrSelector {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) GetAuthorizationGroups() *ValueOrSelector {
+	if x != nil {
+		return x.AuthorizationGroups
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewAuthorizationSpec) GetResourceAttributes() *KubernetesSubjectAccessReviewResourceAttributesSpec {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+// KubernetesSubjectAccessReviewResourceAttributesSpec is generated from github.com/kuadrant/authorino/api/v1beta3.KubernetesSubjectAccessReviewResourceAttributesSpec
+type KubernetesSubjectAccessReviewResourceAttributesSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *ValueOrSelector       `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Resource      *ValueOrSelector       `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Subresource   *ValueOrSelector       `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	Name          *ValueOrSelector       `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     *ValueOrSelector       `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Verb          *ValueOrSelector       `protobuf:"bytes,6,opt,name=verb,proto3" json:"verb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) Reset() {
+	*x = KubernetesSubjectAccessReviewResourceAttributesSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesSubjectAccessReviewResourceAttributesSpec) ProtoMessage() {}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesSubjectAccessReviewResourceAttributesSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesSubjectAccessReviewResourceAttributesSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetGroup() *ValueOrSelector {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetResource() *ValueOrSelector {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetSubresource() *ValueOrSelector {
+	if x != nil {
+		return x.Subresource
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetName() *ValueOrSelector {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetNamespace() *ValueOrSelector {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *KubernetesSubjectAccessReviewResourceAttributesSpec) GetVerb() *ValueOrSelector {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+// KubernetesTokenReviewSpec is generated from github.com/kuadrant/authorino/api/v1beta3.KubernetesTokenReviewSpec
+type KubernetesTokenReviewSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audiences     []string               `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesTokenReviewSpec) Reset() {
+	*x = KubernetesTokenReviewSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesTokenReviewSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesTokenReviewSpec) ProtoMessage() {}
+
+func (x *KubernetesTokenReviewSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesTokenReviewSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesTokenReviewSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *KubernetesTokenReviewSpec) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+// Limit is generated from github.com/kuadrant/kuadrant-operator/api/v1.Limit
+type Limit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	When          []*Predicate           `protobuf:"bytes,1,rep,name=when,proto3" json:"when,omitempty"`
+	Counters      []*Counter             `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters,omitempty"`
+	Rates         []*Rate                `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Limit) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Limit) GetCounters() []*Counter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *Limit) GetRates() []*Rate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *Limit) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// LimitadorSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.LimitadorSpec
+type LimitadorSpec struct {
+	state                protoimpl.MessageState   `protogen:"open.v1"`
+	Replicas             *int64                   `protobuf:"varint,1,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	Storage              *Storage                 `protobuf:"bytes,2,opt,name=storage,proto3" json:"storage,omitempty"`
+	ResourceRequirements *ResourceRequirements    `protobuf:"bytes,3,opt,name=resourceRequirements,proto3" json:"resourceRequirements,omitempty"`
+	Pdb                  *PodDisruptionBudgetType `protobuf:"bytes,4,opt,name=pdb,proto3" json:"pdb,omitempty"`
+	Verbosity            *int64                   `protobuf:"varint,5,opt,name=verbosity,proto3,oneof" json:"verbosity,omitempty"`
+	MetricLabelsDefault  *string                  `protobuf:"bytes,6,opt,name=metricLabelsDefault,proto3,oneof" json:"metricLabelsDefault,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LimitadorSpec) Reset() {
+	*x = LimitadorSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitadorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitadorSpec) ProtoMessage() {}
+
+func (x *LimitadorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitadorSpec.ProtoReflect.Descriptor instead.
+func (*LimitadorSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LimitadorSpec) GetReplicas() int64 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *LimitadorSpec) GetStorage() *Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *LimitadorSpec) GetResourceRequirements() *ResourceRequirements {
+	if x != nil {
+		return x.ResourceRequirements
+	}
+	return nil
+}
+
+func (x *LimitadorSpec) GetPdb() *PodDisruptionBudgetType {
+	if x != nil {
+		return x.Pdb
+	}
+	return nil
+}
+
+func (x *LimitadorSpec) GetVerbosity() int64 {
+	if x != nil && x.Verbosity != nil {
+		return *x.Verbosity
+	}
+	return 0
+}
+
+func (x *LimitadorSpec) GetMetricLabelsDefault() string {
+	if x != nil && x.MetricLabelsDefault != nil {
+		return *x.MetricLabelsDefault
+	}
+	return ""
+}
+
+// LimitadorStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.LimitadorStatus
+type LimitadorStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replicas      int32                  `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Storage       string                 `protobuf:"bytes,2,opt,name=storage,proto3" json:"storage,omitempty"`
+	Verbosity     *int64                 `protobuf:"varint,3,opt,name=verbosity,proto3,oneof" json:"verbosity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitadorStatus) Reset() {
+	*x = LimitadorStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitadorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitadorStatus) ProtoMessage() {}
+
+func (x *LimitadorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitadorStatus.ProtoReflect.Descriptor instead.
+func (*LimitadorStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LimitadorStatus) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *LimitadorStatus) GetStorage() string {
+	if x != nil {
+		return x.Storage
+	}
+	return ""
+}
+
+func (x *LimitadorStatus) GetVerbosity() int64 {
+	if x != nil && x.Verbosity != nil {
+		return *x.Verbosity
+	}
+	return 0
+}
+
+// LoadBalancingSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.LoadBalancingSpec
+type LoadBalancingSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        int64                  `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Geo           string                 `protobuf:"bytes,2,opt,name=geo,proto3" json:"geo,omitempty"`
+	DefaultGeo    bool                   `protobuf:"varint,3,opt,name=defaultGeo,proto3" json:"defaultGeo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadBalancingSpec) Reset() {
+	*x = LoadBalancingSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadBalancingSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancingSpec) ProtoMessage() {}
+
+func (x *LoadBalancingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancingSpec.ProtoReflect.Descriptor instead.
+func (*LoadBalancingSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LoadBalancingSpec) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *LoadBalancingSpec) GetGeo() string {
+	if x != nil {
+		return x.Geo
+	}
+	return ""
+}
+
+func (x *LoadBalancingSpec) GetDefaultGeo() bool {
+	if x != nil {
+		return x.DefaultGeo
+	}
+	return false
+}
+
+// MTLS is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.MTLS
+type MTLS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Authorino     *bool                  `protobuf:"varint,2,opt,name=authorino,proto3,oneof" json:"authorino,omitempty"`
+	Limitador     *bool                  `protobuf:"varint,3,opt,name=limitador,proto3,oneof" json:"limitador,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MTLS) Reset() {
+	*x = MTLS{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MTLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MTLS) ProtoMessage() {}
+
+func (x *MTLS) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MTLS.ProtoReflect.Descriptor instead.
+func (*MTLS) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MTLS) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *MTLS) GetAuthorino() bool {
+	if x != nil && x.Authorino != nil {
+		return *x.Authorino
+	}
+	return false
+}
+
+func (x *MTLS) GetLimitador() bool {
+	if x != nil && x.Limitador != nil {
+		return *x.Limitador
+	}
+	return false
+}
+
+// MergeableAuthPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableAuthPolicySpec
+type MergeableAuthPolicySpec struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Strategy      string                                  `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Patterns      map[string]*MergeablePatternExpressions `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	When          []*Predicate                            `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Rules         *AuthSchemeSpec                         `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	Mode          string                                  `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	FailureMode   string                                  `protobuf:"bytes,6,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                                 `protobuf:"bytes,7,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableAuthPolicySpec) Reset() {
+	*x = MergeableAuthPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableAuthPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableAuthPolicySpec) ProtoMessage() {}
+
+func (x *MergeableAuthPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableAuthPolicySpec.ProtoReflect.Descriptor instead.
+func (*MergeableAuthPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MergeableAuthPolicySpec) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *MergeableAuthPolicySpec) GetPatterns() map[string]*MergeablePatternExpressions {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *MergeableAuthPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableAuthPolicySpec) GetRules() *AuthSchemeSpec {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *MergeableAuthPolicySpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MergeableAuthPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *MergeableAuthPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// MergeableAuthenticationSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableAuthenticationSpec
+type MergeableAuthenticationSpec struct {
+	state                 protoimpl.MessageState                   `protogen:"open.v1"`
+	Priority              int64                                    `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics               bool                                     `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When                  []*PatternExpressionOrRef                `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache                 *EvaluatorCaching                        `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	Credentials           *Credentials                             `protobuf:"bytes,5,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Overrides             map[string]*ValueOrSelector              `protobuf:"bytes,6,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Defaults              map[string]*ValueOrSelector              `protobuf:"bytes,7,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ApiKey                *ApiKeyAuthenticationSpec                `protobuf:"bytes,8,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	Jwt                   *JwtAuthenticationSpec                   `protobuf:"bytes,9,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Oauth2Introspection   *OAuth2TokenIntrospectionSpec            `protobuf:"bytes,10,opt,name=oauth2Introspection,proto3" json:"oauth2Introspection,omitempty"`
+	KubernetesTokenReview *KubernetesTokenReviewSpec               `protobuf:"bytes,11,opt,name=kubernetesTokenReview,proto3" json:"kubernetesTokenReview,omitempty"`
+	X509                  *X509ClientCertificateAuthenticationSpec `protobuf:"bytes,12,opt,name=x509,proto3" json:"x509,omitempty"`
+	Plain                 *PlainIdentitySpec                       `protobuf:"bytes,13,opt,name=plain,proto3" json:"plain,omitempty"`
+	Anonymous             *AnonymousAccessSpec                     `protobuf:"bytes,14,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MergeableAuthenticationSpec) Reset() {
+	*x = MergeableAuthenticationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableAuthenticationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableAuthenticationSpec) ProtoMessage() {}
+
+func (x *MergeableAuthenticationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableAuthenticationSpec.ProtoReflect.Descriptor instead.
+func (*MergeableAuthenticationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MergeableAuthenticationSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableAuthenticationSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableAuthenticationSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetCredentials() *Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetOverrides() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetDefaults() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetApiKey() *ApiKeyAuthenticationSpec {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetJwt() *JwtAuthenticationSpec {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetOauth2Introspection() *OAuth2TokenIntrospectionSpec {
+	if x != nil {
+		return x.Oauth2Introspection
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetKubernetesTokenReview() *KubernetesTokenReviewSpec {
+	if x != nil {
+		return x.KubernetesTokenReview
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetX509() *X509ClientCertificateAuthenticationSpec {
+	if x != nil {
+		return x.X509
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetPlain() *PlainIdentitySpec {
+	if x != nil {
+		return x.Plain
+	}
+	return nil
+}
+
+func (x *MergeableAuthenticationSpec) GetAnonymous() *AnonymousAccessSpec {
+	if x != nil {
+		return x.Anonymous
+	}
+	return nil
+}
+
+// MergeableAuthorizationSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableAuthorizationSpec
+type MergeableAuthorizationSpec struct {
+	state                         protoimpl.MessageState                          `protogen:"open.v1"`
+	Priority                      int64                                           `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics                       bool                                            `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When                          []*PatternExpressionOrRef                       `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache                         *EvaluatorCaching                               `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	PatternMatching               *PatternMatchingAuthorizationSpec               `protobuf:"bytes,5,opt,name=patternMatching,proto3" json:"patternMatching,omitempty"`
+	Opa                           *OpaAuthorizationSpec                           `protobuf:"bytes,6,opt,name=opa,proto3" json:"opa,omitempty"`
+	KubernetesSubjectAccessReview *KubernetesSubjectAccessReviewAuthorizationSpec `protobuf:"bytes,7,opt,name=kubernetesSubjectAccessReview,proto3" json:"kubernetesSubjectAccessReview,omitempty"`
+	Spicedb                       *SpiceDBAuthorizationSpec                       `protobuf:"bytes,8,opt,name=spicedb,proto3" json:"spicedb,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *MergeableAuthorizationSpec) Reset() {
+	*x = MergeableAuthorizationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableAuthorizationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableAuthorizationSpec) ProtoMessage() {}
+
+func (x *MergeableAuthorizationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableAuthorizationSpec.ProtoReflect.Descriptor instead.
+func (*MergeableAuthorizationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeableAuthorizationSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableAuthorizationSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableAuthorizationSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableAuthorizationSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableAuthorizationSpec) GetPatternMatching() *PatternMatchingAuthorizationSpec {
+	if x != nil {
+		return x.PatternMatching
+	}
+	return nil
+}
+
+func (x *MergeableAuthorizationSpec) GetOpa() *OpaAuthorizationSpec {
+	if x != nil {
+		return x.Opa
+	}
+	return nil
+}
+
+func (x *MergeableAuthorizationSpec) GetKubernetesSubjectAccessReview() *KubernetesSubjectAccessReviewAuthorizationSpec {
+	if x != nil {
+		return x.KubernetesSubjectAccessReview
+	}
+	return nil
+}
+
+func (x *MergeableAuthorizationSpec) GetSpicedb() *SpiceDBAuthorizationSpec {
+	if x != nil {
+		return x.Spicedb
+	}
+	return nil
+}
+
+// MergeableCallbackSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableCallbackSpec
+type MergeableCallbackSpec struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Priority      int64                     `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics       bool                      `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When          []*PatternExpressionOrRef `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache         *EvaluatorCaching         `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	Http          *HttpEndpointSpec         `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableCallbackSpec) Reset() {
+	*x = MergeableCallbackSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableCallbackSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableCallbackSpec) ProtoMessage() {}
+
+func (x *MergeableCallbackSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableCallbackSpec.ProtoReflect.Descriptor instead.
+func (*MergeableCallbackSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MergeableCallbackSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableCallbackSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableCallbackSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableCallbackSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableCallbackSpec) GetHttp() *HttpEndpointSpec {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+// MergeableDenyWithSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableDenyWithSpec
+type MergeableDenyWithSpec struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Code          int64                       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       *ValueOrSelector            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Headers       map[string]*ValueOrSelector `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          *ValueOrSelector            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableDenyWithSpec) Reset() {
+	*x = MergeableDenyWithSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableDenyWithSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableDenyWithSpec) ProtoMessage() {}
+
+func (x *MergeableDenyWithSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableDenyWithSpec.ProtoReflect.Descriptor instead.
+func (*MergeableDenyWithSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MergeableDenyWithSpec) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MergeableDenyWithSpec) GetMessage() *ValueOrSelector {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MergeableDenyWithSpec) GetHeaders() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *MergeableDenyWithSpec) GetBody() *ValueOrSelector {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// MergeableHeaderSuccessResponseSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableHeaderSuccessResponseSpec
+type MergeableHeaderSuccessResponseSpec struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Priority      int64                      `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics       bool                       `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When          []*PatternExpressionOrRef  `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache         *EvaluatorCaching          `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	Plain         *PlainAuthResponseSpec     `protobuf:"bytes,5,opt,name=plain,proto3" json:"plain,omitempty"`
+	Json          *JsonAuthResponseSpec      `protobuf:"bytes,6,opt,name=json,proto3" json:"json,omitempty"`
+	Wristband     *WristbandAuthResponseSpec `protobuf:"bytes,7,opt,name=wristband,proto3" json:"wristband,omitempty"`
+	Key           string                     `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) Reset() {
+	*x = MergeableHeaderSuccessResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableHeaderSuccessResponseSpec) ProtoMessage() {}
+
+func (x *MergeableHeaderSuccessResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableHeaderSuccessResponseSpec.ProtoReflect.Descriptor instead.
+func (*MergeableHeaderSuccessResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetPlain() *PlainAuthResponseSpec {
+	if x != nil {
+		return x.Plain
+	}
+	return nil
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetJson() *JsonAuthResponseSpec {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetWristband() *WristbandAuthResponseSpec {
+	if x != nil {
+		return x.Wristband
+	}
+	return nil
+}
+
+func (x *MergeableHeaderSuccessResponseSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// MergeableMetadataSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableMetadataSpec
+type MergeableMetadataSpec struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Priority      int64                     `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics       bool                      `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When          []*PatternExpressionOrRef `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache         *EvaluatorCaching         `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	Http          *HttpEndpointSpec         `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	UserInfo      *UserInfoMetadataSpec     `protobuf:"bytes,6,opt,name=userInfo,proto3" json:"userInfo,omitempty"`
+	Uma           *UmaMetadataSpec          `protobuf:"bytes,7,opt,name=uma,proto3" json:"uma,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableMetadataSpec) Reset() {
+	*x = MergeableMetadataSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableMetadataSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableMetadataSpec) ProtoMessage() {}
+
+func (x *MergeableMetadataSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableMetadataSpec.ProtoReflect.Descriptor instead.
+func (*MergeableMetadataSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MergeableMetadataSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableMetadataSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableMetadataSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableMetadataSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableMetadataSpec) GetHttp() *HttpEndpointSpec {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *MergeableMetadataSpec) GetUserInfo() *UserInfoMetadataSpec {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+func (x *MergeableMetadataSpec) GetUma() *UmaMetadataSpec {
+	if x != nil {
+		return x.Uma
+	}
+	return nil
+}
+
+// MergeablePatternExpressions is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeablePatternExpressions
+type MergeablePatternExpressions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllOf         []*PatternExpression   `protobuf:"bytes,1,rep,name=allOf,proto3" json:"allOf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeablePatternExpressions) Reset() {
+	*x = MergeablePatternExpressions{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeablePatternExpressions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeablePatternExpressions) ProtoMessage() {}
+
+func (x *MergeablePatternExpressions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeablePatternExpressions.ProtoReflect.Descriptor instead.
+func (*MergeablePatternExpressions) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MergeablePatternExpressions) GetAllOf() []*PatternExpression {
+	if x != nil {
+		return x.AllOf
+	}
+	return nil
+}
+
+// MergeableRateLimitPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableRateLimitPolicySpec
+type MergeableRateLimitPolicySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategy      string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	When          []*Predicate           `protobuf:"bytes,2,rep,name=when,proto3" json:"when,omitempty"`
+	Limits        map[string]*Limit      `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	FailureMode   string                 `protobuf:"bytes,5,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                `protobuf:"bytes,6,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableRateLimitPolicySpec) Reset() {
+	*x = MergeableRateLimitPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableRateLimitPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableRateLimitPolicySpec) ProtoMessage() {}
+
+func (x *MergeableRateLimitPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableRateLimitPolicySpec.ProtoReflect.Descriptor instead.
+func (*MergeableRateLimitPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MergeableRateLimitPolicySpec) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *MergeableRateLimitPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableRateLimitPolicySpec) GetLimits() map[string]*Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *MergeableRateLimitPolicySpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MergeableRateLimitPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *MergeableRateLimitPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// MergeableResponseSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableResponseSpec
+type MergeableResponseSpec struct {
+	state           protoimpl.MessageState               `protogen:"open.v1"`
+	Unauthenticated *MergeableDenyWithSpec               `protobuf:"bytes,1,opt,name=unauthenticated,proto3" json:"unauthenticated,omitempty"`
+	Unauthorized    *MergeableDenyWithSpec               `protobuf:"bytes,2,opt,name=unauthorized,proto3" json:"unauthorized,omitempty"`
+	Success         *MergeableWrappedSuccessResponseSpec `protobuf:"bytes,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeableResponseSpec) Reset() {
+	*x = MergeableResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableResponseSpec) ProtoMessage() {}
+
+func (x *MergeableResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableResponseSpec.ProtoReflect.Descriptor instead.
+func (*MergeableResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MergeableResponseSpec) GetUnauthenticated() *MergeableDenyWithSpec {
+	if x != nil {
+		return x.Unauthenticated
+	}
+	return nil
+}
+
+func (x *MergeableResponseSpec) GetUnauthorized() *MergeableDenyWithSpec {
+	if x != nil {
+		return x.Unauthorized
+	}
+	return nil
+}
+
+func (x *MergeableResponseSpec) GetSuccess() *MergeableWrappedSuccessResponseSpec {
+	if x != nil {
+		return x.Success
+	}
+	return nil
+}
+
+// MergeableSuccessResponseSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableSuccessResponseSpec
+type MergeableSuccessResponseSpec struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Priority      int64                      `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metrics       bool                       `protobuf:"varint,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	When          []*PatternExpressionOrRef  `protobuf:"bytes,3,rep,name=when,proto3" json:"when,omitempty"`
+	Cache         *EvaluatorCaching          `protobuf:"bytes,4,opt,name=cache,proto3" json:"cache,omitempty"`
+	Plain         *PlainAuthResponseSpec     `protobuf:"bytes,5,opt,name=plain,proto3" json:"plain,omitempty"`
+	Json          *JsonAuthResponseSpec      `protobuf:"bytes,6,opt,name=json,proto3" json:"json,omitempty"`
+	Wristband     *WristbandAuthResponseSpec `protobuf:"bytes,7,opt,name=wristband,proto3" json:"wristband,omitempty"`
+	Key           string                     `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableSuccessResponseSpec) Reset() {
+	*x = MergeableSuccessResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableSuccessResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableSuccessResponseSpec) ProtoMessage() {}
+
+func (x *MergeableSuccessResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableSuccessResponseSpec.ProtoReflect.Descriptor instead.
+func (*MergeableSuccessResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MergeableSuccessResponseSpec) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MergeableSuccessResponseSpec) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *MergeableSuccessResponseSpec) GetWhen() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableSuccessResponseSpec) GetCache() *EvaluatorCaching {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *MergeableSuccessResponseSpec) GetPlain() *PlainAuthResponseSpec {
+	if x != nil {
+		return x.Plain
+	}
+	return nil
+}
+
+func (x *MergeableSuccessResponseSpec) GetJson() *JsonAuthResponseSpec {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *MergeableSuccessResponseSpec) GetWristband() *WristbandAuthResponseSpec {
+	if x != nil {
+		return x.Wristband
+	}
+	return nil
+}
+
+func (x *MergeableSuccessResponseSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// MergeableTokenRateLimitPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.MergeableTokenRateLimitPolicySpec
+type MergeableTokenRateLimitPolicySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategy      string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	When          []*Predicate           `protobuf:"bytes,2,rep,name=when,proto3" json:"when,omitempty"`
+	Limits        map[string]*TokenLimit `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FailureMode   string                 `protobuf:"bytes,4,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                `protobuf:"bytes,5,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) Reset() {
+	*x = MergeableTokenRateLimitPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableTokenRateLimitPolicySpec) ProtoMessage() {}
+
+func (x *MergeableTokenRateLimitPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableTokenRateLimitPolicySpec.ProtoReflect.Descriptor instead.
+func (*MergeableTokenRateLimitPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) GetLimits() map[string]*TokenLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *MergeableTokenRateLimitPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// MergeableWrappedSuccessResponseSpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.MergeableWrappedSuccessResponseSpec
+type MergeableWrappedSuccessResponseSpec struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Headers       map[string]*MergeableHeaderSuccessResponseSpec `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Filters       map[string]*MergeableSuccessResponseSpec       `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableWrappedSuccessResponseSpec) Reset() {
+	*x = MergeableWrappedSuccessResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableWrappedSuccessResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableWrappedSuccessResponseSpec) ProtoMessage() {}
+
+func (x *MergeableWrappedSuccessResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableWrappedSuccessResponseSpec.ProtoReflect.Descriptor instead.
+func (*MergeableWrappedSuccessResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MergeableWrappedSuccessResponseSpec) GetHeaders() map[string]*MergeableHeaderSuccessResponseSpec {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *MergeableWrappedSuccessResponseSpec) GetFilters() map[string]*MergeableSuccessResponseSpec {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// Named is generated from github.com/kuadrant/authorino/api/v1beta3.Named
+type Named struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Named) Reset() {
+	*x = Named{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Named) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Named) ProtoMessage() {}
+
+func (x *Named) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Named.ProtoReflect.Descriptor instead.
+func (*Named) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Named) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OAuth2ClientAuthentication is generated from github.com/kuadrant/authorino/api/v1beta3.OAuth2ClientAuthentication
+type OAuth2ClientAuthentication struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokenUrl        string                 `protobuf:"bytes,1,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
+	ClientId        string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ClientSecretRef *SecretKeyReference    `protobuf:"bytes,3,opt,name=clientSecretRef,proto3" json:"clientSecretRef,omitempty"`
+	Scopes          []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExtraParams     map[string]string      `protobuf:"bytes,5,rep,name=extraParams,proto3" json:"extraParams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Cache           *bool                  `protobuf:"varint,6,opt,name=cache,proto3,oneof" json:"cache,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OAuth2ClientAuthentication) Reset() {
+	*x = OAuth2ClientAuthentication{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2ClientAuthentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2ClientAuthentication) ProtoMessage() {}
+
+func (x *OAuth2ClientAuthentication) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2ClientAuthentication.ProtoReflect.Descriptor instead.
+func (*OAuth2ClientAuthentication) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OAuth2ClientAuthentication) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *OAuth2ClientAuthentication) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuth2ClientAuthentication) GetClientSecretRef() *SecretKeyReference {
+	if x != nil {
+		return x.ClientSecretRef
+	}
+	return nil
+}
+
+func (x *OAuth2ClientAuthentication) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuth2ClientAuthentication) GetExtraParams() map[string]string {
+	if x != nil {
+		return x.ExtraParams
+	}
+	return nil
+}
+
+func (x *OAuth2ClientAuthentication) GetCache() bool {
+	if x != nil && x.Cache != nil {
+		return *x.Cache
+	}
+	return false
+}
+
+// OAuth2TokenIntrospectionSpec is generated from github.com/kuadrant/authorino/api/v1beta3.OAuth2TokenIntrospectionSpec
+type OAuth2TokenIntrospectionSpec struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Endpoint       string                    `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	TokenTypeHint  string                    `protobuf:"bytes,2,opt,name=tokenTypeHint,proto3" json:"tokenTypeHint,omitempty"`
+	CredentialsRef *CoreLocalObjectReference `protobuf:"bytes,3,opt,name=credentialsRef,proto3" json:"credentialsRef,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OAuth2TokenIntrospectionSpec) Reset() {
+	*x = OAuth2TokenIntrospectionSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2TokenIntrospectionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2TokenIntrospectionSpec) ProtoMessage() {}
+
+func (x *OAuth2TokenIntrospectionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2TokenIntrospectionSpec.ProtoReflect.Descriptor instead.
+func (*OAuth2TokenIntrospectionSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *OAuth2TokenIntrospectionSpec) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *OAuth2TokenIntrospectionSpec) GetTokenTypeHint() string {
+	if x != nil {
+		return x.TokenTypeHint
+	}
+	return ""
+}
+
+func (x *OAuth2TokenIntrospectionSpec) GetCredentialsRef() *CoreLocalObjectReference {
+	if x != nil {
+		return x.CredentialsRef
+	}
+	return nil
+}
+
+// Observability is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.Observability
+type Observability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Observability) Reset() {
+	*x = Observability{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observability) ProtoMessage() {}
+
+func (x *Observability) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observability.ProtoReflect.Descriptor instead.
+func (*Observability) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Observability) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+// OpaAuthorizationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.OpaAuthorizationSpec
+type OpaAuthorizationSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rego           string                 `protobuf:"bytes,1,opt,name=rego,proto3" json:"rego,omitempty"`
+	ExternalPolicy *ExternalOpaPolicy     `protobuf:"bytes,2,opt,name=externalPolicy,proto3" json:"externalPolicy,omitempty"`
+	AllValues      bool                   `protobuf:"varint,3,opt,name=allValues,proto3" json:"allValues,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OpaAuthorizationSpec) Reset() {
+	*x = OpaAuthorizationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaAuthorizationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaAuthorizationSpec) ProtoMessage() {}
+
+func (x *OpaAuthorizationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpaAuthorizationSpec.ProtoReflect.Descriptor instead.
+func (*OpaAuthorizationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *OpaAuthorizationSpec) GetRego() string {
+	if x != nil {
+		return x.Rego
+	}
+	return ""
+}
+
+func (x *OpaAuthorizationSpec) GetExternalPolicy() *ExternalOpaPolicy {
+	if x != nil {
+		return x.ExternalPolicy
+	}
+	return nil
+}
+
+func (x *OpaAuthorizationSpec) GetAllValues() bool {
+	if x != nil {
+		return x.AllValues
+	}
+	return false
+}
+
+// PVCGenericSpec is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.PVCGenericSpec
+type PVCGenericSpec struct {
+	state            protoimpl.MessageState          `protogen:"open.v1"`
+	StorageClassName *string                         `protobuf:"bytes,1,opt,name=storageClassName,proto3,oneof" json:"storageClassName,omitempty"`
+	Resources        *PersistentVolumeClaimResources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	VolumeName       *string                         `protobuf:"bytes,3,opt,name=volumeName,proto3,oneof" json:"volumeName,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PVCGenericSpec) Reset() {
+	*x = PVCGenericSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVCGenericSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVCGenericSpec) ProtoMessage() {}
+
+func (x *PVCGenericSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVCGenericSpec.ProtoReflect.Descriptor instead.
+func (*PVCGenericSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PVCGenericSpec) GetStorageClassName() string {
+	if x != nil && x.StorageClassName != nil {
+		return *x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PVCGenericSpec) GetResources() *PersistentVolumeClaimResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *PVCGenericSpec) GetVolumeName() string {
+	if x != nil && x.VolumeName != nil {
+		return *x.VolumeName
+	}
+	return ""
+}
+
+// PatternExpression is generated from github.com/kuadrant/authorino/api/v1beta3.PatternExpression
+type PatternExpression struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      string                 `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternExpression) Reset() {
+	*x = PatternExpression{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternExpression) ProtoMessage() {}
+
+func (x *PatternExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternExpression.ProtoReflect.Descriptor instead.
+func (*PatternExpression) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PatternExpression) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *PatternExpression) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PatternExpression) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// PatternExpressionOrRef is generated from github.com/kuadrant/authorino/api/v1beta3.PatternExpressionOrRef
+type PatternExpressionOrRef struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Selector      string                                `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Operator      string                                `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string                                `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PatternRef    string                                `protobuf:"bytes,4,opt,name=patternRef,proto3" json:"patternRef,omitempty"`
+	Predicate     string                                `protobuf:"bytes,5,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	All           []*UnstructuredPatternExpressionOrRef `protobuf:"bytes,6,rep,name=all,proto3" json:"all,omitempty"`
+	Any           []*UnstructuredPatternExpressionOrRef `protobuf:"bytes,7,rep,name=any,proto3" json:"any,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternExpressionOrRef) Reset() {
+	*x = PatternExpressionOrRef{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternExpressionOrRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternExpressionOrRef) ProtoMessage() {}
+
+func (x *PatternExpressionOrRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternExpressionOrRef.ProtoReflect.Descriptor instead.
+func (*PatternExpressionOrRef) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PatternExpressionOrRef) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *PatternExpressionOrRef) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PatternExpressionOrRef) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PatternExpressionOrRef) GetPatternRef() string {
+	if x != nil {
+		return x.PatternRef
+	}
+	return ""
+}
+
+func (x *PatternExpressionOrRef) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
+func (x *PatternExpressionOrRef) GetAll() []*UnstructuredPatternExpressionOrRef {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *PatternExpressionOrRef) GetAny() []*UnstructuredPatternExpressionOrRef {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+// PatternMatchingAuthorizationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.PatternMatchingAuthorizationSpec
+type PatternMatchingAuthorizationSpec struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Patterns      []*PatternExpressionOrRef `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatternMatchingAuthorizationSpec) Reset() {
+	*x = PatternMatchingAuthorizationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatternMatchingAuthorizationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatternMatchingAuthorizationSpec) ProtoMessage() {}
+
+func (x *PatternMatchingAuthorizationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatternMatchingAuthorizationSpec.ProtoReflect.Descriptor instead.
+func (*PatternMatchingAuthorizationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PatternMatchingAuthorizationSpec) GetPatterns() []*PatternExpressionOrRef {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// PersistentVolumeClaimResources is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.PersistentVolumeClaimResources
+type PersistentVolumeClaimResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      string                 `protobuf:"bytes,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistentVolumeClaimResources) Reset() {
+	*x = PersistentVolumeClaimResources{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistentVolumeClaimResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentVolumeClaimResources) ProtoMessage() {}
+
+func (x *PersistentVolumeClaimResources) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentVolumeClaimResources.ProtoReflect.Descriptor instead.
+func (*PersistentVolumeClaimResources) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PersistentVolumeClaimResources) GetRequests() string {
+	if x != nil {
+		return x.Requests
+	}
+	return ""
+}
+
+// PlainAuthResponseSpec is generated from github.com/kuadrant/authorino/api/v1beta3.PlainAuthResponseSpec
+type PlainAuthResponseSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *_struct.Value         `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Selector      string                 `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlainAuthResponseSpec) Reset() {
+	*x = PlainAuthResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlainAuthResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainAuthResponseSpec) ProtoMessage() {}
+
+func (x *PlainAuthResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainAuthResponseSpec.ProtoReflect.Descriptor instead.
+func (*PlainAuthResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PlainAuthResponseSpec) GetValue() *_struct.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PlainAuthResponseSpec) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *PlainAuthResponseSpec) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// PlainIdentitySpec is generated from github.com/kuadrant/authorino/api/v1beta3.PlainIdentitySpec
+type PlainIdentitySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      string                 `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlainIdentitySpec) Reset() {
+	*x = PlainIdentitySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlainIdentitySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainIdentitySpec) ProtoMessage() {}
+
+func (x *PlainIdentitySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainIdentitySpec.ProtoReflect.Descriptor instead.
+func (*PlainIdentitySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PlainIdentitySpec) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *PlainIdentitySpec) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// PodDisruptionBudgetType is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.PodDisruptionBudgetType
+type PodDisruptionBudgetType struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxUnavailable string                 `protobuf:"bytes,1,opt,name=maxUnavailable,proto3" json:"maxUnavailable,omitempty"`
+	MinAvailable   string                 `protobuf:"bytes,2,opt,name=minAvailable,proto3" json:"minAvailable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PodDisruptionBudgetType) Reset() {
+	*x = PodDisruptionBudgetType{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodDisruptionBudgetType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodDisruptionBudgetType) ProtoMessage() {}
+
+func (x *PodDisruptionBudgetType) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodDisruptionBudgetType.ProtoReflect.Descriptor instead.
+func (*PodDisruptionBudgetType) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PodDisruptionBudgetType) GetMaxUnavailable() string {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return ""
+}
+
+func (x *PodDisruptionBudgetType) GetMinAvailable() string {
+	if x != nil {
+		return x.MinAvailable
+	}
+	return ""
+}
+
+// Predicate is generated from github.com/kuadrant/kuadrant-operator/api/v1.Predicate
+type Predicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Predicate     string                 `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Predicate) Reset() {
+	*x = Predicate{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Predicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Predicate) ProtoMessage() {}
+
+func (x *Predicate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Predicate.ProtoReflect.Descriptor instead.
+func (*Predicate) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Predicate) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
+// Prefixed is generated from github.com/kuadrant/authorino/api/v1beta3.Prefixed
+type Prefixed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prefixed) Reset() {
+	*x = Prefixed{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prefixed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prefixed) ProtoMessage() {}
+
+func (x *Prefixed) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prefixed.ProtoReflect.Descriptor instead.
+func (*Prefixed) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Prefixed) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// ProviderRecordConditions is generated from github.com/kuadrant/kuadrant-operator/api/v1.ProviderRecordConditions
+type ProviderRecordConditions struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Name             string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RecordConditions map[string]*ConditionList `protobuf:"bytes,2,rep,name=recordConditions,proto3" json:"recordConditions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProviderRecordConditions) Reset() {
+	*x = ProviderRecordConditions{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRecordConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRecordConditions) ProtoMessage() {}
+
+func (x *ProviderRecordConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRecordConditions.ProtoReflect.Descriptor instead.
+func (*ProviderRecordConditions) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ProviderRecordConditions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderRecordConditions) GetRecordConditions() map[string]*ConditionList {
+	if x != nil {
+		return x.RecordConditions
+	}
+	return nil
+}
+
+// ProviderRef is generated from github.com/kuadrant/dns-operator/api/v1alpha1.ProviderRef
+type ProviderRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderRef) Reset() {
+	*x = ProviderRef{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRef) ProtoMessage() {}
+
+func (x *ProviderRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRef.ProtoReflect.Descriptor instead.
+func (*ProviderRef) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ProviderRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Rate is generated from github.com/kuadrant/kuadrant-operator/api/v1.Rate
+type Rate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Window        string                 `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rate) Reset() {
+	*x = Rate{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rate) ProtoMessage() {}
+
+func (x *Rate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rate.ProtoReflect.Descriptor instead.
+func (*Rate) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Rate) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Rate) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+// RateLimitPolicy is generated from github.com/kuadrant/kuadrant-operator/api/v1.RateLimitPolicy
+type RateLimitPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *RateLimitPolicySpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *RateLimitPolicyStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitPolicy) Reset() {
+	*x = RateLimitPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitPolicy) ProtoMessage() {}
+
+func (x *RateLimitPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitPolicy.ProtoReflect.Descriptor instead.
+func (*RateLimitPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RateLimitPolicy) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RateLimitPolicy) GetSpec() *RateLimitPolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *RateLimitPolicy) GetStatus() *RateLimitPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// RateLimitPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.RateLimitPolicySpec
+type RateLimitPolicySpec struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	TargetRef     *LocalPolicyTargetReferenceWithSectionName `protobuf:"bytes,1,opt,name=targetRef,proto3" json:"targetRef,omitempty"`
+	Defaults      *MergeableRateLimitPolicySpec              `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Overrides     *MergeableRateLimitPolicySpec              `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	When          []*Predicate                               `protobuf:"bytes,4,rep,name=when,proto3" json:"when,omitempty"`
+	Limits        map[string]*Limit                          `protobuf:"bytes,5,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Mode          string                                     `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	FailureMode   string                                     `protobuf:"bytes,7,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                                    `protobuf:"bytes,8,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitPolicySpec) Reset() {
+	*x = RateLimitPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitPolicySpec) ProtoMessage() {}
+
+func (x *RateLimitPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitPolicySpec.ProtoReflect.Descriptor instead.
+func (*RateLimitPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RateLimitPolicySpec) GetTargetRef() *LocalPolicyTargetReferenceWithSectionName {
+	if x != nil {
+		return x.TargetRef
+	}
+	return nil
+}
+
+func (x *RateLimitPolicySpec) GetDefaults() *MergeableRateLimitPolicySpec {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *RateLimitPolicySpec) GetOverrides() *MergeableRateLimitPolicySpec {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *RateLimitPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *RateLimitPolicySpec) GetLimits() map[string]*Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *RateLimitPolicySpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *RateLimitPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *RateLimitPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// RateLimitPolicyStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1.RateLimitPolicyStatus
+type RateLimitPolicyStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ObservedGeneration int64                  `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions         []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	ShadowLimits       []string               `protobuf:"bytes,3,rep,name=shadowLimits,proto3" json:"shadowLimits,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RateLimitPolicyStatus) Reset() {
+	*x = RateLimitPolicyStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitPolicyStatus) ProtoMessage() {}
+
+func (x *RateLimitPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitPolicyStatus.ProtoReflect.Descriptor instead.
+func (*RateLimitPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RateLimitPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *RateLimitPolicyStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *RateLimitPolicyStatus) GetShadowLimits() []string {
+	if x != nil {
+		return x.ShadowLimits
+	}
+	return nil
+}
+
+// Redis is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.Redis
+type Redis struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	ConfigSecretRef *CoreLocalObjectReference `protobuf:"bytes,1,opt,name=configSecretRef,proto3" json:"configSecretRef,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Redis) Reset() {
+	*x = Redis{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redis) ProtoMessage() {}
+
+func (x *Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redis.ProtoReflect.Descriptor instead.
+func (*Redis) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Redis) GetConfigSecretRef() *CoreLocalObjectReference {
+	if x != nil {
+		return x.ConfigSecretRef
+	}
+	return nil
+}
+
+// RedisCached is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.RedisCached
+type RedisCached struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	ConfigSecretRef *CoreLocalObjectReference `protobuf:"bytes,1,opt,name=configSecretRef,proto3" json:"configSecretRef,omitempty"`
+	Options         *RedisCachedOptions       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RedisCached) Reset() {
+	*x = RedisCached{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedisCached) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedisCached) ProtoMessage() {}
+
+func (x *RedisCached) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedisCached.ProtoReflect.Descriptor instead.
+func (*RedisCached) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RedisCached) GetConfigSecretRef() *CoreLocalObjectReference {
+	if x != nil {
+		return x.ConfigSecretRef
+	}
+	return nil
+}
+
+func (x *RedisCached) GetOptions() *RedisCachedOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// RedisCachedOptions is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.RedisCachedOptions
+type RedisCachedOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FlushPeriod     *int64                 `protobuf:"varint,1,opt,name=flush_period,json=flushPeriod,proto3,oneof" json:"flush_period,omitempty"`
+	MaxCached       *int64                 `protobuf:"varint,2,opt,name=max_cached,json=maxCached,proto3,oneof" json:"max_cached,omitempty"`
+	ResponseTimeout *int64                 `protobuf:"varint,3,opt,name=response_timeout,json=responseTimeout,proto3,oneof" json:"response_timeout,omitempty"`
+	BatchSize       *int64                 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RedisCachedOptions) Reset() {
+	*x = RedisCachedOptions{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedisCachedOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedisCachedOptions) ProtoMessage() {}
+
+func (x *RedisCachedOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedisCachedOptions.ProtoReflect.Descriptor instead.
+func (*RedisCachedOptions) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RedisCachedOptions) GetFlushPeriod() int64 {
+	if x != nil && x.FlushPeriod != nil {
+		return *x.FlushPeriod
+	}
+	return 0
+}
+
+func (x *RedisCachedOptions) GetMaxCached() int64 {
+	if x != nil && x.MaxCached != nil {
+		return *x.MaxCached
+	}
+	return 0
+}
+
+func (x *RedisCachedOptions) GetResponseTimeout() int64 {
+	if x != nil && x.ResponseTimeout != nil {
+		return *x.ResponseTimeout
+	}
+	return 0
+}
+
+func (x *RedisCachedOptions) GetBatchSize() int64 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+// RemoteExtension is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.RemoteExtension
+type RemoteExtension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteExtension) Reset() {
+	*x = RemoteExtension{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteExtension) ProtoMessage() {}
+
+func (x *RemoteExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteExtension.ProtoReflect.Descriptor instead.
+func (*RemoteExtension) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RemoteExtension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// SecretKeyReference is generated from github.com/kuadrant/authorino/api/v1beta3.SecretKeyReference
+type SecretKeyReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretKeyReference) Reset() {
+	*x = SecretKeyReference{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretKeyReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretKeyReference) ProtoMessage() {}
+
+func (x *SecretKeyReference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretKeyReference.ProtoReflect.Descriptor instead.
+func (*SecretKeyReference) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SecretKeyReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretKeyReference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// ServiceEndpoint is generated from github.com/kuadrant/kuadrant-operator/api/v1beta1.ServiceEndpoint
+type ServiceEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEndpoint) Reset() {
+	*x = ServiceEndpoint{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpoint) ProtoMessage() {}
+
+func (x *ServiceEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEndpoint.ProtoReflect.Descriptor instead.
+func (*ServiceEndpoint) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ServiceEndpoint) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// SpiceDBAuthorizationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.SpiceDBAuthorizationSpec
+type SpiceDBAuthorizationSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint        string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Insecure        bool                   `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	SharedSecretRef *SecretKeyReference    `protobuf:"bytes,3,opt,name=sharedSecretRef,proto3" json:"sharedSecretRef,omitempty"`
+	Subject         *SpiceDBObject         `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Resource        *SpiceDBObject         `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Permission      *ValueOrSelector       `protobuf:"bytes,6,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SpiceDBAuthorizationSpec) Reset() {
+	*x = SpiceDBAuthorizationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpiceDBAuthorizationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpiceDBAuthorizationSpec) ProtoMessage() {}
+
+func (x *SpiceDBAuthorizationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpiceDBAuthorizationSpec.ProtoReflect.Descriptor instead.
+func (*SpiceDBAuthorizationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SpiceDBAuthorizationSpec) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SpiceDBAuthorizationSpec) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *SpiceDBAuthorizationSpec) GetSharedSecretRef() *SecretKeyReference {
+	if x != nil {
+		return x.SharedSecretRef
+	}
+	return nil
+}
+
+func (x *SpiceDBAuthorizationSpec) GetSubject() *SpiceDBObject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *SpiceDBAuthorizationSpec) GetResource() *SpiceDBObject {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *SpiceDBAuthorizationSpec) GetPermission() *ValueOrSelector {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+// SpiceDBObject is generated from github.com/kuadrant/authorino/api/v1beta3.SpiceDBObject
+type SpiceDBObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *ValueOrSelector       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          *ValueOrSelector       `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpiceDBObject) Reset() {
+	*x = SpiceDBObject{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpiceDBObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpiceDBObject) ProtoMessage() {}
+
+func (x *SpiceDBObject) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpiceDBObject.ProtoReflect.Descriptor instead.
+func (*SpiceDBObject) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SpiceDBObject) GetName() *ValueOrSelector {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SpiceDBObject) GetKind() *ValueOrSelector {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+// Storage is generated from github.com/kuadrant/limitador-operator/api/v1alpha1.Storage
+type Storage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redis         *Redis                 `protobuf:"bytes,1,opt,name=redis,proto3" json:"redis,omitempty"`
+	RedisCached   *RedisCached           `protobuf:"bytes,2,opt,name=redis_cached,json=redisCached,proto3" json:"redis_cached,omitempty"`
+	Disk          *DiskSpec              `protobuf:"bytes,3,opt,name=disk,proto3" json:"disk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storage) Reset() {
+	*x = Storage{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage) ProtoMessage() {}
+
+func (x *Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage.ProtoReflect.Descriptor instead.
+func (*Storage) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Storage) GetRedis() *Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Storage) GetRedisCached() *RedisCached {
+	if x != nil {
+		return x.RedisCached
+	}
+	return nil
+}
+
+func (x *Storage) GetDisk() *DiskSpec {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+// TLSPolicy is generated from github.com/kuadrant/kuadrant-operator/api/v1.TLSPolicy
+type TLSPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *TLSPolicySpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *TLSPolicyStatus       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TLSPolicy) Reset() {
+	*x = TLSPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSPolicy) ProtoMessage() {}
+
+func (x *TLSPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSPolicy.ProtoReflect.Descriptor instead.
+func (*TLSPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TLSPolicy) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TLSPolicy) GetSpec() *TLSPolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *TLSPolicy) GetStatus() *TLSPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// TLSPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1.TLSPolicySpec
+type TLSPolicySpec struct {
+	state                protoimpl.MessageState                     `protogen:"open.v1"`
+	TargetRef            *LocalPolicyTargetReferenceWithSectionName `protobuf:"bytes,1,opt,name=targetRef,proto3" json:"targetRef,omitempty"`
+	RouteHostnames       bool                                       `protobuf:"varint,9,opt,name=routeHostnames,proto3" json:"routeHostnames,omitempty"`
+	IssuerRef            *CertManagerObjectReference                `protobuf:"bytes,2,opt,name=issuerRef,proto3" json:"issuerRef,omitempty"`
+	CommonName           string                                     `protobuf:"bytes,3,opt,name=commonName,proto3" json:"commonName,omitempty"`
+	Duration             *duration.Duration                         `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	RenewBefore          *duration.Duration                         `protobuf:"bytes,5,opt,name=renewBefore,proto3" json:"renewBefore,omitempty"`
+	Usages               []string                                   `protobuf:"bytes,6,rep,name=usages,proto3" json:"usages,omitempty"`
+	RevisionHistoryLimit *int32                                     `protobuf:"varint,7,opt,name=revisionHistoryLimit,proto3,oneof" json:"revisionHistoryLimit,omitempty"`
+	PrivateKey           *CertificatePrivateKey                     `protobuf:"bytes,8,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TLSPolicySpec) Reset() {
+	*x = TLSPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSPolicySpec) ProtoMessage() {}
+
+func (x *TLSPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSPolicySpec.ProtoReflect.Descriptor instead.
+func (*TLSPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TLSPolicySpec) GetTargetRef() *LocalPolicyTargetReferenceWithSectionName {
+	if x != nil {
+		return x.TargetRef
+	}
+	return nil
+}
+
+func (x *TLSPolicySpec) GetRouteHostnames() bool {
+	if x != nil {
+		return x.RouteHostnames
+	}
+	return false
+}
+
+func (x *TLSPolicySpec) GetIssuerRef() *CertManagerObjectReference {
+	if x != nil {
+		return x.IssuerRef
+	}
+	return nil
+}
+
+func (x *TLSPolicySpec) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *TLSPolicySpec) GetDuration() *duration.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *TLSPolicySpec) GetRenewBefore() *duration.Duration {
+	if x != nil {
+		return x.RenewBefore
+	}
+	return nil
+}
+
+func (x *TLSPolicySpec) GetUsages() []string {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *TLSPolicySpec) GetRevisionHistoryLimit() int32 {
+	if x != nil && x.RevisionHistoryLimit != nil {
+		return *x.RevisionHistoryLimit
+	}
+	return 0
+}
+
+func (x *TLSPolicySpec) GetPrivateKey() *CertificatePrivateKey {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+// TLSPolicyStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1.TLSPolicyStatus
+type TLSPolicyStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Conditions         []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	ObservedGeneration int64                  `protobuf:"varint,2,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Certificates       []*CertificateStatus   `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TLSPolicyStatus) Reset() {
+	*x = TLSPolicyStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSPolicyStatus) ProtoMessage() {}
+
+func (x *TLSPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSPolicyStatus.ProtoReflect.Descriptor instead.
+func (*TLSPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TLSPolicyStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *TLSPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *TLSPolicyStatus) GetCertificates() []*CertificateStatus {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+// Tls is generated from github.com/kuadrant/authorino-operator/api/v1beta1.Tls
+type Tls struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Enabled       *bool                     `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	CertSecretRef *CoreLocalObjectReference `protobuf:"bytes,2,opt,name=certSecretRef,proto3" json:"certSecretRef,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tls) Reset() {
+	*x = Tls{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tls) ProtoMessage() {}
+
+func (x *Tls) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tls.ProtoReflect.Descriptor instead.
+func (*Tls) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Tls) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *Tls) GetCertSecretRef() *CoreLocalObjectReference {
+	if x != nil {
+		return x.CertSecretRef
+	}
+	return nil
+}
+
+// TokenLimit is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.TokenLimit
+type TokenLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	When          []*Predicate           `protobuf:"bytes,1,rep,name=when,proto3" json:"when,omitempty"`
+	Rates         []*Rate                `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	Counters      []*Counter             `protobuf:"bytes,3,rep,name=counters,proto3" json:"counters,omitempty"`
+	Usage         *TokenUsage            `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenLimit) Reset() {
+	*x = TokenLimit{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLimit) ProtoMessage() {}
+
+func (x *TokenLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenLimit.ProtoReflect.Descriptor instead.
+func (*TokenLimit) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *TokenLimit) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *TokenLimit) GetRates() []*Rate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *TokenLimit) GetCounters() []*Counter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *TokenLimit) GetUsage() *TokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// TokenRateLimitPolicy is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.TokenRateLimitPolicy
+type TokenRateLimitPolicy struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Metadata      *Metadata                   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *TokenRateLimitPolicySpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *TokenRateLimitPolicyStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRateLimitPolicy) Reset() {
+	*x = TokenRateLimitPolicy{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRateLimitPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRateLimitPolicy) ProtoMessage() {}
+
+func (x *TokenRateLimitPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRateLimitPolicy.ProtoReflect.Descriptor instead.
+func (*TokenRateLimitPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *TokenRateLimitPolicy) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicy) GetSpec() *TokenRateLimitPolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicy) GetStatus() *TokenRateLimitPolicyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// TokenRateLimitPolicySpec is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.TokenRateLimitPolicySpec
+type TokenRateLimitPolicySpec struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	TargetRef     *LocalPolicyTargetReferenceWithSectionName `protobuf:"bytes,1,opt,name=targetRef,proto3" json:"targetRef,omitempty"`
+	Defaults      *MergeableTokenRateLimitPolicySpec         `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Overrides     *MergeableTokenRateLimitPolicySpec         `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	When          []*Predicate                               `protobuf:"bytes,4,rep,name=when,proto3" json:"when,omitempty"`
+	Limits        map[string]*TokenLimit                     `protobuf:"bytes,5,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FailureMode   string                                     `protobuf:"bytes,6,opt,name=failureMode,proto3" json:"failureMode,omitempty"`
+	Timeout       *string                                    `protobuf:"bytes,7,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRateLimitPolicySpec) Reset() {
+	*x = TokenRateLimitPolicySpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRateLimitPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRateLimitPolicySpec) ProtoMessage() {}
+
+func (x *TokenRateLimitPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRateLimitPolicySpec.ProtoReflect.Descriptor instead.
+func (*TokenRateLimitPolicySpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *TokenRateLimitPolicySpec) GetTargetRef() *LocalPolicyTargetReferenceWithSectionName {
+	if x != nil {
+		return x.TargetRef
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicySpec) GetDefaults() *MergeableTokenRateLimitPolicySpec {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicySpec) GetOverrides() *MergeableTokenRateLimitPolicySpec {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicySpec) GetWhen() []*Predicate {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicySpec) GetLimits() map[string]*TokenLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicySpec) GetFailureMode() string {
+	if x != nil {
+		return x.FailureMode
+	}
+	return ""
+}
+
+func (x *TokenRateLimitPolicySpec) GetTimeout() string {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return ""
+}
+
+// TokenRateLimitPolicyStatus is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.TokenRateLimitPolicyStatus
+type TokenRateLimitPolicyStatus struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ObservedGeneration int64                  `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
+	Conditions         []*Condition           `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	StreamingLimits    []string               `protobuf:"bytes,3,rep,name=streamingLimits,proto3" json:"streamingLimits,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TokenRateLimitPolicyStatus) Reset() {
+	*x = TokenRateLimitPolicyStatus{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRateLimitPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRateLimitPolicyStatus) ProtoMessage() {}
+
+func (x *TokenRateLimitPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRateLimitPolicyStatus.ProtoReflect.Descriptor instead.
+func (*TokenRateLimitPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TokenRateLimitPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *TokenRateLimitPolicyStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *TokenRateLimitPolicyStatus) GetStreamingLimits() []string {
+	if x != nil {
+		return x.StreamingLimits
+	}
+	return nil
+}
+
+// TokenUsage is generated from github.com/kuadrant/kuadrant-operator/api/v1alpha1.TokenUsage
+type TokenUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JsonPointer   string                 `protobuf:"bytes,1,opt,name=jsonPointer,proto3" json:"jsonPointer,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Streaming     bool                   `protobuf:"varint,3,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenUsage) Reset() {
+	*x = TokenUsage{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUsage) ProtoMessage() {}
+
+func (x *TokenUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUsage.ProtoReflect.Descriptor instead.
+func (*TokenUsage) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TokenUsage) GetJsonPointer() string {
+	if x != nil {
+		return x.JsonPointer
+	}
+	return ""
+}
+
+func (x *TokenUsage) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *TokenUsage) GetStreaming() bool {
+	if x != nil {
+		return x.Streaming
+	}
+	return false
+}
+
+// Tracing is generated from github.com/kuadrant/authorino-operator/api/v1beta1.Tracing
+type Tracing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Insecure      bool                   `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing) ProtoMessage() {}
+
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Tracing) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Tracing) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Tracing) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+// UmaMetadataSpec is generated from github.com/kuadrant/authorino/api/v1beta3.UmaMetadataSpec
+type UmaMetadataSpec struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Endpoint       string                    `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	CredentialsRef *CoreLocalObjectReference `protobuf:"bytes,2,opt,name=credentialsRef,proto3" json:"credentialsRef,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UmaMetadataSpec) Reset() {
+	*x = UmaMetadataSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UmaMetadataSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UmaMetadataSpec) ProtoMessage() {}
+
+func (x *UmaMetadataSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UmaMetadataSpec.ProtoReflect.Descriptor instead.
+func (*UmaMetadataSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UmaMetadataSpec) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *UmaMetadataSpec) GetCredentialsRef() *CoreLocalObjectReference {
+	if x != nil {
+		return x.CredentialsRef
+	}
+	return nil
+}
+
+// UnstructuredPatternExpressionOrRef is generated from github.com/kuadrant/authorino/api/v1beta3.UnstructuredPatternExpressionOrRef
+type UnstructuredPatternExpressionOrRef struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Selector      string                                `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Operator      string                                `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string                                `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PatternRef    string                                `protobuf:"bytes,4,opt,name=patternRef,proto3" json:"patternRef,omitempty"`
+	Predicate     string                                `protobuf:"bytes,5,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	All           []*UnstructuredPatternExpressionOrRef `protobuf:"bytes,6,rep,name=all,proto3" json:"all,omitempty"`
+	Any           []*UnstructuredPatternExpressionOrRef `protobuf:"bytes,7,rep,name=any,proto3" json:"any,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnstructuredPatternExpressionOrRef) Reset() {
+	*x = UnstructuredPatternExpressionOrRef{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnstructuredPatternExpressionOrRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstructuredPatternExpressionOrRef) ProtoMessage() {}
+
+func (x *UnstructuredPatternExpressionOrRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstructuredPatternExpressionOrRef.ProtoReflect.Descriptor instead.
+func (*UnstructuredPatternExpressionOrRef) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetPatternRef() string {
+	if x != nil {
+		return x.PatternRef
+	}
+	return ""
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetAll() []*UnstructuredPatternExpressionOrRef {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *UnstructuredPatternExpressionOrRef) GetAny() []*UnstructuredPatternExpressionOrRef {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+// UserInfoMetadataSpec is generated from github.com/kuadrant/authorino/api/v1beta3.UserInfoMetadataSpec
+type UserInfoMetadataSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdentitySource string                 `protobuf:"bytes,1,opt,name=identitySource,proto3" json:"identitySource,omitempty"`
+	UserInfoUrl    string                 `protobuf:"bytes,2,opt,name=userInfoUrl,proto3" json:"userInfoUrl,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserInfoMetadataSpec) Reset() {
+	*x = UserInfoMetadataSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfoMetadataSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoMetadataSpec) ProtoMessage() {}
+
+func (x *UserInfoMetadataSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoMetadataSpec.ProtoReflect.Descriptor instead.
+func (*UserInfoMetadataSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UserInfoMetadataSpec) GetIdentitySource() string {
+	if x != nil {
+		return x.IdentitySource
+	}
+	return ""
+}
+
+func (x *UserInfoMetadataSpec) GetUserInfoUrl() string {
+	if x != nil {
+		return x.UserInfoUrl
+	}
+	return ""
+}
+
+// ValueOrSelector is generated from github.com/kuadrant/authorino/api/v1beta3.ValueOrSelector
+type ValueOrSelector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *_struct.Value         `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Selector      string                 `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueOrSelector) Reset() {
+	*x = ValueOrSelector{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueOrSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueOrSelector) ProtoMessage() {}
+
+func (x *ValueOrSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueOrSelector.ProtoReflect.Descriptor instead.
+func (*ValueOrSelector) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ValueOrSelector) GetValue() *_struct.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ValueOrSelector) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *ValueOrSelector) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// WristbandAuthResponseSpec is generated from github.com/kuadrant/authorino/api/v1beta3.WristbandAuthResponseSpec
+type WristbandAuthResponseSpec struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Issuer         string                      `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	CustomClaims   map[string]*ValueOrSelector `protobuf:"bytes,2,rep,name=customClaims,proto3" json:"customClaims,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TokenDuration  *int64                      `protobuf:"varint,3,opt,name=tokenDuration,proto3,oneof" json:"tokenDuration,omitempty"`
+	SigningKeyRefs []*WristbandSigningKeyRef   `protobuf:"bytes,4,rep,name=signingKeyRefs,proto3" json:"signingKeyRefs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WristbandAuthResponseSpec) Reset() {
+	*x = WristbandAuthResponseSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WristbandAuthResponseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WristbandAuthResponseSpec) ProtoMessage() {}
+
+func (x *WristbandAuthResponseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WristbandAuthResponseSpec.ProtoReflect.Descriptor instead.
+func (*WristbandAuthResponseSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *WristbandAuthResponseSpec) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *WristbandAuthResponseSpec) GetCustomClaims() map[string]*ValueOrSelector {
+	if x != nil {
+		return x.CustomClaims
+	}
+	return nil
+}
+
+func (x *WristbandAuthResponseSpec) GetTokenDuration() int64 {
+	if x != nil && x.TokenDuration != nil {
+		return *x.TokenDuration
+	}
+	return 0
+}
+
+func (x *WristbandAuthResponseSpec) GetSigningKeyRefs() []*WristbandSigningKeyRef {
+	if x != nil {
+		return x.SigningKeyRefs
+	}
+	return nil
+}
+
+// WristbandSigningKeyRef is generated from github.com/kuadrant/authorino/api/v1beta3.WristbandSigningKeyRef
+type WristbandSigningKeyRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WristbandSigningKeyRef) Reset() {
+	*x = WristbandSigningKeyRef{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WristbandSigningKeyRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WristbandSigningKeyRef) ProtoMessage() {}
+
+func (x *WristbandSigningKeyRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WristbandSigningKeyRef.ProtoReflect.Descriptor instead.
+func (*WristbandSigningKeyRef) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *WristbandSigningKeyRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WristbandSigningKeyRef) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+// X509ClientCertificateAuthenticationSpec is generated from github.com/kuadrant/authorino/api/v1beta3.X509ClientCertificateAuthenticationSpec
+type X509ClientCertificateAuthenticationSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *LabelSelector         `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	AllNamespaces bool                   `protobuf:"varint,2,opt,name=allNamespaces,proto3" json:"allNamespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *X509ClientCertificateAuthenticationSpec) Reset() {
+	*x = X509ClientCertificateAuthenticationSpec{}
+	mi := &file_v1_kuadrant_api_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *X509ClientCertificateAuthenticationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509ClientCertificateAuthenticationSpec) ProtoMessage() {}
+
+func (x *X509ClientCertificateAuthenticationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kuadrant_api_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509ClientCertificateAuthenticationSpec.ProtoReflect.Descriptor instead.
+func (*X509ClientCertificateAuthenticationSpec) Descriptor() ([]byte, []int) {
+	return file_v1_kuadrant_api_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *X509ClientCertificateAuthenticationSpec) GetSelector() *LabelSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *X509ClientCertificateAuthenticationSpec) GetAllNamespaces() bool {
+	if x != nil {
+		return x.AllNamespaces
+	}
+	return false
+}
+
+var File_v1_kuadrant_api_proto protoreflect.FileDescriptor
+
+const file_v1_kuadrant_api_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/kuadrant_api.proto\x12\vkuadrant.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fv1/common.proto\x1a\x14v1/gateway_api.proto\x1a\x10v1/k8s_api.proto\"*\n" +
+	"\x14AdditionalHeadersRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x15\n" +
+	"\x13AnonymousAccessSpec\"x\n" +
+	"\x18ApiKeyAuthenticationSpec\x126\n" +
+	"\bselector\x18\x01 \x01(\v2\x1a.kuadrant.v1.LabelSelectorR\bselector\x12$\n" +
+	"\rallNamespaces\x18\x02 \x01(\bR\rallNamespaces\"\xa7\x01\n" +
+	"\n" +
+	"AuthPolicy\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12/\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1b.kuadrant.v1.AuthPolicySpecR\x04spec\x125\n" +
+	"\x06status\x18\x03 \x01(\v2\x1d.kuadrant.v1.AuthPolicyStatusR\x06status\"\xda\x04\n" +
+	"\x0eAuthPolicySpec\x12T\n" +
+	"\ttargetRef\x18\x01 \x01(\v26.kuadrant.v1.LocalPolicyTargetReferenceWithSectionNameR\ttargetRef\x12@\n" +
+	"\bdefaults\x18\x02 \x01(\v2$.kuadrant.v1.MergeableAuthPolicySpecR\bdefaults\x12B\n" +
+	"\toverrides\x18\x03 \x01(\v2$.kuadrant.v1.MergeableAuthPolicySpecR\toverrides\x12E\n" +
+	"\bpatterns\x18\x04 \x03(\v2).kuadrant.v1.AuthPolicySpec.PatternsEntryR\bpatterns\x12*\n" +
+	"\x04when\x18\x05 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x121\n" +
+	"\x05rules\x18\x06 \x01(\v2\x1b.kuadrant.v1.AuthSchemeSpecR\x05rules\x12\x12\n" +
+	"\x04mode\x18\a \x01(\tR\x04mode\x12 \n" +
+	"\vfailureMode\x18\b \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\t \x01(\tH\x00R\atimeout\x88\x01\x01\x1ae\n" +
+	"\rPatternsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.kuadrant.v1.MergeablePatternExpressionsR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"z\n" +
+	"\x10AuthPolicyStatus\x12.\n" +
+	"\x12observedGeneration\x18\x01 \x01(\x03R\x12observedGeneration\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"\xab\x06\n" +
+	"\x0eAuthSchemeSpec\x12W\n" +
+	"\x0eauthentication\x18\x01 \x03(\v2/.kuadrant.v1.AuthSchemeSpec.AuthenticationEntryR\x0eauthentication\x12E\n" +
+	"\bmetadata\x18\x02 \x03(\v2).kuadrant.v1.AuthSchemeSpec.MetadataEntryR\bmetadata\x12T\n" +
+	"\rauthorization\x18\x03 \x03(\v2..kuadrant.v1.AuthSchemeSpec.AuthorizationEntryR\rauthorization\x12>\n" +
+	"\bresponse\x18\x04 \x01(\v2\".kuadrant.v1.MergeableResponseSpecR\bresponse\x12H\n" +
+	"\tcallbacks\x18\x05 \x03(\v2*.kuadrant.v1.AuthSchemeSpec.CallbacksEntryR\tcallbacks\x1ak\n" +
+	"\x13AuthenticationEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.kuadrant.v1.MergeableAuthenticationSpecR\x05value:\x028\x01\x1a_\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".kuadrant.v1.MergeableMetadataSpecR\x05value:\x028\x01\x1ai\n" +
+	"\x12AuthorizationEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\x05value\x18\x02 \x01(\v2'.kuadrant.v1.MergeableAuthorizationSpecR\x05value:\x028\x01\x1a`\n" +
+	"\x0eCallbacksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".kuadrant.v1.MergeableCallbackSpecR\x05value:\x028\x01\"9\n" +
+	"\x13AuthorinoOIDCServer\x12\"\n" +
+	"\x03tls\x18\x01 \x01(\v2\x10.kuadrant.v1.TlsR\x03tls\"\xb1\x02\n" +
+	"\rAuthorinoSpec\x12\x1f\n" +
+	"\breplicas\x18\x01 \x01(\x05H\x00R\breplicas\x88\x01\x01\x12\x1a\n" +
+	"\blogLevel\x18\x02 \x01(\tR\blogLevel\x12\x18\n" +
+	"\alogMode\x18\x03 \x01(\tR\alogMode\x123\n" +
+	"\x12evaluatorCacheSize\x18\x04 \x01(\x03H\x01R\x12evaluatorCacheSize\x88\x01\x01\x12@\n" +
+	"\n" +
+	"oidcServer\x18\x05 \x01(\v2 .kuadrant.v1.AuthorinoOIDCServerR\n" +
+	"oidcServer\x12.\n" +
+	"\atracing\x18\x06 \x01(\v2\x14.kuadrant.v1.TracingR\atracingB\v\n" +
+	"\t_replicasB\x15\n" +
+	"\x13_evaluatorCacheSize\"\xfc\x01\n" +
+	"\x0fAuthorinoStatus\x12\x1a\n" +
+	"\breplicas\x18\x01 \x01(\x05R\breplicas\x12\x1a\n" +
+	"\blogLevel\x18\x02 \x01(\tR\blogLevel\x123\n" +
+	"\x12evaluatorCacheSize\x18\x03 \x01(\x03H\x00R\x12evaluatorCacheSize\x88\x01\x01\x12)\n" +
+	"\roidcServerTLS\x18\x04 \x01(\bH\x01R\roidcServerTLS\x88\x01\x01\x12(\n" +
+	"\x0ftracingEndpoint\x18\x05 \x01(\tR\x0ftracingEndpointB\x15\n" +
+	"\x13_evaluatorCacheSizeB\x10\n" +
+	"\x0e_oidcServerTLS\"Z\n" +
+	"\x1aCertManagerObjectReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"\x8d\x01\n" +
+	"\x15CertificatePrivateKey\x12&\n" +
+	"\x0erotationPolicy\x18\x01 \x01(\tR\x0erotationPolicy\x12\x1a\n" +
+	"\bencoding\x18\x02 \x01(\tR\bencoding\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\tR\talgorithm\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"\xf7\x02\n" +
+	"\x11CertificateStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x12\x1a\n" +
+	"\blistener\x18\x04 \x01(\tR\blistener\x126\n" +
+	"\bnotAfter\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x12<\n" +
+	"\vrenewalTime\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vrenewalTime\x12D\n" +
+	"\x0flastFailureTime\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFailureTime\x12,\n" +
+	"\x11lastFailureReason\x18\b \x01(\tR\x11lastFailureReasonJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aJ\x04\b\a\x10\b\")\n" +
+	"\aCounter\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\"\xf7\x01\n" +
+	"\vCredentials\x12G\n" +
+	"\x13authorizationHeader\x18\x01 \x01(\v2\x15.kuadrant.v1.PrefixedR\x13authorizationHeader\x12=\n" +
+	"\fcustomHeader\x18\x02 \x01(\v2\x19.kuadrant.v1.CustomHeaderR\fcustomHeader\x124\n" +
+	"\vqueryString\x18\x03 \x01(\v2\x12.kuadrant.v1.NamedR\vqueryString\x12*\n" +
+	"\x06cookie\x18\x04 \x01(\v2\x12.kuadrant.v1.NamedR\x06cookie\"\"\n" +
+	"\fCustomHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xa4\x01\n" +
+	"\tDNSPolicy\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.kuadrant.v1.DNSPolicySpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.kuadrant.v1.DNSPolicyStatusR\x06status\"\x99\x03\n" +
+	"\rDNSPolicySpec\x12T\n" +
+	"\ttargetRef\x18\x01 \x01(\v26.kuadrant.v1.LocalPolicyTargetReferenceWithSectionNameR\ttargetRef\x12>\n" +
+	"\vhealthCheck\x18\x02 \x01(\v2\x1c.kuadrant.v1.HealthCheckSpecR\vhealthCheck\x12D\n" +
+	"\rloadBalancing\x18\x03 \x01(\v2\x1e.kuadrant.v1.LoadBalancingSpecR\rloadBalancing\x12<\n" +
+	"\fproviderRefs\x18\x04 \x03(\v2\x18.kuadrant.v1.ProviderRefR\fproviderRefs\x12*\n" +
+	"\x10excludeAddresses\x18\x05 \x03(\tR\x10excludeAddresses\x12&\n" +
+	"\x0erouteHostnames\x18\a \x01(\bR\x0erouteHostnames\x12\x1a\n" +
+	"\bdelegate\x18\x06 \x01(\bR\bdelegate\"\x83\x04\n" +
+	"\x0fDNSPolicyStatus\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12.\n" +
+	"\x12observedGeneration\x18\x02 \x01(\x03R\x12observedGeneration\x12@\n" +
+	"\vhealthCheck\x18\x03 \x01(\v2\x1e.kuadrant.v1.HealthCheckStatusR\vhealthCheck\x12^\n" +
+	"\x10recordConditions\x18\x04 \x03(\v22.kuadrant.v1.DNSPolicyStatus.RecordConditionsEntryR\x10recordConditions\x12a\n" +
+	"\x18providerRecordConditions\x18\x06 \x03(\v2%.kuadrant.v1.ProviderRecordConditionsR\x18providerRecordConditions\x12\"\n" +
+	"\ftotalRecords\x18\x05 \x01(\x05R\ftotalRecords\x1a_\n" +
+	"\x15RecordConditionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.kuadrant.v1.ConditionListR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\bDiskSpec\x12Q\n" +
+	"\x15persistentVolumeClaim\x18\x01 \x01(\v2\x1b.kuadrant.v1.PVCGenericSpecR\x15persistentVolumeClaim\x12\x1f\n" +
+	"\boptimize\x18\x02 \x01(\tH\x00R\boptimize\x88\x01\x01B\v\n" +
+	"\t_optimize\"T\n" +
+	"\x10EvaluatorCaching\x12.\n" +
+	"\x03key\x18\x01 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x03key\x12\x10\n" +
+	"\x03ttl\x18\x02 \x01(\x03R\x03ttl\"\x84\x03\n" +
+	"\x0fExtensionStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"executable\x18\x02 \x01(\tR\n" +
+	"executable\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1a\n" +
+	"\brestarts\x18\x04 \x01(\x05R\brestarts\x12\x1c\n" +
+	"\tlastError\x18\x05 \x01(\tR\tlastError\x12C\n" +
+	"\x0flastPingLatency\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0flastPingLatency\x12\x1a\n" +
+	"\bmutators\x18\a \x01(\x05R\bmutators\x12$\n" +
+	"\rsubscriptions\x18\b \x01(\x05R\rsubscriptions\x12\x18\n" +
+	"\aaddress\x18\t \x01(\tR\aaddress\x12\x1e\n" +
+	"\n" +
+	"apiVersion\x18\n" +
+	" \x01(\tR\n" +
+	"apiVersion\x12,\n" +
+	"\x11incompatibilities\x18\v \x03(\tR\x11incompatibilities\"F\n" +
+	"\x0eExtensionsSpec\x124\n" +
+	"\x06remote\x18\x01 \x03(\v2\x1c.kuadrant.v1.RemoteExtensionR\x06remote\"\xff\x05\n" +
+	"\x11ExternalOpaPolicy\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12$\n" +
+	"\rurlExpression\x18\x02 \x01(\tR\rurlExpression\x12\x1b\n" +
+	"\x06method\x18\x03 \x01(\tH\x00R\x06method\x88\x01\x01\x120\n" +
+	"\x04body\x18\x04 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04body\x12Z\n" +
+	"\x0ebodyParameters\x18\x05 \x03(\v22.kuadrant.v1.ExternalOpaPolicy.BodyParametersEntryR\x0ebodyParameters\x12 \n" +
+	"\vcontentType\x18\x06 \x01(\tR\vcontentType\x12E\n" +
+	"\aheaders\x18\a \x03(\v2+.kuadrant.v1.ExternalOpaPolicy.HeadersEntryR\aheaders\x12I\n" +
+	"\x0fsharedSecretRef\x18\b \x01(\v2\x1f.kuadrant.v1.SecretKeyReferenceR\x0fsharedSecretRef\x12?\n" +
+	"\x06oauth2\x18\t \x01(\v2'.kuadrant.v1.OAuth2ClientAuthenticationR\x06oauth2\x12:\n" +
+	"\vcredentials\x18\n" +
+	" \x01(\v2\x18.kuadrant.v1.CredentialsR\vcredentials\x12\x10\n" +
+	"\x03ttl\x18\v \x01(\x03R\x03ttl\x1a_\n" +
+	"\x13BodyParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\x1aX\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01B\t\n" +
+	"\a_method\"D\n" +
+	"\x10GatewayReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xc4\x01\n" +
+	"\x0fGatewayServices\x129\n" +
+	"\bgateways\x18\x01 \x03(\v2\x1d.kuadrant.v1.GatewayReferenceR\bgateways\x12:\n" +
+	"\tlimitador\x18\x02 \x01(\v2\x1c.kuadrant.v1.ServiceEndpointR\tlimitador\x12:\n" +
+	"\tauthorino\x18\x03 \x01(\v2\x1c.kuadrant.v1.ServiceEndpointR\tauthorino\"\x8f\x02\n" +
+	"\x0fHealthCheckSpec\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x03R\x04port\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x125\n" +
+	"\binterval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12U\n" +
+	"\x14additionalHeadersRef\x18\x05 \x01(\v2!.kuadrant.v1.AdditionalHeadersRefR\x14additionalHeadersRef\x12*\n" +
+	"\x10failureThreshold\x18\x06 \x01(\x03R\x10failureThreshold\"\x88\x01\n" +
+	"\x11HealthCheckStatus\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12;\n" +
+	"\x06probes\x18\x02 \x03(\v2#.kuadrant.v1.HealthCheckStatusProbeR\x06probes\"\xaa\x01\n" +
+	"\x16HealthCheckStatusProbe\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tipAddress\x18\x02 \x01(\tR\tipAddress\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x16\n" +
+	"\x06synced\x18\x04 \x01(\bR\x06synced\x126\n" +
+	"\n" +
+	"conditions\x18\x05 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\"\xea\x05\n" +
+	"\x10HttpEndpointSpec\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12$\n" +
+	"\rurlExpression\x18\x02 \x01(\tR\rurlExpression\x12\x1b\n" +
+	"\x06method\x18\x03 \x01(\tH\x00R\x06method\x88\x01\x01\x120\n" +
+	"\x04body\x18\x04 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04body\x12Y\n" +
+	"\x0ebodyParameters\x18\x05 \x03(\v21.kuadrant.v1.HttpEndpointSpec.BodyParametersEntryR\x0ebodyParameters\x12 \n" +
+	"\vcontentType\x18\x06 \x01(\tR\vcontentType\x12D\n" +
+	"\aheaders\x18\a \x03(\v2*.kuadrant.v1.HttpEndpointSpec.HeadersEntryR\aheaders\x12I\n" +
+	"\x0fsharedSecretRef\x18\b \x01(\v2\x1f.kuadrant.v1.SecretKeyReferenceR\x0fsharedSecretRef\x12?\n" +
+	"\x06oauth2\x18\t \x01(\v2'.kuadrant.v1.OAuth2ClientAuthenticationR\x06oauth2\x12:\n" +
+	"\vcredentials\x18\n" +
+	" \x01(\v2\x18.kuadrant.v1.CredentialsR\vcredentials\x1a_\n" +
+	"\x13BodyParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\x1aX\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01B\t\n" +
+	"\a_method\"\xc6\x01\n" +
+	"\x14JsonAuthResponseSpec\x12Q\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\v21.kuadrant.v1.JsonAuthResponseSpec.PropertiesEntryR\n" +
+	"properties\x1a[\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\"a\n" +
+	"\x15JwtAuthenticationSpec\x12\x18\n" +
+	"\ajwksUrl\x18\x01 \x01(\tR\ajwksUrl\x12\x1c\n" +
+	"\tissuerUrl\x18\x02 \x01(\tR\tissuerUrl\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\x03R\x03ttl\"\xa1\x01\n" +
+	"\bKuadrant\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12-\n" +
+	"\x04spec\x18\x02 \x01(\v2\x19.kuadrant.v1.KuadrantSpecR\x04spec\x123\n" +
+	"\x06status\x18\x03 \x01(\v2\x1b.kuadrant.v1.KuadrantStatusR\x06status\"\xf0\x02\n" +
+	"\fKuadrantSpec\x12@\n" +
+	"\robservability\x18\x01 \x01(\v2\x1a.kuadrant.v1.ObservabilityR\robservability\x12%\n" +
+	"\x04mtls\x18\x02 \x01(\v2\x11.kuadrant.v1.MTLSR\x04mtls\x128\n" +
+	"\tlimitador\x18\x04 \x01(\v2\x1a.kuadrant.v1.LimitadorSpecR\tlimitador\x128\n" +
+	"\tauthorino\x18\x05 \x01(\v2\x1a.kuadrant.v1.AuthorinoSpecR\tauthorino\x12F\n" +
+	"\x0fgatewayServices\x18\x03 \x03(\v2\x1c.kuadrant.v1.GatewayServicesR\x0fgatewayServices\x12;\n" +
+	"\n" +
+	"extensions\x18\x06 \x01(\v2\x1b.kuadrant.v1.ExtensionsSpecR\n" +
+	"extensions\"\xa8\x03\n" +
+	"\x0eKuadrantStatus\x12.\n" +
+	"\x12observedGeneration\x18\x01 \x01(\x03R\x12observedGeneration\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12)\n" +
+	"\rmtlsAuthorino\x18\x03 \x01(\bH\x00R\rmtlsAuthorino\x88\x01\x01\x12)\n" +
+	"\rmtlsLimitador\x18\x04 \x01(\bH\x01R\rmtlsLimitador\x88\x01\x01\x12:\n" +
+	"\tlimitador\x18\x05 \x01(\v2\x1c.kuadrant.v1.LimitadorStatusR\tlimitador\x12:\n" +
+	"\tauthorino\x18\x06 \x01(\v2\x1c.kuadrant.v1.AuthorinoStatusR\tauthorino\x12<\n" +
+	"\n" +
+	"extensions\x18\a \x03(\v2\x1c.kuadrant.v1.ExtensionStatusR\n" +
+	"extensionsB\x10\n" +
+	"\x0e_mtlsAuthorinoB\x10\n" +
+	"\x0e_mtlsLimitador\"\xbc\x02\n" +
+	".KubernetesSubjectAccessReviewAuthorizationSpec\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04user\x12\x16\n" +
+	"\x06groups\x18\x02 \x03(\tR\x06groups\x12N\n" +
+	"\x13authorizationGroups\x18\x03 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x13authorizationGroups\x12p\n" +
+	"\x12resourceAttributes\x18\x04 \x01(\v2@.kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpecR\x12resourceAttributes\"\x83\x03\n" +
+	"3KubernetesSubjectAccessReviewResourceAttributesSpec\x122\n" +
+	"\x05group\x18\x01 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05group\x128\n" +
+	"\bresource\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\bresource\x12>\n" +
+	"\vsubresource\x18\x03 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\vsubresource\x120\n" +
+	"\x04name\x18\x04 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04name\x12:\n" +
+	"\tnamespace\x18\x05 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\tnamespace\x120\n" +
+	"\x04verb\x18\x06 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04verb\"9\n" +
+	"\x19KubernetesTokenReviewSpec\x12\x1c\n" +
+	"\taudiences\x18\x01 \x03(\tR\taudiences\"\xa2\x01\n" +
+	"\x05Limit\x12*\n" +
+	"\x04when\x18\x01 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x120\n" +
+	"\bcounters\x18\x02 \x03(\v2\x14.kuadrant.v1.CounterR\bcounters\x12'\n" +
+	"\x05rates\x18\x03 \x03(\v2\x11.kuadrant.v1.RateR\x05rates\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\"\xfc\x02\n" +
+	"\rLimitadorSpec\x12\x1f\n" +
+	"\breplicas\x18\x01 \x01(\x03H\x00R\breplicas\x88\x01\x01\x12.\n" +
+	"\astorage\x18\x02 \x01(\v2\x14.kuadrant.v1.StorageR\astorage\x12U\n" +
+	"\x14resourceRequirements\x18\x03 \x01(\v2!.kuadrant.v1.ResourceRequirementsR\x14resourceRequirements\x126\n" +
+	"\x03pdb\x18\x04 \x01(\v2$.kuadrant.v1.PodDisruptionBudgetTypeR\x03pdb\x12!\n" +
+	"\tverbosity\x18\x05 \x01(\x03H\x01R\tverbosity\x88\x01\x01\x125\n" +
+	"\x13metricLabelsDefault\x18\x06 \x01(\tH\x02R\x13metricLabelsDefault\x88\x01\x01B\v\n" +
+	"\t_replicasB\f\n" +
+	"\n" +
+	"_verbosityB\x16\n" +
+	"\x14_metricLabelsDefault\"x\n" +
+	"\x0fLimitadorStatus\x12\x1a\n" +
+	"\breplicas\x18\x01 \x01(\x05R\breplicas\x12\x18\n" +
+	"\astorage\x18\x02 \x01(\tR\astorage\x12!\n" +
+	"\tverbosity\x18\x03 \x01(\x03H\x00R\tverbosity\x88\x01\x01B\f\n" +
+	"\n" +
+	"_verbosity\"]\n" +
+	"\x11LoadBalancingSpec\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x03R\x06weight\x12\x10\n" +
+	"\x03geo\x18\x02 \x01(\tR\x03geo\x12\x1e\n" +
+	"\n" +
+	"defaultGeo\x18\x03 \x01(\bR\n" +
+	"defaultGeo\"\x80\x01\n" +
+	"\x04MTLS\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12!\n" +
+	"\tauthorino\x18\x02 \x01(\bH\x00R\tauthorino\x88\x01\x01\x12!\n" +
+	"\tlimitador\x18\x03 \x01(\bH\x01R\tlimitador\x88\x01\x01B\f\n" +
+	"\n" +
+	"_authorinoB\f\n" +
+	"\n" +
+	"_limitador\"\xac\x03\n" +
+	"\x17MergeableAuthPolicySpec\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12N\n" +
+	"\bpatterns\x18\x02 \x03(\v22.kuadrant.v1.MergeableAuthPolicySpec.PatternsEntryR\bpatterns\x12*\n" +
+	"\x04when\x18\x03 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x121\n" +
+	"\x05rules\x18\x04 \x01(\v2\x1b.kuadrant.v1.AuthSchemeSpecR\x05rules\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12 \n" +
+	"\vfailureMode\x18\x06 \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\a \x01(\tH\x00R\atimeout\x88\x01\x01\x1ae\n" +
+	"\rPatternsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.kuadrant.v1.MergeablePatternExpressionsR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"\xcf\b\n" +
+	"\x1bMergeableAuthenticationSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x12:\n" +
+	"\vcredentials\x18\x05 \x01(\v2\x18.kuadrant.v1.CredentialsR\vcredentials\x12U\n" +
+	"\toverrides\x18\x06 \x03(\v27.kuadrant.v1.MergeableAuthenticationSpec.OverridesEntryR\toverrides\x12R\n" +
+	"\bdefaults\x18\a \x03(\v26.kuadrant.v1.MergeableAuthenticationSpec.DefaultsEntryR\bdefaults\x12=\n" +
+	"\x06apiKey\x18\b \x01(\v2%.kuadrant.v1.ApiKeyAuthenticationSpecR\x06apiKey\x124\n" +
+	"\x03jwt\x18\t \x01(\v2\".kuadrant.v1.JwtAuthenticationSpecR\x03jwt\x12[\n" +
+	"\x13oauth2Introspection\x18\n" +
+	" \x01(\v2).kuadrant.v1.OAuth2TokenIntrospectionSpecR\x13oauth2Introspection\x12\\\n" +
+	"\x15kubernetesTokenReview\x18\v \x01(\v2&.kuadrant.v1.KubernetesTokenReviewSpecR\x15kubernetesTokenReview\x12H\n" +
+	"\x04x509\x18\f \x01(\v24.kuadrant.v1.X509ClientCertificateAuthenticationSpecR\x04x509\x124\n" +
+	"\x05plain\x18\r \x01(\v2\x1e.kuadrant.v1.PlainIdentitySpecR\x05plain\x12>\n" +
+	"\tanonymous\x18\x0e \x01(\v2 .kuadrant.v1.AnonymousAccessSpecR\tanonymous\x1aZ\n" +
+	"\x0eOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\x1aY\n" +
+	"\rDefaultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\"\x93\x04\n" +
+	"\x1aMergeableAuthorizationSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x12W\n" +
+	"\x0fpatternMatching\x18\x05 \x01(\v2-.kuadrant.v1.PatternMatchingAuthorizationSpecR\x0fpatternMatching\x123\n" +
+	"\x03opa\x18\x06 \x01(\v2!.kuadrant.v1.OpaAuthorizationSpecR\x03opa\x12\x81\x01\n" +
+	"\x1dkubernetesSubjectAccessReview\x18\a \x01(\v2;.kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpecR\x1dkubernetesSubjectAccessReview\x12?\n" +
+	"\aspicedb\x18\b \x01(\v2%.kuadrant.v1.SpiceDBAuthorizationSpecR\aspicedb\"\xee\x01\n" +
+	"\x15MergeableCallbackSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x121\n" +
+	"\x04http\x18\x05 \x01(\v2\x1d.kuadrant.v1.HttpEndpointSpecR\x04http\"\xba\x02\n" +
+	"\x15MergeableDenyWithSpec\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x03R\x04code\x126\n" +
+	"\amessage\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\amessage\x12I\n" +
+	"\aheaders\x18\x03 \x03(\v2/.kuadrant.v1.MergeableDenyWithSpec.HeadersEntryR\aheaders\x120\n" +
+	"\x04body\x18\x04 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04body\x1aX\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01\"\x91\x03\n" +
+	"\"MergeableHeaderSuccessResponseSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x128\n" +
+	"\x05plain\x18\x05 \x01(\v2\".kuadrant.v1.PlainAuthResponseSpecR\x05plain\x125\n" +
+	"\x04json\x18\x06 \x01(\v2!.kuadrant.v1.JsonAuthResponseSpecR\x04json\x12D\n" +
+	"\twristband\x18\a \x01(\v2&.kuadrant.v1.WristbandAuthResponseSpecR\twristband\x12\x10\n" +
+	"\x03key\x18\b \x01(\tR\x03key\"\xdd\x02\n" +
+	"\x15MergeableMetadataSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x121\n" +
+	"\x04http\x18\x05 \x01(\v2\x1d.kuadrant.v1.HttpEndpointSpecR\x04http\x12=\n" +
+	"\buserInfo\x18\x06 \x01(\v2!.kuadrant.v1.UserInfoMetadataSpecR\buserInfo\x12.\n" +
+	"\x03uma\x18\a \x01(\v2\x1c.kuadrant.v1.UmaMetadataSpecR\x03uma\"S\n" +
+	"\x1bMergeablePatternExpressions\x124\n" +
+	"\x05allOf\x18\x01 \x03(\v2\x1e.kuadrant.v1.PatternExpressionR\x05allOf\"\xe5\x02\n" +
+	"\x1cMergeableRateLimitPolicySpec\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12*\n" +
+	"\x04when\x18\x02 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x12M\n" +
+	"\x06limits\x18\x03 \x03(\v25.kuadrant.v1.MergeableRateLimitPolicySpec.LimitsEntryR\x06limits\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12 \n" +
+	"\vfailureMode\x18\x05 \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\x06 \x01(\tH\x00R\atimeout\x88\x01\x01\x1aM\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.kuadrant.v1.LimitR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"\xf9\x01\n" +
+	"\x15MergeableResponseSpec\x12L\n" +
+	"\x0funauthenticated\x18\x01 \x01(\v2\".kuadrant.v1.MergeableDenyWithSpecR\x0funauthenticated\x12F\n" +
+	"\funauthorized\x18\x02 \x01(\v2\".kuadrant.v1.MergeableDenyWithSpecR\funauthorized\x12J\n" +
+	"\asuccess\x18\x03 \x01(\v20.kuadrant.v1.MergeableWrappedSuccessResponseSpecR\asuccess\"\x8b\x03\n" +
+	"\x1cMergeableSuccessResponseSpec\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x03R\bpriority\x12\x18\n" +
+	"\ametrics\x18\x02 \x01(\bR\ametrics\x127\n" +
+	"\x04when\x18\x03 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\x04when\x123\n" +
+	"\x05cache\x18\x04 \x01(\v2\x1d.kuadrant.v1.EvaluatorCachingR\x05cache\x128\n" +
+	"\x05plain\x18\x05 \x01(\v2\".kuadrant.v1.PlainAuthResponseSpecR\x05plain\x125\n" +
+	"\x04json\x18\x06 \x01(\v2!.kuadrant.v1.JsonAuthResponseSpecR\x04json\x12D\n" +
+	"\twristband\x18\a \x01(\v2&.kuadrant.v1.WristbandAuthResponseSpecR\twristband\x12\x10\n" +
+	"\x03key\x18\b \x01(\tR\x03key\"\xe0\x02\n" +
+	"!MergeableTokenRateLimitPolicySpec\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12*\n" +
+	"\x04when\x18\x02 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x12R\n" +
+	"\x06limits\x18\x03 \x03(\v2:.kuadrant.v1.MergeableTokenRateLimitPolicySpec.LimitsEntryR\x06limits\x12 \n" +
+	"\vfailureMode\x18\x04 \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\x05 \x01(\tH\x00R\atimeout\x88\x01\x01\x1aR\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.kuadrant.v1.TokenLimitR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"\xab\x03\n" +
+	"#MergeableWrappedSuccessResponseSpec\x12W\n" +
+	"\aheaders\x18\x01 \x03(\v2=.kuadrant.v1.MergeableWrappedSuccessResponseSpec.HeadersEntryR\aheaders\x12W\n" +
+	"\afilters\x18\x02 \x03(\v2=.kuadrant.v1.MergeableWrappedSuccessResponseSpec.FiltersEntryR\afilters\x1ak\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12E\n" +
+	"\x05value\x18\x02 \x01(\v2/.kuadrant.v1.MergeableHeaderSuccessResponseSpecR\x05value:\x028\x01\x1ae\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).kuadrant.v1.MergeableSuccessResponseSpecR\x05value:\x028\x01\"\x1b\n" +
+	"\x05Named\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xf8\x02\n" +
+	"\x1aOAuth2ClientAuthentication\x12\x1a\n" +
+	"\btokenUrl\x18\x01 \x01(\tR\btokenUrl\x12\x1a\n" +
+	"\bclientId\x18\x02 \x01(\tR\bclientId\x12I\n" +
+	"\x0fclientSecretRef\x18\x03 \x01(\v2\x1f.kuadrant.v1.SecretKeyReferenceR\x0fclientSecretRef\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12Z\n" +
+	"\vextraParams\x18\x05 \x03(\v28.kuadrant.v1.OAuth2ClientAuthentication.ExtraParamsEntryR\vextraParams\x12\x19\n" +
+	"\x05cache\x18\x06 \x01(\bH\x00R\x05cache\x88\x01\x01\x1a>\n" +
+	"\x10ExtraParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_cache\"\xaf\x01\n" +
+	"\x1cOAuth2TokenIntrospectionSpec\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12$\n" +
+	"\rtokenTypeHint\x18\x02 \x01(\tR\rtokenTypeHint\x12M\n" +
+	"\x0ecredentialsRef\x18\x03 \x01(\v2%.kuadrant.v1.CoreLocalObjectReferenceR\x0ecredentialsRef\"'\n" +
+	"\rObservability\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\"\x90\x01\n" +
+	"\x14OpaAuthorizationSpec\x12\x12\n" +
+	"\x04rego\x18\x01 \x01(\tR\x04rego\x12F\n" +
+	"\x0eexternalPolicy\x18\x02 \x01(\v2\x1e.kuadrant.v1.ExternalOpaPolicyR\x0eexternalPolicy\x12\x1c\n" +
+	"\tallValues\x18\x03 \x01(\bR\tallValues\"\xd5\x01\n" +
+	"\x0ePVCGenericSpec\x12/\n" +
+	"\x10storageClassName\x18\x01 \x01(\tH\x00R\x10storageClassName\x88\x01\x01\x12I\n" +
+	"\tresources\x18\x02 \x01(\v2+.kuadrant.v1.PersistentVolumeClaimResourcesR\tresources\x12#\n" +
+	"\n" +
+	"volumeName\x18\x03 \x01(\tH\x01R\n" +
+	"volumeName\x88\x01\x01B\x13\n" +
+	"\x11_storageClassNameB\r\n" +
+	"\v_volumeName\"a\n" +
+	"\x11PatternExpression\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xaa\x02\n" +
+	"\x16PatternExpressionOrRef\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1e\n" +
+	"\n" +
+	"patternRef\x18\x04 \x01(\tR\n" +
+	"patternRef\x12\x1c\n" +
+	"\tpredicate\x18\x05 \x01(\tR\tpredicate\x12A\n" +
+	"\x03all\x18\x06 \x03(\v2/.kuadrant.v1.UnstructuredPatternExpressionOrRefR\x03all\x12A\n" +
+	"\x03any\x18\a \x03(\v2/.kuadrant.v1.UnstructuredPatternExpressionOrRefR\x03any\"c\n" +
+	" PatternMatchingAuthorizationSpec\x12?\n" +
+	"\bpatterns\x18\x01 \x03(\v2#.kuadrant.v1.PatternExpressionOrRefR\bpatterns\"<\n" +
+	"\x1ePersistentVolumeClaimResources\x12\x1a\n" +
+	"\brequests\x18\x01 \x01(\tR\brequests\"\x81\x01\n" +
+	"\x15PlainAuthResponseSpec\x12,\n" +
+	"\x05value\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12\x1a\n" +
+	"\bselector\x18\x02 \x01(\tR\bselector\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\"O\n" +
+	"\x11PlainIdentitySpec\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\"e\n" +
+	"\x17PodDisruptionBudgetType\x12&\n" +
+	"\x0emaxUnavailable\x18\x01 \x01(\tR\x0emaxUnavailable\x12\"\n" +
+	"\fminAvailable\x18\x02 \x01(\tR\fminAvailable\")\n" +
+	"\tPredicate\x12\x1c\n" +
+	"\tpredicate\x18\x01 \x01(\tR\tpredicate\"\"\n" +
+	"\bPrefixed\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"\xf8\x01\n" +
+	"\x18ProviderRecordConditions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12g\n" +
+	"\x10recordConditions\x18\x02 \x03(\v2;.kuadrant.v1.ProviderRecordConditions.RecordConditionsEntryR\x10recordConditions\x1a_\n" +
+	"\x15RecordConditionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.kuadrant.v1.ConditionListR\x05value:\x028\x01\"!\n" +
+	"\vProviderRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x04Rate\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\tR\x06window\"\xb6\x01\n" +
+	"\x0fRateLimitPolicy\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x124\n" +
+	"\x04spec\x18\x02 \x01(\v2 .kuadrant.v1.RateLimitPolicySpecR\x04spec\x12:\n" +
+	"\x06status\x18\x03 \x01(\v2\".kuadrant.v1.RateLimitPolicyStatusR\x06status\"\x9d\x04\n" +
+	"\x13RateLimitPolicySpec\x12T\n" +
+	"\ttargetRef\x18\x01 \x01(\v26.kuadrant.v1.LocalPolicyTargetReferenceWithSectionNameR\ttargetRef\x12E\n" +
+	"\bdefaults\x18\x02 \x01(\v2).kuadrant.v1.MergeableRateLimitPolicySpecR\bdefaults\x12G\n" +
+	"\toverrides\x18\x03 \x01(\v2).kuadrant.v1.MergeableRateLimitPolicySpecR\toverrides\x12*\n" +
+	"\x04when\x18\x04 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x12D\n" +
+	"\x06limits\x18\x05 \x03(\v2,.kuadrant.v1.RateLimitPolicySpec.LimitsEntryR\x06limits\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12 \n" +
+	"\vfailureMode\x18\a \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\b \x01(\tH\x00R\atimeout\x88\x01\x01\x1aM\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.kuadrant.v1.LimitR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"\xa3\x01\n" +
+	"\x15RateLimitPolicyStatus\x12.\n" +
+	"\x12observedGeneration\x18\x01 \x01(\x03R\x12observedGeneration\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12\"\n" +
+	"\fshadowLimits\x18\x03 \x03(\tR\fshadowLimits\"X\n" +
+	"\x05Redis\x12O\n" +
+	"\x0fconfigSecretRef\x18\x01 \x01(\v2%.kuadrant.v1.CoreLocalObjectReferenceR\x0fconfigSecretRef\"\x99\x01\n" +
+	"\vRedisCached\x12O\n" +
+	"\x0fconfigSecretRef\x18\x01 \x01(\v2%.kuadrant.v1.CoreLocalObjectReferenceR\x0fconfigSecretRef\x129\n" +
+	"\aoptions\x18\x02 \x01(\v2\x1f.kuadrant.v1.RedisCachedOptionsR\aoptions\"\xf8\x01\n" +
+	"\x12RedisCachedOptions\x12&\n" +
+	"\fflush_period\x18\x01 \x01(\x03H\x00R\vflushPeriod\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_cached\x18\x02 \x01(\x03H\x01R\tmaxCached\x88\x01\x01\x12.\n" +
+	"\x10response_timeout\x18\x03 \x01(\x03H\x02R\x0fresponseTimeout\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x03H\x03R\tbatchSize\x88\x01\x01B\x0f\n" +
+	"\r_flush_periodB\r\n" +
+	"\v_max_cachedB\x13\n" +
+	"\x11_response_timeoutB\r\n" +
+	"\v_batch_size\"%\n" +
+	"\x0fRemoteExtension\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\":\n" +
+	"\x12SecretKeyReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"9\n" +
+	"\x0fServiceEndpoint\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\"\xc9\x02\n" +
+	"\x18SpiceDBAuthorizationSpec\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x02 \x01(\bR\binsecure\x12I\n" +
+	"\x0fsharedSecretRef\x18\x03 \x01(\v2\x1f.kuadrant.v1.SecretKeyReferenceR\x0fsharedSecretRef\x124\n" +
+	"\asubject\x18\x04 \x01(\v2\x1a.kuadrant.v1.SpiceDBObjectR\asubject\x126\n" +
+	"\bresource\x18\x05 \x01(\v2\x1a.kuadrant.v1.SpiceDBObjectR\bresource\x12<\n" +
+	"\n" +
+	"permission\x18\x06 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\n" +
+	"permission\"s\n" +
+	"\rSpiceDBObject\x120\n" +
+	"\x04name\x18\x01 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04name\x120\n" +
+	"\x04kind\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x04kind\"\x9b\x01\n" +
+	"\aStorage\x12(\n" +
+	"\x05redis\x18\x01 \x01(\v2\x12.kuadrant.v1.RedisR\x05redis\x12;\n" +
+	"\fredis_cached\x18\x02 \x01(\v2\x18.kuadrant.v1.RedisCachedR\vredisCached\x12)\n" +
+	"\x04disk\x18\x03 \x01(\v2\x15.kuadrant.v1.DiskSpecR\x04disk\"\xa4\x01\n" +
+	"\tTLSPolicy\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.kuadrant.v1.TLSPolicySpecR\x04spec\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.kuadrant.v1.TLSPolicyStatusR\x06status\"\x96\x04\n" +
+	"\rTLSPolicySpec\x12T\n" +
+	"\ttargetRef\x18\x01 \x01(\v26.kuadrant.v1.LocalPolicyTargetReferenceWithSectionNameR\ttargetRef\x12&\n" +
+	"\x0erouteHostnames\x18\t \x01(\bR\x0erouteHostnames\x12E\n" +
+	"\tissuerRef\x18\x02 \x01(\v2'.kuadrant.v1.CertManagerObjectReferenceR\tissuerRef\x12\x1e\n" +
+	"\n" +
+	"commonName\x18\x03 \x01(\tR\n" +
+	"commonName\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12;\n" +
+	"\vrenewBefore\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vrenewBefore\x12\x16\n" +
+	"\x06usages\x18\x06 \x03(\tR\x06usages\x127\n" +
+	"\x14revisionHistoryLimit\x18\a \x01(\x05H\x00R\x14revisionHistoryLimit\x88\x01\x01\x12B\n" +
+	"\n" +
+	"privateKey\x18\b \x01(\v2\".kuadrant.v1.CertificatePrivateKeyR\n" +
+	"privateKeyB\x17\n" +
+	"\x15_revisionHistoryLimit\"\xbd\x01\n" +
+	"\x0fTLSPolicyStatus\x126\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12.\n" +
+	"\x12observedGeneration\x18\x02 \x01(\x03R\x12observedGeneration\x12B\n" +
+	"\fcertificates\x18\x03 \x03(\v2\x1e.kuadrant.v1.CertificateStatusR\fcertificates\"}\n" +
+	"\x03Tls\x12\x1d\n" +
+	"\aenabled\x18\x01 \x01(\bH\x00R\aenabled\x88\x01\x01\x12K\n" +
+	"\rcertSecretRef\x18\x02 \x01(\v2%.kuadrant.v1.CoreLocalObjectReferenceR\rcertSecretRefB\n" +
+	"\n" +
+	"\b_enabled\"\xc2\x01\n" +
+	"\n" +
+	"TokenLimit\x12*\n" +
+	"\x04when\x18\x01 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x12'\n" +
+	"\x05rates\x18\x02 \x03(\v2\x11.kuadrant.v1.RateR\x05rates\x120\n" +
+	"\bcounters\x18\x03 \x03(\v2\x14.kuadrant.v1.CounterR\bcounters\x12-\n" +
+	"\x05usage\x18\x04 \x01(\v2\x17.kuadrant.v1.TokenUsageR\x05usage\"\xc5\x01\n" +
+	"\x14TokenRateLimitPolicy\x121\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x129\n" +
+	"\x04spec\x18\x02 \x01(\v2%.kuadrant.v1.TokenRateLimitPolicySpecR\x04spec\x12?\n" +
+	"\x06status\x18\x03 \x01(\v2'.kuadrant.v1.TokenRateLimitPolicyStatusR\x06status\"\xa2\x04\n" +
+	"\x18TokenRateLimitPolicySpec\x12T\n" +
+	"\ttargetRef\x18\x01 \x01(\v26.kuadrant.v1.LocalPolicyTargetReferenceWithSectionNameR\ttargetRef\x12J\n" +
+	"\bdefaults\x18\x02 \x01(\v2..kuadrant.v1.MergeableTokenRateLimitPolicySpecR\bdefaults\x12L\n" +
+	"\toverrides\x18\x03 \x01(\v2..kuadrant.v1.MergeableTokenRateLimitPolicySpecR\toverrides\x12*\n" +
+	"\x04when\x18\x04 \x03(\v2\x16.kuadrant.v1.PredicateR\x04when\x12I\n" +
+	"\x06limits\x18\x05 \x03(\v21.kuadrant.v1.TokenRateLimitPolicySpec.LimitsEntryR\x06limits\x12 \n" +
+	"\vfailureMode\x18\x06 \x01(\tR\vfailureMode\x12\x1d\n" +
+	"\atimeout\x18\a \x01(\tH\x00R\atimeout\x88\x01\x01\x1aR\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.kuadrant.v1.TokenLimitR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_timeout\"\xae\x01\n" +
+	"\x1aTokenRateLimitPolicyStatus\x12.\n" +
+	"\x12observedGeneration\x18\x01 \x01(\x03R\x12observedGeneration\x126\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
+	"conditions\x12(\n" +
+	"\x0fstreamingLimits\x18\x03 \x03(\tR\x0fstreamingLimits\"l\n" +
+	"\n" +
+	"TokenUsage\x12 \n" +
+	"\vjsonPointer\x18\x01 \x01(\tR\vjsonPointer\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x1c\n" +
+	"\tstreaming\x18\x03 \x01(\bR\tstreaming\"\xae\x01\n" +
+	"\aTracing\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x122\n" +
+	"\x04tags\x18\x02 \x03(\v2\x1e.kuadrant.v1.Tracing.TagsEntryR\x04tags\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"|\n" +
+	"\x0fUmaMetadataSpec\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12M\n" +
+	"\x0ecredentialsRef\x18\x02 \x01(\v2%.kuadrant.v1.CoreLocalObjectReferenceR\x0ecredentialsRef\"\xb6\x02\n" +
+	"\"UnstructuredPatternExpressionOrRef\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1e\n" +
+	"\n" +
+	"patternRef\x18\x04 \x01(\tR\n" +
+	"patternRef\x12\x1c\n" +
+	"\tpredicate\x18\x05 \x01(\tR\tpredicate\x12A\n" +
+	"\x03all\x18\x06 \x03(\v2/.kuadrant.v1.UnstructuredPatternExpressionOrRefR\x03all\x12A\n" +
+	"\x03any\x18\a \x03(\v2/.kuadrant.v1.UnstructuredPatternExpressionOrRefR\x03any\"`\n" +
+	"\x14UserInfoMetadataSpec\x12&\n" +
+	"\x0eidentitySource\x18\x01 \x01(\tR\x0eidentitySource\x12 \n" +
+	"\vuserInfoUrl\x18\x02 \x01(\tR\vuserInfoUrl\"{\n" +
+	"\x0fValueOrSelector\x12,\n" +
+	"\x05value\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12\x1a\n" +
+	"\bselector\x18\x02 \x01(\tR\bselector\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\"\xfa\x02\n" +
+	"\x19WristbandAuthResponseSpec\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\\\n" +
+	"\fcustomClaims\x18\x02 \x03(\v28.kuadrant.v1.WristbandAuthResponseSpec.CustomClaimsEntryR\fcustomClaims\x12)\n" +
+	"\rtokenDuration\x18\x03 \x01(\x03H\x00R\rtokenDuration\x88\x01\x01\x12K\n" +
+	"\x0esigningKeyRefs\x18\x04 \x03(\v2#.kuadrant.v1.WristbandSigningKeyRefR\x0esigningKeyRefs\x1a]\n" +
+	"\x11CustomClaimsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kuadrant.v1.ValueOrSelectorR\x05value:\x028\x01B\x10\n" +
+	"\x0e_tokenDuration\"J\n" +
+	"\x16WristbandSigningKeyRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\"\x87\x01\n" +
+	"'X509ClientCertificateAuthenticationSpec\x126\n" +
+	"\bselector\x18\x01 \x01(\v2\x1a.kuadrant.v1.LabelSelectorR\bselector\x12$\n" +
+	"\rallNamespaces\x18\x02 \x01(\bR\rallNamespacesB\x05Z\x03/v1b\x06proto3"
+
+var (
+	file_v1_kuadrant_api_proto_rawDescOnce sync.Once
+	file_v1_kuadrant_api_proto_rawDescData []byte
+)
+
+func file_v1_kuadrant_api_proto_rawDescGZIP() []byte {
+	file_v1_kuadrant_api_proto_rawDescOnce.Do(func() {
+		file_v1_kuadrant_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_kuadrant_api_proto_rawDesc), len(file_v1_kuadrant_api_proto_rawDesc)))
+	})
+	return file_v1_kuadrant_api_proto_rawDescData
+}
+
+var file_v1_kuadrant_api_proto_msgTypes = make([]protoimpl.MessageInfo, 128)
+var file_v1_kuadrant_api_proto_goTypes = []any{
+	(*AdditionalHeadersRef)(nil),                                // 0: kuadrant.v1.AdditionalHeadersRef
+	(*AnonymousAccessSpec)(nil),                                 // 1: kuadrant.v1.AnonymousAccessSpec
+	(*ApiKeyAuthenticationSpec)(nil),                            // 2: kuadrant.v1.ApiKeyAuthenticationSpec
+	(*AuthPolicy)(nil),                                          // 3: kuadrant.v1.AuthPolicy
+	(*AuthPolicySpec)(nil),                                      // 4: kuadrant.v1.AuthPolicySpec
+	(*AuthPolicyStatus)(nil),                                    // 5: kuadrant.v1.AuthPolicyStatus
+	(*AuthSchemeSpec)(nil),                                      // 6: kuadrant.v1.AuthSchemeSpec
+	(*AuthorinoOIDCServer)(nil),                                 // 7: kuadrant.v1.AuthorinoOIDCServer
+	(*AuthorinoSpec)(nil),                                       // 8: kuadrant.v1.AuthorinoSpec
+	(*AuthorinoStatus)(nil),                                     // 9: kuadrant.v1.AuthorinoStatus
+	(*CertManagerObjectReference)(nil),                          // 10: kuadrant.v1.CertManagerObjectReference
+	(*CertificatePrivateKey)(nil),                               // 11: kuadrant.v1.CertificatePrivateKey
+	(*CertificateStatus)(nil),                                   // 12: kuadrant.v1.CertificateStatus
+	(*Counter)(nil),                                             // 13: kuadrant.v1.Counter
+	(*Credentials)(nil),                                         // 14: kuadrant.v1.Credentials
+	(*CustomHeader)(nil),                                        // 15: kuadrant.v1.CustomHeader
+	(*DNSPolicy)(nil),                                           // 16: kuadrant.v1.DNSPolicy
+	(*DNSPolicySpec)(nil),                                       // 17: kuadrant.v1.DNSPolicySpec
+	(*DNSPolicyStatus)(nil),                                     // 18: kuadrant.v1.DNSPolicyStatus
+	(*DiskSpec)(nil),                                            // 19: kuadrant.v1.DiskSpec
+	(*EvaluatorCaching)(nil),                                    // 20: kuadrant.v1.EvaluatorCaching
+	(*ExtensionStatus)(nil),                                     // 21: kuadrant.v1.ExtensionStatus
+	(*ExtensionsSpec)(nil),                                      // 22: kuadrant.v1.ExtensionsSpec
+	(*ExternalOpaPolicy)(nil),                                   // 23: kuadrant.v1.ExternalOpaPolicy
+	(*GatewayReference)(nil),                                    // 24: kuadrant.v1.GatewayReference
+	(*GatewayServices)(nil),                                     // 25: kuadrant.v1.GatewayServices
+	(*HealthCheckSpec)(nil),                                     // 26: kuadrant.v1.HealthCheckSpec
+	(*HealthCheckStatus)(nil),                                   // 27: kuadrant.v1.HealthCheckStatus
+	(*HealthCheckStatusProbe)(nil),                              // 28: kuadrant.v1.HealthCheckStatusProbe
+	(*HttpEndpointSpec)(nil),                                    // 29: kuadrant.v1.HttpEndpointSpec
+	(*JsonAuthResponseSpec)(nil),                                // 30: kuadrant.v1.JsonAuthResponseSpec
+	(*JwtAuthenticationSpec)(nil),                               // 31: kuadrant.v1.JwtAuthenticationSpec
+	(*Kuadrant)(nil),                                            // 32: kuadrant.v1.Kuadrant
+	(*KuadrantSpec)(nil),                                        // 33: kuadrant.v1.KuadrantSpec
+	(*KuadrantStatus)(nil),                                      // 34: kuadrant.v1.KuadrantStatus
+	(*KubernetesSubjectAccessReviewAuthorizationSpec)(nil),      // 35: kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpec
+	(*KubernetesSubjectAccessReviewResourceAttributesSpec)(nil), // 36: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec
+	(*KubernetesTokenReviewSpec)(nil),                           // 37: kuadrant.v1.KubernetesTokenReviewSpec
+	(*Limit)(nil),                                               // 38: kuadrant.v1.Limit
+	(*LimitadorSpec)(nil),                                       // 39: kuadrant.v1.LimitadorSpec
+	(*LimitadorStatus)(nil),                                     // 40: kuadrant.v1.LimitadorStatus
+	(*LoadBalancingSpec)(nil),                                   // 41: kuadrant.v1.LoadBalancingSpec
+	(*MTLS)(nil),                                                // 42: kuadrant.v1.MTLS
+	(*MergeableAuthPolicySpec)(nil),                             // 43: kuadrant.v1.MergeableAuthPolicySpec
+	(*MergeableAuthenticationSpec)(nil),                         // 44: kuadrant.v1.MergeableAuthenticationSpec
+	(*MergeableAuthorizationSpec)(nil),                          // 45: kuadrant.v1.MergeableAuthorizationSpec
+	(*MergeableCallbackSpec)(nil),                               // 46: kuadrant.v1.MergeableCallbackSpec
+	(*MergeableDenyWithSpec)(nil),                               // 47: kuadrant.v1.MergeableDenyWithSpec
+	(*MergeableHeaderSuccessResponseSpec)(nil),                  // 48: kuadrant.v1.MergeableHeaderSuccessResponseSpec
+	(*MergeableMetadataSpec)(nil),                               // 49: kuadrant.v1.MergeableMetadataSpec
+	(*MergeablePatternExpressions)(nil),                         // 50: kuadrant.v1.MergeablePatternExpressions
+	(*MergeableRateLimitPolicySpec)(nil),                        // 51: kuadrant.v1.MergeableRateLimitPolicySpec
+	(*MergeableResponseSpec)(nil),                               // 52: kuadrant.v1.MergeableResponseSpec
+	(*MergeableSuccessResponseSpec)(nil),                        // 53: kuadrant.v1.MergeableSuccessResponseSpec
+	(*MergeableTokenRateLimitPolicySpec)(nil),                   // 54: kuadrant.v1.MergeableTokenRateLimitPolicySpec
+	(*MergeableWrappedSuccessResponseSpec)(nil),                 // 55: kuadrant.v1.MergeableWrappedSuccessResponseSpec
+	(*Named)(nil),                                               // 56: kuadrant.v1.Named
+	(*OAuth2ClientAuthentication)(nil),                          // 57: kuadrant.v1.OAuth2ClientAuthentication
+	(*OAuth2TokenIntrospectionSpec)(nil),                        // 58: kuadrant.v1.OAuth2TokenIntrospectionSpec
+	(*Observability)(nil),                                       // 59: kuadrant.v1.Observability
+	(*OpaAuthorizationSpec)(nil),                                // 60: kuadrant.v1.OpaAuthorizationSpec
+	(*PVCGenericSpec)(nil),                                      // 61: kuadrant.v1.PVCGenericSpec
+	(*PatternExpression)(nil),                                   // 62: kuadrant.v1.PatternExpression
+	(*PatternExpressionOrRef)(nil),                              // 63: kuadrant.v1.PatternExpressionOrRef
+	(*PatternMatchingAuthorizationSpec)(nil),                    // 64: kuadrant.v1.PatternMatchingAuthorizationSpec
+	(*PersistentVolumeClaimResources)(nil),                      // 65: kuadrant.v1.PersistentVolumeClaimResources
+	(*PlainAuthResponseSpec)(nil),                               // 66: kuadrant.v1.PlainAuthResponseSpec
+	(*PlainIdentitySpec)(nil),                                   // 67: kuadrant.v1.PlainIdentitySpec
+	(*PodDisruptionBudgetType)(nil),                             // 68: kuadrant.v1.PodDisruptionBudgetType
+	(*Predicate)(nil),                                           // 69: kuadrant.v1.Predicate
+	(*Prefixed)(nil),                                            // 70: kuadrant.v1.Prefixed
+	(*ProviderRecordConditions)(nil),                            // 71: kuadrant.v1.ProviderRecordConditions
+	(*ProviderRef)(nil),                                         // 72: kuadrant.v1.ProviderRef
+	(*Rate)(nil),                                                // 73: kuadrant.v1.Rate
+	(*RateLimitPolicy)(nil),                                     // 74: kuadrant.v1.RateLimitPolicy
+	(*RateLimitPolicySpec)(nil),                                 // 75: kuadrant.v1.RateLimitPolicySpec
+	(*RateLimitPolicyStatus)(nil),                               // 76: kuadrant.v1.RateLimitPolicyStatus
+	(*Redis)(nil),                                               // 77: kuadrant.v1.Redis
+	(*RedisCached)(nil),                                         // 78: kuadrant.v1.RedisCached
+	(*RedisCachedOptions)(nil),                                  // 79: kuadrant.v1.RedisCachedOptions
+	(*RemoteExtension)(nil),                                     // 80: kuadrant.v1.RemoteExtension
+	(*SecretKeyReference)(nil),                                  // 81: kuadrant.v1.SecretKeyReference
+	(*ServiceEndpoint)(nil),                                     // 82: kuadrant.v1.ServiceEndpoint
+	(*SpiceDBAuthorizationSpec)(nil),                            // 83: kuadrant.v1.SpiceDBAuthorizationSpec
+	(*SpiceDBObject)(nil),                                       // 84: kuadrant.v1.SpiceDBObject
+	(*Storage)(nil),                                             // 85: kuadrant.v1.Storage
+	(*TLSPolicy)(nil),                                           // 86: kuadrant.v1.TLSPolicy
+	(*TLSPolicySpec)(nil),                                       // 87: kuadrant.v1.TLSPolicySpec
+	(*TLSPolicyStatus)(nil),                                     // 88: kuadrant.v1.TLSPolicyStatus
+	(*Tls)(nil),                                                 // 89: kuadrant.v1.Tls
+	(*TokenLimit)(nil),                                          // 90: kuadrant.v1.TokenLimit
+	(*TokenRateLimitPolicy)(nil),                                // 91: kuadrant.v1.TokenRateLimitPolicy
+	(*TokenRateLimitPolicySpec)(nil),                            // 92: kuadrant.v1.TokenRateLimitPolicySpec
+	(*TokenRateLimitPolicyStatus)(nil),                          // 93: kuadrant.v1.TokenRateLimitPolicyStatus
+	(*TokenUsage)(nil),                                          // 94: kuadrant.v1.TokenUsage
+	(*Tracing)(nil),                                             // 95: kuadrant.v1.Tracing
+	(*UmaMetadataSpec)(nil),                                     // 96: kuadrant.v1.UmaMetadataSpec
+	(*UnstructuredPatternExpressionOrRef)(nil),                  // 97: kuadrant.v1.UnstructuredPatternExpressionOrRef
+	(*UserInfoMetadataSpec)(nil),                                // 98: kuadrant.v1.UserInfoMetadataSpec
+	(*ValueOrSelector)(nil),                                     // 99: kuadrant.v1.ValueOrSelector
+	(*WristbandAuthResponseSpec)(nil),                           // 100: kuadrant.v1.WristbandAuthResponseSpec
+	(*WristbandSigningKeyRef)(nil),                              // 101: kuadrant.v1.WristbandSigningKeyRef
+	(*X509ClientCertificateAuthenticationSpec)(nil),             // 102: kuadrant.v1.X509ClientCertificateAuthenticationSpec
+	nil,                   // 103: kuadrant.v1.AuthPolicySpec.PatternsEntry
+	nil,                   // 104: kuadrant.v1.AuthSchemeSpec.AuthenticationEntry
+	nil,                   // 105: kuadrant.v1.AuthSchemeSpec.MetadataEntry
+	nil,                   // 106: kuadrant.v1.AuthSchemeSpec.AuthorizationEntry
+	nil,                   // 107: kuadrant.v1.AuthSchemeSpec.CallbacksEntry
+	nil,                   // 108: kuadrant.v1.DNSPolicyStatus.RecordConditionsEntry
+	nil,                   // 109: kuadrant.v1.ExternalOpaPolicy.BodyParametersEntry
+	nil,                   // 110: kuadrant.v1.ExternalOpaPolicy.HeadersEntry
+	nil,                   // 111: kuadrant.v1.HttpEndpointSpec.BodyParametersEntry
+	nil,                   // 112: kuadrant.v1.HttpEndpointSpec.HeadersEntry
+	nil,                   // 113: kuadrant.v1.JsonAuthResponseSpec.PropertiesEntry
+	nil,                   // 114: kuadrant.v1.MergeableAuthPolicySpec.PatternsEntry
+	nil,                   // 115: kuadrant.v1.MergeableAuthenticationSpec.OverridesEntry
+	nil,                   // 116: kuadrant.v1.MergeableAuthenticationSpec.DefaultsEntry
+	nil,                   // 117: kuadrant.v1.MergeableDenyWithSpec.HeadersEntry
+	nil,                   // 118: kuadrant.v1.MergeableRateLimitPolicySpec.LimitsEntry
+	nil,                   // 119: kuadrant.v1.MergeableTokenRateLimitPolicySpec.LimitsEntry
+	nil,                   // 120: kuadrant.v1.MergeableWrappedSuccessResponseSpec.HeadersEntry
+	nil,                   // 121: kuadrant.v1.MergeableWrappedSuccessResponseSpec.FiltersEntry
+	nil,                   // 122: kuadrant.v1.OAuth2ClientAuthentication.ExtraParamsEntry
+	nil,                   // 123: kuadrant.v1.ProviderRecordConditions.RecordConditionsEntry
+	nil,                   // 124: kuadrant.v1.RateLimitPolicySpec.LimitsEntry
+	nil,                   // 125: kuadrant.v1.TokenRateLimitPolicySpec.LimitsEntry
+	nil,                   // 126: kuadrant.v1.Tracing.TagsEntry
+	nil,                   // 127: kuadrant.v1.WristbandAuthResponseSpec.CustomClaimsEntry
+	(*LabelSelector)(nil), // 128: kuadrant.v1.LabelSelector
+	(*Metadata)(nil),      // 129: kuadrant.v1.Metadata
+	(*LocalPolicyTargetReferenceWithSectionName)(nil), // 130: kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	(*Condition)(nil),                // 131: kuadrant.v1.Condition
+	(*timestamp.Timestamp)(nil),      // 132: google.protobuf.Timestamp
+	(*duration.Duration)(nil),        // 133: google.protobuf.Duration
+	(*ResourceRequirements)(nil),     // 134: kuadrant.v1.ResourceRequirements
+	(*CoreLocalObjectReference)(nil), // 135: kuadrant.v1.CoreLocalObjectReference
+	(*_struct.Value)(nil),            // 136: google.protobuf.Value
+	(*ConditionList)(nil),            // 137: kuadrant.v1.ConditionList
+}
+var file_v1_kuadrant_api_proto_depIdxs = []int32{
+	128, // 0: kuadrant.v1.ApiKeyAuthenticationSpec.selector:type_name -> kuadrant.v1.LabelSelector
+	129, // 1: kuadrant.v1.AuthPolicy.metadata:type_name -> kuadrant.v1.Metadata
+	4,   // 2: kuadrant.v1.AuthPolicy.spec:type_name -> kuadrant.v1.AuthPolicySpec
+	5,   // 3: kuadrant.v1.AuthPolicy.status:type_name -> kuadrant.v1.AuthPolicyStatus
+	130, // 4: kuadrant.v1.AuthPolicySpec.targetRef:type_name -> kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	43,  // 5: kuadrant.v1.AuthPolicySpec.defaults:type_name -> kuadrant.v1.MergeableAuthPolicySpec
+	43,  // 6: kuadrant.v1.AuthPolicySpec.overrides:type_name -> kuadrant.v1.MergeableAuthPolicySpec
+	103, // 7: kuadrant.v1.AuthPolicySpec.patterns:type_name -> kuadrant.v1.AuthPolicySpec.PatternsEntry
+	69,  // 8: kuadrant.v1.AuthPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	6,   // 9: kuadrant.v1.AuthPolicySpec.rules:type_name -> kuadrant.v1.AuthSchemeSpec
+	131, // 10: kuadrant.v1.AuthPolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	104, // 11: kuadrant.v1.AuthSchemeSpec.authentication:type_name -> kuadrant.v1.AuthSchemeSpec.AuthenticationEntry
+	105, // 12: kuadrant.v1.AuthSchemeSpec.metadata:type_name -> kuadrant.v1.AuthSchemeSpec.MetadataEntry
+	106, // 13: kuadrant.v1.AuthSchemeSpec.authorization:type_name -> kuadrant.v1.AuthSchemeSpec.AuthorizationEntry
+	52,  // 14: kuadrant.v1.AuthSchemeSpec.response:type_name -> kuadrant.v1.MergeableResponseSpec
+	107, // 15: kuadrant.v1.AuthSchemeSpec.callbacks:type_name -> kuadrant.v1.AuthSchemeSpec.CallbacksEntry
+	89,  // 16: kuadrant.v1.AuthorinoOIDCServer.tls:type_name -> kuadrant.v1.Tls
+	7,   // 17: kuadrant.v1.AuthorinoSpec.oidcServer:type_name -> kuadrant.v1.AuthorinoOIDCServer
+	95,  // 18: kuadrant.v1.AuthorinoSpec.tracing:type_name -> kuadrant.v1.Tracing
+	132, // 19: kuadrant.v1.CertificateStatus.notAfter:type_name -> google.protobuf.Timestamp
+	132, // 20: kuadrant.v1.CertificateStatus.renewalTime:type_name -> google.protobuf.Timestamp
+	132, // 21: kuadrant.v1.CertificateStatus.lastFailureTime:type_name -> google.protobuf.Timestamp
+	70,  // 22: kuadrant.v1.Credentials.authorizationHeader:type_name -> kuadrant.v1.Prefixed
+	15,  // 23: kuadrant.v1.Credentials.customHeader:type_name -> kuadrant.v1.CustomHeader
+	56,  // 24: kuadrant.v1.Credentials.queryString:type_name -> kuadrant.v1.Named
+	56,  // 25: kuadrant.v1.Credentials.cookie:type_name -> kuadrant.v1.Named
+	129, // 26: kuadrant.v1.DNSPolicy.metadata:type_name -> kuadrant.v1.Metadata
+	17,  // 27: kuadrant.v1.DNSPolicy.spec:type_name -> kuadrant.v1.DNSPolicySpec
+	18,  // 28: kuadrant.v1.DNSPolicy.status:type_name -> kuadrant.v1.DNSPolicyStatus
+	130, // 29: kuadrant.v1.DNSPolicySpec.targetRef:type_name -> kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	26,  // 30: kuadrant.v1.DNSPolicySpec.healthCheck:type_name -> kuadrant.v1.HealthCheckSpec
+	41,  // 31: kuadrant.v1.DNSPolicySpec.loadBalancing:type_name -> kuadrant.v1.LoadBalancingSpec
+	72,  // 32: kuadrant.v1.DNSPolicySpec.providerRefs:type_name -> kuadrant.v1.ProviderRef
+	131, // 33: kuadrant.v1.DNSPolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	27,  // 34: kuadrant.v1.DNSPolicyStatus.healthCheck:type_name -> kuadrant.v1.HealthCheckStatus
+	108, // 35: kuadrant.v1.DNSPolicyStatus.recordConditions:type_name -> kuadrant.v1.DNSPolicyStatus.RecordConditionsEntry
+	71,  // 36: kuadrant.v1.DNSPolicyStatus.providerRecordConditions:type_name -> kuadrant.v1.ProviderRecordConditions
+	61,  // 37: kuadrant.v1.DiskSpec.persistentVolumeClaim:type_name -> kuadrant.v1.PVCGenericSpec
+	99,  // 38: kuadrant.v1.EvaluatorCaching.key:type_name -> kuadrant.v1.ValueOrSelector
+	133, // 39: kuadrant.v1.ExtensionStatus.lastPingLatency:type_name -> google.protobuf.Duration
+	80,  // 40: kuadrant.v1.ExtensionsSpec.remote:type_name -> kuadrant.v1.RemoteExtension
+	99,  // 41: kuadrant.v1.ExternalOpaPolicy.body:type_name -> kuadrant.v1.ValueOrSelector
+	109, // 42: kuadrant.v1.ExternalOpaPolicy.bodyParameters:type_name -> kuadrant.v1.ExternalOpaPolicy.BodyParametersEntry
+	110, // 43: kuadrant.v1.ExternalOpaPolicy.headers:type_name -> kuadrant.v1.ExternalOpaPolicy.HeadersEntry
+	81,  // 44: kuadrant.v1.ExternalOpaPolicy.sharedSecretRef:type_name -> kuadrant.v1.SecretKeyReference
+	57,  // 45: kuadrant.v1.ExternalOpaPolicy.oauth2:type_name -> kuadrant.v1.OAuth2ClientAuthentication
+	14,  // 46: kuadrant.v1.ExternalOpaPolicy.credentials:type_name -> kuadrant.v1.Credentials
+	24,  // 47: kuadrant.v1.GatewayServices.gateways:type_name -> kuadrant.v1.GatewayReference
+	82,  // 48: kuadrant.v1.GatewayServices.limitador:type_name -> kuadrant.v1.ServiceEndpoint
+	82,  // 49: kuadrant.v1.GatewayServices.authorino:type_name -> kuadrant.v1.ServiceEndpoint
+	133, // 50: kuadrant.v1.HealthCheckSpec.interval:type_name -> google.protobuf.Duration
+	0,   // 51: kuadrant.v1.HealthCheckSpec.additionalHeadersRef:type_name -> kuadrant.v1.AdditionalHeadersRef
+	131, // 52: kuadrant.v1.HealthCheckStatus.conditions:type_name -> kuadrant.v1.Condition
+	28,  // 53: kuadrant.v1.HealthCheckStatus.probes:type_name -> kuadrant.v1.HealthCheckStatusProbe
+	131, // 54: kuadrant.v1.HealthCheckStatusProbe.conditions:type_name -> kuadrant.v1.Condition
+	99,  // 55: kuadrant.v1.HttpEndpointSpec.body:type_name -> kuadrant.v1.ValueOrSelector
+	111, // 56: kuadrant.v1.HttpEndpointSpec.bodyParameters:type_name -> kuadrant.v1.HttpEndpointSpec.BodyParametersEntry
+	112, // 57: kuadrant.v1.HttpEndpointSpec.headers:type_name -> kuadrant.v1.HttpEndpointSpec.HeadersEntry
+	81,  // 58: kuadrant.v1.HttpEndpointSpec.sharedSecretRef:type_name -> kuadrant.v1.SecretKeyReference
+	57,  // 59: kuadrant.v1.HttpEndpointSpec.oauth2:type_name -> kuadrant.v1.OAuth2ClientAuthentication
+	14,  // 60: kuadrant.v1.HttpEndpointSpec.credentials:type_name -> kuadrant.v1.Credentials
+	113, // 61: kuadrant.v1.JsonAuthResponseSpec.properties:type_name -> kuadrant.v1.JsonAuthResponseSpec.PropertiesEntry
+	129, // 62: kuadrant.v1.Kuadrant.metadata:type_name -> kuadrant.v1.Metadata
+	33,  // 63: kuadrant.v1.Kuadrant.spec:type_name -> kuadrant.v1.KuadrantSpec
+	34,  // 64: kuadrant.v1.Kuadrant.status:type_name -> kuadrant.v1.KuadrantStatus
+	59,  // 65: kuadrant.v1.KuadrantSpec.observability:type_name -> kuadrant.v1.Observability
+	42,  // 66: kuadrant.v1.KuadrantSpec.mtls:type_name -> kuadrant.v1.MTLS
+	39,  // 67: kuadrant.v1.KuadrantSpec.limitador:type_name -> kuadrant.v1.LimitadorSpec
+	8,   // 68: kuadrant.v1.KuadrantSpec.authorino:type_name -> kuadrant.v1.AuthorinoSpec
+	25,  // 69: kuadrant.v1.KuadrantSpec.gatewayServices:type_name -> kuadrant.v1.GatewayServices
+	22,  // 70: kuadrant.v1.KuadrantSpec.extensions:type_name -> kuadrant.v1.ExtensionsSpec
+	131, // 71: kuadrant.v1.KuadrantStatus.conditions:type_name -> kuadrant.v1.Condition
+	40,  // 72: kuadrant.v1.KuadrantStatus.limitador:type_name -> kuadrant.v1.LimitadorStatus
+	9,   // 73: kuadrant.v1.KuadrantStatus.authorino:type_name -> kuadrant.v1.AuthorinoStatus
+	21,  // 74: kuadrant.v1.KuadrantStatus.extensions:type_name -> kuadrant.v1.ExtensionStatus
+	99,  // 75: kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpec.user:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 76: kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpec.authorizationGroups:type_name -> kuadrant.v1.ValueOrSelector
+	36,  // 77: kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpec.resourceAttributes:type_name -> kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec
+	99,  // 78: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.group:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 79: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.resource:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 80: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.subresource:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 81: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.name:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 82: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.namespace:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 83: kuadrant.v1.KubernetesSubjectAccessReviewResourceAttributesSpec.verb:type_name -> kuadrant.v1.ValueOrSelector
+	69,  // 84: kuadrant.v1.Limit.when:type_name -> kuadrant.v1.Predicate
+	13,  // 85: kuadrant.v1.Limit.counters:type_name -> kuadrant.v1.Counter
+	73,  // 86: kuadrant.v1.Limit.rates:type_name -> kuadrant.v1.Rate
+	85,  // 87: kuadrant.v1.LimitadorSpec.storage:type_name -> kuadrant.v1.Storage
+	134, // 88: kuadrant.v1.LimitadorSpec.resourceRequirements:type_name -> kuadrant.v1.ResourceRequirements
+	68,  // 89: kuadrant.v1.LimitadorSpec.pdb:type_name -> kuadrant.v1.PodDisruptionBudgetType
+	114, // 90: kuadrant.v1.MergeableAuthPolicySpec.patterns:type_name -> kuadrant.v1.MergeableAuthPolicySpec.PatternsEntry
+	69,  // 91: kuadrant.v1.MergeableAuthPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	6,   // 92: kuadrant.v1.MergeableAuthPolicySpec.rules:type_name -> kuadrant.v1.AuthSchemeSpec
+	63,  // 93: kuadrant.v1.MergeableAuthenticationSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 94: kuadrant.v1.MergeableAuthenticationSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	14,  // 95: kuadrant.v1.MergeableAuthenticationSpec.credentials:type_name -> kuadrant.v1.Credentials
+	115, // 96: kuadrant.v1.MergeableAuthenticationSpec.overrides:type_name -> kuadrant.v1.MergeableAuthenticationSpec.OverridesEntry
+	116, // 97: kuadrant.v1.MergeableAuthenticationSpec.defaults:type_name -> kuadrant.v1.MergeableAuthenticationSpec.DefaultsEntry
+	2,   // 98: kuadrant.v1.MergeableAuthenticationSpec.apiKey:type_name -> kuadrant.v1.ApiKeyAuthenticationSpec
+	31,  // 99: kuadrant.v1.MergeableAuthenticationSpec.jwt:type_name -> kuadrant.v1.JwtAuthenticationSpec
+	58,  // 100: kuadrant.v1.MergeableAuthenticationSpec.oauth2Introspection:type_name -> kuadrant.v1.OAuth2TokenIntrospectionSpec
+	37,  // 101: kuadrant.v1.MergeableAuthenticationSpec.kubernetesTokenReview:type_name -> kuadrant.v1.KubernetesTokenReviewSpec
+	102, // 102: kuadrant.v1.MergeableAuthenticationSpec.x509:type_name -> kuadrant.v1.X509ClientCertificateAuthenticationSpec
+	67,  // 103: kuadrant.v1.MergeableAuthenticationSpec.plain:type_name -> kuadrant.v1.PlainIdentitySpec
+	1,   // 104: kuadrant.v1.MergeableAuthenticationSpec.anonymous:type_name -> kuadrant.v1.AnonymousAccessSpec
+	63,  // 105: kuadrant.v1.MergeableAuthorizationSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 106: kuadrant.v1.MergeableAuthorizationSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	64,  // 107: kuadrant.v1.MergeableAuthorizationSpec.patternMatching:type_name -> kuadrant.v1.PatternMatchingAuthorizationSpec
+	60,  // 108: kuadrant.v1.MergeableAuthorizationSpec.opa:type_name -> kuadrant.v1.OpaAuthorizationSpec
+	35,  // 109: kuadrant.v1.MergeableAuthorizationSpec.kubernetesSubjectAccessReview:type_name -> kuadrant.v1.KubernetesSubjectAccessReviewAuthorizationSpec
+	83,  // 110: kuadrant.v1.MergeableAuthorizationSpec.spicedb:type_name -> kuadrant.v1.SpiceDBAuthorizationSpec
+	63,  // 111: kuadrant.v1.MergeableCallbackSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 112: kuadrant.v1.MergeableCallbackSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	29,  // 113: kuadrant.v1.MergeableCallbackSpec.http:type_name -> kuadrant.v1.HttpEndpointSpec
+	99,  // 114: kuadrant.v1.MergeableDenyWithSpec.message:type_name -> kuadrant.v1.ValueOrSelector
+	117, // 115: kuadrant.v1.MergeableDenyWithSpec.headers:type_name -> kuadrant.v1.MergeableDenyWithSpec.HeadersEntry
+	99,  // 116: kuadrant.v1.MergeableDenyWithSpec.body:type_name -> kuadrant.v1.ValueOrSelector
+	63,  // 117: kuadrant.v1.MergeableHeaderSuccessResponseSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 118: kuadrant.v1.MergeableHeaderSuccessResponseSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	66,  // 119: kuadrant.v1.MergeableHeaderSuccessResponseSpec.plain:type_name -> kuadrant.v1.PlainAuthResponseSpec
+	30,  // 120: kuadrant.v1.MergeableHeaderSuccessResponseSpec.json:type_name -> kuadrant.v1.JsonAuthResponseSpec
+	100, // 121: kuadrant.v1.MergeableHeaderSuccessResponseSpec.wristband:type_name -> kuadrant.v1.WristbandAuthResponseSpec
+	63,  // 122: kuadrant.v1.MergeableMetadataSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 123: kuadrant.v1.MergeableMetadataSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	29,  // 124: kuadrant.v1.MergeableMetadataSpec.http:type_name -> kuadrant.v1.HttpEndpointSpec
+	98,  // 125: kuadrant.v1.MergeableMetadataSpec.userInfo:type_name -> kuadrant.v1.UserInfoMetadataSpec
+	96,  // 126: kuadrant.v1.MergeableMetadataSpec.uma:type_name -> kuadrant.v1.UmaMetadataSpec
+	62,  // 127: kuadrant.v1.MergeablePatternExpressions.allOf:type_name -> kuadrant.v1.PatternExpression
+	69,  // 128: kuadrant.v1.MergeableRateLimitPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	118, // 129: kuadrant.v1.MergeableRateLimitPolicySpec.limits:type_name -> kuadrant.v1.MergeableRateLimitPolicySpec.LimitsEntry
+	47,  // 130: kuadrant.v1.MergeableResponseSpec.unauthenticated:type_name -> kuadrant.v1.MergeableDenyWithSpec
+	47,  // 131: kuadrant.v1.MergeableResponseSpec.unauthorized:type_name -> kuadrant.v1.MergeableDenyWithSpec
+	55,  // 132: kuadrant.v1.MergeableResponseSpec.success:type_name -> kuadrant.v1.MergeableWrappedSuccessResponseSpec
+	63,  // 133: kuadrant.v1.MergeableSuccessResponseSpec.when:type_name -> kuadrant.v1.PatternExpressionOrRef
+	20,  // 134: kuadrant.v1.MergeableSuccessResponseSpec.cache:type_name -> kuadrant.v1.EvaluatorCaching
+	66,  // 135: kuadrant.v1.MergeableSuccessResponseSpec.plain:type_name -> kuadrant.v1.PlainAuthResponseSpec
+	30,  // 136: kuadrant.v1.MergeableSuccessResponseSpec.json:type_name -> kuadrant.v1.JsonAuthResponseSpec
+	100, // 137: kuadrant.v1.MergeableSuccessResponseSpec.wristband:type_name -> kuadrant.v1.WristbandAuthResponseSpec
+	69,  // 138: kuadrant.v1.MergeableTokenRateLimitPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	119, // 139: kuadrant.v1.MergeableTokenRateLimitPolicySpec.limits:type_name -> kuadrant.v1.MergeableTokenRateLimitPolicySpec.LimitsEntry
+	120, // 140: kuadrant.v1.MergeableWrappedSuccessResponseSpec.headers:type_name -> kuadrant.v1.MergeableWrappedSuccessResponseSpec.HeadersEntry
+	121, // 141: kuadrant.v1.MergeableWrappedSuccessResponseSpec.filters:type_name -> kuadrant.v1.MergeableWrappedSuccessResponseSpec.FiltersEntry
+	81,  // 142: kuadrant.v1.OAuth2ClientAuthentication.clientSecretRef:type_name -> kuadrant.v1.SecretKeyReference
+	122, // 143: kuadrant.v1.OAuth2ClientAuthentication.extraParams:type_name -> kuadrant.v1.OAuth2ClientAuthentication.ExtraParamsEntry
+	135, // 144: kuadrant.v1.OAuth2TokenIntrospectionSpec.credentialsRef:type_name -> kuadrant.v1.CoreLocalObjectReference
+	23,  // 145: kuadrant.v1.OpaAuthorizationSpec.externalPolicy:type_name -> kuadrant.v1.ExternalOpaPolicy
+	65,  // 146: kuadrant.v1.PVCGenericSpec.resources:type_name -> kuadrant.v1.PersistentVolumeClaimResources
+	97,  // 147: kuadrant.v1.PatternExpressionOrRef.all:type_name -> kuadrant.v1.UnstructuredPatternExpressionOrRef
+	97,  // 148: kuadrant.v1.PatternExpressionOrRef.any:type_name -> kuadrant.v1.UnstructuredPatternExpressionOrRef
+	63,  // 149: kuadrant.v1.PatternMatchingAuthorizationSpec.patterns:type_name -> kuadrant.v1.PatternExpressionOrRef
+	136, // 150: kuadrant.v1.PlainAuthResponseSpec.value:type_name -> google.protobuf.Value
+	123, // 151: kuadrant.v1.ProviderRecordConditions.recordConditions:type_name -> kuadrant.v1.ProviderRecordConditions.RecordConditionsEntry
+	129, // 152: kuadrant.v1.RateLimitPolicy.metadata:type_name -> kuadrant.v1.Metadata
+	75,  // 153: kuadrant.v1.RateLimitPolicy.spec:type_name -> kuadrant.v1.RateLimitPolicySpec
+	76,  // 154: kuadrant.v1.RateLimitPolicy.status:type_name -> kuadrant.v1.RateLimitPolicyStatus
+	130, // 155: kuadrant.v1.RateLimitPolicySpec.targetRef:type_name -> kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	51,  // 156: kuadrant.v1.RateLimitPolicySpec.defaults:type_name -> kuadrant.v1.MergeableRateLimitPolicySpec
+	51,  // 157: kuadrant.v1.RateLimitPolicySpec.overrides:type_name -> kuadrant.v1.MergeableRateLimitPolicySpec
+	69,  // 158: kuadrant.v1.RateLimitPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	124, // 159: kuadrant.v1.RateLimitPolicySpec.limits:type_name -> kuadrant.v1.RateLimitPolicySpec.LimitsEntry
+	131, // 160: kuadrant.v1.RateLimitPolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	135, // 161: kuadrant.v1.Redis.configSecretRef:type_name -> kuadrant.v1.CoreLocalObjectReference
+	135, // 162: kuadrant.v1.RedisCached.configSecretRef:type_name -> kuadrant.v1.CoreLocalObjectReference
+	79,  // 163: kuadrant.v1.RedisCached.options:type_name -> kuadrant.v1.RedisCachedOptions
+	81,  // 164: kuadrant.v1.SpiceDBAuthorizationSpec.sharedSecretRef:type_name -> kuadrant.v1.SecretKeyReference
+	84,  // 165: kuadrant.v1.SpiceDBAuthorizationSpec.subject:type_name -> kuadrant.v1.SpiceDBObject
+	84,  // 166: kuadrant.v1.SpiceDBAuthorizationSpec.resource:type_name -> kuadrant.v1.SpiceDBObject
+	99,  // 167: kuadrant.v1.SpiceDBAuthorizationSpec.permission:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 168: kuadrant.v1.SpiceDBObject.name:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 169: kuadrant.v1.SpiceDBObject.kind:type_name -> kuadrant.v1.ValueOrSelector
+	77,  // 170: kuadrant.v1.Storage.redis:type_name -> kuadrant.v1.Redis
+	78,  // 171: kuadrant.v1.Storage.redis_cached:type_name -> kuadrant.v1.RedisCached
+	19,  // 172: kuadrant.v1.Storage.disk:type_name -> kuadrant.v1.DiskSpec
+	129, // 173: kuadrant.v1.TLSPolicy.metadata:type_name -> kuadrant.v1.Metadata
+	87,  // 174: kuadrant.v1.TLSPolicy.spec:type_name -> kuadrant.v1.TLSPolicySpec
+	88,  // 175: kuadrant.v1.TLSPolicy.status:type_name -> kuadrant.v1.TLSPolicyStatus
+	130, // 176: kuadrant.v1.TLSPolicySpec.targetRef:type_name -> kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	10,  // 177: kuadrant.v1.TLSPolicySpec.issuerRef:type_name -> kuadrant.v1.CertManagerObjectReference
+	133, // 178: kuadrant.v1.TLSPolicySpec.duration:type_name -> google.protobuf.Duration
+	133, // 179: kuadrant.v1.TLSPolicySpec.renewBefore:type_name -> google.protobuf.Duration
+	11,  // 180: kuadrant.v1.TLSPolicySpec.privateKey:type_name -> kuadrant.v1.CertificatePrivateKey
+	131, // 181: kuadrant.v1.TLSPolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	12,  // 182: kuadrant.v1.TLSPolicyStatus.certificates:type_name -> kuadrant.v1.CertificateStatus
+	135, // 183: kuadrant.v1.Tls.certSecretRef:type_name -> kuadrant.v1.CoreLocalObjectReference
+	69,  // 184: kuadrant.v1.TokenLimit.when:type_name -> kuadrant.v1.Predicate
+	73,  // 185: kuadrant.v1.TokenLimit.rates:type_name -> kuadrant.v1.Rate
+	13,  // 186: kuadrant.v1.TokenLimit.counters:type_name -> kuadrant.v1.Counter
+	94,  // 187: kuadrant.v1.TokenLimit.usage:type_name -> kuadrant.v1.TokenUsage
+	129, // 188: kuadrant.v1.TokenRateLimitPolicy.metadata:type_name -> kuadrant.v1.Metadata
+	92,  // 189: kuadrant.v1.TokenRateLimitPolicy.spec:type_name -> kuadrant.v1.TokenRateLimitPolicySpec
+	93,  // 190: kuadrant.v1.TokenRateLimitPolicy.status:type_name -> kuadrant.v1.TokenRateLimitPolicyStatus
+	130, // 191: kuadrant.v1.TokenRateLimitPolicySpec.targetRef:type_name -> kuadrant.v1.LocalPolicyTargetReferenceWithSectionName
+	54,  // 192: kuadrant.v1.TokenRateLimitPolicySpec.defaults:type_name -> kuadrant.v1.MergeableTokenRateLimitPolicySpec
+	54,  // 193: kuadrant.v1.TokenRateLimitPolicySpec.overrides:type_name -> kuadrant.v1.MergeableTokenRateLimitPolicySpec
+	69,  // 194: kuadrant.v1.TokenRateLimitPolicySpec.when:type_name -> kuadrant.v1.Predicate
+	125, // 195: kuadrant.v1.TokenRateLimitPolicySpec.limits:type_name -> kuadrant.v1.TokenRateLimitPolicySpec.LimitsEntry
+	131, // 196: kuadrant.v1.TokenRateLimitPolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	126, // 197: kuadrant.v1.Tracing.tags:type_name -> kuadrant.v1.Tracing.TagsEntry
+	135, // 198: kuadrant.v1.UmaMetadataSpec.credentialsRef:type_name -> kuadrant.v1.CoreLocalObjectReference
+	97,  // 199: kuadrant.v1.UnstructuredPatternExpressionOrRef.all:type_name -> kuadrant.v1.UnstructuredPatternExpressionOrRef
+	97,  // 200: kuadrant.v1.UnstructuredPatternExpressionOrRef.any:type_name -> kuadrant.v1.UnstructuredPatternExpressionOrRef
+	136, // 201: kuadrant.v1.ValueOrSelector.value:type_name -> google.protobuf.Value
+	127, // 202: kuadrant.v1.WristbandAuthResponseSpec.customClaims:type_name -> kuadrant.v1.WristbandAuthResponseSpec.CustomClaimsEntry
+	101, // 203: kuadrant.v1.WristbandAuthResponseSpec.signingKeyRefs:type_name -> kuadrant.v1.WristbandSigningKeyRef
+	128, // 204: kuadrant.v1.X509ClientCertificateAuthenticationSpec.selector:type_name -> kuadrant.v1.LabelSelector
+	50,  // 205: kuadrant.v1.AuthPolicySpec.PatternsEntry.value:type_name -> kuadrant.v1.MergeablePatternExpressions
+	44,  // 206: kuadrant.v1.AuthSchemeSpec.AuthenticationEntry.value:type_name -> kuadrant.v1.MergeableAuthenticationSpec
+	49,  // 207: kuadrant.v1.AuthSchemeSpec.MetadataEntry.value:type_name -> kuadrant.v1.MergeableMetadataSpec
+	45,  // 208: kuadrant.v1.AuthSchemeSpec.AuthorizationEntry.value:type_name -> kuadrant.v1.MergeableAuthorizationSpec
+	46,  // 209: kuadrant.v1.AuthSchemeSpec.CallbacksEntry.value:type_name -> kuadrant.v1.MergeableCallbackSpec
+	137, // 210: kuadrant.v1.DNSPolicyStatus.RecordConditionsEntry.value:type_name -> kuadrant.v1.ConditionList
+	99,  // 211: kuadrant.v1.ExternalOpaPolicy.BodyParametersEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 212: kuadrant.v1.ExternalOpaPolicy.HeadersEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 213: kuadrant.v1.HttpEndpointSpec.BodyParametersEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 214: kuadrant.v1.HttpEndpointSpec.HeadersEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 215: kuadrant.v1.JsonAuthResponseSpec.PropertiesEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	50,  // 216: kuadrant.v1.MergeableAuthPolicySpec.PatternsEntry.value:type_name -> kuadrant.v1.MergeablePatternExpressions
+	99,  // 217: kuadrant.v1.MergeableAuthenticationSpec.OverridesEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 218: kuadrant.v1.MergeableAuthenticationSpec.DefaultsEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	99,  // 219: kuadrant.v1.MergeableDenyWithSpec.HeadersEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	38,  // 220: kuadrant.v1.MergeableRateLimitPolicySpec.LimitsEntry.value:type_name -> kuadrant.v1.Limit
+	90,  // 221: kuadrant.v1.MergeableTokenRateLimitPolicySpec.LimitsEntry.value:type_name -> kuadrant.v1.TokenLimit
+	48,  // 222: kuadrant.v1.MergeableWrappedSuccessResponseSpec.HeadersEntry.value:type_name -> kuadrant.v1.MergeableHeaderSuccessResponseSpec
+	53,  // 223: kuadrant.v1.MergeableWrappedSuccessResponseSpec.FiltersEntry.value:type_name -> kuadrant.v1.MergeableSuccessResponseSpec
+	137, // 224: kuadrant.v1.ProviderRecordConditions.RecordConditionsEntry.value:type_name -> kuadrant.v1.ConditionList
+	38,  // 225: kuadrant.v1.RateLimitPolicySpec.LimitsEntry.value:type_name -> kuadrant.v1.Limit
+	90,  // 226: kuadrant.v1.TokenRateLimitPolicySpec.LimitsEntry.value:type_name -> kuadrant.v1.TokenLimit
+	99,  // 227: kuadrant.v1.WristbandAuthResponseSpec.CustomClaimsEntry.value:type_name -> kuadrant.v1.ValueOrSelector
+	228, // [228:228] is the sub-list for method output_type
+	228, // [228:228] is the sub-list for method input_type
+	228, // [228:228] is the sub-list for extension type_name
+	228, // [228:228] is the sub-list for extension extendee
+	0,   // [0:228] is the sub-list for field type_name
+}
+
+func init() { file_v1_kuadrant_api_proto_init() }
+func file_v1_kuadrant_api_proto_init() {
+	if File_v1_kuadrant_api_proto != nil {
+		return
+	}
+	file_v1_common_proto_init()
+	file_v1_gateway_api_proto_init()
+	file_v1_k8s_api_proto_init()
+	file_v1_kuadrant_api_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[8].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[9].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[19].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[23].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[29].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[34].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[39].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[40].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[42].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[43].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[51].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[54].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[57].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[61].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[75].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[79].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[87].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[89].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[92].OneofWrappers = []any{}
+	file_v1_kuadrant_api_proto_msgTypes[100].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_kuadrant_api_proto_rawDesc), len(file_v1_kuadrant_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   128,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_kuadrant_api_proto_goTypes,
+		DependencyIndexes: file_v1_kuadrant_api_proto_depIdxs,
+		MessageInfos:      file_v1_kuadrant_api_proto_msgTypes,
+	}.Build()
+	File_v1_kuadrant_api_proto = out.File
+	file_v1_kuadrant_api_proto_goTypes = nil
+	file_v1_kuadrant_api_proto_depIdxs = nil
+}
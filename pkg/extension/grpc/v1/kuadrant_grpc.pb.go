@@ -25,6 +25,8 @@ const (
 	ExtensionService_Resolve_FullMethodName         = "/kuadrant.v1.ExtensionService/Resolve"
 	ExtensionService_RegisterMutator_FullMethodName = "/kuadrant.v1.ExtensionService/RegisterMutator"
 	ExtensionService_ClearPolicy_FullMethodName     = "/kuadrant.v1.ExtensionService/ClearPolicy"
+	ExtensionService_ReportStatus_FullMethodName    = "/kuadrant.v1.ExtensionService/ReportStatus"
+	ExtensionService_GetCapabilities_FullMethodName = "/kuadrant.v1.ExtensionService/GetCapabilities"
 )
 
 // ExtensionServiceClient is the client API for ExtensionService service.
@@ -43,6 +45,10 @@ type ExtensionServiceClient interface {
 	RegisterMutator(ctx context.Context, in *RegisterMutatorRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Clear all subscriptions and registered mutators for a policy
 	ClearPolicy(ctx context.Context, in *ClearPolicyRequest, opts ...grpc.CallOption) (*ClearPolicyResponse, error)
+	// Report the status conditions of a policy, surfaced on the objects it targets
+	ReportStatus(ctx context.Context, in *ReportStatusRequest, opts ...grpc.CallOption) (*ReportStatusResponse, error)
+	// Negotiate the version of the API and the features the extension requires with the ones kuadrant-operator supports
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
 }
 
 type extensionServiceClient struct {
@@ -112,6 +118,26 @@ func (c *extensionServiceClient) ClearPolicy(ctx context.Context, in *ClearPolic
 	return out, nil
 }
 
+func (c *extensionServiceClient) ReportStatus(ctx context.Context, in *ReportStatusRequest, opts ...grpc.CallOption) (*ReportStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportStatusResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_ReportStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, ExtensionService_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExtensionServiceServer is the server API for ExtensionService service.
 // All implementations must embed UnimplementedExtensionServiceServer
 // for forward compatibility.
@@ -128,6 +154,10 @@ type ExtensionServiceServer interface {
 	RegisterMutator(context.Context, *RegisterMutatorRequest) (*empty.Empty, error)
 	// Clear all subscriptions and registered mutators for a policy
 	ClearPolicy(context.Context, *ClearPolicyRequest) (*ClearPolicyResponse, error)
+	// Report the status conditions of a policy, surfaced on the objects it targets
+	ReportStatus(context.Context, *ReportStatusRequest) (*ReportStatusResponse, error)
+	// Negotiate the version of the API and the features the extension requires with the ones kuadrant-operator supports
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
 	mustEmbedUnimplementedExtensionServiceServer()
 }
 
@@ -153,6 +183,12 @@ func (UnimplementedExtensionServiceServer) RegisterMutator(context.Context, *Reg
 func (UnimplementedExtensionServiceServer) ClearPolicy(context.Context, *ClearPolicyRequest) (*ClearPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearPolicy not implemented")
 }
+func (UnimplementedExtensionServiceServer) ReportStatus(context.Context, *ReportStatusRequest) (*ReportStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportStatus not implemented")
+}
+func (UnimplementedExtensionServiceServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedExtensionServiceServer) mustEmbedUnimplementedExtensionServiceServer() {}
 func (UnimplementedExtensionServiceServer) testEmbeddedByValue()                          {}
 
@@ -257,6 +293,42 @@ func _ExtensionService_ClearPolicy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExtensionService_ReportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).ReportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_ReportStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).ReportStatus(ctx, req.(*ReportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExtensionService_ServiceDesc is the grpc.ServiceDesc for ExtensionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -280,6 +352,14 @@ var ExtensionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearPolicy",
 			Handler:    _ExtensionService_ClearPolicy_Handler,
 		},
+		{
+			MethodName: "ReportStatus",
+			Handler:    _ExtensionService_ReportStatus_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _ExtensionService_GetCapabilities_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7,6 +7,8 @@
 package v1
 
 import (
+	any1 "github.com/golang/protobuf/ptypes/any"
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,10 +24,12 @@ const (
 )
 
 type Policy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	TargetRefs    []*TargetRef           `protobuf:"bytes,2,rep,name=targetRefs,proto3" json:"targetRefs,omitempty"`
-	Status        *PolicyStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Metadata   *Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TargetRefs []*TargetRef           `protobuf:"bytes,2,rep,name=targetRefs,proto3" json:"targetRefs,omitempty"`
+	Status     *PolicyStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// spec is the spec of a Kuadrant policy, e.g. a kuadrant.v1.RateLimitPolicySpec
+	Spec          *any1.Any `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +85,13 @@ func (x *Policy) GetStatus() *PolicyStatus {
 	return nil
 }
 
+func (x *Policy) GetSpec() *any1.Any {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
 type PolicyStatus struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	ObservedGeneration int64                  `protobuf:"varint,1,opt,name=observedGeneration,proto3" json:"observedGeneration,omitempty"`
@@ -133,22 +144,151 @@ func (x *PolicyStatus) GetConditions() []*Condition {
 	return nil
 }
 
+// EffectivePolicy is the result of merging the policies of a kind along a path of the topology,
+// from a gateway class down to a route rule
+type EffectivePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// path is the sequence of targetables, from the gateway class to the route rule
+	Path          []*TargetRef              `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Rules         map[string]*MergeableRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectivePolicy) Reset() {
+	*x = EffectivePolicy{}
+	mi := &file_v1_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectivePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectivePolicy) ProtoMessage() {}
+
+func (x *EffectivePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectivePolicy.ProtoReflect.Descriptor instead.
+func (*EffectivePolicy) Descriptor() ([]byte, []int) {
+	return file_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EffectivePolicy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EffectivePolicy) GetPath() []*TargetRef {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *EffectivePolicy) GetRules() map[string]*MergeableRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type MergeableRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// spec is the rule as in the spec of the policy it comes from
+	Spec *_struct.Value `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// source is the locator of the policy the rule comes from
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeableRule) Reset() {
+	*x = MergeableRule{}
+	mi := &file_v1_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeableRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeableRule) ProtoMessage() {}
+
+func (x *MergeableRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeableRule.ProtoReflect.Descriptor instead.
+func (*MergeableRule) Descriptor() ([]byte, []int) {
+	return file_v1_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeableRule) GetSpec() *_struct.Value {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *MergeableRule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 var File_v1_policy_proto protoreflect.FileDescriptor
 
 const file_v1_policy_proto_rawDesc = "" +
 	"\n" +
-	"\x0fv1/policy.proto\x12\vkuadrant.v1\x1a\x0fv1/common.proto\"\xa6\x01\n" +
+	"\x0fv1/policy.proto\x12\vkuadrant.v1\x1a\x19google/protobuf/any.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x0fv1/common.proto\"\xd0\x01\n" +
 	"\x06Policy\x121\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\bmetadata\x126\n" +
 	"\n" +
 	"targetRefs\x18\x02 \x03(\v2\x16.kuadrant.v1.TargetRefR\n" +
 	"targetRefs\x121\n" +
-	"\x06status\x18\x03 \x01(\v2\x19.kuadrant.v1.PolicyStatusR\x06status\"v\n" +
+	"\x06status\x18\x03 \x01(\v2\x19.kuadrant.v1.PolicyStatusR\x06status\x12(\n" +
+	"\x04spec\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\x04spec\"v\n" +
 	"\fPolicyStatus\x12.\n" +
 	"\x12observedGeneration\x18\x01 \x01(\x03R\x12observedGeneration\x126\n" +
 	"\n" +
 	"conditions\x18\x02 \x03(\v2\x16.kuadrant.v1.ConditionR\n" +
-	"conditionsB\x05Z\x03/v1b\x06proto3"
+	"conditions\"\xe6\x01\n" +
+	"\x0fEffectivePolicy\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12*\n" +
+	"\x04path\x18\x02 \x03(\v2\x16.kuadrant.v1.TargetRefR\x04path\x12=\n" +
+	"\x05rules\x18\x03 \x03(\v2'.kuadrant.v1.EffectivePolicy.RulesEntryR\x05rules\x1aT\n" +
+	"\n" +
+	"RulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.kuadrant.v1.MergeableRuleR\x05value:\x028\x01\"S\n" +
+	"\rMergeableRule\x12*\n" +
+	"\x04spec\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x04spec\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06sourceB\x05Z\x03/v1b\x06proto3"
 
 var (
 	file_v1_policy_proto_rawDescOnce sync.Once
@@ -162,24 +302,34 @@ func file_v1_policy_proto_rawDescGZIP() []byte {
 	return file_v1_policy_proto_rawDescData
 }
 
-var file_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_v1_policy_proto_goTypes = []any{
-	(*Policy)(nil),       // 0: kuadrant.v1.Policy
-	(*PolicyStatus)(nil), // 1: kuadrant.v1.PolicyStatus
-	(*Metadata)(nil),     // 2: kuadrant.v1.Metadata
-	(*TargetRef)(nil),    // 3: kuadrant.v1.TargetRef
-	(*Condition)(nil),    // 4: kuadrant.v1.Condition
+	(*Policy)(nil),          // 0: kuadrant.v1.Policy
+	(*PolicyStatus)(nil),    // 1: kuadrant.v1.PolicyStatus
+	(*EffectivePolicy)(nil), // 2: kuadrant.v1.EffectivePolicy
+	(*MergeableRule)(nil),   // 3: kuadrant.v1.MergeableRule
+	nil,                     // 4: kuadrant.v1.EffectivePolicy.RulesEntry
+	(*Metadata)(nil),        // 5: kuadrant.v1.Metadata
+	(*TargetRef)(nil),       // 6: kuadrant.v1.TargetRef
+	(*any1.Any)(nil),        // 7: google.protobuf.Any
+	(*Condition)(nil),       // 8: kuadrant.v1.Condition
+	(*_struct.Value)(nil),   // 9: google.protobuf.Value
 }
 var file_v1_policy_proto_depIdxs = []int32{
-	2, // 0: kuadrant.v1.Policy.metadata:type_name -> kuadrant.v1.Metadata
-	3, // 1: kuadrant.v1.Policy.targetRefs:type_name -> kuadrant.v1.TargetRef
+	5, // 0: kuadrant.v1.Policy.metadata:type_name -> kuadrant.v1.Metadata
+	6, // 1: kuadrant.v1.Policy.targetRefs:type_name -> kuadrant.v1.TargetRef
 	1, // 2: kuadrant.v1.Policy.status:type_name -> kuadrant.v1.PolicyStatus
-	4, // 3: kuadrant.v1.PolicyStatus.conditions:type_name -> kuadrant.v1.Condition
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7, // 3: kuadrant.v1.Policy.spec:type_name -> google.protobuf.Any
+	8, // 4: kuadrant.v1.PolicyStatus.conditions:type_name -> kuadrant.v1.Condition
+	6, // 5: kuadrant.v1.EffectivePolicy.path:type_name -> kuadrant.v1.TargetRef
+	4, // 6: kuadrant.v1.EffectivePolicy.rules:type_name -> kuadrant.v1.EffectivePolicy.RulesEntry
+	9, // 7: kuadrant.v1.MergeableRule.spec:type_name -> google.protobuf.Value
+	3, // 8: kuadrant.v1.EffectivePolicy.RulesEntry.value:type_name -> kuadrant.v1.MergeableRule
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_v1_policy_proto_init() }
@@ -194,7 +344,7 @@ func file_v1_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_policy_proto_rawDesc), len(file_v1_policy_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: v1/topology.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GatewayListener is a listener along with the gateway it belongs to
+type GatewayListener struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       *Metadata              `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Listener      *Listener              `protobuf:"bytes,2,opt,name=listener,proto3" json:"listener,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayListener) Reset() {
+	*x = GatewayListener{}
+	mi := &file_v1_topology_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayListener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayListener) ProtoMessage() {}
+
+func (x *GatewayListener) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_topology_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayListener.ProtoReflect.Descriptor instead.
+func (*GatewayListener) Descriptor() ([]byte, []int) {
+	return file_v1_topology_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewayListener) GetGateway() *Metadata {
+	if x != nil {
+		return x.Gateway
+	}
+	return nil
+}
+
+func (x *GatewayListener) GetListener() *Listener {
+	if x != nil {
+		return x.Listener
+	}
+	return nil
+}
+
+var File_v1_topology_proto protoreflect.FileDescriptor
+
+const file_v1_topology_proto_rawDesc = "" +
+	"\n" +
+	"\x11v1/topology.proto\x12\vkuadrant.v1\x1a\x0fv1/common.proto\x1a\x14v1/gateway_api.proto\"u\n" +
+	"\x0fGatewayListener\x12/\n" +
+	"\agateway\x18\x01 \x01(\v2\x15.kuadrant.v1.MetadataR\agateway\x121\n" +
+	"\blistener\x18\x02 \x01(\v2\x15.kuadrant.v1.ListenerR\blistenerB\x05Z\x03/v1b\x06proto3"
+
+var (
+	file_v1_topology_proto_rawDescOnce sync.Once
+	file_v1_topology_proto_rawDescData []byte
+)
+
+func file_v1_topology_proto_rawDescGZIP() []byte {
+	file_v1_topology_proto_rawDescOnce.Do(func() {
+		file_v1_topology_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_topology_proto_rawDesc), len(file_v1_topology_proto_rawDesc)))
+	})
+	return file_v1_topology_proto_rawDescData
+}
+
+var file_v1_topology_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v1_topology_proto_goTypes = []any{
+	(*GatewayListener)(nil), // 0: kuadrant.v1.GatewayListener
+	(*Metadata)(nil),        // 1: kuadrant.v1.Metadata
+	(*Listener)(nil),        // 2: kuadrant.v1.Listener
+}
+var file_v1_topology_proto_depIdxs = []int32{
+	1, // 0: kuadrant.v1.GatewayListener.gateway:type_name -> kuadrant.v1.Metadata
+	2, // 1: kuadrant.v1.GatewayListener.listener:type_name -> kuadrant.v1.Listener
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_topology_proto_init() }
+func file_v1_topology_proto_init() {
+	if File_v1_topology_proto != nil {
+		return
+	}
+	file_v1_common_proto_init()
+	file_v1_gateway_api_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_topology_proto_rawDesc), len(file_v1_topology_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_topology_proto_goTypes,
+		DependencyIndexes: file_v1_topology_proto_depIdxs,
+		MessageInfos:      file_v1_topology_proto_msgTypes,
+	}.Build()
+	File_v1_topology_proto = out.File
+	file_v1_topology_proto_goTypes = nil
+	file_v1_topology_proto_depIdxs = nil
+}
@@ -0,0 +1,1946 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package v1
+
+import (
+	certmanv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmanmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	authorinooperatorv1beta1 "github.com/kuadrant/authorino-operator/api/v1beta1"
+	authorinov1beta3 "github.com/kuadrant/authorino/api/v1beta3"
+	kuadrantdnsv1alpha1 "github.com/kuadrant/dns-operator/api/v1alpha1"
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	limitadorv1alpha1 "github.com/kuadrant/limitador-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func convertAdditionalHeadersRef(in *kuadrantdnsv1alpha1.AdditionalHeadersRef) *AdditionalHeadersRef {
+	if in == nil {
+		return nil
+	}
+	return &AdditionalHeadersRef{
+		Name: in.Name,
+	}
+}
+
+func convertAllowedRoutes(in *gatewayapiv1.AllowedRoutes) *AllowedRoutes {
+	if in == nil {
+		return nil
+	}
+	return &AllowedRoutes{
+		Namespaces: convertRouteNamespaces(in.Namespaces),
+		Kinds:      convertList(in.Kinds, convertRouteGroupKind),
+	}
+}
+
+func convertAnonymousAccessSpec(in *authorinov1beta3.AnonymousAccessSpec) *AnonymousAccessSpec {
+	if in == nil {
+		return nil
+	}
+	return &AnonymousAccessSpec{}
+}
+
+func convertApiKeyAuthenticationSpec(in *authorinov1beta3.ApiKeyAuthenticationSpec) *ApiKeyAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	return &ApiKeyAuthenticationSpec{
+		Selector:      convertLabelSelector(in.Selector),
+		AllNamespaces: in.AllNamespaces,
+	}
+}
+
+// ConvertAuthPolicy converts a kuadrantv1.AuthPolicy into its protobuf representation
+func ConvertAuthPolicy(in *kuadrantv1.AuthPolicy) *AuthPolicy {
+	if in == nil {
+		return nil
+	}
+	return &AuthPolicy{
+		Metadata: convertObjectMeta("kuadrant.io", "AuthPolicy", &in.ObjectMeta),
+		Spec:     convertAuthPolicySpec(&in.Spec),
+		Status:   convertAuthPolicyStatus(&in.Status),
+	}
+}
+
+func convertAuthPolicySpec(in *kuadrantv1.AuthPolicySpec) *AuthPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &AuthPolicySpec{
+		TargetRef:   convertLocalPolicyTargetReferenceWithSectionName(&in.TargetRef),
+		Defaults:    convertMergeableAuthPolicySpec(in.Defaults),
+		Overrides:   convertMergeableAuthPolicySpec(in.Overrides),
+		Patterns:    convertMap(in.AuthPolicySpecProper.NamedPatterns, convertMergeablePatternExpressions),
+		When:        convertList(in.AuthPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Rules:       convertAuthSchemeSpec(in.AuthPolicySpecProper.AuthScheme),
+		Mode:        string(in.AuthPolicySpecProper.Mode),
+		FailureMode: string(in.AuthPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.AuthPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertAuthPolicyStatus(in *kuadrantv1.AuthPolicyStatus) *AuthPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	return &AuthPolicyStatus{
+		ObservedGeneration: in.ObservedGeneration,
+		Conditions:         convertList(in.Conditions, convertCondition),
+	}
+}
+
+func convertAuthSchemeSpec(in *kuadrantv1.AuthSchemeSpec) *AuthSchemeSpec {
+	if in == nil {
+		return nil
+	}
+	return &AuthSchemeSpec{
+		Authentication: convertMap(in.Authentication, convertMergeableAuthenticationSpec),
+		Metadata:       convertMap(in.Metadata, convertMergeableMetadataSpec),
+		Authorization:  convertMap(in.Authorization, convertMergeableAuthorizationSpec),
+		Response:       convertMergeableResponseSpec(in.Response),
+		Callbacks:      convertMap(in.Callbacks, convertMergeableCallbackSpec),
+	}
+}
+
+func convertAuthorinoOIDCServer(in *kuadrantv1beta1.AuthorinoOIDCServer) *AuthorinoOIDCServer {
+	if in == nil {
+		return nil
+	}
+	return &AuthorinoOIDCServer{
+		Tls: convertTls(&in.TLS),
+	}
+}
+
+func convertAuthorinoSpec(in *kuadrantv1beta1.AuthorinoSpec) *AuthorinoSpec {
+	if in == nil {
+		return nil
+	}
+	return &AuthorinoSpec{
+		Replicas:           optionalInt32(in.Replicas),
+		LogLevel:           in.LogLevel,
+		LogMode:            in.LogMode,
+		EvaluatorCacheSize: optionalInt64(in.EvaluatorCacheSize),
+		OidcServer:         convertAuthorinoOIDCServer(in.OIDCServer),
+		Tracing:            convertTracing(in.Tracing),
+	}
+}
+
+func convertAuthorinoStatus(in *kuadrantv1beta1.AuthorinoStatus) *AuthorinoStatus {
+	if in == nil {
+		return nil
+	}
+	return &AuthorinoStatus{
+		Replicas:           in.Replicas,
+		LogLevel:           in.LogLevel,
+		EvaluatorCacheSize: optionalInt64(in.EvaluatorCacheSize),
+		OidcServerTLS:      optionalBool(in.OIDCServerTLS),
+		TracingEndpoint:    in.TracingEndpoint,
+	}
+}
+
+func convertBackendObjectReference(in *gatewayapiv1.BackendObjectReference) *BackendObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &BackendObjectReference{
+		Group:     optionalString(in.Group),
+		Kind:      optionalString(in.Kind),
+		Name:      string(in.Name),
+		Namespace: optionalString(in.Namespace),
+		Port:      optionalInt32(in.Port),
+	}
+}
+
+func convertCertManagerObjectReference(in *certmanmetav1.ObjectReference) *CertManagerObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &CertManagerObjectReference{
+		Name:  in.Name,
+		Kind:  in.Kind,
+		Group: in.Group,
+	}
+}
+
+func convertCertificatePrivateKey(in *certmanv1.CertificatePrivateKey) *CertificatePrivateKey {
+	if in == nil {
+		return nil
+	}
+	return &CertificatePrivateKey{
+		RotationPolicy: string(in.RotationPolicy),
+		Encoding:       string(in.Encoding),
+		Algorithm:      string(in.Algorithm),
+		Size:           int64(in.Size),
+	}
+}
+
+func convertCertificateStatus(in *kuadrantv1.CertificateStatus) *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	return &CertificateStatus{
+		Name:              in.Name,
+		Namespace:         in.Namespace,
+		Gateway:           in.Gateway,
+		Listener:          in.Listener,
+		NotAfter:          convertTime(in.NotAfter),
+		RenewalTime:       convertTime(in.RenewalTime),
+		LastFailureTime:   convertTime(in.LastFailureTime),
+		LastFailureReason: in.LastFailureReason,
+	}
+}
+
+func convertCookieConfig(in *gatewayapiv1.CookieConfig) *CookieConfig {
+	if in == nil {
+		return nil
+	}
+	return &CookieConfig{
+		LifetimeType: optionalString(in.LifetimeType),
+	}
+}
+
+func convertCoreLocalObjectReference(in *corev1.LocalObjectReference) *CoreLocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &CoreLocalObjectReference{
+		Name: in.Name,
+	}
+}
+
+func convertCounter(in *kuadrantv1.Counter) *Counter {
+	if in == nil {
+		return nil
+	}
+	return &Counter{
+		Expression: string(in.Expression),
+	}
+}
+
+func convertCredentials(in *authorinov1beta3.Credentials) *Credentials {
+	if in == nil {
+		return nil
+	}
+	return &Credentials{
+		AuthorizationHeader: convertPrefixed(in.AuthorizationHeader),
+		CustomHeader:        convertCustomHeader(in.CustomHeader),
+		QueryString:         convertNamed(in.QueryString),
+		Cookie:              convertNamed(in.Cookie),
+	}
+}
+
+func convertCustomHeader(in *authorinov1beta3.CustomHeader) *CustomHeader {
+	if in == nil {
+		return nil
+	}
+	return &CustomHeader{
+		Name: in.Named.Name,
+	}
+}
+
+// ConvertDNSPolicy converts a kuadrantv1.DNSPolicy into its protobuf representation
+func ConvertDNSPolicy(in *kuadrantv1.DNSPolicy) *DNSPolicy {
+	if in == nil {
+		return nil
+	}
+	return &DNSPolicy{
+		Metadata: convertObjectMeta("kuadrant.io", "DNSPolicy", &in.ObjectMeta),
+		Spec:     convertDNSPolicySpec(&in.Spec),
+		Status:   convertDNSPolicyStatus(&in.Status),
+	}
+}
+
+func convertDNSPolicySpec(in *kuadrantv1.DNSPolicySpec) *DNSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &DNSPolicySpec{
+		TargetRef:        convertLocalPolicyTargetReferenceWithSectionName(&in.TargetRef),
+		HealthCheck:      convertHealthCheckSpec(in.HealthCheck),
+		LoadBalancing:    convertLoadBalancingSpec(in.LoadBalancing),
+		ProviderRefs:     convertList(in.ProviderRefs, convertProviderRef),
+		ExcludeAddresses: stringList(in.ExcludeAddresses),
+		RouteHostnames:   in.RouteHostnames,
+		Delegate:         in.Delegate,
+	}
+}
+
+func convertDNSPolicyStatus(in *kuadrantv1.DNSPolicyStatus) *DNSPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	return &DNSPolicyStatus{
+		Conditions:               convertList(in.Conditions, convertCondition),
+		ObservedGeneration:       in.ObservedGeneration,
+		HealthCheck:              convertHealthCheckStatus(in.HealthCheck),
+		RecordConditions:         convertMap(in.RecordConditions, convertConditionList),
+		ProviderRecordConditions: convertList(in.ProviderRecordConditions, convertProviderRecordConditions),
+		TotalRecords:             in.TotalRecords,
+	}
+}
+
+func convertDiskSpec(in *limitadorv1alpha1.DiskSpec) *DiskSpec {
+	if in == nil {
+		return nil
+	}
+	return &DiskSpec{
+		PersistentVolumeClaim: convertPVCGenericSpec(in.PVC),
+		Optimize:              optionalString(in.Optimize),
+	}
+}
+
+func convertEvaluatorCaching(in *authorinov1beta3.EvaluatorCaching) *EvaluatorCaching {
+	if in == nil {
+		return nil
+	}
+	return &EvaluatorCaching{
+		Key: convertValueOrSelector(&in.Key),
+		Ttl: int64(in.TTL),
+	}
+}
+
+func convertExtensionStatus(in *kuadrantv1beta1.ExtensionStatus) *ExtensionStatus {
+	if in == nil {
+		return nil
+	}
+	return &ExtensionStatus{
+		Name:              in.Name,
+		Executable:        in.Executable,
+		State:             in.State,
+		Restarts:          in.Restarts,
+		LastError:         in.LastError,
+		LastPingLatency:   convertDuration(in.LastPingLatency),
+		Mutators:          in.Mutators,
+		Subscriptions:     in.Subscriptions,
+		Address:           in.Address,
+		ApiVersion:        in.APIVersion,
+		Incompatibilities: stringList(in.Incompatibilities),
+	}
+}
+
+func convertExtensionsSpec(in *kuadrantv1beta1.ExtensionsSpec) *ExtensionsSpec {
+	if in == nil {
+		return nil
+	}
+	return &ExtensionsSpec{
+		Remote: convertList(in.Remote, convertRemoteExtension),
+	}
+}
+
+func convertExternalOpaPolicy(in *authorinov1beta3.ExternalOpaPolicy) *ExternalOpaPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &ExternalOpaPolicy{
+		Ttl: int64(in.TTL),
+	}
+	if in.HttpEndpointSpec != nil {
+		out.Url = in.HttpEndpointSpec.Url
+		out.UrlExpression = string(in.HttpEndpointSpec.UrlExpression)
+		out.Method = optionalString(in.HttpEndpointSpec.Method)
+		out.Body = convertValueOrSelector(in.HttpEndpointSpec.Body)
+		out.BodyParameters = convertMap(in.HttpEndpointSpec.Parameters, convertValueOrSelector)
+		out.ContentType = string(in.HttpEndpointSpec.ContentType)
+		out.Headers = convertMap(in.HttpEndpointSpec.Headers, convertValueOrSelector)
+		out.SharedSecretRef = convertSecretKeyReference(in.HttpEndpointSpec.SharedSecret)
+		out.Oauth2 = convertOAuth2ClientAuthentication(in.HttpEndpointSpec.OAuth2)
+		out.Credentials = convertCredentials(&in.HttpEndpointSpec.Credentials)
+	}
+	return out
+}
+
+func convertFraction(in *gatewayapiv1.Fraction) *Fraction {
+	if in == nil {
+		return nil
+	}
+	return &Fraction{
+		Numerator:   in.Numerator,
+		Denominator: optionalInt32(in.Denominator),
+	}
+}
+
+func convertFrontendTLSValidation(in *gatewayapiv1.FrontendTLSValidation) *FrontendTLSValidation {
+	if in == nil {
+		return nil
+	}
+	return &FrontendTLSValidation{
+		CaCertificateRefs: convertList(in.CACertificateRefs, convertObjectReference),
+	}
+}
+
+func convertGRPCBackendRef(in *gatewayapiv1.GRPCBackendRef) *GRPCBackendRef {
+	if in == nil {
+		return nil
+	}
+	return &GRPCBackendRef{
+		Group:     optionalString(in.BackendRef.BackendObjectReference.Group),
+		Kind:      optionalString(in.BackendRef.BackendObjectReference.Kind),
+		Name:      string(in.BackendRef.BackendObjectReference.Name),
+		Namespace: optionalString(in.BackendRef.BackendObjectReference.Namespace),
+		Port:      optionalInt32(in.BackendRef.BackendObjectReference.Port),
+		Weight:    optionalInt32(in.BackendRef.Weight),
+		Filters:   convertList(in.Filters, convertGRPCRouteFilter),
+	}
+}
+
+func convertGRPCHeaderMatch(in *gatewayapiv1.GRPCHeaderMatch) *GRPCHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	return &GRPCHeaderMatch{
+		Type:  optionalString(in.Type),
+		Name:  string(in.Name),
+		Value: in.Value,
+	}
+}
+
+func convertGRPCMethodMatch(in *gatewayapiv1.GRPCMethodMatch) *GRPCMethodMatch {
+	if in == nil {
+		return nil
+	}
+	return &GRPCMethodMatch{
+		Type:    optionalString(in.Type),
+		Service: optionalString(in.Service),
+		Method:  optionalString(in.Method),
+	}
+}
+
+// ConvertGRPCRoute converts a gatewayapiv1.GRPCRoute into its protobuf representation
+func ConvertGRPCRoute(in *gatewayapiv1.GRPCRoute) *GRPCRoute {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRoute{
+		Metadata: convertObjectMeta("gateway.networking.k8s.io", "GRPCRoute", &in.ObjectMeta),
+		Spec:     convertGRPCRouteSpec(&in.Spec),
+		Status:   convertGRPCRouteStatus(&in.Status),
+	}
+}
+
+func convertGRPCRouteFilter(in *gatewayapiv1.GRPCRouteFilter) *GRPCRouteFilter {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRouteFilter{
+		Type:                   string(in.Type),
+		RequestHeaderModifier:  convertHTTPHeaderFilter(in.RequestHeaderModifier),
+		ResponseHeaderModifier: convertHTTPHeaderFilter(in.ResponseHeaderModifier),
+		RequestMirror:          convertHTTPRequestMirrorFilter(in.RequestMirror),
+		ExtensionRef:           convertLocalObjectReference(in.ExtensionRef),
+	}
+}
+
+func convertGRPCRouteMatch(in *gatewayapiv1.GRPCRouteMatch) *GRPCRouteMatch {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRouteMatch{
+		Method:  convertGRPCMethodMatch(in.Method),
+		Headers: convertList(in.Headers, convertGRPCHeaderMatch),
+	}
+}
+
+func convertGRPCRouteRule(in *gatewayapiv1.GRPCRouteRule) *GRPCRouteRule {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRouteRule{
+		Name:               optionalString(in.Name),
+		Matches:            convertList(in.Matches, convertGRPCRouteMatch),
+		Filters:            convertList(in.Filters, convertGRPCRouteFilter),
+		BackendRefs:        convertList(in.BackendRefs, convertGRPCBackendRef),
+		SessionPersistence: convertSessionPersistence(in.SessionPersistence),
+	}
+}
+
+func convertGRPCRouteSpec(in *gatewayapiv1.GRPCRouteSpec) *GRPCRouteSpec {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRouteSpec{
+		ParentRefs: convertList(in.CommonRouteSpec.ParentRefs, convertParentReference),
+		Hostnames:  stringList(in.Hostnames),
+		Rules:      convertList(in.Rules, convertGRPCRouteRule),
+	}
+}
+
+func convertGRPCRouteStatus(in *gatewayapiv1.GRPCRouteStatus) *GRPCRouteStatus {
+	if in == nil {
+		return nil
+	}
+	return &GRPCRouteStatus{
+		Parents: convertList(in.RouteStatus.Parents, convertRouteParentStatus),
+	}
+}
+
+// ConvertGateway converts a gatewayapiv1.Gateway into its protobuf representation
+func ConvertGateway(in *gatewayapiv1.Gateway) *Gateway {
+	if in == nil {
+		return nil
+	}
+	return &Gateway{
+		Metadata: convertObjectMeta("gateway.networking.k8s.io", "Gateway", &in.ObjectMeta),
+		Spec:     convertGatewaySpec(&in.Spec),
+		Status:   convertGatewayStatus(&in.Status),
+	}
+}
+
+func convertGatewayAddress(in *gatewayapiv1.GatewayAddress) *GatewayAddress {
+	if in == nil {
+		return nil
+	}
+	return &GatewayAddress{
+		Type:  optionalString(in.Type),
+		Value: in.Value,
+	}
+}
+
+func convertGatewayBackendTLS(in *gatewayapiv1.GatewayBackendTLS) *GatewayBackendTLS {
+	if in == nil {
+		return nil
+	}
+	return &GatewayBackendTLS{
+		ClientCertificateRef: convertSecretObjectReference(in.ClientCertificateRef),
+	}
+}
+
+// ConvertGatewayClass converts a gatewayapiv1.GatewayClass into its protobuf representation
+func ConvertGatewayClass(in *gatewayapiv1.GatewayClass) *GatewayClass {
+	if in == nil {
+		return nil
+	}
+	return &GatewayClass{
+		Metadata: convertObjectMeta("gateway.networking.k8s.io", "GatewayClass", &in.ObjectMeta),
+		Spec:     convertGatewayClassSpec(&in.Spec),
+		Status:   convertGatewayClassStatus(&in.Status),
+	}
+}
+
+func convertGatewayClassSpec(in *gatewayapiv1.GatewayClassSpec) *GatewayClassSpec {
+	if in == nil {
+		return nil
+	}
+	return &GatewayClassSpec{
+		ControllerName: string(in.ControllerName),
+		ParametersRef:  convertParametersReference(in.ParametersRef),
+		Description:    optionalString(in.Description),
+	}
+}
+
+func convertGatewayClassStatus(in *gatewayapiv1.GatewayClassStatus) *GatewayClassStatus {
+	if in == nil {
+		return nil
+	}
+	return &GatewayClassStatus{
+		Conditions:        convertList(in.Conditions, convertCondition),
+		SupportedFeatures: convertList(in.SupportedFeatures, convertSupportedFeature),
+	}
+}
+
+func convertGatewayInfrastructure(in *gatewayapiv1.GatewayInfrastructure) *GatewayInfrastructure {
+	if in == nil {
+		return nil
+	}
+	return &GatewayInfrastructure{
+		Labels:        stringMap(in.Labels),
+		Annotations:   stringMap(in.Annotations),
+		ParametersRef: convertLocalParametersReference(in.ParametersRef),
+	}
+}
+
+func convertGatewayReference(in *kuadrantv1beta1.GatewayReference) *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	return &GatewayReference{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+	}
+}
+
+func convertGatewayServices(in *kuadrantv1beta1.GatewayServices) *GatewayServices {
+	if in == nil {
+		return nil
+	}
+	return &GatewayServices{
+		Gateways:  convertList(in.Gateways, convertGatewayReference),
+		Limitador: convertServiceEndpoint(in.Limitador),
+		Authorino: convertServiceEndpoint(in.Authorino),
+	}
+}
+
+func convertGatewaySpec(in *gatewayapiv1.GatewaySpec) *GatewaySpec {
+	if in == nil {
+		return nil
+	}
+	return &GatewaySpec{
+		GatewayClassName: string(in.GatewayClassName),
+		Listeners:        convertList(in.Listeners, convertListener),
+		Addresses:        convertList(in.Addresses, convertGatewayAddress),
+		Infrastructure:   convertGatewayInfrastructure(in.Infrastructure),
+		BackendTLS:       convertGatewayBackendTLS(in.BackendTLS),
+	}
+}
+
+func convertGatewayStatus(in *gatewayapiv1.GatewayStatus) *GatewayStatus {
+	if in == nil {
+		return nil
+	}
+	return &GatewayStatus{
+		Addresses:  convertList(in.Addresses, convertGatewayStatusAddress),
+		Conditions: convertList(in.Conditions, convertCondition),
+		Listeners:  convertList(in.Listeners, convertListenerStatus),
+	}
+}
+
+func convertGatewayStatusAddress(in *gatewayapiv1.GatewayStatusAddress) *GatewayStatusAddress {
+	if in == nil {
+		return nil
+	}
+	return &GatewayStatusAddress{
+		Type:  optionalString(in.Type),
+		Value: in.Value,
+	}
+}
+
+func convertGatewayTLSConfig(in *gatewayapiv1.GatewayTLSConfig) *GatewayTLSConfig {
+	if in == nil {
+		return nil
+	}
+	return &GatewayTLSConfig{
+		Mode:               optionalString(in.Mode),
+		CertificateRefs:    convertList(in.CertificateRefs, convertSecretObjectReference),
+		FrontendValidation: convertFrontendTLSValidation(in.FrontendValidation),
+		Options:            stringMap(in.Options),
+	}
+}
+
+func convertHTTPBackendRef(in *gatewayapiv1.HTTPBackendRef) *HTTPBackendRef {
+	if in == nil {
+		return nil
+	}
+	return &HTTPBackendRef{
+		Group:     optionalString(in.BackendRef.BackendObjectReference.Group),
+		Kind:      optionalString(in.BackendRef.BackendObjectReference.Kind),
+		Name:      string(in.BackendRef.BackendObjectReference.Name),
+		Namespace: optionalString(in.BackendRef.BackendObjectReference.Namespace),
+		Port:      optionalInt32(in.BackendRef.BackendObjectReference.Port),
+		Weight:    optionalInt32(in.BackendRef.Weight),
+		Filters:   convertList(in.Filters, convertHTTPRouteFilter),
+	}
+}
+
+func convertHTTPHeader(in *gatewayapiv1.HTTPHeader) *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	return &HTTPHeader{
+		Name:  string(in.Name),
+		Value: in.Value,
+	}
+}
+
+func convertHTTPHeaderFilter(in *gatewayapiv1.HTTPHeaderFilter) *HTTPHeaderFilter {
+	if in == nil {
+		return nil
+	}
+	return &HTTPHeaderFilter{
+		Set:    convertList(in.Set, convertHTTPHeader),
+		Add:    convertList(in.Add, convertHTTPHeader),
+		Remove: stringList(in.Remove),
+	}
+}
+
+func convertHTTPHeaderMatch(in *gatewayapiv1.HTTPHeaderMatch) *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	return &HTTPHeaderMatch{
+		Type:  optionalString(in.Type),
+		Name:  string(in.Name),
+		Value: in.Value,
+	}
+}
+
+func convertHTTPPathMatch(in *gatewayapiv1.HTTPPathMatch) *HTTPPathMatch {
+	if in == nil {
+		return nil
+	}
+	return &HTTPPathMatch{
+		Type:  optionalString(in.Type),
+		Value: optionalString(in.Value),
+	}
+}
+
+func convertHTTPPathModifier(in *gatewayapiv1.HTTPPathModifier) *HTTPPathModifier {
+	if in == nil {
+		return nil
+	}
+	return &HTTPPathModifier{
+		Type:               string(in.Type),
+		ReplaceFullPath:    optionalString(in.ReplaceFullPath),
+		ReplacePrefixMatch: optionalString(in.ReplacePrefixMatch),
+	}
+}
+
+func convertHTTPQueryParamMatch(in *gatewayapiv1.HTTPQueryParamMatch) *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	return &HTTPQueryParamMatch{
+		Type:  optionalString(in.Type),
+		Name:  string(in.Name),
+		Value: in.Value,
+	}
+}
+
+func convertHTTPRequestMirrorFilter(in *gatewayapiv1.HTTPRequestMirrorFilter) *HTTPRequestMirrorFilter {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRequestMirrorFilter{
+		BackendRef: convertBackendObjectReference(&in.BackendRef),
+		Percent:    optionalInt32(in.Percent),
+		Fraction:   convertFraction(in.Fraction),
+	}
+}
+
+func convertHTTPRequestRedirectFilter(in *gatewayapiv1.HTTPRequestRedirectFilter) *HTTPRequestRedirectFilter {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRequestRedirectFilter{
+		Scheme:     optionalString(in.Scheme),
+		Hostname:   optionalString(in.Hostname),
+		Path:       convertHTTPPathModifier(in.Path),
+		Port:       optionalInt32(in.Port),
+		StatusCode: optionalInt64(in.StatusCode),
+	}
+}
+
+// ConvertHTTPRoute converts a gatewayapiv1.HTTPRoute into its protobuf representation
+func ConvertHTTPRoute(in *gatewayapiv1.HTTPRoute) *HTTPRoute {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRoute{
+		Metadata: convertObjectMeta("gateway.networking.k8s.io", "HTTPRoute", &in.ObjectMeta),
+		Spec:     convertHTTPRouteSpec(&in.Spec),
+		Status:   convertHTTPRouteStatus(&in.Status),
+	}
+}
+
+func convertHTTPRouteFilter(in *gatewayapiv1.HTTPRouteFilter) *HTTPRouteFilter {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteFilter{
+		Type:                   string(in.Type),
+		RequestHeaderModifier:  convertHTTPHeaderFilter(in.RequestHeaderModifier),
+		ResponseHeaderModifier: convertHTTPHeaderFilter(in.ResponseHeaderModifier),
+		RequestMirror:          convertHTTPRequestMirrorFilter(in.RequestMirror),
+		RequestRedirect:        convertHTTPRequestRedirectFilter(in.RequestRedirect),
+		UrlRewrite:             convertHTTPURLRewriteFilter(in.URLRewrite),
+		ExtensionRef:           convertLocalObjectReference(in.ExtensionRef),
+	}
+}
+
+func convertHTTPRouteMatch(in *gatewayapiv1.HTTPRouteMatch) *HTTPRouteMatch {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteMatch{
+		Path:        convertHTTPPathMatch(in.Path),
+		Headers:     convertList(in.Headers, convertHTTPHeaderMatch),
+		QueryParams: convertList(in.QueryParams, convertHTTPQueryParamMatch),
+		Method:      optionalString(in.Method),
+	}
+}
+
+func convertHTTPRouteRetry(in *gatewayapiv1.HTTPRouteRetry) *HTTPRouteRetry {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteRetry{
+		Codes:    int64List(in.Codes),
+		Attempts: optionalInt64(in.Attempts),
+		Backoff:  optionalString(in.Backoff),
+	}
+}
+
+func convertHTTPRouteRule(in *gatewayapiv1.HTTPRouteRule) *HTTPRouteRule {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteRule{
+		Name:               optionalString(in.Name),
+		Matches:            convertList(in.Matches, convertHTTPRouteMatch),
+		Filters:            convertList(in.Filters, convertHTTPRouteFilter),
+		BackendRefs:        convertList(in.BackendRefs, convertHTTPBackendRef),
+		Timeouts:           convertHTTPRouteTimeouts(in.Timeouts),
+		Retry:              convertHTTPRouteRetry(in.Retry),
+		SessionPersistence: convertSessionPersistence(in.SessionPersistence),
+	}
+}
+
+func convertHTTPRouteSpec(in *gatewayapiv1.HTTPRouteSpec) *HTTPRouteSpec {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteSpec{
+		ParentRefs: convertList(in.CommonRouteSpec.ParentRefs, convertParentReference),
+		Hostnames:  stringList(in.Hostnames),
+		Rules:      convertList(in.Rules, convertHTTPRouteRule),
+	}
+}
+
+func convertHTTPRouteStatus(in *gatewayapiv1.HTTPRouteStatus) *HTTPRouteStatus {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteStatus{
+		Parents: convertList(in.RouteStatus.Parents, convertRouteParentStatus),
+	}
+}
+
+func convertHTTPRouteTimeouts(in *gatewayapiv1.HTTPRouteTimeouts) *HTTPRouteTimeouts {
+	if in == nil {
+		return nil
+	}
+	return &HTTPRouteTimeouts{
+		Request:        optionalString(in.Request),
+		BackendRequest: optionalString(in.BackendRequest),
+	}
+}
+
+func convertHTTPURLRewriteFilter(in *gatewayapiv1.HTTPURLRewriteFilter) *HTTPURLRewriteFilter {
+	if in == nil {
+		return nil
+	}
+	return &HTTPURLRewriteFilter{
+		Hostname: optionalString(in.Hostname),
+		Path:     convertHTTPPathModifier(in.Path),
+	}
+}
+
+func convertHealthCheckSpec(in *kuadrantdnsv1alpha1.HealthCheckSpec) *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	return &HealthCheckSpec{
+		Port:                 int64(in.Port),
+		Path:                 in.Path,
+		Protocol:             string(in.Protocol),
+		Interval:             convertDuration(in.Interval),
+		AdditionalHeadersRef: convertAdditionalHeadersRef(in.AdditionalHeadersRef),
+		FailureThreshold:     int64(in.FailureThreshold),
+	}
+}
+
+func convertHealthCheckStatus(in *kuadrantdnsv1alpha1.HealthCheckStatus) *HealthCheckStatus {
+	if in == nil {
+		return nil
+	}
+	return &HealthCheckStatus{
+		Conditions: convertList(in.Conditions, convertCondition),
+		Probes:     convertList(in.Probes, convertHealthCheckStatusProbe),
+	}
+}
+
+func convertHealthCheckStatusProbe(in *kuadrantdnsv1alpha1.HealthCheckStatusProbe) *HealthCheckStatusProbe {
+	if in == nil {
+		return nil
+	}
+	return &HealthCheckStatusProbe{
+		Id:         in.ID,
+		IpAddress:  in.IPAddress,
+		Host:       in.Host,
+		Synced:     in.Synced,
+		Conditions: convertList(in.Conditions, convertCondition),
+	}
+}
+
+func convertHttpEndpointSpec(in *authorinov1beta3.HttpEndpointSpec) *HttpEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	return &HttpEndpointSpec{
+		Url:             in.Url,
+		UrlExpression:   string(in.UrlExpression),
+		Method:          optionalString(in.Method),
+		Body:            convertValueOrSelector(in.Body),
+		BodyParameters:  convertMap(in.Parameters, convertValueOrSelector),
+		ContentType:     string(in.ContentType),
+		Headers:         convertMap(in.Headers, convertValueOrSelector),
+		SharedSecretRef: convertSecretKeyReference(in.SharedSecret),
+		Oauth2:          convertOAuth2ClientAuthentication(in.OAuth2),
+		Credentials:     convertCredentials(&in.Credentials),
+	}
+}
+
+func convertJsonAuthResponseSpec(in *authorinov1beta3.JsonAuthResponseSpec) *JsonAuthResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &JsonAuthResponseSpec{
+		Properties: convertMap(in.Properties, convertValueOrSelector),
+	}
+}
+
+func convertJwtAuthenticationSpec(in *authorinov1beta3.JwtAuthenticationSpec) *JwtAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	return &JwtAuthenticationSpec{
+		JwksUrl:   in.JwksUrl,
+		IssuerUrl: in.IssuerUrl,
+		Ttl:       int64(in.TTL),
+	}
+}
+
+// ConvertKuadrant converts a kuadrantv1beta1.Kuadrant into its protobuf representation
+func ConvertKuadrant(in *kuadrantv1beta1.Kuadrant) *Kuadrant {
+	if in == nil {
+		return nil
+	}
+	return &Kuadrant{
+		Metadata: convertObjectMeta("kuadrant.io", "Kuadrant", &in.ObjectMeta),
+		Spec:     convertKuadrantSpec(&in.Spec),
+		Status:   convertKuadrantStatus(&in.Status),
+	}
+}
+
+func convertKuadrantSpec(in *kuadrantv1beta1.KuadrantSpec) *KuadrantSpec {
+	if in == nil {
+		return nil
+	}
+	return &KuadrantSpec{
+		Observability:   convertObservability(&in.Observability),
+		Mtls:            convertMTLS(in.MTLS),
+		Limitador:       convertLimitadorSpec(in.Limitador),
+		Authorino:       convertAuthorinoSpec(in.Authorino),
+		GatewayServices: convertList(in.GatewayServices, convertGatewayServices),
+		Extensions:      convertExtensionsSpec(in.Extensions),
+	}
+}
+
+func convertKuadrantStatus(in *kuadrantv1beta1.KuadrantStatus) *KuadrantStatus {
+	if in == nil {
+		return nil
+	}
+	return &KuadrantStatus{
+		ObservedGeneration: in.ObservedGeneration,
+		Conditions:         convertList(in.Conditions, convertCondition),
+		MtlsAuthorino:      optionalBool(in.MtlsAuthorino),
+		MtlsLimitador:      optionalBool(in.MtlsLimitador),
+		Limitador:          convertLimitadorStatus(in.Limitador),
+		Authorino:          convertAuthorinoStatus(in.Authorino),
+		Extensions:         convertList(in.Extensions, convertExtensionStatus),
+	}
+}
+
+func convertKubernetesSubjectAccessReviewAuthorizationSpec(in *authorinov1beta3.KubernetesSubjectAccessReviewAuthorizationSpec) *KubernetesSubjectAccessReviewAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	return &KubernetesSubjectAccessReviewAuthorizationSpec{
+		User:                convertValueOrSelector(in.User),
+		Groups:              stringList(in.Groups),
+		AuthorizationGroups: convertValueOrSelector(in.AuthorizationGroups),
+		ResourceAttributes:  convertKubernetesSubjectAccessReviewResourceAttributesSpec(in.ResourceAttributes),
+	}
+}
+
+func convertKubernetesSubjectAccessReviewResourceAttributesSpec(in *authorinov1beta3.KubernetesSubjectAccessReviewResourceAttributesSpec) *KubernetesSubjectAccessReviewResourceAttributesSpec {
+	if in == nil {
+		return nil
+	}
+	return &KubernetesSubjectAccessReviewResourceAttributesSpec{
+		Group:       convertValueOrSelector(&in.Group),
+		Resource:    convertValueOrSelector(&in.Resource),
+		Subresource: convertValueOrSelector(&in.SubResource),
+		Name:        convertValueOrSelector(&in.Name),
+		Namespace:   convertValueOrSelector(&in.Namespace),
+		Verb:        convertValueOrSelector(&in.Verb),
+	}
+}
+
+func convertKubernetesTokenReviewSpec(in *authorinov1beta3.KubernetesTokenReviewSpec) *KubernetesTokenReviewSpec {
+	if in == nil {
+		return nil
+	}
+	return &KubernetesTokenReviewSpec{
+		Audiences: stringList(in.Audiences),
+	}
+}
+
+func convertLabelSelector(in *metav1.LabelSelector) *LabelSelector {
+	if in == nil {
+		return nil
+	}
+	return &LabelSelector{
+		MatchLabels:      stringMap(in.MatchLabels),
+		MatchExpressions: convertList(in.MatchExpressions, convertLabelSelectorRequirement),
+	}
+}
+
+func convertLabelSelectorRequirement(in *metav1.LabelSelectorRequirement) *LabelSelectorRequirement {
+	if in == nil {
+		return nil
+	}
+	return &LabelSelectorRequirement{
+		Key:      in.Key,
+		Operator: string(in.Operator),
+		Values:   stringList(in.Values),
+	}
+}
+
+func convertLimit(in *kuadrantv1.Limit) *Limit {
+	if in == nil {
+		return nil
+	}
+	return &Limit{
+		When:     convertList(in.When, convertPredicate),
+		Counters: convertList(in.Counters, convertCounter),
+		Rates:    convertList(in.Rates, convertRate),
+		Mode:     string(in.Mode),
+	}
+}
+
+func convertLimitadorSpec(in *kuadrantv1beta1.LimitadorSpec) *LimitadorSpec {
+	if in == nil {
+		return nil
+	}
+	return &LimitadorSpec{
+		Replicas:             optionalInt64(in.Replicas),
+		Storage:              convertStorage(in.Storage),
+		ResourceRequirements: convertResourceRequirements(in.ResourceRequirements),
+		Pdb:                  convertPodDisruptionBudgetType(in.PodDisruptionBudget),
+		Verbosity:            optionalInt64(in.Verbosity),
+		MetricLabelsDefault:  optionalString(in.MetricLabelsDefault),
+	}
+}
+
+func convertLimitadorStatus(in *kuadrantv1beta1.LimitadorStatus) *LimitadorStatus {
+	if in == nil {
+		return nil
+	}
+	return &LimitadorStatus{
+		Replicas:  in.Replicas,
+		Storage:   in.Storage,
+		Verbosity: optionalInt64(in.Verbosity),
+	}
+}
+
+func convertListener(in *gatewayapiv1.Listener) *Listener {
+	if in == nil {
+		return nil
+	}
+	return &Listener{
+		Name:          string(in.Name),
+		Hostname:      optionalString(in.Hostname),
+		Port:          int32(in.Port),
+		Protocol:      string(in.Protocol),
+		Tls:           convertGatewayTLSConfig(in.TLS),
+		AllowedRoutes: convertAllowedRoutes(in.AllowedRoutes),
+	}
+}
+
+func convertListenerStatus(in *gatewayapiv1.ListenerStatus) *ListenerStatus {
+	if in == nil {
+		return nil
+	}
+	return &ListenerStatus{
+		Name:           string(in.Name),
+		SupportedKinds: convertList(in.SupportedKinds, convertRouteGroupKind),
+		AttachedRoutes: in.AttachedRoutes,
+		Conditions:     convertList(in.Conditions, convertCondition),
+	}
+}
+
+func convertLoadBalancingSpec(in *kuadrantv1.LoadBalancingSpec) *LoadBalancingSpec {
+	if in == nil {
+		return nil
+	}
+	return &LoadBalancingSpec{
+		Weight:     int64(in.Weight),
+		Geo:        in.Geo,
+		DefaultGeo: in.DefaultGeo,
+	}
+}
+
+func convertLocalObjectReference(in *gatewayapiv1.LocalObjectReference) *LocalObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &LocalObjectReference{
+		Group: string(in.Group),
+		Kind:  string(in.Kind),
+		Name:  string(in.Name),
+	}
+}
+
+func convertLocalParametersReference(in *gatewayapiv1.LocalParametersReference) *LocalParametersReference {
+	if in == nil {
+		return nil
+	}
+	return &LocalParametersReference{
+		Group: string(in.Group),
+		Kind:  string(in.Kind),
+		Name:  in.Name,
+	}
+}
+
+func convertLocalPolicyTargetReferenceWithSectionName(in *gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName) *LocalPolicyTargetReferenceWithSectionName {
+	if in == nil {
+		return nil
+	}
+	return &LocalPolicyTargetReferenceWithSectionName{
+		Group:       string(in.LocalPolicyTargetReference.Group),
+		Kind:        string(in.LocalPolicyTargetReference.Kind),
+		Name:        string(in.LocalPolicyTargetReference.Name),
+		SectionName: optionalString(in.SectionName),
+	}
+}
+
+func convertMTLS(in *kuadrantv1beta1.MTLS) *MTLS {
+	if in == nil {
+		return nil
+	}
+	return &MTLS{
+		Enable:    in.Enable,
+		Authorino: optionalBool(in.Authorino),
+		Limitador: optionalBool(in.Limitador),
+	}
+}
+
+func convertMergeableAuthPolicySpec(in *kuadrantv1.MergeableAuthPolicySpec) *MergeableAuthPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableAuthPolicySpec{
+		Strategy:    in.Strategy,
+		Patterns:    convertMap(in.AuthPolicySpecProper.NamedPatterns, convertMergeablePatternExpressions),
+		When:        convertList(in.AuthPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Rules:       convertAuthSchemeSpec(in.AuthPolicySpecProper.AuthScheme),
+		Mode:        string(in.AuthPolicySpecProper.Mode),
+		FailureMode: string(in.AuthPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.AuthPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertMergeableAuthenticationSpec(in *kuadrantv1.MergeableAuthenticationSpec) *MergeableAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableAuthenticationSpec{
+		Priority:              int64(in.AuthenticationSpec.CommonEvaluatorSpec.Priority),
+		Metrics:               in.AuthenticationSpec.CommonEvaluatorSpec.Metrics,
+		When:                  convertList(in.AuthenticationSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:                 convertEvaluatorCaching(in.AuthenticationSpec.CommonEvaluatorSpec.Cache),
+		Credentials:           convertCredentials(&in.AuthenticationSpec.Credentials),
+		Overrides:             convertMap(in.AuthenticationSpec.Overrides, convertValueOrSelector),
+		Defaults:              convertMap(in.AuthenticationSpec.Defaults, convertValueOrSelector),
+		ApiKey:                convertApiKeyAuthenticationSpec(in.AuthenticationSpec.AuthenticationMethodSpec.ApiKey),
+		Jwt:                   convertJwtAuthenticationSpec(in.AuthenticationSpec.AuthenticationMethodSpec.Jwt),
+		Oauth2Introspection:   convertOAuth2TokenIntrospectionSpec(in.AuthenticationSpec.AuthenticationMethodSpec.OAuth2TokenIntrospection),
+		KubernetesTokenReview: convertKubernetesTokenReviewSpec(in.AuthenticationSpec.AuthenticationMethodSpec.KubernetesTokenReview),
+		X509:                  convertX509ClientCertificateAuthenticationSpec(in.AuthenticationSpec.AuthenticationMethodSpec.X509ClientCertificate),
+		Plain:                 convertPlainIdentitySpec(in.AuthenticationSpec.AuthenticationMethodSpec.Plain),
+		Anonymous:             convertAnonymousAccessSpec(in.AuthenticationSpec.AuthenticationMethodSpec.AnonymousAccess),
+	}
+}
+
+func convertMergeableAuthorizationSpec(in *kuadrantv1.MergeableAuthorizationSpec) *MergeableAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableAuthorizationSpec{
+		Priority:                      int64(in.AuthorizationSpec.CommonEvaluatorSpec.Priority),
+		Metrics:                       in.AuthorizationSpec.CommonEvaluatorSpec.Metrics,
+		When:                          convertList(in.AuthorizationSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:                         convertEvaluatorCaching(in.AuthorizationSpec.CommonEvaluatorSpec.Cache),
+		PatternMatching:               convertPatternMatchingAuthorizationSpec(in.AuthorizationSpec.AuthorizationMethodSpec.PatternMatching),
+		Opa:                           convertOpaAuthorizationSpec(in.AuthorizationSpec.AuthorizationMethodSpec.Opa),
+		KubernetesSubjectAccessReview: convertKubernetesSubjectAccessReviewAuthorizationSpec(in.AuthorizationSpec.AuthorizationMethodSpec.KubernetesSubjectAccessReview),
+		Spicedb:                       convertSpiceDBAuthorizationSpec(in.AuthorizationSpec.AuthorizationMethodSpec.SpiceDB),
+	}
+}
+
+func convertMergeableCallbackSpec(in *kuadrantv1.MergeableCallbackSpec) *MergeableCallbackSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableCallbackSpec{
+		Priority: int64(in.CallbackSpec.CommonEvaluatorSpec.Priority),
+		Metrics:  in.CallbackSpec.CommonEvaluatorSpec.Metrics,
+		When:     convertList(in.CallbackSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:    convertEvaluatorCaching(in.CallbackSpec.CommonEvaluatorSpec.Cache),
+		Http:     convertHttpEndpointSpec(in.CallbackSpec.CallbackMethodSpec.Http),
+	}
+}
+
+func convertMergeableDenyWithSpec(in *kuadrantv1.MergeableDenyWithSpec) *MergeableDenyWithSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableDenyWithSpec{
+		Code:    int64(in.DenyWithSpec.Code),
+		Message: convertValueOrSelector(in.DenyWithSpec.Message),
+		Headers: convertMap(in.DenyWithSpec.Headers, convertValueOrSelector),
+		Body:    convertValueOrSelector(in.DenyWithSpec.Body),
+	}
+}
+
+func convertMergeableHeaderSuccessResponseSpec(in *kuadrantv1.MergeableHeaderSuccessResponseSpec) *MergeableHeaderSuccessResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableHeaderSuccessResponseSpec{
+		Priority:  int64(in.HeaderSuccessResponseSpec.SuccessResponseSpec.CommonEvaluatorSpec.Priority),
+		Metrics:   in.HeaderSuccessResponseSpec.SuccessResponseSpec.CommonEvaluatorSpec.Metrics,
+		When:      convertList(in.HeaderSuccessResponseSpec.SuccessResponseSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:     convertEvaluatorCaching(in.HeaderSuccessResponseSpec.SuccessResponseSpec.CommonEvaluatorSpec.Cache),
+		Plain:     convertPlainAuthResponseSpec(in.HeaderSuccessResponseSpec.SuccessResponseSpec.AuthResponseMethodSpec.Plain),
+		Json:      convertJsonAuthResponseSpec(in.HeaderSuccessResponseSpec.SuccessResponseSpec.AuthResponseMethodSpec.Json),
+		Wristband: convertWristbandAuthResponseSpec(in.HeaderSuccessResponseSpec.SuccessResponseSpec.AuthResponseMethodSpec.Wristband),
+		Key:       in.HeaderSuccessResponseSpec.SuccessResponseSpec.Key,
+	}
+}
+
+func convertMergeableMetadataSpec(in *kuadrantv1.MergeableMetadataSpec) *MergeableMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableMetadataSpec{
+		Priority: int64(in.MetadataSpec.CommonEvaluatorSpec.Priority),
+		Metrics:  in.MetadataSpec.CommonEvaluatorSpec.Metrics,
+		When:     convertList(in.MetadataSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:    convertEvaluatorCaching(in.MetadataSpec.CommonEvaluatorSpec.Cache),
+		Http:     convertHttpEndpointSpec(in.MetadataSpec.MetadataMethodSpec.Http),
+		UserInfo: convertUserInfoMetadataSpec(in.MetadataSpec.MetadataMethodSpec.UserInfo),
+		Uma:      convertUmaMetadataSpec(in.MetadataSpec.MetadataMethodSpec.Uma),
+	}
+}
+
+func convertMergeablePatternExpressions(in *kuadrantv1.MergeablePatternExpressions) *MergeablePatternExpressions {
+	if in == nil {
+		return nil
+	}
+	return &MergeablePatternExpressions{
+		AllOf: convertList(in.PatternExpressions, convertPatternExpression),
+	}
+}
+
+func convertMergeableRateLimitPolicySpec(in *kuadrantv1.MergeableRateLimitPolicySpec) *MergeableRateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableRateLimitPolicySpec{
+		Strategy:    in.Strategy,
+		When:        convertList(in.RateLimitPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Limits:      convertMap(in.RateLimitPolicySpecProper.Limits, convertLimit),
+		Mode:        string(in.RateLimitPolicySpecProper.Mode),
+		FailureMode: string(in.RateLimitPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.RateLimitPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertMergeableResponseSpec(in *kuadrantv1.MergeableResponseSpec) *MergeableResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableResponseSpec{
+		Unauthenticated: convertMergeableDenyWithSpec(in.Unauthenticated),
+		Unauthorized:    convertMergeableDenyWithSpec(in.Unauthorized),
+		Success:         convertMergeableWrappedSuccessResponseSpec(&in.Success),
+	}
+}
+
+func convertMergeableSuccessResponseSpec(in *kuadrantv1.MergeableSuccessResponseSpec) *MergeableSuccessResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableSuccessResponseSpec{
+		Priority:  int64(in.SuccessResponseSpec.CommonEvaluatorSpec.Priority),
+		Metrics:   in.SuccessResponseSpec.CommonEvaluatorSpec.Metrics,
+		When:      convertList(in.SuccessResponseSpec.CommonEvaluatorSpec.Conditions, convertPatternExpressionOrRef),
+		Cache:     convertEvaluatorCaching(in.SuccessResponseSpec.CommonEvaluatorSpec.Cache),
+		Plain:     convertPlainAuthResponseSpec(in.SuccessResponseSpec.AuthResponseMethodSpec.Plain),
+		Json:      convertJsonAuthResponseSpec(in.SuccessResponseSpec.AuthResponseMethodSpec.Json),
+		Wristband: convertWristbandAuthResponseSpec(in.SuccessResponseSpec.AuthResponseMethodSpec.Wristband),
+		Key:       in.SuccessResponseSpec.Key,
+	}
+}
+
+func convertMergeableTokenRateLimitPolicySpec(in *kuadrantv1alpha1.MergeableTokenRateLimitPolicySpec) *MergeableTokenRateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableTokenRateLimitPolicySpec{
+		Strategy:    in.Strategy,
+		When:        convertList(in.TokenRateLimitPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Limits:      convertMap(in.TokenRateLimitPolicySpecProper.Limits, convertTokenLimit),
+		FailureMode: string(in.TokenRateLimitPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.TokenRateLimitPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertMergeableWrappedSuccessResponseSpec(in *kuadrantv1.MergeableWrappedSuccessResponseSpec) *MergeableWrappedSuccessResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &MergeableWrappedSuccessResponseSpec{
+		Headers: convertMap(in.Headers, convertMergeableHeaderSuccessResponseSpec),
+		Filters: convertMap(in.DynamicMetadata, convertMergeableSuccessResponseSpec),
+	}
+}
+
+func convertNamed(in *authorinov1beta3.Named) *Named {
+	if in == nil {
+		return nil
+	}
+	return &Named{
+		Name: in.Name,
+	}
+}
+
+func convertOAuth2ClientAuthentication(in *authorinov1beta3.OAuth2ClientAuthentication) *OAuth2ClientAuthentication {
+	if in == nil {
+		return nil
+	}
+	return &OAuth2ClientAuthentication{
+		TokenUrl:        in.TokenUrl,
+		ClientId:        in.ClientId,
+		ClientSecretRef: convertSecretKeyReference(&in.ClientSecret),
+		Scopes:          stringList(in.Scopes),
+		ExtraParams:     stringMap(in.ExtraParams),
+		Cache:           optionalBool(in.Cache),
+	}
+}
+
+func convertOAuth2TokenIntrospectionSpec(in *authorinov1beta3.OAuth2TokenIntrospectionSpec) *OAuth2TokenIntrospectionSpec {
+	if in == nil {
+		return nil
+	}
+	return &OAuth2TokenIntrospectionSpec{
+		Endpoint:       in.Url,
+		TokenTypeHint:  in.TokenTypeHint,
+		CredentialsRef: convertCoreLocalObjectReference(in.Credentials),
+	}
+}
+
+func convertObjectReference(in *gatewayapiv1.ObjectReference) *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &ObjectReference{
+		Group:     string(in.Group),
+		Kind:      string(in.Kind),
+		Name:      string(in.Name),
+		Namespace: optionalString(in.Namespace),
+	}
+}
+
+func convertObservability(in *kuadrantv1beta1.Observability) *Observability {
+	if in == nil {
+		return nil
+	}
+	return &Observability{
+		Enable: in.Enable,
+	}
+}
+
+func convertOpaAuthorizationSpec(in *authorinov1beta3.OpaAuthorizationSpec) *OpaAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	return &OpaAuthorizationSpec{
+		Rego:           in.Rego,
+		ExternalPolicy: convertExternalOpaPolicy(in.External),
+		AllValues:      in.AllValues,
+	}
+}
+
+func convertPVCGenericSpec(in *limitadorv1alpha1.PVCGenericSpec) *PVCGenericSpec {
+	if in == nil {
+		return nil
+	}
+	return &PVCGenericSpec{
+		StorageClassName: optionalString(in.StorageClassName),
+		Resources:        convertPersistentVolumeClaimResources(in.Resources),
+		VolumeName:       optionalString(in.VolumeName),
+	}
+}
+
+func convertParametersReference(in *gatewayapiv1.ParametersReference) *ParametersReference {
+	if in == nil {
+		return nil
+	}
+	return &ParametersReference{
+		Group:     string(in.Group),
+		Kind:      string(in.Kind),
+		Name:      in.Name,
+		Namespace: optionalString(in.Namespace),
+	}
+}
+
+func convertParentReference(in *gatewayapiv1.ParentReference) *ParentReference {
+	if in == nil {
+		return nil
+	}
+	return &ParentReference{
+		Group:       optionalString(in.Group),
+		Kind:        optionalString(in.Kind),
+		Namespace:   optionalString(in.Namespace),
+		Name:        string(in.Name),
+		SectionName: optionalString(in.SectionName),
+		Port:        optionalInt32(in.Port),
+	}
+}
+
+func convertPatternExpression(in *authorinov1beta3.PatternExpression) *PatternExpression {
+	if in == nil {
+		return nil
+	}
+	return &PatternExpression{
+		Selector: in.Selector,
+		Operator: string(in.Operator),
+		Value:    in.Value,
+	}
+}
+
+func convertPatternExpressionOrRef(in *authorinov1beta3.PatternExpressionOrRef) *PatternExpressionOrRef {
+	if in == nil {
+		return nil
+	}
+	return &PatternExpressionOrRef{
+		Selector:   in.PatternExpression.Selector,
+		Operator:   string(in.PatternExpression.Operator),
+		Value:      in.PatternExpression.Value,
+		PatternRef: in.PatternRef.Name,
+		Predicate:  in.CelPredicate.Predicate,
+		All:        convertList(in.All, convertUnstructuredPatternExpressionOrRef),
+		Any:        convertList(in.Any, convertUnstructuredPatternExpressionOrRef),
+	}
+}
+
+func convertPatternMatchingAuthorizationSpec(in *authorinov1beta3.PatternMatchingAuthorizationSpec) *PatternMatchingAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	return &PatternMatchingAuthorizationSpec{
+		Patterns: convertList(in.Patterns, convertPatternExpressionOrRef),
+	}
+}
+
+func convertPersistentVolumeClaimResources(in *limitadorv1alpha1.PersistentVolumeClaimResources) *PersistentVolumeClaimResources {
+	if in == nil {
+		return nil
+	}
+	return &PersistentVolumeClaimResources{
+		Requests: convertQuantity(&in.Requests),
+	}
+}
+
+func convertPlainAuthResponseSpec(in *authorinov1beta3.PlainAuthResponseSpec) *PlainAuthResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &PlainAuthResponseSpec{
+		Value:      convertRawExtension(&in.Value),
+		Selector:   in.Selector,
+		Expression: string(in.Expression),
+	}
+}
+
+func convertPlainIdentitySpec(in *authorinov1beta3.PlainIdentitySpec) *PlainIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	return &PlainIdentitySpec{
+		Selector:   in.Selector,
+		Expression: string(in.Expression),
+	}
+}
+
+func convertPodDisruptionBudgetType(in *limitadorv1alpha1.PodDisruptionBudgetType) *PodDisruptionBudgetType {
+	if in == nil {
+		return nil
+	}
+	return &PodDisruptionBudgetType{
+		MaxUnavailable: convertIntOrString(in.MaxUnavailable),
+		MinAvailable:   convertIntOrString(in.MinAvailable),
+	}
+}
+
+func convertPredicate(in *kuadrantv1.Predicate) *Predicate {
+	if in == nil {
+		return nil
+	}
+	return &Predicate{
+		Predicate: in.Predicate,
+	}
+}
+
+func convertPrefixed(in *authorinov1beta3.Prefixed) *Prefixed {
+	if in == nil {
+		return nil
+	}
+	return &Prefixed{
+		Prefix: in.Prefix,
+	}
+}
+
+func convertProviderRecordConditions(in *kuadrantv1.ProviderRecordConditions) *ProviderRecordConditions {
+	if in == nil {
+		return nil
+	}
+	return &ProviderRecordConditions{
+		Name:             in.Name,
+		RecordConditions: convertMap(in.RecordConditions, convertConditionList),
+	}
+}
+
+func convertProviderRef(in *kuadrantdnsv1alpha1.ProviderRef) *ProviderRef {
+	if in == nil {
+		return nil
+	}
+	return &ProviderRef{
+		Name: in.Name,
+	}
+}
+
+func convertRate(in *kuadrantv1.Rate) *Rate {
+	if in == nil {
+		return nil
+	}
+	return &Rate{
+		Limit:  int64(in.Limit),
+		Window: string(in.Window),
+	}
+}
+
+// ConvertRateLimitPolicy converts a kuadrantv1.RateLimitPolicy into its protobuf representation
+func ConvertRateLimitPolicy(in *kuadrantv1.RateLimitPolicy) *RateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	return &RateLimitPolicy{
+		Metadata: convertObjectMeta("kuadrant.io", "RateLimitPolicy", &in.ObjectMeta),
+		Spec:     convertRateLimitPolicySpec(&in.Spec),
+		Status:   convertRateLimitPolicyStatus(&in.Status),
+	}
+}
+
+func convertRateLimitPolicySpec(in *kuadrantv1.RateLimitPolicySpec) *RateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &RateLimitPolicySpec{
+		TargetRef:   convertLocalPolicyTargetReferenceWithSectionName(&in.TargetRef),
+		Defaults:    convertMergeableRateLimitPolicySpec(in.Defaults),
+		Overrides:   convertMergeableRateLimitPolicySpec(in.Overrides),
+		When:        convertList(in.RateLimitPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Limits:      convertMap(in.RateLimitPolicySpecProper.Limits, convertLimit),
+		Mode:        string(in.RateLimitPolicySpecProper.Mode),
+		FailureMode: string(in.RateLimitPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.RateLimitPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertRateLimitPolicyStatus(in *kuadrantv1.RateLimitPolicyStatus) *RateLimitPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	return &RateLimitPolicyStatus{
+		ObservedGeneration: in.ObservedGeneration,
+		Conditions:         convertList(in.Conditions, convertCondition),
+		ShadowLimits:       stringList(in.ShadowLimits),
+	}
+}
+
+func convertRedis(in *limitadorv1alpha1.Redis) *Redis {
+	if in == nil {
+		return nil
+	}
+	return &Redis{
+		ConfigSecretRef: convertCoreLocalObjectReference(in.ConfigSecretRef),
+	}
+}
+
+func convertRedisCached(in *limitadorv1alpha1.RedisCached) *RedisCached {
+	if in == nil {
+		return nil
+	}
+	return &RedisCached{
+		ConfigSecretRef: convertCoreLocalObjectReference(in.ConfigSecretRef),
+		Options:         convertRedisCachedOptions(in.Options),
+	}
+}
+
+func convertRedisCachedOptions(in *limitadorv1alpha1.RedisCachedOptions) *RedisCachedOptions {
+	if in == nil {
+		return nil
+	}
+	return &RedisCachedOptions{
+		FlushPeriod:     optionalInt64(in.FlushPeriod),
+		MaxCached:       optionalInt64(in.MaxCached),
+		ResponseTimeout: optionalInt64(in.ResponseTimeout),
+		BatchSize:       optionalInt64(in.BatchSize),
+	}
+}
+
+func convertRemoteExtension(in *kuadrantv1beta1.RemoteExtension) *RemoteExtension {
+	if in == nil {
+		return nil
+	}
+	return &RemoteExtension{
+		Name: in.Name,
+	}
+}
+
+func convertResourceClaim(in *corev1.ResourceClaim) *ResourceClaim {
+	if in == nil {
+		return nil
+	}
+	return &ResourceClaim{
+		Name:    in.Name,
+		Request: in.Request,
+	}
+}
+
+func convertResourceRequirements(in *corev1.ResourceRequirements) *ResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	return &ResourceRequirements{
+		Limits:   convertMap(in.Limits, convertQuantity),
+		Requests: convertMap(in.Requests, convertQuantity),
+		Claims:   convertList(in.Claims, convertResourceClaim),
+	}
+}
+
+func convertRouteGroupKind(in *gatewayapiv1.RouteGroupKind) *RouteGroupKind {
+	if in == nil {
+		return nil
+	}
+	return &RouteGroupKind{
+		Group: optionalString(in.Group),
+		Kind:  string(in.Kind),
+	}
+}
+
+func convertRouteNamespaces(in *gatewayapiv1.RouteNamespaces) *RouteNamespaces {
+	if in == nil {
+		return nil
+	}
+	return &RouteNamespaces{
+		From:     optionalString(in.From),
+		Selector: convertLabelSelector(in.Selector),
+	}
+}
+
+func convertRouteParentStatus(in *gatewayapiv1.RouteParentStatus) *RouteParentStatus {
+	if in == nil {
+		return nil
+	}
+	return &RouteParentStatus{
+		ParentRef:      convertParentReference(&in.ParentRef),
+		ControllerName: string(in.ControllerName),
+		Conditions:     convertList(in.Conditions, convertCondition),
+	}
+}
+
+func convertSecretKeyReference(in *authorinov1beta3.SecretKeyReference) *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	return &SecretKeyReference{
+		Name: in.Name,
+		Key:  in.Key,
+	}
+}
+
+func convertSecretObjectReference(in *gatewayapiv1.SecretObjectReference) *SecretObjectReference {
+	if in == nil {
+		return nil
+	}
+	return &SecretObjectReference{
+		Group:     optionalString(in.Group),
+		Kind:      optionalString(in.Kind),
+		Name:      string(in.Name),
+		Namespace: optionalString(in.Namespace),
+	}
+}
+
+func convertServiceEndpoint(in *kuadrantv1beta1.ServiceEndpoint) *ServiceEndpoint {
+	if in == nil {
+		return nil
+	}
+	return &ServiceEndpoint{
+		Host: in.Host,
+		Port: in.Port,
+	}
+}
+
+func convertSessionPersistence(in *gatewayapiv1.SessionPersistence) *SessionPersistence {
+	if in == nil {
+		return nil
+	}
+	return &SessionPersistence{
+		SessionName:     optionalString(in.SessionName),
+		AbsoluteTimeout: optionalString(in.AbsoluteTimeout),
+		IdleTimeout:     optionalString(in.IdleTimeout),
+		Type:            optionalString(in.Type),
+		CookieConfig:    convertCookieConfig(in.CookieConfig),
+	}
+}
+
+func convertSpiceDBAuthorizationSpec(in *authorinov1beta3.SpiceDBAuthorizationSpec) *SpiceDBAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	return &SpiceDBAuthorizationSpec{
+		Endpoint:        in.Endpoint,
+		Insecure:        in.Insecure,
+		SharedSecretRef: convertSecretKeyReference(in.SharedSecret),
+		Subject:         convertSpiceDBObject(in.Subject),
+		Resource:        convertSpiceDBObject(in.Resource),
+		Permission:      convertValueOrSelector(&in.Permission),
+	}
+}
+
+func convertSpiceDBObject(in *authorinov1beta3.SpiceDBObject) *SpiceDBObject {
+	if in == nil {
+		return nil
+	}
+	return &SpiceDBObject{
+		Name: convertValueOrSelector(&in.Name),
+		Kind: convertValueOrSelector(&in.Kind),
+	}
+}
+
+func convertStorage(in *limitadorv1alpha1.Storage) *Storage {
+	if in == nil {
+		return nil
+	}
+	return &Storage{
+		Redis:       convertRedis(in.Redis),
+		RedisCached: convertRedisCached(in.RedisCached),
+		Disk:        convertDiskSpec(in.Disk),
+	}
+}
+
+func convertSupportedFeature(in *gatewayapiv1.SupportedFeature) *SupportedFeature {
+	if in == nil {
+		return nil
+	}
+	return &SupportedFeature{
+		Name: string(in.Name),
+	}
+}
+
+// ConvertTLSPolicy converts a kuadrantv1.TLSPolicy into its protobuf representation
+func ConvertTLSPolicy(in *kuadrantv1.TLSPolicy) *TLSPolicy {
+	if in == nil {
+		return nil
+	}
+	return &TLSPolicy{
+		Metadata: convertObjectMeta("kuadrant.io", "TLSPolicy", &in.ObjectMeta),
+		Spec:     convertTLSPolicySpec(&in.Spec),
+		Status:   convertTLSPolicyStatus(&in.Status),
+	}
+}
+
+func convertTLSPolicySpec(in *kuadrantv1.TLSPolicySpec) *TLSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &TLSPolicySpec{
+		TargetRef:            convertLocalPolicyTargetReferenceWithSectionName(&in.TargetRef),
+		RouteHostnames:       in.RouteHostnames,
+		IssuerRef:            convertCertManagerObjectReference(&in.CertificateSpec.IssuerRef),
+		CommonName:           in.CertificateSpec.CommonName,
+		Duration:             convertDuration(in.CertificateSpec.Duration),
+		RenewBefore:          convertDuration(in.CertificateSpec.RenewBefore),
+		Usages:               stringList(in.CertificateSpec.Usages),
+		RevisionHistoryLimit: optionalInt32(in.CertificateSpec.RevisionHistoryLimit),
+		PrivateKey:           convertCertificatePrivateKey(in.CertificateSpec.PrivateKey),
+	}
+}
+
+func convertTLSPolicyStatus(in *kuadrantv1.TLSPolicyStatus) *TLSPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	return &TLSPolicyStatus{
+		Conditions:         convertList(in.Conditions, convertCondition),
+		ObservedGeneration: in.ObservedGeneration,
+		Certificates:       convertList(in.Certificates, convertCertificateStatus),
+	}
+}
+
+func convertTls(in *authorinooperatorv1beta1.Tls) *Tls {
+	if in == nil {
+		return nil
+	}
+	return &Tls{
+		Enabled:       optionalBool(in.Enabled),
+		CertSecretRef: convertCoreLocalObjectReference(in.CertSecret),
+	}
+}
+
+func convertTokenLimit(in *kuadrantv1alpha1.TokenLimit) *TokenLimit {
+	if in == nil {
+		return nil
+	}
+	return &TokenLimit{
+		When:     convertList(in.When, convertPredicate),
+		Rates:    convertList(in.Rates, convertRate),
+		Counters: convertList(in.Counters, convertCounter),
+		Usage:    convertTokenUsage(in.Usage),
+	}
+}
+
+// ConvertTokenRateLimitPolicy converts a kuadrantv1alpha1.TokenRateLimitPolicy into its protobuf representation
+func ConvertTokenRateLimitPolicy(in *kuadrantv1alpha1.TokenRateLimitPolicy) *TokenRateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	return &TokenRateLimitPolicy{
+		Metadata: convertObjectMeta("kuadrant.io", "TokenRateLimitPolicy", &in.ObjectMeta),
+		Spec:     convertTokenRateLimitPolicySpec(&in.Spec),
+		Status:   convertTokenRateLimitPolicyStatus(&in.Status),
+	}
+}
+
+func convertTokenRateLimitPolicySpec(in *kuadrantv1alpha1.TokenRateLimitPolicySpec) *TokenRateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return &TokenRateLimitPolicySpec{
+		TargetRef:   convertLocalPolicyTargetReferenceWithSectionName(&in.TargetRef),
+		Defaults:    convertMergeableTokenRateLimitPolicySpec(in.Defaults),
+		Overrides:   convertMergeableTokenRateLimitPolicySpec(in.Overrides),
+		When:        convertList(in.TokenRateLimitPolicySpecProper.MergeableWhenPredicates.Predicates, convertPredicate),
+		Limits:      convertMap(in.TokenRateLimitPolicySpecProper.Limits, convertTokenLimit),
+		FailureMode: string(in.TokenRateLimitPolicySpecProper.MergeableServiceSettings.FailureMode),
+		Timeout:     optionalString(in.TokenRateLimitPolicySpecProper.MergeableServiceSettings.Timeout),
+	}
+}
+
+func convertTokenRateLimitPolicyStatus(in *kuadrantv1alpha1.TokenRateLimitPolicyStatus) *TokenRateLimitPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	return &TokenRateLimitPolicyStatus{
+		ObservedGeneration: in.ObservedGeneration,
+		Conditions:         convertList(in.Conditions, convertCondition),
+		StreamingLimits:    stringList(in.StreamingLimits),
+	}
+}
+
+func convertTokenUsage(in *kuadrantv1alpha1.TokenUsage) *TokenUsage {
+	if in == nil {
+		return nil
+	}
+	return &TokenUsage{
+		JsonPointer: in.JSONPointer,
+		Expression:  string(in.Expression),
+		Streaming:   in.Streaming,
+	}
+}
+
+func convertTracing(in *authorinooperatorv1beta1.Tracing) *Tracing {
+	if in == nil {
+		return nil
+	}
+	return &Tracing{
+		Endpoint: in.Endpoint,
+		Tags:     stringMap(in.Tags),
+		Insecure: in.Insecure,
+	}
+}
+
+func convertUmaMetadataSpec(in *authorinov1beta3.UmaMetadataSpec) *UmaMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	return &UmaMetadataSpec{
+		Endpoint:       in.Endpoint,
+		CredentialsRef: convertCoreLocalObjectReference(in.Credentials),
+	}
+}
+
+func convertUnstructuredPatternExpressionOrRef(in *authorinov1beta3.UnstructuredPatternExpressionOrRef) *UnstructuredPatternExpressionOrRef {
+	if in == nil {
+		return nil
+	}
+	return &UnstructuredPatternExpressionOrRef{
+		Selector:   in.PatternExpressionOrRef.PatternExpression.Selector,
+		Operator:   string(in.PatternExpressionOrRef.PatternExpression.Operator),
+		Value:      in.PatternExpressionOrRef.PatternExpression.Value,
+		PatternRef: in.PatternExpressionOrRef.PatternRef.Name,
+		Predicate:  in.PatternExpressionOrRef.CelPredicate.Predicate,
+		All:        convertList(in.PatternExpressionOrRef.All, convertUnstructuredPatternExpressionOrRef),
+		Any:        convertList(in.PatternExpressionOrRef.Any, convertUnstructuredPatternExpressionOrRef),
+	}
+}
+
+func convertUserInfoMetadataSpec(in *authorinov1beta3.UserInfoMetadataSpec) *UserInfoMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	return &UserInfoMetadataSpec{
+		IdentitySource: in.IdentitySource,
+		UserInfoUrl:    in.UserInfoUrl,
+	}
+}
+
+func convertValueOrSelector(in *authorinov1beta3.ValueOrSelector) *ValueOrSelector {
+	if in == nil {
+		return nil
+	}
+	return &ValueOrSelector{
+		Value:      convertRawExtension(&in.Value),
+		Selector:   in.Selector,
+		Expression: string(in.Expression),
+	}
+}
+
+func convertWristbandAuthResponseSpec(in *authorinov1beta3.WristbandAuthResponseSpec) *WristbandAuthResponseSpec {
+	if in == nil {
+		return nil
+	}
+	return &WristbandAuthResponseSpec{
+		Issuer:         in.Issuer,
+		CustomClaims:   convertMap(in.CustomClaims, convertValueOrSelector),
+		TokenDuration:  optionalInt64(in.TokenDuration),
+		SigningKeyRefs: convertPtrList(in.SigningKeyRefs, convertWristbandSigningKeyRef),
+	}
+}
+
+func convertWristbandSigningKeyRef(in *authorinov1beta3.WristbandSigningKeyRef) *WristbandSigningKeyRef {
+	if in == nil {
+		return nil
+	}
+	return &WristbandSigningKeyRef{
+		Name:      in.Name,
+		Algorithm: string(in.Algorithm),
+	}
+}
+
+func convertX509ClientCertificateAuthenticationSpec(in *authorinov1beta3.X509ClientCertificateAuthenticationSpec) *X509ClientCertificateAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	return &X509ClientCertificateAuthenticationSpec{
+		Selector:      convertLabelSelector(in.Selector),
+		AllNamespaces: in.AllNamespaces,
+	}
+}
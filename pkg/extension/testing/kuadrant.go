@@ -0,0 +1,300 @@
+// Package testing provides an in-process kuadrant-operator to unit test extensions against, without a cluster.
+//
+// The extension service of kuadrant-operator is served over an in-memory connection and backed by a synthetic
+// topology, so the expressions an extension resolves, the data it adds, its subscriptions and the statuses it reports
+// behave as they do against kuadrant-operator. The objects the extension reconciles are stored in a fake client.
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kuadrant/policy-machinery/machinery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kuadrantv1 "github.com/kuadrant/kuadrant-operator/api/v1"
+	kuadrantv1alpha1 "github.com/kuadrant/kuadrant-operator/api/v1alpha1"
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	"github.com/kuadrant/kuadrant-operator/internal/extension"
+	extcontroller "github.com/kuadrant/kuadrant-operator/pkg/extension/controller"
+	extpb "github.com/kuadrant/kuadrant-operator/pkg/extension/grpc/v1"
+	exttypes "github.com/kuadrant/kuadrant-operator/pkg/extension/types"
+	extutils "github.com/kuadrant/kuadrant-operator/pkg/extension/utils"
+)
+
+const bufSize = 1024 * 1024
+
+// Mutator is the data an extension added to a domain for the objects a policy targets
+type Mutator struct {
+	// TargetRef is the locator of the object targeted, e.g. gateway.networking.k8s.io:Gateway:default/my-gateway
+	TargetRef  string
+	Domain     exttypes.Domain
+	Binding    string
+	Expression string
+}
+
+// Kuadrant is an in-process kuadrant-operator
+type Kuadrant struct {
+	service     *extension.InProcessService
+	server      *grpc.Server
+	listener    *bufconn.Listener
+	conn        *grpc.ClientConn
+	client      client.Client
+	scheme      *runtime.Scheme
+	logger      logr.Logger
+	kuadrantCtx *extcontroller.ExtensionController
+
+	objects []client.Object
+
+	mu            sync.Mutex
+	notifications []string
+}
+
+type Option func(*Kuadrant)
+
+// WithScheme sets the scheme of the objects the extension reconciles. It defaults to the kubernetes, gateway api and
+// kuadrant types.
+func WithScheme(scheme *runtime.Scheme) Option {
+	return func(k *Kuadrant) {
+		k.scheme = scheme
+	}
+}
+
+// WithObjects stores the objects in the fake client, e.g. the policies of the extension
+func WithObjects(objects ...client.Object) Option {
+	return func(k *Kuadrant) {
+		k.objects = append(k.objects, objects...)
+	}
+}
+
+// WithClient replaces the fake client the extension reconciles objects with
+func WithClient(c client.Client) Option {
+	return func(k *Kuadrant) {
+		k.client = c
+	}
+}
+
+func WithLogger(logger logr.Logger) Option {
+	return func(k *Kuadrant) {
+		k.logger = logger
+	}
+}
+
+// NewKuadrant starts an in-process kuadrant-operator backed by the topology. Close it once done.
+func NewKuadrant(topology *machinery.Topology, opts ...Option) (*Kuadrant, error) {
+	k := &Kuadrant{logger: logr.Discard()}
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	if k.scheme == nil {
+		scheme, err := defaultScheme()
+		if err != nil {
+			return nil, err
+		}
+		k.scheme = scheme
+	}
+	if k.client == nil {
+		k.client = fake.NewClientBuilder().
+			WithScheme(k.scheme).
+			WithObjects(k.objects...).
+			WithStatusSubresource(k.objects...).
+			Build()
+	}
+
+	k.service = extension.NewInProcessService(k.logger)
+	k.service.SetChangeNotifier(k.notify)
+	k.service.SetTopology(topology)
+
+	k.listener = bufconn.Listen(bufSize)
+	k.server = grpc.NewServer()
+	extpb.RegisterExtensionServiceServer(k.server, k.service)
+	go func() {
+		_ = k.server.Serve(k.listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///kuadrant",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return k.listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		k.server.Stop()
+		return nil, err
+	}
+	k.conn = conn
+	k.kuadrantCtx = extcontroller.NewKuadrantCtx(conn, k.client, k.scheme, k.logger)
+
+	return k, nil
+}
+
+// KuadrantCtx returns the KuadrantCtx to pass to the reconcile function of the extension
+func (k *Kuadrant) KuadrantCtx() exttypes.KuadrantCtx {
+	return k.kuadrantCtx
+}
+
+// Context returns the context to pass to the reconcile function of the extension, which holds the logger, client and
+// scheme of the extension
+func (k *Kuadrant) Context(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, logr.Logger{}, k.logger)
+	ctx = context.WithValue(ctx, extutils.SchemeKey, k.scheme)
+	ctx = context.WithValue(ctx, extutils.ClientKey, k.client)
+	return ctx
+}
+
+// Client returns the client the extension reconciles objects with
+func (k *Kuadrant) Client() client.Client {
+	return k.client
+}
+
+// SetTopology replaces the topology, which notifies the subscriptions whose value changed
+func (k *Kuadrant) SetTopology(topology *machinery.Topology) {
+	k.service.SetTopology(topology)
+}
+
+// Subscribe returns the events of the subscriptions of the policies of the kind, until the context is done. The
+// topology changes from the moment it returns are notified.
+func (k *Kuadrant) Subscribe(ctx context.Context, policyKind string) (<-chan *extpb.Event, error) {
+	if policyKind == "" {
+		return nil, errors.New("policy kind is required")
+	}
+	subscribers := k.service.Subscribers()
+	stream, err := extpb.NewExtensionServiceClient(k.conn).Subscribe(ctx, &extpb.SubscribeRequest{PolicyKind: policyKind})
+	if err != nil {
+		return nil, err
+	}
+	// the stream is served asynchronously
+	for k.service.Subscribers() == subscribers {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	events := make(chan *extpb.Event)
+	go func() {
+		defer close(events)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case events <- response.GetEvent():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// Mutators returns the data the extension added for the policy
+func (k *Kuadrant) Mutators(policy exttypes.Policy) ([]Mutator, error) {
+	data, err := k.service.PolicyData(resourceID(policy))
+	if err != nil {
+		return nil, err
+	}
+	mutators := make([]Mutator, 0, len(data.Mutators))
+	for _, m := range data.Mutators {
+		mutators = append(mutators, Mutator{
+			TargetRef:  m.TargetRefLocator,
+			Domain:     convertDomainFromProtobuf(m.Domain),
+			Binding:    m.Binding,
+			Expression: m.Expression,
+		})
+	}
+	return mutators, nil
+}
+
+// Subscriptions returns the expressions the extension subscribed to for the policy
+func (k *Kuadrant) Subscriptions(policy exttypes.Policy) ([]string, error) {
+	data, err := k.service.PolicyData(resourceID(policy))
+	if err != nil {
+		return nil, err
+	}
+	expressions := make([]string, 0, len(data.Subscriptions))
+	for _, s := range data.Subscriptions {
+		expressions = append(expressions, s.Expression)
+	}
+	return expressions, nil
+}
+
+// ReportedConditions returns the conditions the extension reported for the policy, if any
+func (k *Kuadrant) ReportedConditions(policy exttypes.Policy) ([]metav1.Condition, bool) {
+	status, ok := k.service.ReportedStatus(resourceID(policy))
+	return status.Conditions, ok
+}
+
+// Notifications returns the reasons of the reconciliations kuadrant-operator was asked for by the extension, e.g. when
+// it added or cleared data
+func (k *Kuadrant) Notifications() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return append([]string(nil), k.notifications...)
+}
+
+func (k *Kuadrant) Close() error {
+	err := k.conn.Close()
+	k.server.Stop()
+	return errors.Join(err, k.listener.Close())
+}
+
+func (k *Kuadrant) notify(reason string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.notifications = append(k.notifications, reason)
+	return nil
+}
+
+func resourceID(policy exttypes.Policy) extension.ResourceID {
+	return extension.ResourceID{
+		Kind:      policy.GetObjectKind().GroupVersionKind().Kind,
+		Namespace: policy.GetNamespace(),
+		Name:      policy.GetName(),
+	}
+}
+
+func convertDomainFromProtobuf(domain extpb.Domain) exttypes.Domain {
+	switch domain {
+	case extpb.Domain_DOMAIN_AUTH:
+		return exttypes.DomainAuth
+	case extpb.Domain_DOMAIN_REQUEST:
+		return exttypes.DomainRequest
+	case extpb.Domain_DOMAIN_RESPONSE:
+		return exttypes.DomainResponse
+	default:
+		return exttypes.DomainUnspecified
+	}
+}
+
+func defaultScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		gatewayapiv1.Install,
+		kuadrantv1.AddToScheme,
+		kuadrantv1alpha1.AddToScheme,
+		kuadrantv1beta1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, fmt.Errorf("failed to build scheme: %w", err)
+		}
+	}
+	return scheme, nil
+}
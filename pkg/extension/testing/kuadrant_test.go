@@ -0,0 +1,138 @@
+//go:build unit
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kuadrant/policy-machinery/machinery"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+	"github.com/kuadrant/kuadrant-operator/cmd/extensions/telemetry-policy/api/v1alpha1"
+	exttesting "github.com/kuadrant/kuadrant-operator/pkg/extension/testing"
+	exttypes "github.com/kuadrant/kuadrant-operator/pkg/extension/types"
+)
+
+func testGateway(listeners ...string) *gatewayapiv1.Gateway {
+	gateway := &gatewayapiv1.Gateway{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gatewayapiv1.GroupVersion.String(), Kind: "Gateway"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-gateway", Namespace: "default"},
+		Spec:       gatewayapiv1.GatewaySpec{GatewayClassName: "istio"},
+	}
+	for _, name := range listeners {
+		gateway.Spec.Listeners = append(gateway.Spec.Listeners, gatewayapiv1.Listener{
+			Name:     gatewayapiv1.SectionName(name),
+			Hostname: ptr.To(gatewayapiv1.Hostname(name + ".example.com")),
+			Port:     80,
+			Protocol: gatewayapiv1.HTTPProtocolType,
+		})
+	}
+	return gateway
+}
+
+func testTopology(t *testing.T, listeners ...string) *machinery.Topology {
+	t.Helper()
+	topology, err := exttesting.NewTopology(
+		&gatewayapiv1.GatewayClass{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gatewayapiv1.GroupVersion.String(), Kind: "GatewayClass"},
+			ObjectMeta: metav1.ObjectMeta{Name: "istio"},
+		},
+		testGateway(listeners...),
+		&kuadrantv1beta1.Kuadrant{
+			TypeMeta:   metav1.TypeMeta{APIVersion: kuadrantv1beta1.GroupVersion.String(), Kind: "Kuadrant"},
+			ObjectMeta: metav1.ObjectMeta{Name: "kuadrant", Namespace: "kuadrant-system"},
+		},
+	)
+	assert.NilError(t, err)
+	return topology
+}
+
+func testPolicy() *v1alpha1.TelemetryPolicy {
+	return &v1alpha1.TelemetryPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: "TelemetryPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "telemetry", Namespace: "default"},
+		Spec: v1alpha1.TelemetryPolicySpec{
+			TargetRef: gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: gatewayapiv1alpha2.LocalPolicyTargetReference{
+					Group: gatewayapiv1.GroupName,
+					Kind:  "Gateway",
+					Name:  "my-gateway",
+				},
+			},
+		},
+	}
+}
+
+func TestKuadrant(t *testing.T) {
+	kuadrant, err := exttesting.NewKuadrant(testTopology(t, "api"))
+	assert.NilError(t, err)
+	defer kuadrant.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	policy := testPolicy()
+
+	listeners := "self.findListeners().size()"
+	val, err := kuadrant.KuadrantCtx().Resolve(ctx, policy, listeners, true)
+	assert.NilError(t, err)
+	assert.Equal(t, val.Value(), int64(1))
+	subscriptions, err := kuadrant.Subscriptions(policy)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, subscriptions, []string{listeners})
+
+	events, err := kuadrant.Subscribe(ctx, "TelemetryPolicy")
+	assert.NilError(t, err)
+	kuadrant.SetTopology(testTopology(t, "api", "admin"))
+	select {
+	case event := <-events:
+		assert.Equal(t, event.GetMetadata().GetName(), "telemetry")
+	case <-ctx.Done():
+		t.Fatal("the subscription was not notified of the new listener")
+	}
+
+	assert.NilError(t, kuadrant.KuadrantCtx().AddDataTo(ctx, policy, exttypes.DomainRequest, "metrics.labels.user", "auth.identity.userid"))
+	mutators, err := kuadrant.Mutators(policy)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, mutators, []exttesting.Mutator{{
+		TargetRef:  "gateway.gateway.networking.k8s.io:default/my-gateway",
+		Domain:     exttypes.DomainRequest,
+		Binding:    "metrics.labels.user",
+		Expression: "auth.identity.userid",
+	}})
+	assert.DeepEqual(t, kuadrant.Notifications(), []string{"mutator registered for policy default/telemetry"})
+
+	_, err = kuadrant.KuadrantCtx().ReportStatus(ctx, policy, []metav1.Condition{{Type: "Enforced", Status: metav1.ConditionTrue, Reason: "Enforced"}})
+	assert.NilError(t, err)
+	conditions, reported := kuadrant.ReportedConditions(policy)
+	assert.Assert(t, reported)
+	assert.Assert(t, meta.IsStatusConditionTrue(conditions, "Enforced"))
+}
+
+func TestKuadrantReconcileObject(t *testing.T) {
+	kuadrant, err := exttesting.NewKuadrant(testTopology(t))
+	assert.NilError(t, err)
+	defer kuadrant.Close()
+	ctx := kuadrant.Context(context.Background())
+
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+		Data:       map[string]string{"key": "value"},
+	}
+	_, err = kuadrant.KuadrantCtx().ReconcileObject(ctx, &corev1.ConfigMap{}, desired, func(_, _ client.Object) (bool, error) {
+		return false, nil
+	})
+	assert.NilError(t, err)
+
+	configMap := &corev1.ConfigMap{}
+	assert.NilError(t, kuadrant.Client().Get(ctx, client.ObjectKeyFromObject(desired), configMap))
+	assert.Equal(t, configMap.Data["key"], "value")
+}
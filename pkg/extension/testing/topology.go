@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"fmt"
+
+	"github.com/kuadrant/policy-machinery/controller"
+	"github.com/kuadrant/policy-machinery/machinery"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	kuadrantv1beta1 "github.com/kuadrant/kuadrant-operator/api/v1beta1"
+)
+
+// NewTopology builds a topology of gateway classes, gateways, http and grpc routes, services, kuadrant policies and
+// Kuadrant CRs, linked the way kuadrant-operator links them. The objects must have their kind set.
+func NewTopology(objects ...client.Object) (*machinery.Topology, error) {
+	var (
+		gatewayClasses []*gatewayapiv1.GatewayClass
+		gateways       []*gatewayapiv1.Gateway
+		httpRoutes     []*gatewayapiv1.HTTPRoute
+		grpcRoutes     []*gatewayapiv1.GRPCRoute
+		services       []*corev1.Service
+		policies       []machinery.Policy
+		others         []machinery.Object
+	)
+	store := make(controller.Store)
+
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *gatewayapiv1.GatewayClass:
+			gatewayClasses = append(gatewayClasses, o)
+		case *gatewayapiv1.Gateway:
+			gateways = append(gateways, o)
+		case *gatewayapiv1.HTTPRoute:
+			httpRoutes = append(httpRoutes, o)
+		case *gatewayapiv1.GRPCRoute:
+			grpcRoutes = append(grpcRoutes, o)
+		case *corev1.Service:
+			services = append(services, o)
+		case *kuadrantv1beta1.Kuadrant:
+			others = append(others, o)
+			store[o.GetNamespace()+"/"+o.GetName()] = o
+		case machinery.Policy:
+			policies = append(policies, o)
+		default:
+			return nil, fmt.Errorf("unsupported object %T", obj)
+		}
+	}
+
+	return machinery.NewGatewayAPITopology(
+		machinery.WithGatewayClasses(gatewayClasses...),
+		machinery.WithGateways(gateways...),
+		machinery.ExpandGatewayListeners(),
+		machinery.WithHTTPRoutes(httpRoutes...),
+		machinery.ExpandHTTPRouteRules(),
+		machinery.WithGRPCRoutes(grpcRoutes...),
+		machinery.ExpandGRPCRouteRules(),
+		machinery.WithServices(services...),
+		machinery.WithGatewayAPITopologyPolicies(policies...),
+		machinery.WithGatewayAPITopologyObjects(others...),
+		machinery.WithGatewayAPITopologyLinks(kuadrantv1beta1.LinkKuadrantToGatewayClasses(store)),
+	)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/go-logr/logr"
 	celref "github.com/google/cel-go/common/types/ref"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -28,6 +30,7 @@ const (
 	DomainUnspecified Domain = iota
 	DomainAuth
 	DomainRequest
+	DomainResponse
 )
 
 type Policy interface {
@@ -37,11 +40,51 @@ type Policy interface {
 	GetTargetRefs() []gatewayapiv1alpha2.LocalPolicyTargetReferenceWithSectionName
 }
 
+// PolicyStatus is the status of a policy of an extension, which reflects the generation of the policy it was observed
+// for and the Accepted and Enforced conditions of the policy
+type PolicyStatus interface {
+	GetObservedGeneration() int64
+	SetObservedGeneration(int64)
+	GetConditions() []metav1.Condition
+	SetConditions([]metav1.Condition)
+}
+
+// StatusPolicy is a policy of an extension with a PolicyStatus
+type StatusPolicy interface {
+	Policy
+	client.Object
+	// GetPolicyStatus returns the status of the policy, which updates the policy when set
+	GetPolicyStatus() PolicyStatus
+}
+
+// StatusEquals compares two statuses, regardless of the order of their conditions
+func StatusEquals(status, other PolicyStatus, logger logr.Logger) bool {
+	if status.GetObservedGeneration() != other.GetObservedGeneration() {
+		diff := cmp.Diff(status.GetObservedGeneration(), other.GetObservedGeneration())
+		logger.V(1).Info("status observedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := kuadrant.ConditionMarshal(status.GetConditions())
+	otherMarshaledJSON, _ := kuadrant.ConditionMarshal(other.GetConditions())
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("status conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
 type KuadrantCtx interface {
 	Resolve(context.Context, Policy, string, bool) (celref.Val, error)
 	ResolvePolicy(context.Context, Policy, string, bool) (Policy, error)
 	AddDataTo(context.Context, Policy, Domain, string, string) error
 	ReconcileObject(context.Context, client.Object, client.Object, MutateFn) (client.Object, error)
+	// ReportStatus publishes the conditions of a policy, surfaced on the objects it targets, and returns them along
+	// with the ones of the Kuadrant policies it owns
+	ReportStatus(context.Context, Policy, []metav1.Condition) ([]metav1.Condition, error)
 }
 
 type ReconcileFn func(ctx context.Context, request reconcile.Request, kuadrant KuadrantCtx) (reconcile.Result, error)
@@ -293,16 +293,40 @@ var _ = Describe("DNSPolicy controller", func() {
 			g.Expect(k8sClient.Update(ctx, dnsPolicy)).To(Succeed())
 		}, tests.TimeoutMedium, time.Second).Should(Succeed())
 
-		// should not allow adding another providerRef
+		// should allow adding another providerRef
 		Eventually(func(g Gomega) {
 			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dnsPolicy), dnsPolicy)
 			g.Expect(err).NotTo(HaveOccurred())
 			dnsPolicy.Spec.ProviderRefs = append(dnsPolicy.Spec.ProviderRefs, kuadrantdnsv1alpha1.ProviderRef{
 				Name: "some-other-provider-secret",
 			})
+			g.Expect(k8sClient.Update(ctx, dnsPolicy)).To(Succeed())
+		}, tests.TimeoutMedium, time.Second).Should(Succeed())
+
+		// should not allow duplicated providerRefs
+		Eventually(func(g Gomega) {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dnsPolicy), dnsPolicy)
+			g.Expect(err).NotTo(HaveOccurred())
+			dnsPolicy.Spec.ProviderRefs = append(dnsPolicy.Spec.ProviderRefs, kuadrantdnsv1alpha1.ProviderRef{
+				Name: "some-other-provider-secret",
+			})
+			err = k8sClient.Update(ctx, dnsPolicy)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err).To(MatchError(ContainSubstring("providerRefs must be unique")))
+		}, tests.TimeoutMedium, time.Second).Should(Succeed())
+
+		// should not allow more than 5 providerRefs
+		Eventually(func(g Gomega) {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dnsPolicy), dnsPolicy)
+			g.Expect(err).NotTo(HaveOccurred())
+			for i := range 4 {
+				dnsPolicy.Spec.ProviderRefs = append(dnsPolicy.Spec.ProviderRefs, kuadrantdnsv1alpha1.ProviderRef{
+					Name: fmt.Sprintf("provider-secret-%d", i),
+				})
+			}
 			err = k8sClient.Update(ctx, dnsPolicy)
 			g.Expect(err).To(HaveOccurred())
-			g.Expect(err).To(MatchError(ContainSubstring("spec.providerRefs: Too many: 2: must have at most 1 items")))
+			g.Expect(err).To(MatchError(ContainSubstring("spec.providerRefs: Too many: 6: must have at most 5 items")))
 		}, tests.TimeoutMedium, time.Second).Should(Succeed())
 	}, testTimeOut)
 
@@ -197,6 +197,12 @@ var _ = Describe("wasm controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{
@@ -379,6 +385,12 @@ var _ = Describe("wasm controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{
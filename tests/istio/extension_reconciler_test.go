@@ -176,6 +176,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{
@@ -880,6 +886,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{
@@ -1121,6 +1133,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -1349,6 +1367,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -1495,6 +1519,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -1715,6 +1745,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -1824,6 +1860,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -2019,6 +2061,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -2146,6 +2194,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -2377,6 +2431,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -2501,6 +2561,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 							FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 							Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 						},
+						wasm.RateLimitShadowServiceName: {
+							Type:        wasm.RateLimitReportServiceType,
+							Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+							FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+							Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+						},
 					},
 					ActionSets: []wasm.ActionSet{
 						{
@@ -2690,6 +2756,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{
@@ -2786,6 +2858,12 @@ var _ = Describe("Rate Limiting WasmPlugin controller", func() {
 						FailureMode: wasm.RatelimitReportServiceFailureMode(&logger),
 						Timeout:     ptr.To(wasm.RatelimitReportServiceTimeout()),
 					},
+					wasm.RateLimitShadowServiceName: {
+						Type:        wasm.RateLimitReportServiceType,
+						Endpoint:    kuadrant.KuadrantRateLimitClusterName,
+						FailureMode: wasm.RatelimitServiceFailureMode(&logger),
+						Timeout:     ptr.To(wasm.RatelimitServiceTimeout()),
+					},
 				},
 				ActionSets: []wasm.ActionSet{
 					{